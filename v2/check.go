@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// FileDrift is a generated file whose content on disk differs from what the generator renders
+type FileDrift struct {
+	FilePath string
+	Missing  bool   // the file does not exist in the output folder
+	Stale    bool   // the file was generated for an object that no longer exists
+	Reason   string // for a stale file, the object it was generated for
+	Diff     string // unified diff from the file on disk to the rendered content
+}
+
+// writeGeneratedFile writes a generated file to the output folder. In -check mode nothing
+// gets written: the content is compared with the file on disk, and the differences are collected.
+// The file is recorded in the manifest, under the given owner.
+func (t *ToolOptions) writeGeneratedFile(owner ManifestFile, filePath string, source []byte) error {
+
+	t.addToManifest(owner, filePath, source)
+
+	if !t.CheckOnly {
+		return ioutil.WriteFile(filePath, source, 0644)
+	}
+
+	existing, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		t.Drift = append(t.Drift, FileDrift{FilePath: filePath, Missing: true})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(existing, source) {
+		t.Drift = append(t.Drift, FileDrift{
+			FilePath: filePath,
+			Diff:     UnifiedDiff(filePath+" (on disk)", filePath+" (generated)", string(existing), string(source)),
+		})
+	}
+
+	return nil
+}
+
+// PrintDrift writes the differences found in -check mode
+func (t *ToolOptions) PrintDrift(w io.Writer) {
+
+	fmt.Fprintln(w, "--------------------------------------------------------------------------------------------")
+	if len(t.Drift) == 0 {
+		fmt.Fprintln(w, "Check: the generated files in "+t.OutputFolder+" are up to date.")
+		fmt.Fprintln(w, "--------------------------------------------------------------------------------------------")
+		return
+	}
+
+	fmt.Fprintf(w, "Check: %d generated file(s) in %s are out of date, run pgtogogen to regenerate them.\n", len(t.Drift), t.OutputFolder)
+	fmt.Fprintln(w, "--------------------------------------------------------------------------------------------")
+
+	for _, drift := range t.Drift {
+		if drift.Missing {
+			fmt.Fprintln(w, "Missing file: "+drift.FilePath)
+			continue
+		}
+		if drift.Stale {
+			fmt.Fprintln(w, "Stale file: "+drift.FilePath+" (generated for the "+drift.Reason+", which no longer exists)")
+			continue
+		}
+		fmt.Fprint(w, drift.Diff)
+	}
+}
+
+// the number of unchanged lines shown around each change
+const diffContextLines = 3
+
+// past this number of differences, the diff shows the whole file as replaced
+const diffMaxEdits = 2000
+
+type diffOp struct {
+	kind  byte // ' ' unchanged, '-' removed, '+' added
+	aLine int  // index in the old lines, for the unchanged and the removed lines
+	bLine int  // index in the new lines, for the unchanged and the added lines
+}
+
+// UnifiedDiff returns the differences between two texts in the unified diff format,
+// or an empty string if they are identical
+func UnifiedDiff(aName, bName, a, b string) string {
+
+	if a == b {
+		return ""
+	}
+
+	aLines, bLines := splitLines(a), splitLines(b)
+	ops := diffLines(aLines, bLines)
+
+	var diff strings.Builder
+	diff.WriteString("--- " + aName + "\n")
+	diff.WriteString("+++ " + bName + "\n")
+
+	for start := 0; start < len(ops); {
+
+		// find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+
+		// extend the hunk as long as the changes are close enough to share their context
+		first := maxInt(start-diffContextLines, 0)
+		end := start
+		for {
+			for end < len(ops) && ops[end].kind != ' ' {
+				end++
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*diffContextLines {
+				break
+			}
+			end = next
+		}
+		last := minInt(end+diffContextLines, len(ops))
+
+		writeHunk(&diff, ops[first:last], aLines, bLines)
+		start = last
+	}
+
+	return diff.String()
+}
+
+func writeHunk(w *strings.Builder, ops []diffOp, aLines, bLines []string) {
+
+	aStart, bStart, aCount, bCount := -1, -1, 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			if aStart < 0 {
+				aStart = op.aLine
+			}
+			aCount++
+		}
+		if op.kind != '-' {
+			if bStart < 0 {
+				bStart = op.bLine
+			}
+			bCount++
+		}
+	}
+
+	fmt.Fprintf(w, "@@ -%s +%s @@\n", hunkRange(aStart, aCount, ops[0].aLine), hunkRange(bStart, bCount, ops[0].bLine))
+
+	for _, op := range ops {
+		switch op.kind {
+		case '-':
+			w.WriteString("-" + aLines[op.aLine] + "\n")
+		case '+':
+			w.WriteString("+" + bLines[op.bLine] + "\n")
+		default:
+			w.WriteString(" " + aLines[op.aLine] + "\n")
+		}
+	}
+}
+
+// hunkRange formats the 1-based start line and the line count of a hunk side;
+// an empty side is reported at the line before the change
+func hunkRange(start, count, fallback int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", fallback)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines computes the shortest edit script from a to b (Myers' algorithm)
+func diffLines(a, b []string) []diffOp {
+
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	found := false
+	for d := 0; d <= n+m && !found; d++ {
+
+		if d > diffMaxEdits {
+			return replaceAll(a, b)
+		}
+
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	// walk the trace backwards to recover the edits
+	var ops []diffOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{kind: ' ', aLine: x, bLine: y})
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, diffOp{kind: '+', aLine: x, bLine: y})
+		} else {
+			x--
+			ops = append(ops, diffOp{kind: '-', aLine: x, bLine: y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		ops = append(ops, diffOp{kind: ' ', aLine: x, bLine: y})
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+func replaceAll(a, b []string) []diffOp {
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for i := range a {
+		ops = append(ops, diffOp{kind: '-', aLine: i})
+	}
+	for i := range b {
+		ops = append(ops, diffOp{kind: '+', aLine: len(a), bLine: i})
+	}
+	return ops
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
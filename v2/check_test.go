@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{"identical", "a\nb\n", "a\nb\n", ""},
+		{"changed line", "a\nb\nc\n", "a\nx\nc\n", `--- old
++++ new
+@@ -1,3 +1,3 @@
+ a
+-b
++x
+ c
+`},
+		{"added to an empty file", "", "a\nb\n", `--- old
++++ new
+@@ -0,0 +1,2 @@
++a
++b
+`},
+		{"everything removed", "a\nb\n", "", `--- old
++++ new
+@@ -1,2 +0,0 @@
+-a
+-b
+`},
+		{"insertion past the context", "1\n2\n3\n4\n5\n6\n7\n8\n", "1\n2\n3\n4\n5\n6\n7\n8\n9\n", `--- old
++++ new
+@@ -6,3 +6,4 @@
+ 6
+ 7
+ 8
++9
+`},
+		{"two hunks", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n", "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n", `--- old
++++ new
+@@ -1,3 +1,4 @@
++0
+ 1
+ 2
+ 3
+@@ -9,4 +10,3 @@
+ 9
+ 10
+ 11
+-12
+`},
+		{"close changes share a hunk", "1\n2\n3\n4\n5\n6\n7\n", "1\nx\n3\n4\n5\n6\ny\n", `--- old
++++ new
+@@ -1,7 +1,7 @@
+ 1
+-2
++x
+ 3
+ 4
+ 5
+ 6
+-7
++y
+`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := UnifiedDiff("old", "new", test.a, test.b); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+// applyUnifiedDiff applies a diff produced by UnifiedDiff to the old lines, checking the context lines
+func applyUnifiedDiff(t *testing.T, a []string, diff string) []string {
+
+	var result []string
+	aPos := 0
+
+	lines := splitLines(diff)
+	for i := 2; i < len(lines); i++ {
+
+		line := lines[i]
+		if strings.HasPrefix(line, "@@ ") {
+			var aStart, aCount, bStart, bCount int
+			if _, err := fmt.Sscanf(line, "@@ -%d,%d +%d,%d @@", &aStart, &aCount, &bStart, &bCount); err != nil {
+				t.Fatalf("invalid hunk header %q: %s", line, err)
+			}
+			// an empty side is reported at the line before the change
+			if aCount > 0 {
+				aStart--
+			}
+			if aStart < aPos {
+				t.Fatalf("overlapping hunk %q", line)
+			}
+			result = append(result, a[aPos:aStart]...)
+			aPos = aStart
+			continue
+		}
+
+		switch line[0] {
+		case ' ', '-':
+			if aPos >= len(a) || a[aPos] != line[1:] {
+				t.Fatalf("line %q of the diff does not match the old text at line %d", line, aPos+1)
+			}
+			if line[0] == ' ' {
+				result = append(result, line[1:])
+			}
+			aPos++
+		case '+':
+			result = append(result, line[1:])
+		default:
+			t.Fatalf("unexpected diff line %q", line)
+		}
+	}
+
+	return append(result, a[aPos:]...)
+}
+
+// lcsLength returns the length of the longest common subsequence, to check that the diff is minimal
+func lcsLength(a, b []string) int {
+
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = maxInt(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	return lengths[0][0]
+}
+
+func TestUnifiedDiffRandom(t *testing.T) {
+
+	random := rand.New(rand.NewSource(1))
+	randomText := func() []string {
+		lines := make([]string, random.Intn(30))
+		for i := range lines {
+			lines[i] = strconv.Itoa(random.Intn(6))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+
+		a, b := randomText(), randomText()
+		aText, bText := strings.Join(append(a, ""), "\n"), strings.Join(append(b, ""), "\n")
+		if len(a) == 0 {
+			aText = ""
+		}
+		if len(b) == 0 {
+			bText = ""
+		}
+
+		diff := UnifiedDiff("a", "b", aText, bText)
+		if aText == bText {
+			if diff != "" {
+				t.Fatalf("identical texts gave a diff:\n%s", diff)
+			}
+			continue
+		}
+
+		if got := applyUnifiedDiff(t, a, diff); strings.Join(got, "\n") != strings.Join(b, "\n") {
+			t.Fatalf("applying the diff of\n%q\nto\n%q\ngave\n%q\ndiff:\n%s", a, b, got, diff)
+		}
+
+		edits := 0
+		for _, line := range splitLines(diff)[2:] {
+			if line[0] == '+' || line[0] == '-' {
+				edits++
+			}
+		}
+		if want := len(a) + len(b) - 2*lcsLength(a, b); edits != want {
+			t.Fatalf("the diff of %q and %q has %d edits, the shortest has %d:\n%s", a, b, edits, want, diff)
+		}
+	}
+}
+
+func TestDiffLinesTooManyEdits(t *testing.T) {
+
+	var a, b []string
+	for i := 0; i <= diffMaxEdits; i++ {
+		a = append(a, "a"+strconv.Itoa(i))
+		b = append(b, "b"+strconv.Itoa(i))
+	}
+
+	ops := diffLines(a, b)
+	if len(ops) != len(a)+len(b) || ops[0].kind != '-' || ops[len(ops)-1].kind != '+' {
+		t.Errorf("past %d edits the whole file should be replaced, got %d operations", diffMaxEdits, len(ops))
+	}
+}
+
+func TestWriteGeneratedFileCheckOnly(t *testing.T) {
+
+	outputFolder := tempDir(t)
+	upToDate, outdated, missing := filepath.Join(outputFolder, "a.go"), filepath.Join(outputFolder, "b.go"), filepath.Join(outputFolder, "c.go")
+	for filePath, content := range map[string]string{upToDate: "package models\n", outdated: "package models\n\nvar x = 1\n"} {
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	options := &ToolOptions{OutputFolder: outputFolder, CheckOnly: true}
+	for _, filePath := range []string{upToDate, outdated, missing} {
+		if err := options.writeGeneratedFile(ManifestFile{Kind: "table"}, filePath, []byte("package models\n")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(options.Drift) != 2 || options.Drift[0].FilePath != outdated || options.Drift[1].FilePath != missing || !options.Drift[1].Missing {
+		t.Fatalf("got the drift %+v", options.Drift)
+	}
+	if !strings.Contains(options.Drift[0].Diff, "-var x = 1\n") {
+		t.Errorf("got the diff\n%s", options.Drift[0].Diff)
+	}
+	if content, _ := ioutil.ReadFile(outdated); string(content) != "package models\n\nvar x = 1\n" {
+		t.Errorf("-check should not write anything")
+	}
+	if len(options.Manifest.Files) != 3 {
+		t.Errorf("every rendered file should be in the manifest, got %+v", options.Manifest.Files)
+	}
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+// CONFIG_FILE_NAME is the project configuration file looked up from the current
+// directory upwards, when no -config flag is provided.
+const CONFIG_FILE_NAME string = "pgtogogen.json"
+
+// ProjectConfig holds the contents of the pgtogogen.json file. Every command-line flag has
+// a counterpart here; a flag that is explicitly set on the command line wins over the file.
+// String values can reference environment variables (e.g. "password": "$PGPASSWORD").
+type ProjectConfig struct {
+	Host     *string `json:"host"`
+	Port     *int    `json:"port"`
+	Database *string `json:"database"`
+	User     *string `json:"user"`
+	Password *string `json:"password"`
+	Schema   *string `json:"schema"`
+	SSL      *string `json:"ssl"`
+
+	DDL          *string `json:"ddl"`
+	Output       *string `json:"output"`
+	CreateFolder *bool   `json:"createFolder"`
+	Debug        *bool   `json:"debug"`
+	Package      *string `json:"package"`
+	Target       *string `json:"target"`
+	CtxOnly      *bool   `json:"ctxOnly"`
+	KeepGoing    *bool   `json:"keepGoing"`
+	Jobs         *int    `json:"jobs"`
+	Templates    *string `json:"templates"`
+
+	Functions   *bool `json:"functions"`
+	PKGetters   *bool `json:"pkGetters"`
+	UQGetters   *bool `json:"uqGetters"`
+	GuidGetters *bool `json:"guidGetters"`
+
+	Repositories *bool `json:"repositories"`
+
+	// custom Go types, keyed by database type, domain or table.column
+	Types map[string]*gen.TypeMapping `json:"types"`
+
+	// per-object overrides, keyed by the database name. "tables" covers the views as well.
+	Tables            map[string]*gen.TableConfig    `json:"tables"`
+	FunctionOverrides map[string]*gen.FunctionConfig `json:"functionOverrides"`
+
+	// the location of the file, relative paths inside it are resolved against its folder
+	filePath string
+}
+
+// FindConfigFile looks for the pgtogogen.json file in the current directory and its parents.
+// It returns an empty string if there is none.
+func FindConfigFile() string {
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	for {
+		candidate := filepath.Join(dir, CONFIG_FILE_NAME)
+		if gen.FileExists(candidate) {
+			return candidate
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// LoadProjectConfig reads and validates the configuration file.
+func LoadProjectConfig(configFilePath string) (*ProjectConfig, error) {
+
+	content, err := ioutil.ReadFile(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &ProjectConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("%s: %s", configFilePath, err)
+	}
+
+	if config.filePath, err = filepath.Abs(configFilePath); err != nil {
+		return nil, err
+	}
+
+	// sorted, so that the first error found is always the same
+	for _, tableName := range sortedKeys(config.Tables) {
+		tableConfig := config.Tables[tableName]
+		if tableConfig == nil {
+			return nil, fmt.Errorf("%s: table %s has no settings", configFilePath, tableName)
+		}
+		for _, group := range tableConfig.Generate {
+			if !gen.IsTemplateGroup(group) {
+				return nil, fmt.Errorf("%s: table %s: unknown template group %q (valid groups: %s)",
+					configFilePath, tableName, group, strings.Join(gen.TemplateGroups, ", "))
+			}
+		}
+		for _, columnName := range sortedKeys(tableConfig.Columns) {
+			if tableConfig.Columns[columnName] == nil {
+				return nil, fmt.Errorf("%s: table %s, column %s has no settings", configFilePath, tableName, columnName)
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// ApplyToFlags assigns the file values to the flags that were not explicitly set
+// on the command line.
+func (c *ProjectConfig) ApplyToFlags() error {
+
+	setOnCommandLine := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { setOnCommandLine[f.Name] = true })
+
+	values := c.flagValues()
+	for _, name := range sortedKeys(values) {
+		value := values[name]
+		if setOnCommandLine[name] {
+			continue
+		}
+		if err := flag.Set(name, value); err != nil {
+			return fmt.Errorf("%s: invalid value %q for flag -%s: %s", c.filePath, value, name, err)
+		}
+	}
+
+	return nil
+}
+
+// flagValues maps the flag names to the values present in the file
+func (c *ProjectConfig) flagValues() map[string]string {
+
+	values := make(map[string]string)
+
+	setString := func(name string, value *string) {
+		if value != nil {
+			values[name] = os.ExpandEnv(*value)
+		}
+	}
+	setPath := func(name string, value *string) {
+		if value != nil {
+			values[name] = c.resolvePath(os.ExpandEnv(*value))
+		}
+	}
+	setBool := func(name string, value *bool) {
+		if value != nil {
+			values[name] = strconv.FormatBool(*value)
+		}
+	}
+
+	setString("h", c.Host)
+	if c.Port != nil {
+		values["port"] = strconv.Itoa(*c.Port)
+	}
+	setString("n", c.Database)
+	setString("u", c.User)
+	setString("pass", c.Password)
+	setString("schema", c.Schema)
+	setString("ssl", c.SSL)
+
+	setPath("ddl", c.DDL)
+	setPath("o", c.Output)
+	setBool("createFolder", c.CreateFolder)
+	setBool("debug", c.Debug)
+	setString("pkg", c.Package)
+	setString("target", c.Target)
+	setBool("ctxOnly", c.CtxOnly)
+	setBool("keep-going", c.KeepGoing)
+	if c.Jobs != nil {
+		values["j"] = strconv.Itoa(*c.Jobs)
+	}
+	setPath("templates", c.Templates)
+
+	setBool("fn", c.Functions)
+	setBool("pk", c.PKGetters)
+	setBool("uq", c.UQGetters)
+	setBool("guid", c.GuidGetters)
+	setBool("repo", c.Repositories)
+
+	return values
+}
+
+// resolvePath makes the relative paths relative to the folder of the configuration file,
+// so that go:generate works from any package folder
+func (c *ProjectConfig) resolvePath(path string) string {
+
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(c.filePath), path)
+}
+
+// sortedKeys returns the keys of a map with string keys, in order
+func sortedKeys(m interface{}) []string {
+
+	var keys []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testFolder holds the temporary folders of the tests, removed once they ran
+var testFolder string
+
+func TestMain(m *testing.M) {
+
+	var err error
+	if testFolder, err = ioutil.TempDir("", "pgtogogen-test"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(testFolder)
+	os.Exit(code)
+}
+
+// tempDir returns a new empty folder in testFolder, standing in for the t.TempDir of go 1.15
+func tempDir(t *testing.T) string {
+
+	folder, err := ioutil.TempDir(testFolder, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return folder
+}
+
+// writeConfigFile writes a pgtogogen.json file to a temporary folder and returns its path
+func writeConfigFile(t *testing.T, content string) string {
+
+	configFilePath := filepath.Join(tempDir(t), CONFIG_FILE_NAME)
+	if err := ioutil.WriteFile(configFilePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return configFilePath
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+
+	configFilePath := writeConfigFile(t, `{
+		"host": "db.local",
+		"port": 6432,
+		"repositories": true,
+		"tables": {"users": {"goName": "Member", "generate": ["select", "insert"], "columns": {"email": {"json": "mail"}}}}
+	}`)
+
+	config, err := LoadProjectConfig(configFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *config.Host != "db.local" || *config.Port != 6432 || !*config.Repositories {
+		t.Errorf("got host %s, port %d, repositories %v", *config.Host, *config.Port, *config.Repositories)
+	}
+	if config.Database != nil || config.Debug != nil {
+		t.Errorf("the settings missing from the file should stay nil")
+	}
+	if users := config.Tables["users"]; users.GoName != "Member" || users.Columns["email"].JSONTag != "mail" {
+		t.Errorf("got the users settings %+v", users)
+	}
+}
+
+func TestLoadProjectConfigErrors(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"malformed json", `{"host": "db.local",}`, "invalid character"},
+		{"unknown setting", `{"hostname": "db.local"}`, `unknown field "hostname"`},
+		{"wrong type", `{"port": "5432"}`, "cannot unmarshal string"},
+		{"table without settings", `{"tables": {"users": null}}`, "table users has no settings"},
+		{"unknown template group", `{"tables": {"users": {"generate": ["select", "upsert"]}}}`, `unknown template group "upsert"`},
+		{"column without settings", `{"tables": {"users": {"columns": {"email": null}}}}`, "table users, column email has no settings"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			configFilePath := writeConfigFile(t, test.content)
+			_, err := LoadProjectConfig(configFilePath)
+			if err == nil || !strings.Contains(err.Error(), test.want) || !strings.Contains(err.Error(), configFilePath) {
+				t.Errorf("got error %v, want one about %s in %s", err, test.want, configFilePath)
+			}
+		})
+	}
+
+	if _, err := LoadProjectConfig(filepath.Join(tempDir(t), CONFIG_FILE_NAME)); err == nil {
+		t.Errorf("a missing file should be an error")
+	}
+}
+
+func TestProjectConfigFlagValues(t *testing.T) {
+
+	os.Setenv("PGTOGOGEN_TEST_PASSWORD", "secret")
+	defer os.Unsetenv("PGTOGOGEN_TEST_PASSWORD")
+
+	configFilePath := writeConfigFile(t, `{
+		"password": "$PGTOGOGEN_TEST_PASSWORD",
+		"port": 6432,
+		"ddl": "db/schema.sql",
+		"output": "/abs/models",
+		"templates": "",
+		"debug": false,
+		"jobs": 4
+	}`)
+
+	config, err := LoadProjectConfig(configFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configFolder := filepath.Dir(config.filePath)
+	want := map[string]string{
+		"pass":      "secret",
+		"port":      "6432",
+		"ddl":       filepath.Join(configFolder, "db", "schema.sql"),
+		"o":         "/abs/models",
+		"templates": "",
+		"debug":     "false",
+		"j":         "4",
+	}
+
+	values := config.flagValues()
+	if len(values) != len(want) {
+		t.Errorf("got the flag values %v, want %v", values, want)
+	}
+	for name, value := range want {
+		if values[name] != value {
+			t.Errorf("flag -%s: got %q, want %q", name, values[name], value)
+		}
+	}
+}
+
+func TestProjectConfigApplyToFlags(t *testing.T) {
+
+	savedCommandLine := flag.CommandLine
+	defer func() { flag.CommandLine = savedCommandLine }()
+
+	flag.CommandLine = flag.NewFlagSet("pgtogogen", flag.ContinueOnError)
+	host := flag.String("h", "localhost", "")
+	user := flag.String("u", "postgres", "")
+	port := flag.Int("port", 5432, "")
+	if err := flag.CommandLine.Parse([]string{"-h", "cli.local"}); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadProjectConfig(writeConfigFile(t, `{"host": "file.local", "user": "app", "port": 6432}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.ApplyToFlags(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the command line wins over the file, which wins over the defaults
+	if *host != "cli.local" || *user != "app" || *port != 6432 {
+		t.Errorf("got host %s, user %s, port %d", *host, *user, *port)
+	}
+
+	config, err = LoadProjectConfig(writeConfigFile(t, `{"port": 6432}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	flag.CommandLine = flag.NewFlagSet("pgtogogen", flag.ContinueOnError)
+	flag.Bool("port", false, "")
+	if err := config.ApplyToFlags(); err == nil || !strings.Contains(err.Error(), "invalid value \"6432\" for flag -port") {
+		t.Errorf("got error %v, want one about the invalid -port value", err)
+	}
+}
+
+func TestFindConfigFile(t *testing.T) {
+
+	root := tempDir(t)
+	nested := filepath.Join(root, "models", "generated")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, CONFIG_FILE_NAME), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(workingDir)
+
+	if err := os.Chdir(nested); err != nil {
+		t.Fatal(err)
+	}
+	found, err := filepath.EvalSymlinks(FindConfigFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := filepath.EvalSymlinks(filepath.Join(root, CONFIG_FILE_NAME))
+	if found != want {
+		t.Errorf("got %s, want %s", found, want)
+	}
+}
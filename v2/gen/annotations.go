@@ -0,0 +1,109 @@
+package gen
+
+import (
+	"regexp"
+)
+
+// Annotations are the generator settings found inside the database comments, so that the
+// schema owners can steer the generation from the migrations, e.g.
+//
+//	COMMENT ON TABLE audit_log IS 'Append only @readonly';
+//	COMMENT ON COLUMN users.password_hash IS 'bcrypt @sensitive';
+//
+// They feed the same settings as the configuration file, which wins when both are present.
+type Annotations struct {
+	Skip       bool    // @pgtogogen:skip, on tables, views, columns and functions
+	ReadOnly   bool    // @readonly, on tables: no insert, copy, update or delete methods
+	Sensitive  bool    // @sensitive, on columns: left out of the json and of String()
+	SoftDelete bool    // @softdelete, on a boolean or timestamp column: delete sets it instead
+	JSONTag    *string // @json:"name", on columns
+	GoType     string  // @gotype:[import/path.]Type, on columns
+}
+
+var (
+	annotationSkip       = regexp.MustCompile(`(^|\s)@pgtogogen:skip\b`)
+	annotationReadOnly   = regexp.MustCompile(`(^|\s)@readonly\b`)
+	annotationSensitive  = regexp.MustCompile(`(^|\s)@sensitive\b`)
+	annotationSoftDelete = regexp.MustCompile(`(^|\s)@softdelete\b`)
+	annotationJSON       = regexp.MustCompile(`(^|\s)@json:"([^"]*)"`)
+	annotationGoType     = regexp.MustCompile(`(^|\s)@gotype:(\S+)`)
+)
+
+// ParseAnnotations extracts the annotations of a database comment
+func ParseAnnotations(comment string) Annotations {
+
+	annotations := Annotations{
+		Skip:       annotationSkip.MatchString(comment),
+		ReadOnly:   annotationReadOnly.MatchString(comment),
+		Sensitive:  annotationSensitive.MatchString(comment),
+		SoftDelete: annotationSoftDelete.MatchString(comment),
+	}
+
+	if match := annotationJSON.FindStringSubmatch(comment); match != nil {
+		annotations.JSONTag = &match[2]
+	}
+	if match := annotationGoType.FindStringSubmatch(comment); match != nil {
+		annotations.GoType = match[2]
+	}
+
+	return annotations
+}
+
+// WithAnnotations returns the table or view settings, completed with the annotations
+// of the table or view comment. It returns nil if there are neither.
+func (tc *TableConfig) WithAnnotations(comment string) *TableConfig {
+
+	annotations := ParseAnnotations(comment)
+	if !annotations.Skip && !annotations.ReadOnly {
+		return tc
+	}
+
+	annotated := &TableConfig{}
+	if tc != nil {
+		*annotated = *tc
+	}
+	annotated.Skip = annotated.Skip || annotations.Skip
+	annotated.ReadOnly = annotated.ReadOnly || annotations.ReadOnly
+
+	return annotated
+}
+
+// WithAnnotations returns the column settings, completed with the annotations
+// of the column comment. The @gotype annotation is handled by the type registry.
+func (cc *ColumnConfig) WithAnnotations(comment string) *ColumnConfig {
+
+	annotations := ParseAnnotations(comment)
+	if !annotations.Skip && !annotations.Sensitive && !annotations.SoftDelete && annotations.JSONTag == nil {
+		return cc
+	}
+
+	annotated := &ColumnConfig{}
+	if cc != nil {
+		*annotated = *cc
+	}
+	annotated.Skip = annotated.Skip || annotations.Skip
+	annotated.Sensitive = annotated.Sensitive || annotations.Sensitive
+	annotated.SoftDelete = annotated.SoftDelete || annotations.SoftDelete
+	if annotated.JSONTag == "" && annotations.JSONTag != nil {
+		annotated.JSONTag = *annotations.JSONTag
+	}
+
+	return annotated
+}
+
+// WithAnnotations returns the function settings, completed with the annotations
+// of the function comment.
+func (fc *FunctionConfig) WithAnnotations(comment string) *FunctionConfig {
+
+	if !ParseAnnotations(comment).Skip {
+		return fc
+	}
+
+	annotated := &FunctionConfig{}
+	if fc != nil {
+		*annotated = *fc
+	}
+	annotated.Skip = true
+
+	return annotated
+}
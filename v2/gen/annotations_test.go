@@ -0,0 +1,101 @@
+package gen_test
+
+import (
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestParseAnnotations(t *testing.T) {
+
+	jsonTag := func(name string) *string { return &name }
+
+	tests := []struct {
+		comment string
+		want    gen.Annotations
+	}{
+		{"", gen.Annotations{}},
+		{"Plain comment", gen.Annotations{}},
+		{"@pgtogogen:skip", gen.Annotations{Skip: true}},
+		{"Append only @readonly", gen.Annotations{ReadOnly: true}},
+		{"bcrypt @sensitive\n@softdelete", gen.Annotations{Sensitive: true, SoftDelete: true}},
+		{`@json:"mail" @gotype:github.com/shopspring/decimal.Decimal`, gen.Annotations{JSONTag: jsonTag("mail"), GoType: "github.com/shopspring/decimal.Decimal"}},
+		{`@json:""`, gen.Annotations{JSONTag: jsonTag("")}},
+
+		// malformed or look-alike annotations are ignored
+		{"user@readonly.example.com", gen.Annotations{}},
+		{"@readonlyish @sensitive_data @skip", gen.Annotations{}},
+		{"@pgtogogen:skipped", gen.Annotations{}},
+		{"@json:mail @json:\"unterminated", gen.Annotations{}},
+		{"@gotype: decimal.Decimal", gen.Annotations{}},
+		{"@gotype:", gen.Annotations{}},
+		{"@READONLY", gen.Annotations{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.comment, func(t *testing.T) {
+
+			got := gen.ParseAnnotations(test.comment)
+			if got.Skip != test.want.Skip || got.ReadOnly != test.want.ReadOnly || got.Sensitive != test.want.Sensitive ||
+				got.SoftDelete != test.want.SoftDelete || got.GoType != test.want.GoType ||
+				(got.JSONTag == nil) != (test.want.JSONTag == nil) || (got.JSONTag != nil && *got.JSONTag != *test.want.JSONTag) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTableConfigWithAnnotations(t *testing.T) {
+
+	var noConfig *gen.TableConfig
+	if noConfig.WithAnnotations("plain comment") != nil {
+		t.Errorf("no settings and no annotations should stay nil")
+	}
+
+	config := &gen.TableConfig{GoName: "AuditEntry"}
+	if config.WithAnnotations("plain comment") != config {
+		t.Errorf("the settings should be returned as they are without annotations")
+	}
+
+	annotated := config.WithAnnotations("Append only @readonly")
+	if annotated == config || annotated.GoName != "AuditEntry" || !annotated.ReadOnly || annotated.Skip {
+		t.Errorf("got %+v", annotated)
+	}
+	if config.ReadOnly {
+		t.Errorf("the original settings should be left untouched")
+	}
+
+	if annotated := noConfig.WithAnnotations("@pgtogogen:skip"); annotated == nil || !annotated.Skip {
+		t.Errorf("got %+v, want the table skipped", annotated)
+	}
+}
+
+func TestColumnConfigWithAnnotations(t *testing.T) {
+
+	var noConfig *gen.ColumnConfig
+	if noConfig.WithAnnotations("@gotype:example.com/money.Amount") != nil {
+		t.Errorf("the @gotype annotation is not a column setting")
+	}
+
+	annotated := noConfig.WithAnnotations(`bcrypt @sensitive @json:"hash"`)
+	if annotated == nil || !annotated.Sensitive || annotated.JSONTag != "hash" {
+		t.Errorf("got %+v", annotated)
+	}
+
+	// the configuration file wins over the annotations
+	config := &gen.ColumnConfig{JSONTag: "passwordHash"}
+	if annotated := config.WithAnnotations(`@json:"hash" @softdelete`); annotated.JSONTag != "passwordHash" || !annotated.SoftDelete {
+		t.Errorf("got %+v", annotated)
+	}
+}
+
+func TestFunctionConfigWithAnnotations(t *testing.T) {
+
+	config := &gen.FunctionConfig{GoName: "Refresh"}
+	if config.WithAnnotations("@readonly") != config {
+		t.Errorf("only @pgtogogen:skip applies to the functions")
+	}
+	if annotated := config.WithAnnotations("internal @pgtogogen:skip"); !annotated.Skip || annotated.GoName != "Refresh" || config.Skip {
+		t.Errorf("got %+v", annotated)
+	}
+}
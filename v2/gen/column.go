@@ -0,0 +1,261 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+/* Column Section */
+
+type Column struct {
+	Options     *Generator
+	ParentTable *Table
+
+	DbName          string
+	DbComments      string
+	OrdinalPosition int
+	Type            string
+	MaxLength       int
+	DefaultValue    *string // nil when the column has no default
+	Nullable        bool
+	IsSequence      bool
+
+	IsPK          bool
+	IsCompositePK bool
+
+	IsFK bool
+
+	GoName          string
+	GoNameForInsert string
+	GoType          string
+	GoNullableType  string // e.g. "pgx.NullString"
+	IsGuid          bool
+
+	// the custom Go type from the type registry, nil for the built-in types
+	TypeMapping *TypeMapping
+	// the json tag name from the configuration file, empty for no tag
+	JSONTag string
+	// the column value is left out of the json and of String()
+	IsSensitive bool
+	// the column flags the soft-deleted rows
+	IsSoftDelete bool
+
+	ColumnComment string
+}
+
+func (col *Column) GeneratePKGetter(parentTable *Table) ([]byte, error) {
+
+	col.ParentTable = parentTable
+	return col.getColumnTemplate("PK_GETTER_TEMPLATE_ATOMIC", PK_GETTER_TEMPLATE_ATOMIC)
+}
+
+func (col *Column) GeneratePKGetterTx(parentTable *Table) ([]byte, error) {
+
+	col.ParentTable = parentTable
+	return col.getColumnTemplate("PK_GETTER_TEMPLATE_TX", PK_GETTER_TEMPLATE_TX)
+}
+
+func (col *Column) getColumnTemplate(templateName, templateContent string) ([]byte, error) {
+
+	tmpl, err := col.ParentTable.Options.parsedTemplate(templateName, templateContent)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the %s template for table %s, column %s: %s", templateName, col.ParentTable.DbName, col.DbName, err)
+	}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, col)
+	if err != nil {
+		return nil, fmt.Errorf("error running the %s template for table %s, column %s: %s", templateName, col.ParentTable.DbName, col.DbName, err)
+	}
+
+	col.ParentTable.progress.println("PK Getter structure for column " + col.GoName + " generated.")
+	return generatedTemplate.Bytes(), nil
+}
+
+/* Go expressions for the templates. The custom types get theirs from the TypeMapping,
+the built-in ones depend on the target. */
+
+// nullableValueField returns the field, or the method call, holding the value of the built-in nullable type
+func (col *Column) nullableValueField(nullableType string) string {
+
+	switch {
+	case col.Options.IsPgx5():
+		return GetNullableTypeValueFieldNamePgx5(nullableType)
+	case col.Options.IsSql():
+		return GetNullableTypeValueFieldNameSql(nullableType)
+	}
+	return GetNullableTypeValueFieldName(nullableType)
+}
+
+// nullableNotNullExpr returns the expression telling whether the built-in nullable variable holder is not null
+func (col *Column) nullableNotNullExpr(holder string) string {
+
+	if col.Options.IsPgx5() || col.Options.IsSql() {
+		return holder + ".Valid"
+	}
+	return "boolFromStatus(" + holder + ".Status)"
+}
+
+// ScanValueExpr returns the expression reading the value out of the nullable variable holder
+func (col *Column) ScanValueExpr(holder string) string {
+
+	if col.TypeMapping != nil {
+		return col.TypeMapping.expand(col.TypeMapping.ScanValue, "$v", holder)
+	}
+	return holder + "." + col.nullableValueField(col.GoNullableType)
+}
+
+// ScanNotNullExpr returns the expression telling whether the nullable variable holder is not null
+func (col *Column) ScanNotNullExpr(holder string) string {
+
+	if col.TypeMapping != nil {
+		return col.TypeMapping.expand(col.TypeMapping.ScanNotNull, "$v", holder)
+	}
+	return col.nullableNotNullExpr(holder)
+}
+
+// NullableInitExpr returns the initializer of the nullable variable receiving the scanned value,
+// empty if the zero value does
+func (col *Column) NullableInitExpr() string {
+
+	if col.TypeMapping != nil && col.TypeMapping.NullableInit != "" {
+		return " = " + col.TypeMapping.NullableInit
+	}
+	return ""
+}
+
+/* A function returning a single composite row returns all its columns NULL when it returns NULL,
+so its not null columns are scanned into nullable variables as well. */
+
+// RowHolderType returns the type of the variable receiving the column of a single row.
+// A custom type without a nullable type deals with NULL itself and is scanned as is.
+func (col *Column) RowHolderType() string {
+
+	switch {
+	case col.GoNullableType != "":
+		return col.GoNullableType
+	case col.TypeMapping != nil:
+		return col.GoType
+	case col.Options.IsSql():
+		return GetSqlNullableType(GetGoTypeNullableType(col.GoType))
+	}
+	return GetGoTypeNullableType(col.GoType)
+}
+
+// RowValueExpr returns the expression reading the value out of the variable holder of RowHolderType
+func (col *Column) RowValueExpr(holder string) string {
+
+	switch {
+	case col.GoNullableType != "":
+		return col.ScanValueExpr(holder)
+	case col.TypeMapping != nil:
+		return holder
+	}
+	return holder + "." + col.nullableValueField(col.RowHolderType())
+}
+
+// RowNotNullExpr returns the expression telling whether the variable holder of RowHolderType
+// is not null, empty for a custom type dealing with NULL itself
+func (col *Column) RowNotNullExpr(holder string) string {
+
+	switch {
+	case col.GoNullableType != "":
+		return col.ScanNotNullExpr(holder)
+	case col.TypeMapping != nil:
+		return ""
+	}
+	return col.nullableNotNullExpr(holder)
+}
+
+// IsJSON returns true for the json and jsonb columns
+func (col *Column) IsJSON() bool {
+	return IsJSONType(col.Type, "")
+}
+
+// EncodeExpr returns the query argument for the column of the given structure instance.
+// The forInsert flag picks the insert-specific form (e.g. for the Numeric type).
+func (col *Column) EncodeExpr(structInstanceName string, forInsert bool) string {
+
+	value := structInstanceName + "." + col.GoName
+
+	if col.Nullable {
+		if col.TypeMapping != nil {
+			return col.TypeMapping.expand(col.TypeMapping.EncodeNullable, "$v", value, "$notNull", value+"_IsNotNull")
+		}
+		switch {
+		case col.Options.IsPgx5():
+			return GenerateNullableTypeStructTemplatePgx5(col.GoNullableType, value, value+"_IsNotNull", forInsert)
+		case col.Options.IsSql():
+			return GenerateNullableTypeStructTemplateSql(col.GoNullableType, value, value+"_IsNotNull")
+		}
+		return GenerateNullableTypeStructTemplate(col.GoNullableType, value, value+"_IsNotNull", forInsert)
+	}
+
+	if col.TypeMapping != nil && col.TypeMapping.Encode != "" {
+		return col.TypeMapping.expand(col.TypeMapping.Encode, "$v", value)
+	}
+	if forInsert {
+		return structInstanceName + "." + col.GoNameForInsert
+	}
+	return value
+}
+
+// HasLessComparator returns false for the custom types that cannot be sorted
+func (col *Column) HasLessComparator() bool {
+	return col.TypeMapping == nil || col.TypeMapping.Less != ""
+}
+
+// LessExpr returns the expression comparing the a and b values of the column
+func (col *Column) LessExpr(a, b string) string {
+
+	if col.TypeMapping != nil {
+		return col.TypeMapping.expand(col.TypeMapping.Less, "$a", a, "$b", b)
+	}
+	return "LessComparatorFor_" + col.GoType + "(" + a + "," + b + ")"
+}
+
+// HasFromString returns false for the custom types that cannot be parsed from a string
+func (col *Column) HasFromString() bool {
+	return col.TypeMapping == nil || col.TypeMapping.FromString != ""
+}
+
+// FromStringExpr returns the expression parsing the string s into a (value, error) pair.
+// The built-in types are handled by the templates.
+func (col *Column) FromStringExpr(s string) string {
+
+	if col.TypeMapping != nil {
+		return col.TypeMapping.expand(col.TypeMapping.FromString, "$s", s)
+	}
+	return "To_" + col.GoType + "_FromString(" + s + ")"
+}
+
+// NewGuidExpr returns the expression generating a new value for the guid columns
+func (col *Column) NewGuidExpr() string {
+
+	if col.TypeMapping != nil {
+		return col.TypeMapping.NewValue
+	}
+	return "NewGuid()"
+}
+
+// SoftDeleteValue returns the value marking a row as deleted, empty if the column type
+// cannot flag the deleted rows
+func (col *Column) SoftDeleteValue() string {
+
+	switch col.Type {
+	case "boolean":
+		return "true"
+	case "date", "timestamp without time zone", "timestamp with time zone":
+		return "now()"
+	}
+	return ""
+}
+
+// NotDeletedCondition returns the condition matching the rows not soft-deleted
+func (col *Column) NotDeletedCondition() string {
+
+	if col.Type == "boolean" {
+		return col.DbName + " IS NOT TRUE"
+	}
+	return col.DbName + " IS NULL"
+}
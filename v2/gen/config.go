@@ -0,0 +1,173 @@
+package gen
+
+// Template groups, as listed in the "generate" setting of a table or view
+const (
+	TEMPLATE_GROUP_SELECT  = "select"
+	TEMPLATE_GROUP_INSERT  = "insert"
+	TEMPLATE_GROUP_COPY    = "copy"
+	TEMPLATE_GROUP_UPDATE  = "update"
+	TEMPLATE_GROUP_DELETE  = "delete"
+	TEMPLATE_GROUP_GETTERS = "getters"
+	TEMPLATE_GROUP_HTTP    = "http"
+)
+
+// TemplateGroups lists the valid values of the "generate" setting
+var TemplateGroups = []string{TEMPLATE_GROUP_SELECT, TEMPLATE_GROUP_INSERT, TEMPLATE_GROUP_COPY,
+	TEMPLATE_GROUP_UPDATE, TEMPLATE_GROUP_DELETE, TEMPLATE_GROUP_GETTERS, TEMPLATE_GROUP_HTTP}
+
+// the groups that write to the table, excluded for the read-only tables
+var writeTemplateGroups = []string{TEMPLATE_GROUP_INSERT, TEMPLATE_GROUP_COPY, TEMPLATE_GROUP_UPDATE, TEMPLATE_GROUP_DELETE}
+
+// TableConfig holds the overrides for a single table or view.
+type TableConfig struct {
+	GoName   string `json:"goName"`
+	Skip     bool   `json:"skip"`
+	ReadOnly bool   `json:"readOnly"` // no insert, copy, update or delete methods
+	NoCache  bool   `json:"noCache"`  // the Cache.Enable() call becomes a no-op
+
+	// the NOT NULL columns identifying a row, ordering the SelectAfter keyset pages of a view.
+	// The tables also have their primary key and unique constraints.
+	Key []string `json:"key"`
+
+	// the template groups to generate (select, insert, copy, update, delete, getters, http).
+	// Empty means all of them.
+	Generate []string `json:"generate"`
+
+	Columns map[string]*ColumnConfig `json:"columns"`
+}
+
+// ColumnConfig holds the overrides for a single column.
+type ColumnConfig struct {
+	GoName string `json:"goName"`
+	Skip   bool   `json:"skip"`
+
+	// GoType replaces the resolved Go type (e.g. "decimal.Decimal"), GoImport is the import
+	// path it needs (e.g. "github.com/shopspring/decimal"). It is a shorthand for a
+	// "table.column" entry in the types section.
+	GoType   string `json:"goType"`
+	GoImport string `json:"goImport"`
+
+	// the json tag name of the struct field, use "-" to leave the field out of the json
+	JSONTag string `json:"json"`
+
+	// a sensitive column is left out of the json (unless it has a json tag) and of String()
+	Sensitive bool `json:"sensitive"`
+
+	// marks the boolean or timestamp column flagging the deleted rows: the delete methods
+	// set it instead of deleting, and the select methods leave out the flagged rows
+	SoftDelete bool `json:"softDelete"`
+}
+
+// FunctionConfig holds the overrides for a single function.
+type FunctionConfig struct {
+	GoName string `json:"goName"`
+	Skip   bool   `json:"skip"`
+}
+
+/* Override lookups. All of them accept a nil config, which means no overrides. */
+
+// TableConfigFor returns the overrides for the given table or view, or nil.
+func (g *Generator) TableConfigFor(dbName string) *TableConfig {
+	return g.TableConfigs[dbName]
+}
+
+// FunctionConfigFor returns the overrides for the given function, or nil.
+func (g *Generator) FunctionConfigFor(dbName string) *FunctionConfig {
+	return g.FunctionConfigs[dbName]
+}
+
+// IsSkipped returns true if the table or view should not be generated at all
+func (tc *TableConfig) IsSkipped() bool {
+	return tc != nil && tc.Skip
+}
+
+// GoNameOr returns the configured Go name, or the default one
+func (tc *TableConfig) GoNameOr(defaultName string) string {
+
+	if tc == nil || tc.GoName == "" {
+		return defaultName
+	}
+	return tc.GoName
+}
+
+// ShouldGenerate returns true if the given template group is enabled
+func (tc *TableConfig) ShouldGenerate(group string) bool {
+
+	if tc == nil {
+		return true
+	}
+	if tc.ReadOnly && stringInSlice(group, writeTemplateGroups) {
+		return false
+	}
+	if len(tc.Generate) == 0 {
+		return true
+	}
+	return stringInSlice(group, tc.Generate)
+}
+
+// ColumnConfigFor returns the overrides for the given column, or nil
+func (tc *TableConfig) ColumnConfigFor(columnName string) *ColumnConfig {
+
+	if tc == nil {
+		return nil
+	}
+	return tc.Columns[columnName]
+}
+
+// IsSkipped returns true if the column should be left out of the generated structure
+func (cc *ColumnConfig) IsSkipped() bool {
+	return cc != nil && cc.Skip
+}
+
+// applyTo sets the overridden Go name, json tag and flags on a resolved column
+func (cc *ColumnConfig) applyTo(col *Column) {
+
+	if cc == nil {
+		return
+	}
+
+	if cc.GoName != "" {
+		col.GoName = cc.GoName
+		if col.TypeMapping == nil {
+			col.GoNameForInsert = GetGoInsertNameForColumn(col.GoName, col.GoType)
+		} else {
+			col.GoNameForInsert = col.GoName
+		}
+	}
+
+	col.JSONTag = cc.JSONTag
+	if col.JSONTag == "" && cc.Sensitive {
+		col.JSONTag = "-"
+	}
+
+	col.IsSensitive = cc.Sensitive
+	col.IsSoftDelete = cc.SoftDelete
+}
+
+// IsSkipped returns true if the function should not be generated
+func (fc *FunctionConfig) IsSkipped() bool {
+	return fc != nil && fc.Skip
+}
+
+// GoNameOr returns the configured Go name, or the default one
+func (fc *FunctionConfig) GoNameOr(defaultName string) string {
+
+	if fc == nil || fc.GoName == "" {
+		return defaultName
+	}
+	return fc.GoName
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for i := range slice {
+		if slice[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTemplateGroup returns true if the group is one of the TemplateGroups
+func IsTemplateGroup(group string) bool {
+	return stringInSlice(group, TemplateGroups)
+}
@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+/* Constraint Section */
+
+type Constraint struct {
+	Options     *Generator
+	ParentTable *Table
+
+	DbName     string
+	DbComments string
+	Type       string
+
+	Columns []Column
+
+	IsPK          bool
+	IsCompositePK bool
+	IsFK          bool
+	IsUnique      bool
+}
+
+// Generates a getter template for the unique constraint
+func (c *Constraint) GenerateUniqueConstraintGetter(parentTable *Table) ([]byte, error) {
+
+	if c.IsUnique == false {
+		return []byte{}, nil
+	}
+
+	c.ParentTable = parentTable
+	return c.getConstraintTemplate("UQ_GETTER_TEMPLATE_ATOMIC", UQ_GETTER_TEMPLATE_ATOMIC)
+}
+
+func (c *Constraint) GenerateUniqueConstraintGetterTx(parentTable *Table) ([]byte, error) {
+
+	if c.IsUnique == false {
+		return []byte{}, nil
+	}
+
+	c.ParentTable = parentTable
+	return c.getConstraintTemplate("UQ_GETTER_TEMPLATE_TX", UQ_GETTER_TEMPLATE_TX)
+}
+
+func (c *Constraint) getConstraintTemplate(templateName, templateContent string) ([]byte, error) {
+
+	tmpl, err := c.ParentTable.Options.parsedTemplate(templateName, templateContent)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the %s template for table %s, unique constraint %s: %s", templateName, c.ParentTable.DbName, c.DbName, err)
+	}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, c)
+	if err != nil {
+		return nil, fmt.Errorf("error running the %s template for table %s, unique constraint %s: %s", templateName, c.ParentTable.DbName, c.DbName, err)
+	}
+
+	c.ParentTable.progress.println("UQ Getter structure for unique constraint " + c.DbName + " generated.")
+	return generatedTemplate.Bytes(), nil
+}
@@ -1,10 +1,7 @@
-package main
+package gen
 
 import (
-	"log"
 	"strings"
-
-	pgtype "github.com/silviucm/pgtogogen/v2/internal/pgx/pgtype"
 )
 
 const (
@@ -27,6 +24,20 @@ const (
 	NULLABLE_TYPE_DATE         = "pgtype.Date"
 )
 
+// The nullable types of the database/sql target. It has no pgtype package: the generated
+// package declares the NullFloat32 and Interval types that database/sql lacks.
+const (
+	SQL_NULLABLE_TYPE_BOOL     = "sql.NullBool"
+	SQL_NULLABLE_TYPE_FLOAT32  = "NullFloat32"
+	SQL_NULLABLE_TYPE_FLOAT64  = "sql.NullFloat64"
+	SQL_NULLABLE_TYPE_INT16    = "sql.NullInt16"
+	SQL_NULLABLE_TYPE_INT32    = "sql.NullInt32"
+	SQL_NULLABLE_TYPE_INT64    = "sql.NullInt64"
+	SQL_NULLABLE_TYPE_INTERVAL = "Interval"
+	SQL_NULLABLE_TYPE_STRING   = "sql.NullString"
+	SQL_NULLABLE_TYPE_TIME     = "sql.NullTime"
+)
+
 /* Utility methods for dealing with SQL data types in general and PostgreSQL data types in particular */
 
 func GetGoFriendlyNameForColumn(columnName string) string {
@@ -38,10 +49,6 @@ func GetGoFriendlyNameForColumn(columnName string) string {
 
 	subNames := strings.Split(columnName, "_")
 
-	if subNames == nil {
-		log.Fatal("GetGoFriendlyNameForColumn() fatal error for column name: ", columnName, ". Please ensure a valid column name is provided.")
-	}
-
 	for i := range subNames {
 		subNames[i] = strings.Title(subNames[i])
 	}
@@ -203,6 +210,57 @@ func GetGoTypeForColumn(columnType string, nullable bool, udtName string) (typeR
 	return typeReturn, nullableTypeReturn, goTypeToImport
 }
 
+// GoTypeForColumn is GetGoTypeForColumn for the target of the options:
+// the database/sql target has its own nullable types
+func (o *Options) GoTypeForColumn(columnType string, nullable bool, udtName string) (typeReturn,
+	nullableTypeReturn, goTypeToImport string) {
+
+	typeReturn, nullableTypeReturn, goTypeToImport = GetGoTypeForColumn(columnType, nullable, udtName)
+
+	if o.IsSql() {
+		typeReturn, nullableTypeReturn = GetSqlGoType(typeReturn), GetSqlNullableType(nullableTypeReturn)
+	}
+	return typeReturn, nullableTypeReturn, goTypeToImport
+}
+
+// GetSqlGoType returns the database/sql target counterpart of a Go type returned by GetGoTypeForColumn
+func GetSqlGoType(goType string) string {
+
+	if goType == NULLABLE_TYPE_INTERVAL {
+		return SQL_NULLABLE_TYPE_INTERVAL
+	}
+	return goType
+}
+
+// GetSqlNullableType returns the database/sql target counterpart of a pgtype nullable type.
+// The JSON, JSONB and Numeric types of the generated package are the same for all the targets.
+func GetSqlNullableType(goNullableType string) string {
+
+	switch goNullableType {
+
+	case NULLABLE_TYPE_BOOL:
+		return SQL_NULLABLE_TYPE_BOOL
+	case NULLABLE_TYPE_FLOAT32:
+		return SQL_NULLABLE_TYPE_FLOAT32
+	case NULLABLE_TYPE_FLOAT64:
+		return SQL_NULLABLE_TYPE_FLOAT64
+	case NULLABLE_TYPE_INT16:
+		return SQL_NULLABLE_TYPE_INT16
+	case NULLABLE_TYPE_INT32:
+		return SQL_NULLABLE_TYPE_INT32
+	case NULLABLE_TYPE_INT64:
+		return SQL_NULLABLE_TYPE_INT64
+	case NULLABLE_TYPE_INTERVAL:
+		return SQL_NULLABLE_TYPE_INTERVAL
+	case NULLABLE_TYPE_TEXT, NULLABLE_TYPE_VARCHAR, NULLABLE_TYPE_UUID:
+		return SQL_NULLABLE_TYPE_STRING
+	case NULLABLE_TYPE_TIMESTAMP_TZ, NULLABLE_TYPE_TIMESTAMP, NULLABLE_TYPE_DATE:
+		return SQL_NULLABLE_TYPE_TIME
+	}
+
+	return goNullableType
+}
+
 func GetGoTypeNullableType(goType string) string {
 
 	switch goType {
@@ -328,38 +386,166 @@ func GetNullableTypeValueFieldName(goNullableType string) string {
 
 }
 
-func DecodeIsColumnSequence(columnDefaultValue pgtype.Text) bool {
+// GenerateNullableTypeStructTemplatePgx5 is the pgx v5 counterpart of GenerateNullableTypeStructTemplate.
+// The v5 nullable types have a Valid field instead of the Status one, e.g. a call such as:
+//
+//  GenerateNullableTypeStructTemplatePgx5("pgtype.Text", "sourceCmsArticle.Overview", "sourceCmsArticle.Overview_IsNotNull", false)
+//
+// would generate the following string:
+//
+//  "&pgtype.Text{String: sourceCmsArticle.Overview, Valid: sourceCmsArticle.Overview_IsNotNull}"
+func GenerateNullableTypeStructTemplatePgx5(goNullableType, valueField, validField string, forInsert bool) string {
 
-	if columnDefaultValue.Status == pgtype.Null {
-		return false
+	switch goNullableType {
+
+	case NULLABLE_TYPE_BOOL:
+		return "&pgtype.Bool{Bool: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_FLOAT32:
+		return "&pgtype.Float4{Float32: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_FLOAT64:
+		return "&pgtype.Float8{Float64: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_NUMERIC:
+		if forInsert {
+			return "toPgxNumeric(" + valueField + ", " + validField + ")"
+		}
+		return "toNumeric(" + valueField + ", " + validField + ")"
+	case NULLABLE_TYPE_INT16:
+		return "&pgtype.Int2{Int16: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_INT32:
+		return "&pgtype.Int4{Int32: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_INT64:
+		return "&pgtype.Int8{Int64: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_JSON:
+		return "&JSON{Bytes: []byte(" + valueField + "), Valid: " + validField + "}"
+	case NULLABLE_TYPE_JSONB:
+		return "&JSONB{Bytes: []byte(" + valueField + "), Valid: " + validField + "}"
+	case NULLABLE_TYPE_TEXT:
+		return "&pgtype.Text{String: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_TIMESTAMP_TZ:
+		return "&pgtype.Timestamptz{Time: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_TIMESTAMP:
+		return "&pgtype.Timestamp{Time: utcTime(" + valueField + "), Valid: " + validField + "}"
+	case NULLABLE_TYPE_DATE:
+		return "&pgtype.Date{Time: " + valueField + ", Valid: " + validField + "}"
 	}
 
-	if strings.HasPrefix(columnDefaultValue.String, "nextval(") {
-		return true
+	return "[GenerateNullableTypeStructTemplatePgx5: could not find the go nullable type: '" + goNullableType + "']"
+
+}
+
+// GetNullableTypeValueFieldNamePgx5 is the pgx v5 counterpart of GetNullableTypeValueFieldName
+func GetNullableTypeValueFieldNamePgx5(goNullableType string) string {
+
+	switch goNullableType {
+
+	case NULLABLE_TYPE_BOOL:
+		return "Bool"
+	case NULLABLE_TYPE_FLOAT32:
+		return "Float32"
+	case NULLABLE_TYPE_FLOAT64:
+		return "Float64"
+	case NULLABLE_TYPE_NUMERIC:
+		return "NumericVal()"
+	case NULLABLE_TYPE_INT16:
+		return "Int16"
+	case NULLABLE_TYPE_INT32:
+		return "Int32"
+	case NULLABLE_TYPE_INT64:
+		return "Int64"
+	case NULLABLE_TYPE_JSON, NULLABLE_TYPE_JSONB:
+		return "String()"
+	case NULLABLE_TYPE_TEXT:
+		return "String"
+	case NULLABLE_TYPE_TIMESTAMP_TZ, NULLABLE_TYPE_TIMESTAMP, NULLABLE_TYPE_DATE:
+		return "Time"
 	}
 
-	return false
+	return "[GetNullableTypeValueFieldNamePgx5: could not find the go nullable type: '" + goNullableType + "']"
+
 }
 
-func DecodeNullable(isNullable string) bool {
+// GenerateNullableTypeStructTemplateSql is the database/sql counterpart of GenerateNullableTypeStructTemplate,
+// for the nullable types returned by GetSqlNullableType, e.g. a call such as:
+//
+//  GenerateNullableTypeStructTemplateSql("sql.NullString", "sourceCmsArticle.Overview", "sourceCmsArticle.Overview_IsNotNull")
+//
+// would generate the following string:
+//
+//  "sql.NullString{String: sourceCmsArticle.Overview, Valid: sourceCmsArticle.Overview_IsNotNull}"
+//
+// The generated Numeric type is a driver.Valuer, the inserts need no particular form.
+func GenerateNullableTypeStructTemplateSql(goNullableType, valueField, validField string) string {
+
+	switch goNullableType {
 
-	if isNullable == "NO" || isNullable == "f" || isNullable == "F" {
-		return false
+	case SQL_NULLABLE_TYPE_BOOL:
+		return "sql.NullBool{Bool: " + valueField + ", Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_FLOAT32:
+		return "NullFloat32{Float32: " + valueField + ", Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_FLOAT64:
+		return "sql.NullFloat64{Float64: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_NUMERIC:
+		return "toNumeric(" + valueField + ", " + validField + ")"
+	case SQL_NULLABLE_TYPE_INT16:
+		return "sql.NullInt16{Int16: " + valueField + ", Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_INT32:
+		return "sql.NullInt32{Int32: " + valueField + ", Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_INT64:
+		return "sql.NullInt64{Int64: " + valueField + ", Valid: " + validField + "}"
+	case NULLABLE_TYPE_JSON:
+		return "JSON{Bytes: []byte(" + valueField + "), Valid: " + validField + "}"
+	case NULLABLE_TYPE_JSONB:
+		return "JSONB{Bytes: []byte(" + valueField + "), Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_STRING:
+		return "sql.NullString{String: " + valueField + ", Valid: " + validField + "}"
+	case SQL_NULLABLE_TYPE_TIME:
+		return "sql.NullTime{Time: " + valueField + ", Valid: " + validField + "}"
 	}
 
-	if isNullable == "YES" || isNullable == "Yes" || isNullable == "yes" || isNullable == "y" || isNullable == "Y" ||
-		isNullable == "t" || isNullable == "T" || isNullable == "true" || isNullable == "TRUE" || isNullable == "True" {
-		return true
+	return "[GenerateNullableTypeStructTemplateSql: could not find the go nullable type: '" + goNullableType + "']"
+
+}
+
+// GetNullableTypeValueFieldNameSql is the database/sql counterpart of GetNullableTypeValueFieldName
+func GetNullableTypeValueFieldNameSql(goNullableType string) string {
+
+	switch goNullableType {
+
+	case SQL_NULLABLE_TYPE_BOOL:
+		return "Bool"
+	case SQL_NULLABLE_TYPE_FLOAT32:
+		return "Float32"
+	case SQL_NULLABLE_TYPE_FLOAT64:
+		return "Float64"
+	case NULLABLE_TYPE_NUMERIC:
+		return "NumericVal()"
+	case SQL_NULLABLE_TYPE_INT16:
+		return "Int16"
+	case SQL_NULLABLE_TYPE_INT32:
+		return "Int32"
+	case SQL_NULLABLE_TYPE_INT64:
+		return "Int64"
+	case NULLABLE_TYPE_JSON, NULLABLE_TYPE_JSONB:
+		return "String()"
+	case SQL_NULLABLE_TYPE_STRING:
+		return "String"
+	case SQL_NULLABLE_TYPE_TIME:
+		return "Time"
 	}
 
-	return false
+	return "[GetNullableTypeValueFieldNameSql: could not find the go nullable type: '" + goNullableType + "']"
+
 }
 
-func DecodeMaxLength(maxLength pgtype.Int4) int {
+func DecodeIsColumnSequence(columnDefaultValue *string) bool {
+
+	if columnDefaultValue == nil {
+		return false
+	}
 
-	if maxLength.Status == pgtype.Null {
-		return -1
+	if strings.HasPrefix(*columnDefaultValue, "nextval(") {
+		return true
 	}
 
-	return int(maxLength.Int)
+	return false
 }
@@ -0,0 +1,110 @@
+package gen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+)
+
+// FINGERPRINT_VERSION is part of every fingerprint. Bump it when a change to the generator changes
+// the generated code in a way the templates do not show, so that every file gets regenerated.
+const FINGERPRINT_VERSION = "1"
+
+// the reasons given in the report for the objects rendered again
+const (
+	REGENERATED_NEW        = "no up-to-date file from a previous run"
+	REGENERATED_DEFINITION = "its definition or configuration changed"
+	REGENERATED_GENERATOR  = "the templates or the settings changed"
+	REGENERATED_FORCED     = "forced"
+)
+
+// Fingerprint identifies what a generated file was rendered from. A file whose fingerprint
+// matches the one of the previous run would come out the same, so it is not rendered again.
+type Fingerprint struct {
+	// the introspected definition of the object and its configuration
+	Definition string `json:"definition"`
+
+	// the templates, the settings and FINGERPRINT_VERSION, the same for all the files of a run
+	Generator string `json:"generator"`
+}
+
+// hashJSON returns the hash of the JSON encoding of the values. They are plain data,
+// which always encodes, and the map keys are sorted by the encoder.
+func hashJSON(values ...interface{}) string {
+
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	for _, value := range values {
+		encoder.Encode(value)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// generatorFingerprint returns the part of the fingerprints shared by all the files: the content
+// of the templates in use, the settings changing the generated code and the schema version
+func (g *Generator) generatorFingerprint() string {
+
+	templateNames := make([]string, 0, len(builtinTemplates))
+	for name := range builtinTemplates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+
+	templates := make([]string, 0, len(templateNames))
+	for _, name := range templateNames {
+		templates = append(templates, name, g.Templates.Lookup(name, builtinTemplates[name]))
+	}
+
+	var userTemplates [][]UserTemplate
+	if g.Templates != nil {
+		userTemplates = [][]UserTemplate{g.Templates.TableTemplates, g.Templates.ViewTemplates, g.Templates.FunctionTemplates}
+	}
+
+	settings := []interface{}{
+		g.PackageName, g.Target, g.PgxImport, g.PgxPoolImport, g.PgTypeImport, g.PgConnImport,
+		g.GenerateFunctions, g.GeneratePKGetters, g.GenerateUQGetters, g.GenerateGuidGetters, g.GenerateRepositories, g.CtxOnly,
+		g.DbSchema, g.DbMajorVersion, g.DbMinorVersion,
+	}
+
+	return hashJSON(FINGERPRINT_VERSION, templates, userTemplates, settings, g.TypeMappings)
+}
+
+// fingerprintCheck is the fingerprint of a file, and how it compares with the one of the previous run
+type fingerprintCheck struct {
+	Fingerprint
+
+	reason    string // why the file is rendered again, empty without fingerprints from a previous run
+	unchanged bool   // true when the file is not rendered again
+}
+
+// checkFingerprint compares the fingerprint of a file with the one of the previous run.
+// Without fingerprints from a previous run, every file is rendered and there is no reason to give.
+func (g *Generator) checkFingerprint(fileName, definition string) fingerprintCheck {
+
+	check := fingerprintCheck{Fingerprint: Fingerprint{Definition: definition, Generator: g.generatorHash}}
+
+	if g.Force {
+		check.reason = REGENERATED_FORCED
+		return check
+	}
+
+	if g.Fingerprints == nil {
+		return check
+	}
+
+	previous, found := g.Fingerprints[fileName]
+	switch {
+	case !found:
+		check.reason = REGENERATED_NEW
+	case previous.Definition != check.Definition:
+		check.reason = REGENERATED_DEFINITION
+	case previous.Generator != check.Generator:
+		check.reason = REGENERATED_GENERATOR
+	default:
+		check.unchanged = true
+	}
+
+	return check
+}
@@ -0,0 +1,56 @@
+package gen
+
+import (
+	"testing"
+)
+
+func TestCheckFingerprint(t *testing.T) {
+
+	g := NewGenerator(Options{PackageName: "models"})
+	g.generatorHash = g.generatorFingerprint()
+
+	if check := g.checkFingerprint("users.go", "d1"); check.unchanged || check.reason != "" {
+		t.Errorf("without a previous run, got %+v", check)
+	}
+
+	g.Fingerprints = map[string]Fingerprint{"users.go": {Definition: "d1", Generator: g.generatorHash}}
+	tests := []struct {
+		fileName   string
+		definition string
+		unchanged  bool
+		reason     string
+	}{
+		{"users.go", "d1", true, ""},
+		{"users.go", "d2", false, REGENERATED_DEFINITION},
+		{"orders.go", "d1", false, REGENERATED_NEW},
+	}
+	for _, test := range tests {
+		if check := g.checkFingerprint(test.fileName, test.definition); check.unchanged != test.unchanged || check.reason != test.reason {
+			t.Errorf("%s, %s: got %+v", test.fileName, test.definition, check)
+		}
+	}
+
+	// the settings and the templates are part of the generator hash
+	changed := NewGenerator(Options{PackageName: "models", CtxOnly: true})
+	changed.generatorHash = changed.generatorFingerprint()
+	changed.Fingerprints = g.Fingerprints
+	if changed.generatorHash == g.generatorHash {
+		t.Errorf("-ctxOnly should change the generator hash")
+	}
+	if check := changed.checkFingerprint("users.go", "d1"); check.unchanged || check.reason != REGENERATED_GENERATOR {
+		t.Errorf("got %+v", check)
+	}
+
+	overridden := NewGenerator(Options{PackageName: "models", Templates: &TemplateSet{Overrides: map[string]string{"TABLE_TEMPLATE": "x"}}})
+	if overridden.generatorFingerprint() == g.generatorHash {
+		t.Errorf("a template override should change the generator hash")
+	}
+	if NewGenerator(Options{PackageName: "models"}).generatorFingerprint() != g.generatorHash {
+		t.Errorf("the generator hash should not change between runs")
+	}
+
+	g.Force = true
+	if check := g.checkFingerprint("users.go", "d1"); check.unchanged || check.reason != REGENERATED_FORCED {
+		t.Errorf("with Force, got %+v", check)
+	}
+}
@@ -0,0 +1,248 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GeneratedSource is the buffer a generated Go file is rendered into, one template at a time.
+// Besides the bytes, it remembers the line where the output of each template starts,
+// so that a syntax error in the file can be reported against the template that produced it.
+type GeneratedSource struct {
+	bytes.Buffer
+	segments []sourceSegment
+}
+
+type sourceSegment struct {
+	templateName string
+	firstLine    int
+}
+
+// WriteTemplate appends the output of the named template to the source
+func (s *GeneratedSource) WriteTemplate(templateName string, output []byte) (int, error) {
+
+	s.segments = append(s.segments, sourceSegment{
+		templateName: templateName,
+		firstLine:    bytes.Count(s.Bytes(), []byte("\n")) + 1,
+	})
+
+	return s.Write(output)
+}
+
+// TemplateAt returns the name of the template that produced the given line,
+// or an empty string if the line was not written through WriteTemplate
+func (s *GeneratedSource) TemplateAt(line int) string {
+
+	templateName := ""
+	for _, segment := range s.segments {
+		if segment.firstLine > line {
+			break
+		}
+		templateName = segment.templateName
+	}
+
+	return templateName
+}
+
+// Format returns the formatted source, with the unused imports removed.
+// If the source does not parse, the error names the template, the line and its content.
+func (s *GeneratedSource) Format(fileName string) ([]byte, error) {
+
+	formatted, err := FormatGoSource(fileName, s.Bytes())
+	if err != nil {
+		if sourceErr, ok := err.(*SourceError); ok {
+			sourceErr.TemplateName = s.TemplateAt(sourceErr.Line)
+		}
+		return nil, err
+	}
+
+	return formatted, nil
+}
+
+// SourceError is returned when a generated file is not valid Go code
+type SourceError struct {
+	FileName     string
+	TemplateName string
+	Line         int
+	LineContent  string
+	Err          error
+}
+
+func (e *SourceError) Error() string {
+
+	origin := ""
+	if e.TemplateName != "" {
+		origin = " (template " + e.TemplateName + ")"
+	}
+
+	return fmt.Sprintf("%s:%d%s: invalid generated code: %v\n\t%d | %s", e.FileName, e.Line, origin, e.Err, e.Line, e.LineContent)
+}
+
+// FormatGoSource does for the generated code what gofmt and goimports would do:
+// it removes the unused imports, sorts the remaining ones and formats the file.
+func FormatGoSource(fileName string, source []byte) ([]byte, error) {
+
+	fileSet := token.NewFileSet()
+
+	file, err := parser.ParseFile(fileSet, fileName, source, parser.ParseComments)
+	if err != nil {
+		return nil, newSourceError(fileName, source, err)
+	}
+
+	removeUnusedImports(file)
+	ast.SortImports(fileSet, file)
+
+	var formatted bytes.Buffer
+	if err := format.Node(&formatted, fileSet, file); err != nil {
+		return nil, newSourceError(fileName, source, err)
+	}
+
+	return groupImports(fileName, formatted.Bytes())
+}
+
+// groupImports rewrites the import block the way goimports lays it out:
+// the standard library first, then a blank line and the other packages, each group sorted.
+// This also cleans up the blank lines left behind by the removed imports.
+func groupImports(fileName string, source []byte) ([]byte, error) {
+
+	fileSet := token.NewFileSet()
+
+	file, err := parser.ParseFile(fileSet, fileName, source, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, newSourceError(fileName, source, err)
+	}
+
+	for _, decl := range file.Decls {
+
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT || !genDecl.Lparen.IsValid() {
+			continue
+		}
+
+		start := fileSet.Position(genDecl.Pos()).Offset
+		end := fileSet.Position(genDecl.End()).Offset
+
+		// leave the hand-written blocks, with comments, alone
+		for _, commentGroup := range file.Comments {
+			if offset := fileSet.Position(commentGroup.Pos()).Offset; offset > start && offset < end {
+				return format.Source(source)
+			}
+		}
+
+		var standard, others []*ast.ImportSpec
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			if strings.Contains(strings.SplitN(importSpec.Path.Value, "/", 2)[0], ".") {
+				others = append(others, importSpec)
+			} else {
+				standard = append(standard, importSpec)
+			}
+		}
+
+		var block bytes.Buffer
+		block.WriteString("import (\n")
+		for _, group := range [][]*ast.ImportSpec{standard, others} {
+			if len(group) == 0 {
+				continue
+			}
+			if block.Len() > len("import (\n") {
+				block.WriteString("\n")
+			}
+			sort.Slice(group, func(i, j int) bool { return group[i].Path.Value < group[j].Path.Value })
+			for _, importSpec := range group {
+				block.WriteString("\t")
+				if importSpec.Name != nil {
+					block.WriteString(importSpec.Name.Name + " ")
+				}
+				block.WriteString(importSpec.Path.Value + "\n")
+			}
+		}
+		block.WriteString(")")
+
+		regrouped := append([]byte{}, source[:start]...)
+		regrouped = append(regrouped, block.Bytes()...)
+		regrouped = append(regrouped, source[end:]...)
+
+		// one import block per generated file
+		return format.Source(regrouped)
+	}
+
+	return format.Source(source)
+}
+
+func newSourceError(fileName string, source []byte, err error) error {
+
+	sourceErr := &SourceError{FileName: fileName, Err: err}
+
+	if errList, ok := err.(scanner.ErrorList); ok && len(errList) > 0 {
+		sourceErr.Line = errList[0].Pos.Line
+		sourceErr.Err = fmt.Errorf("%s", errList[0].Msg)
+	}
+
+	lines := bytes.Split(source, []byte("\n"))
+	if sourceErr.Line > 0 && sourceErr.Line <= len(lines) {
+		sourceErr.LineContent = string(bytes.TrimSpace(lines[sourceErr.Line-1]))
+	}
+
+	return sourceErr
+}
+
+// removeUnusedImports drops the imports whose package name is never referenced in the file.
+// Blank and dot imports are always kept.
+func removeUnusedImports(file *ast.File) {
+
+	used := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			// package names are the only identifiers the parser leaves unresolved
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	isUsed := func(spec *ast.ImportSpec) bool {
+		if spec.Name != nil {
+			return spec.Name.Name == "_" || spec.Name.Name == "." || used[spec.Name.Name]
+		}
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		return err != nil || used[goPackageName(importPath)]
+	}
+
+	var imports []*ast.ImportSpec
+	var decls []ast.Decl
+
+	for _, decl := range file.Decls {
+
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+
+		var specs []ast.Spec
+		for _, spec := range genDecl.Specs {
+			if importSpec := spec.(*ast.ImportSpec); isUsed(importSpec) {
+				specs = append(specs, spec)
+				imports = append(imports, importSpec)
+			}
+		}
+
+		if len(specs) > 0 {
+			genDecl.Specs = specs
+			decls = append(decls, genDecl)
+		}
+	}
+
+	file.Decls = decls
+	file.Imports = imports
+}
@@ -0,0 +1,84 @@
+package gen_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestFormatGoSource(t *testing.T) {
+
+	source := `package models
+import (
+	"strings"
+	pgx "github.com/jackc/pgx/v4"
+	"fmt"
+	"github.com/shopspring/decimal"
+	_ "embed"
+	"time"
+	"unused/pkg"
+)
+func   F(t time.Time) string { return fmt.Sprint(t, decimal.Zero) }
+var _ = pgx.ErrNoRows
+`
+	want := `package models
+
+import (
+	_ "embed"
+	"fmt"
+	"time"
+
+	pgx "github.com/jackc/pgx/v4"
+	"github.com/shopspring/decimal"
+)
+
+func F(t time.Time) string { return fmt.Sprint(t, decimal.Zero) }
+
+var _ = pgx.ErrNoRows
+`
+
+	formatted, err := gen.FormatGoSource("models.go", []byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(formatted) != want {
+		t.Errorf("got\n%s\nwant\n%s", formatted, want)
+	}
+
+	// an import block with comments is only sorted, not regrouped
+	commented := "package models\n\nimport (\n\t// the driver\n\t\"github.com/jackc/pgx/v4\"\n\t\"fmt\"\n\t\"strings\"\n)\n\nvar _ = fmt.Sprint(pgx.ErrNoRows)\n"
+	formatted, err = gen.FormatGoSource("models.go", []byte(commented))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(formatted), "import (\n\t// the driver\n\t\"fmt\"\n\t\"github.com/jackc/pgx/v4\"\n)\n") {
+		t.Errorf("got\n%s", formatted)
+	}
+}
+
+func TestGeneratedSourceFormatError(t *testing.T) {
+
+	var source gen.GeneratedSource
+	source.WriteTemplate("HEADER", []byte("package models\n\n"))
+	source.WriteTemplate("SELECT_TEMPLATE_ALL", []byte("func SelectAll() {\n\treturn\n}\n\n"))
+	source.WriteTemplate("UPDATE_TEMPLATE", []byte("func Update() {\n\tx := \n}\n"))
+
+	if source.TemplateAt(1) != "HEADER" || source.TemplateAt(4) != "SELECT_TEMPLATE_ALL" || source.TemplateAt(8) != "UPDATE_TEMPLATE" {
+		t.Errorf("got the templates %s, %s, %s", source.TemplateAt(1), source.TemplateAt(4), source.TemplateAt(8))
+	}
+
+	_, err := source.Format("users.go")
+
+	var sourceErr *gen.SourceError
+	if !errors.As(err, &sourceErr) {
+		t.Fatalf("got error %v, want a *gen.SourceError", err)
+	}
+	if sourceErr.TemplateName != "UPDATE_TEMPLATE" || sourceErr.Line != 9 || sourceErr.LineContent != "}" {
+		t.Errorf("got %+v", sourceErr)
+	}
+	if !strings.HasPrefix(err.Error(), "users.go:9 (template UPDATE_TEMPLATE): invalid generated code: ") || !strings.HasSuffix(err.Error(), "\n\t9 | }") {
+		t.Errorf("got the message %q", err.Error())
+	}
+}
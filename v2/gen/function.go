@@ -0,0 +1,189 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+/* Function Section */
+
+type Function struct {
+	Options *Generator
+
+	DbName         string
+	DbSpecificName string // The guaranteed unique function name
+	GoFriendlyName string
+	DbComments     string
+
+	Parameters []FunctionParameter
+
+	ReturnType         string
+	ReturnGoType       string
+	ReturnNullableType string // e.g. "pgx.NullString"
+
+	Columns []Column // column definitions if the return type is a table
+
+	IsReturnVoid        bool
+	IsReturnUserDefined bool
+	IsReturnASet        bool
+	IsReturnARecord     bool
+	IsReturnTable       bool
+	IsReturnView        bool
+
+	GeneratedTemplate GeneratedSource
+	GoTypesToImport   map[string]string
+
+	// set when the generation failed and the function is skipped, with -keep-going
+	failed bool
+
+	// the progress messages, printed in order once all the objects are generated
+	progress progressLog
+}
+
+type FunctionParameter struct {
+	DbName         string
+	GoFriendlyName string
+	DbComments     string
+
+	// can be "Input", "Output", "InOut", "Variant"
+	Mode string
+
+	Type           string
+	GoType         string
+	GoNullableType string // e.g. "pgx.NullString"
+
+	IsOptional   bool
+	DefaultValue string
+}
+
+const (
+	FUNC_PARAM_TYPE_INPUT   = "Input"
+	FUNC_PARAM_TYPE_OUTPUT  = "Output"
+	FUNC_PARAM_TYPE_INOUT   = "InOut"
+	FUNC_PARAM_TYPE_VARIANT = "Variant"
+)
+
+func (f *Function) addGoTypeToImport(goTypeToImport string) {
+
+	if f.GoTypesToImport == nil {
+		f.GoTypesToImport = make(map[string]string)
+	}
+
+	f.GoTypesToImport[goTypeToImport] = goTypeToImport
+}
+
+func (f *Function) Generate() error {
+
+	if err := f.generateAndAppendTemplate("FUNCTION_TEMPLATE", FUNCTION_TEMPLATE, "Function generated."); err != nil {
+		return err
+	}
+
+	// the additional function templates from the -templates folder
+	if f.Options.Templates != nil {
+		for _, userTemplate := range f.Options.Templates.FunctionTemplates {
+			if err := f.generateAndAppendTemplate(userTemplate.Name, userTemplate.Content, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *Function) WriteToBuffer(functionBuffer *GeneratedSource) error {
+
+	_, err := functionBuffer.WriteTemplate("FUNCTION_TEMPLATE, function "+f.DbName, f.GeneratedTemplate.Bytes())
+	if err != nil {
+		return fmt.Errorf("WriteToBuffer(): error writing the generated code for function %s: %s", f.DbName, err)
+	}
+
+	return nil
+}
+
+func (f *Function) generateAndAppendTemplate(templateName string, templateContent string, taskCompletionMessage string) error {
+
+	// parsed once per run, a template with the same name in the -templates folder replaces the built-in one
+	tmpl, err := f.Options.parsedTemplate(templateName, templateContent)
+	if err != nil {
+		return fmt.Errorf("error parsing the %s template for function %s: %s", templateName, f.DbName, err)
+	}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, f)
+	if err != nil {
+		return fmt.Errorf("error running the %s template for function %s: %s", templateName, f.DbName, err)
+	}
+
+	if _, err = f.GeneratedTemplate.Write(generatedTemplate.Bytes()); err != nil {
+		return fmt.Errorf("error writing the output of the %s template for function %s: %s", templateName, f.DbName, err)
+	}
+
+	if taskCompletionMessage != "" {
+		f.progress.println(taskCompletionMessage)
+	}
+
+	return nil
+}
+
+func generateFunctionFilePrefix(g *Generator, functionBuffer *GeneratedSource) error {
+
+	templateName := "FUNCTION_TEMPLATE_PREFIX"
+
+	tmpl, err := g.parsedTemplate(templateName, FUNCTION_TEMPLATE_PREFIX)
+	if err != nil {
+		return fmt.Errorf("error parsing the %s template: %s", templateName, err)
+	}
+
+	functionData := struct {
+		Options         *Generator
+		GoTypesToImport map[string]string
+	}{g, g.functionGoTypesToImport}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, functionData)
+	if err != nil {
+		return fmt.Errorf("error running the %s template: %s", templateName, err)
+	}
+
+	if _, err = functionBuffer.WriteTemplate(templateName, generatedTemplate.Bytes()); err != nil {
+		return fmt.Errorf("error writing the output of the %s template: %s", templateName, err)
+	}
+
+	return nil
+
+}
+
+/* Util methods */
+
+func GetGoFriendlyNameForFunction(routineName string) string {
+
+	// find if the table name has underscore
+	if strings.Contains(routineName, "_") == false {
+		return strings.Title(routineName)
+	}
+
+	subNames := strings.Split(routineName, "_")
+
+	for i := range subNames {
+		subNames[i] = strings.Title(subNames[i])
+	}
+
+	return strings.Join(subNames, "")
+}
+
+func GetGoFriendlyNameForFunctionParam(paramName string) string {
+
+	// find if the table name has underscore
+	if strings.Contains(paramName, "_") == false {
+		return strings.Title(paramName)
+	}
+
+	subNames := strings.Split(paramName, "_")
+
+	for i := range subNames {
+		subNames[i] = strings.Title(subNames[i])
+	}
+
+	return strings.Join(subNames, "")
+}
@@ -0,0 +1,724 @@
+// Package gen renders the Go code for a schema read by the schema package: one file per
+// table and view, a file with the functions and the base files of the generated package.
+// It does not touch the disk, the caller decides where and how the files get written.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// the kind of the base files, next to OBJECT_TABLE, OBJECT_VIEW and OBJECT_FUNCTION
+const OBJECT_BASE = "base"
+
+// the drivers the generated code can be written for, see Options.Target
+const (
+	TARGET_PGX4 = "pgx4"
+	TARGET_PGX5 = "pgx5"
+	TARGET_SQL  = "sql" // database/sql, with whatever Postgres driver registered
+)
+
+// Options are the generation settings, the counterpart of the command-line flags
+type Options struct {
+	PackageName string
+
+	// the driver the generated code is written for, one of the TARGET_ values, empty for TARGET_PGX4.
+	// The import paths below must be the ones of that pgx version, SetTarget sets both.
+	// TARGET_SQL needs none of them.
+	Target string
+
+	PgxImport     string // (the full import path e.g. "github.com/jackc/pgx")
+	PgxPoolImport string // (the full import path e.g. "github.com/jackc/pgx/pgxpool")
+	PgTypeImport  string // (the full import path e.g. "github.com/jackc/pgx/pgtype")
+	PgConnImport  string // (the full import path e.g. "github.com/jackc/pgx/pgconn")
+
+	GenerateFunctions   bool
+	GeneratePKGetters   bool
+	GenerateUQGetters   bool
+	GenerateGuidGetters bool
+
+	// generate a <Name>Repository interface for each table and view, with a mock implementing it,
+	// and an in-memory <Name>Fake implementing it for each table
+	GenerateRepositories bool
+
+	// the generated methods take a context.Context first and keep their names, instead of getting
+	// a <Name>Ctx variant next to the ones running with context.Background()
+	CtxOnly bool
+
+	// when true, the tables, views and functions that fail are skipped instead of stopping the run
+	KeepGoing bool
+
+	// the per-object overrides, keyed by the database name. TableConfigs covers the views as well.
+	TableConfigs    map[string]*TableConfig
+	FunctionConfigs map[string]*FunctionConfig
+
+	// the custom Go types, keyed by database type, domain or table.column
+	TypeMappings TypeMappings
+
+	// the templates from a -templates folder, nil when the built-in ones are used
+	Templates *TemplateSet
+
+	// the number of tables, views and functions generated at the same time, 0 or 1 for one at a time
+	Jobs int
+
+	// the fingerprints of the files rendered by the previous run, keyed by file name: the files whose
+	// fingerprint is unchanged are not rendered again. Nil renders everything.
+	Fingerprints map[string]Fingerprint
+
+	// renders everything, whatever the fingerprints
+	Force bool
+
+	// where the progress messages go, nil to render quietly
+	Log io.Writer
+}
+
+// SetTarget sets the target and the import paths of its pgx packages. An empty target is TARGET_PGX4.
+func (o *Options) SetTarget(target string) error {
+
+	switch target {
+	case "", TARGET_PGX4:
+		o.Target = TARGET_PGX4
+		o.PgxImport = "github.com/jackc/pgx/v4"
+		o.PgxPoolImport = "github.com/jackc/pgx/v4/pgxpool"
+		o.PgTypeImport = "github.com/jackc/pgx/pgtype"
+		o.PgConnImport = "github.com/jackc/pgconn"
+	case TARGET_PGX5:
+		o.Target = TARGET_PGX5
+		o.PgxImport = "github.com/jackc/pgx/v5"
+		o.PgxPoolImport = "github.com/jackc/pgx/v5/pgxpool"
+		o.PgTypeImport = "github.com/jackc/pgx/v5/pgtype"
+		o.PgConnImport = "github.com/jackc/pgx/v5/pgconn"
+	case TARGET_SQL:
+		o.Target = TARGET_SQL
+		o.PgxImport, o.PgxPoolImport, o.PgTypeImport, o.PgConnImport = "", "", "", ""
+	default:
+		return fmt.Errorf("SetTarget(): unknown target %q, expected %q, %q or %q", target, TARGET_PGX4, TARGET_PGX5, TARGET_SQL)
+	}
+
+	return nil
+}
+
+// IsPgx5 returns true when the generated code is written for pgx v5
+func (o *Options) IsPgx5() bool {
+	return o.Target == TARGET_PGX5
+}
+
+// IsSql returns true when the generated code is written for database/sql
+func (o *Options) IsSql() bool {
+	return o.Target == TARGET_SQL
+}
+
+// TargetBaseFiles returns the names of the base files written once for the target, which do not
+// build with another one: the transactions, the types and the bulk copy, which TARGET_SQL has none of
+func (o *Options) TargetBaseFiles() []string {
+	return []string{o.PackageName + "_pgtogogen_tx.go", o.PackageName + "_pgtogogen_types.go", o.PackageName + "_pgtogogen_copy.go"}
+}
+
+// CtxSuffix returns the name suffix of the generated methods taking a context.Context,
+// empty when they are the only ones
+func (o *Options) CtxSuffix() string {
+
+	if o.CtxOnly {
+		return ""
+	}
+	return "Ctx"
+}
+
+// Generator holds the tables, views and functions of a schema, ready to be rendered.
+// It is the data the base templates run with, and what the table, view and function
+// templates reach through .Options.
+type Generator struct {
+	Options
+
+	DbSchema string
+
+	DbMajorVersion int
+	DbMinorVersion int
+
+	Tables []Table
+	Views  []View
+
+	Functions []Function
+
+	// what got generated and what got skipped
+	Report GenerationReport
+
+	// the imports needed by the return types of the functions, which share one file
+	functionGoTypesToImport map[string]string
+
+	// the templates parsed so far
+	templateCache templateCache
+
+	// the generator part of the fingerprints, and the fingerprints of the files shared by the objects
+	generatorHash        string
+	baseFingerprint      fingerprintCheck
+	functionsFingerprint fingerprintCheck
+}
+
+// File is a rendered Go file, named relative to the output folder
+type File struct {
+	Name    string
+	Content []byte
+
+	// the object the file was generated for: Kind is one of the OBJECT_ values, Object is the
+	// database name and GoName the Go name, both empty for the base and the functions files
+	Kind   string
+	Object string
+	GoName string
+
+	// what the file was rendered from, nil for the write-once files
+	Fingerprint *Fingerprint
+
+	// true when the fingerprint matches the previous run: the file was not rendered again and
+	// Content is empty, the file of the previous run is up to date
+	Unchanged bool
+
+	// true for the files that are yours to edit once generated (the custom and the one-time
+	// base files): they are only meant to be written when they do not exist yet
+	WriteOnce bool
+}
+
+// Result is the outcome of RenderFiles
+type Result struct {
+	Files  []File
+	Report GenerationReport
+}
+
+// Render renders the Go code for the schema and returns the file contents keyed by file name.
+// The write-once files are part of the map, it is up to the caller not to overwrite them.
+func Render(db *schema.Database, opts Options) (map[string][]byte, error) {
+
+	result, err := RenderFiles(db, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(result.Files))
+	for _, file := range result.Files {
+		files[file.Name] = file.Content
+	}
+
+	return files, nil
+}
+
+// RenderFiles renders the Go code for the schema. With opts.KeepGoing, the objects that fail
+// are left out and listed in the report, otherwise the first failure is returned.
+func RenderFiles(db *schema.Database, opts Options) (*Result, error) {
+
+	switch opts.Target {
+	case "", TARGET_PGX4, TARGET_PGX5, TARGET_SQL:
+	default:
+		return nil, fmt.Errorf("RenderFiles(): unknown target %q, expected %q, %q or %q", opts.Target, TARGET_PGX4, TARGET_PGX5, TARGET_SQL)
+	}
+
+	g := NewGenerator(opts)
+	g.DbSchema = db.Schema
+	g.DbMajorVersion = db.MajorVersion
+	g.DbMinorVersion = db.MinorVersion
+
+	for _, warning := range db.Warnings {
+		g.Report.AddWarning(warning)
+	}
+
+	if err := g.Populate(db); err != nil {
+		return nil, err
+	}
+
+	if err := g.Generate(); err != nil {
+		return nil, err
+	}
+
+	files, err := g.RenderFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Files: files, Report: g.Report}, nil
+}
+
+// NewGenerator returns an empty generator, to be filled by Populate
+func NewGenerator(opts Options) *Generator {
+	if opts.Target == "" {
+		opts.Target = TARGET_PGX4
+	}
+	return &Generator{Options: opts, functionGoTypesToImport: make(map[string]string)}
+}
+
+// handleFailure decides what happens when a table, view or function cannot be populated,
+// generated or rendered. With KeepGoing, the failure goes into the report and nil is returned,
+// so that the caller skips the object and carries on. Otherwise the error is returned, to stop the run.
+func (g *Generator) handleFailure(kind, name string, err error) error {
+
+	if !g.KeepGoing {
+		return err
+	}
+
+	g.logln("Skipping the", kind, name, "because of the error:", err)
+	g.Report.AddFailed(kind, name, err)
+
+	return nil
+}
+
+// logln writes a progress message, if there is somewhere to write it
+func (g *Generator) logln(a ...interface{}) {
+	if g.Log != nil {
+		fmt.Fprintln(g.Log, a...)
+	}
+}
+
+// Generate runs the templates of the tables, views and functions, up to Jobs at a time.
+// The progress messages and the failures are handled in order once all are done, so the
+// output is the same whatever the number of jobs.
+func (g *Generator) Generate() error {
+
+	tableErrs := forEach(g.Jobs, len(g.Tables), func(i int) error {
+		if g.Tables[i].fingerprint.unchanged {
+			return nil
+		}
+		g.Tables[i].progress.println("Beginning generation for table:", g.Tables[i].DbName)
+		return g.generateTable(&g.Tables[i])
+	})
+
+	for i, err := range tableErrs {
+		g.flushProgress(&g.Tables[i].progress)
+		if err != nil {
+			if err = g.handleFailure(OBJECT_TABLE, g.Tables[i].DbName, err); err != nil {
+				return err
+			}
+			g.Tables[i].failed = true
+		}
+	}
+
+	viewErrs := forEach(g.Jobs, len(g.Views), func(i int) error {
+		if g.Views[i].fingerprint.unchanged {
+			return nil
+		}
+		g.Views[i].progress.println("Beginning generation for view:", g.Views[i].DbName)
+		return g.generateView(&g.Views[i])
+	})
+
+	for i, err := range viewErrs {
+		g.flushProgress(&g.Views[i].progress)
+		if err != nil {
+			if err = g.handleFailure(OBJECT_VIEW, g.Views[i].DbName, err); err != nil {
+				return err
+			}
+			g.Views[i].failed = true
+		}
+	}
+
+	// the functions share one file, they are all generated again or not at all
+	if g.functionsFingerprint.unchanged {
+		return nil
+	}
+
+	functionErrs := forEach(g.Jobs, len(g.Functions), func(i int) error {
+		g.Functions[i].progress.println("Beginning generation for function:", g.Functions[i].DbName)
+		return g.Functions[i].Generate()
+	})
+
+	for i, err := range functionErrs {
+		g.flushProgress(&g.Functions[i].progress)
+		if err != nil {
+			if err = g.handleFailure(OBJECT_FUNCTION, g.Functions[i].DbName, err); err != nil {
+				return err
+			}
+			g.Functions[i].failed = true
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) generateTable(tbl *Table) error {
+
+	// generate the table structure
+	if err := tbl.GenerateTableStruct(); err != nil {
+		return err
+	}
+
+	// the column descriptors of the query builder
+	if err := tbl.generateAndAppendTemplate("QUERY_COLUMNS_TEMPLATE", QUERY_COLUMNS_TEMPLATE, ""); err != nil {
+		return err
+	}
+
+	// generate the select statements
+	if err := tbl.GenerateSelectFunctions(); err != nil {
+		return err
+	}
+
+	// generate the insert-related functions
+	if tbl.ShouldGenerate(TEMPLATE_GROUP_INSERT) {
+		if err := tbl.GenerateInsertFunctions(); err != nil {
+			return err
+		}
+	}
+
+	// generate the bulk-copy-related functions
+	if tbl.ShouldGenerate(TEMPLATE_GROUP_COPY) {
+		if err := tbl.GenerateBulkCopyFunctions(); err != nil {
+			return err
+		}
+	}
+
+	// generate the update-related functions
+	if tbl.ShouldGenerate(TEMPLATE_GROUP_UPDATE) {
+		if err := tbl.GenerateUpdateFunctions(); err != nil {
+			return err
+		}
+	}
+
+	// generate the delete-related functions
+	if tbl.ShouldGenerate(TEMPLATE_GROUP_DELETE) {
+		if err := tbl.GenerateDeleteFunctions(); err != nil {
+			return err
+		}
+	}
+
+	// generate the queries by PK
+	if g.GeneratePKGetters == true && tbl.ShouldGenerate(TEMPLATE_GROUP_GETTERS) {
+		tbl.progress.println("Generating Primary Key Accessor Methods...")
+
+		if len(tbl.PKColumns) > 0 {
+
+			// the getter should only return one row,
+			// no need to iterate here, just pass the first PK column,
+			// the GeneratePKGetter template will render according to
+			// the number of PK fields
+			pkGetter, err := tbl.PKColumns[0].GeneratePKGetter(tbl)
+			if err != nil {
+				return err
+			}
+			if _, err := tbl.GeneratedTemplate.WriteTemplate("PK_GETTER_TEMPLATE_ATOMIC", pkGetter); err != nil {
+				return fmt.Errorf("error writing the primary key getter for table %s: %s", tbl.DbName, err)
+			}
+
+			pkGetterTx, err := tbl.PKColumns[0].GeneratePKGetterTx(tbl)
+			if err != nil {
+				return err
+			}
+			if _, err := tbl.GeneratedTemplate.WriteTemplate("PK_GETTER_TEMPLATE_TX", pkGetterTx); err != nil {
+				return fmt.Errorf("error writing the primary key getter for table %s: %s", tbl.DbName, err)
+			}
+		}
+	}
+
+	// if the unique constraints getters generate flag is true, then
+	// generate those as well
+	if g.GenerateUQGetters == true && tbl.ShouldGenerate(TEMPLATE_GROUP_GETTERS) {
+		tbl.progress.println("Generating Unique Constraints Accessor Methods...")
+
+		for cIdx := range tbl.UniqueConstraints {
+
+			// non-transactional getter
+			uqGetter, err := tbl.UniqueConstraints[cIdx].GenerateUniqueConstraintGetter(tbl)
+			if err != nil {
+				return err
+			}
+			if _, err := tbl.GeneratedTemplate.WriteTemplate("UQ_GETTER_TEMPLATE_ATOMIC", uqGetter); err != nil {
+				return fmt.Errorf("error writing the unique constraint getter for table %s: %s", tbl.DbName, err)
+			}
+
+			// transactional getter
+			uqGetterTx, err := tbl.UniqueConstraints[cIdx].GenerateUniqueConstraintGetterTx(tbl)
+			if err != nil {
+				return err
+			}
+			if _, err := tbl.GeneratedTemplate.WriteTemplate("UQ_GETTER_TEMPLATE_TX", uqGetterTx); err != nil {
+				return fmt.Errorf("error writing the unique constraint getter for table %s: %s", tbl.DbName, err)
+			}
+		}
+	}
+
+	// the additional table templates from the -templates folder
+	if err := tbl.GenerateUserTemplates(); err != nil {
+		return err
+	}
+
+	// the repository covers the methods of all the templates above
+	if g.GenerateRepositories {
+		return tbl.GenerateRepository()
+	}
+
+	return nil
+}
+
+func (g *Generator) generateView(v *View) error {
+
+	// generate the view structure
+	if err := v.GenerateViewStruct(); err != nil {
+		return err
+	}
+
+	// the column descriptors of the query builder
+	if err := v.generateAndAppendTemplate("QUERY_COLUMNS_TEMPLATE", QUERY_COLUMNS_TEMPLATE, ""); err != nil {
+		return err
+	}
+
+	// generate the select statements
+	if err := v.GenerateSelectFunctions(); err != nil {
+		return err
+	}
+
+	// the additional view templates from the -templates folder
+	if err := v.GenerateUserTemplates(); err != nil {
+		return err
+	}
+
+	// the repository covers the methods of all the templates above
+	if g.GenerateRepositories {
+		return v.GenerateRepository()
+	}
+
+	return nil
+}
+
+// RenderFiles returns the files of the generated package: the base files, then one file
+// (and its custom file) per table and view, then the functions file. The objects are added
+// to the report as generated once their files are rendered.
+func (g *Generator) RenderFiles() ([]File, error) {
+
+	files, err := g.renderBaseFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	// the generated file, and the one-time only custom file of each table, rendered up to
+	// Jobs at a time and gathered in order
+	tableFiles := make([][2]File, len(g.Tables))
+	tableErrs := forEach(g.Jobs, len(g.Tables), func(i int) (err error) {
+		if g.Tables[i].failed {
+			return nil
+		}
+		if tableFiles[i][0], err = g.Tables[i].RenderFile(); err != nil {
+			return err
+		}
+		tableFiles[i][1], err = g.Tables[i].RenderCustomFile()
+		return err
+	})
+
+	for i, err := range tableErrs {
+
+		if g.Tables[i].failed {
+			continue
+		}
+
+		if err != nil {
+			if err = g.handleFailure(OBJECT_TABLE, g.Tables[i].DbName, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		files = append(files, tableFiles[i][0], tableFiles[i][1])
+		if g.Tables[i].fingerprint.unchanged {
+			g.Report.AddUnchanged(OBJECT_TABLE, g.Tables[i].DbName)
+		} else {
+			g.Report.AddGenerated(OBJECT_TABLE, g.Tables[i].DbName, g.Tables[i].fingerprint.reason)
+		}
+	}
+
+	// the same for the views
+	viewFiles := make([][2]File, len(g.Views))
+	viewErrs := forEach(g.Jobs, len(g.Views), func(i int) (err error) {
+		if g.Views[i].failed {
+			return nil
+		}
+		if viewFiles[i][0], err = g.Views[i].RenderFile(); err != nil {
+			return err
+		}
+		viewFiles[i][1], err = g.Views[i].RenderCustomFile()
+		return err
+	})
+
+	for i, err := range viewErrs {
+
+		if g.Views[i].failed {
+			continue
+		}
+
+		if err != nil {
+			if err = g.handleFailure(OBJECT_VIEW, g.Views[i].DbName, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		files = append(files, viewFiles[i][0], viewFiles[i][1])
+		if g.Views[i].fingerprint.unchanged {
+			g.Report.AddUnchanged(OBJECT_VIEW, g.Views[i].DbName)
+		} else {
+			g.Report.AddGenerated(OBJECT_VIEW, g.Views[i].DbName, g.Views[i].fingerprint.reason)
+		}
+	}
+
+	if len(g.Functions) > 0 {
+
+		// the functions share one file, so a failure there cannot be skipped
+		file, writtenFunctions, err := g.renderFunctionsFile()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+		for _, functionName := range writtenFunctions {
+			if g.functionsFingerprint.unchanged {
+				g.Report.AddUnchanged(OBJECT_FUNCTION, functionName)
+			} else {
+				g.Report.AddGenerated(OBJECT_FUNCTION, functionName, g.functionsFingerprint.reason)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// renderFunctionsFile returns the file holding all the functions that did not fail,
+// along with their names
+func (g *Generator) renderFunctionsFile() (File, []string, error) {
+
+	file := File{Name: g.functionsFileName(), Kind: OBJECT_FUNCTION, Fingerprint: &g.functionsFingerprint.Fingerprint}
+
+	// the file of the previous run is up to date
+	if g.functionsFingerprint.unchanged {
+		file.Unchanged = true
+		var functionNames []string
+		for i := range g.Functions {
+			functionNames = append(functionNames, g.Functions[i].DbName)
+		}
+		return file, functionNames, nil
+	}
+
+	functionFileBuffer := GeneratedSource{}
+
+	if err := generateFunctionFilePrefix(g, &functionFileBuffer); err != nil {
+		return File{}, nil, err
+	}
+
+	var writtenFunctions []string
+	for i := range g.Functions {
+
+		if g.Functions[i].failed {
+			continue
+		}
+
+		if err := g.Functions[i].WriteToBuffer(&functionFileBuffer); err != nil {
+			return File{}, nil, err
+		}
+		writtenFunctions = append(writtenFunctions, g.Functions[i].DbName)
+	}
+
+	source, err := functionFileBuffer.Format(file.Name)
+	if err != nil {
+		return File{}, nil, fmt.Errorf("renderFunctionsFile(): error formatting the functions file: %s", err)
+	}
+	file.Content = source
+
+	return file, writtenFunctions, nil
+}
+
+func (g *Generator) functionsFileName() string {
+	return g.PackageName + "DbFunctions.go"
+}
+
+func (g *Generator) baseFileName() string {
+	return g.PackageName + "_pgtogogen_base.go"
+}
+
+// renderBaseFiles returns the base files of the package, which hold the initialization functions,
+// the convenience functions to get the database handle, the collections, the transactions, etc.
+// Only the main base file is regenerated on every run, the others are yours to edit.
+func (g *Generator) renderBaseFiles() ([]File, error) {
+
+	// the types and the bulk copy are written against the pgtype package of the target.
+	// database/sql cannot bulk copy, the sql target has no bulk copy file.
+	typesName, typesContent := "BASE_DB_TYPES", BASE_DB_TYPES
+	copyName, copyContent := "BASE_BULK_COPY", BASE_BULK_COPY
+	switch {
+	case g.IsPgx5():
+		typesName, typesContent = "BASE_DB_TYPES_PGX5", BASE_DB_TYPES_PGX5
+		copyName, copyContent = "BASE_BULK_COPY_PGX5", BASE_BULK_COPY_PGX5
+	case g.IsSql():
+		typesName, typesContent = "BASE_DB_TYPES_SQL", BASE_DB_TYPES_SQL
+		copyName = ""
+	}
+
+	baseFiles := []struct {
+		templateName, builtinName, templateContent, baseFilename string
+		overwritable                                             bool
+	}{
+		{"main base file", "BASE_TEMPLATE", BASE_TEMPLATE, g.baseFileName(), true},
+		{"db settings base file", "BASE_TEMPLATE_SETTINGS", BASE_TEMPLATE_SETTINGS, g.PackageName + "_pgtogogen_db.go", false},
+		{"collections base file", "BASE_TEMPLATE_COLLECTIONS", BASE_TEMPLATE_COLLECTIONS, g.PackageName + "_pgtogogen_coll.go", false},
+		{"collections base file", "BASE_TEMPLATE_FORMS", BASE_TEMPLATE_FORMS, g.PackageName + "_pgtogogen_forms.go", false},
+		{"collections base file", "BASE_TRANSACTIONS", BASE_TRANSACTIONS, g.PackageName + "_pgtogogen_tx.go", false},
+		{"collections base file", typesName, typesContent, g.PackageName + "_pgtogogen_types.go", false},
+		{"collections base file", copyName, copyContent, g.PackageName + "_pgtogogen_copy.go", false},
+	}
+	if copyName == "" {
+		baseFiles = baseFiles[:len(baseFiles)-1]
+	}
+
+	files := make([]File, len(baseFiles))
+	errs := forEach(g.Jobs, len(baseFiles), func(i int) error {
+
+		baseFile := baseFiles[i]
+
+		// the main base file of the previous run is up to date
+		if baseFile.overwritable && g.baseFingerprint.unchanged {
+			files[i] = File{Name: baseFile.baseFilename, Kind: OBJECT_BASE, Fingerprint: &g.baseFingerprint.Fingerprint, Unchanged: true}
+			return nil
+		}
+
+		tmpl, err := g.parsedTemplate(baseFile.builtinName, baseFile.templateContent)
+		if err != nil {
+			return fmt.Errorf("renderBaseFiles(): error parsing the %s template: %s", baseFile.templateName, err)
+		}
+
+		var generatedTemplate bytes.Buffer
+		err = tmpl.Execute(&generatedTemplate, g)
+		if err != nil {
+			return fmt.Errorf("renderBaseFiles(): error running the %s template: %s", baseFile.templateName, err)
+		}
+
+		source, err := FormatGoSource(baseFile.baseFilename, generatedTemplate.Bytes())
+		if err != nil {
+			return fmt.Errorf("renderBaseFiles(): error formatting the output of the %s template: %s", baseFile.templateName, err)
+		}
+
+		files[i] = File{Name: baseFile.baseFilename, Content: source, Kind: OBJECT_BASE, WriteOnce: !baseFile.overwritable}
+		if baseFile.overwritable {
+			files[i].Fingerprint = &g.baseFingerprint.Fingerprint
+		}
+		return nil
+	})
+
+	// the first failure in the order of the list, whichever finished first
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// GetGoFriendlyNameForTable returns the Go name of a table or view, e.g. "UserRoles" for "user_roles"
+func GetGoFriendlyNameForTable(tableName string) string {
+
+	// find if the table name has underscore
+	if strings.Contains(tableName, "_") == false {
+		return strings.Title(tableName)
+	}
+
+	subNames := strings.Split(tableName, "_")
+	for i := range subNames {
+		subNames[i] = strings.Title(subNames[i])
+	}
+
+	return strings.Join(subNames, "")
+}
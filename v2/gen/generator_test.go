@@ -0,0 +1,114 @@
+package gen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestRenderFilesFingerprints(t *testing.T) {
+
+	options := gen.Options{PackageName: "models", GenerateFunctions: true, Jobs: 4}
+
+	first, err := gen.RenderFiles(fixtureDatabase(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, generated := range first.Report.Generated {
+		if generated.Reason != "" {
+			t.Errorf("without a previous run there is no reason to give, got %+v", generated)
+		}
+	}
+
+	// the fingerprints of the first run, as the manifest would hand them back
+	options.Fingerprints = map[string]gen.Fingerprint{}
+	for _, file := range first.Files {
+		if file.Fingerprint != nil {
+			options.Fingerprints[file.Name] = *file.Fingerprint
+		}
+		if file.WriteOnce && file.Fingerprint != nil {
+			t.Errorf("the write-once file %s should not have a fingerprint", file.Name)
+		}
+	}
+	delete(options.Fingerprints, "dailyTotals.go")
+
+	db := fixtureDatabase(t)
+	db.Tables[1].Columns[4].Comment = "changed"
+
+	second, err := gen.RenderFiles(db, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reasons := map[string]string{}
+	for _, generated := range second.Report.Generated {
+		reasons[generated.Kind+" "+generated.Name] = generated.Reason
+	}
+	if len(reasons) != 2 || reasons["table "+db.Tables[1].Name] != gen.REGENERATED_DEFINITION || reasons["view daily_totals"] != gen.REGENERATED_NEW {
+		t.Errorf("got the regenerated objects %v", reasons)
+	}
+	if accounts := findFile(t, second.Files, "accounts.go"); !accounts.Unchanged || len(accounts.Content) != 0 {
+		t.Errorf("the unchanged accounts.go should not be rendered again")
+	}
+	if functions := findFile(t, second.Files, "modelsDbFunctions.go"); !functions.Unchanged {
+		t.Errorf("the unchanged functions file should not be rendered again")
+	}
+	if base := findFile(t, second.Files, "models_pgtogogen_base.go"); base.Unchanged {
+		t.Errorf("the base file depends on the whole schema, it should be rendered again")
+	}
+	if custom := findFile(t, second.Files, "accounts-custom.go"); custom.Unchanged || len(custom.Content) == 0 {
+		t.Errorf("the write-once custom files are always rendered, the caller decides whether to write them")
+	}
+
+	// any change to the templates or the settings renders everything again
+	options.Templates = writeTemplates(t, map[string]string{"table.extra.tmpl": "// extra code for {{.GoFriendlyName}}\n"})
+	third, err := gen.RenderFiles(fixtureDatabase(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(third.Report.Unchanged) != 0 || third.Report.Generated[0].Reason != gen.REGENERATED_GENERATOR {
+		t.Errorf("got the report %+v", third.Report)
+	}
+
+	options.Templates, options.Force = nil, true
+	forced, err := gen.RenderFiles(fixtureDatabase(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(forced.Report.Unchanged) != 0 || forced.Report.Generated[0].Reason != gen.REGENERATED_FORCED {
+		t.Errorf("got the report %+v", forced.Report)
+	}
+}
+
+func TestRenderFilesKeepGoing(t *testing.T) {
+
+	// a template failing for a single table
+	failing := map[string]string{
+		"table.fail.tmpl": `{{if eq .DbName "transfers"}}{{.NoSuchField}}{{end}}`,
+	}
+
+	for _, jobs := range []int{1, 8} {
+
+		options := gen.Options{PackageName: "models", Jobs: jobs, Templates: writeTemplates(t, failing)}
+		if _, err := gen.RenderFiles(fixtureDatabase(t), options); err == nil || !strings.Contains(err.Error(), "table.fail.tmpl template for table transfers") {
+			t.Errorf("jobs %d: got error %v, want the failure of table transfers", jobs, err)
+		}
+
+		options.KeepGoing = true
+		result, err := gen.RenderFiles(fixtureDatabase(t), options)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !result.Report.HasFailures() || len(result.Report.Skipped) != 1 || result.Report.Skipped[0].Name != "transfers" {
+			t.Errorf("jobs %d: got the skipped objects %+v", jobs, result.Report.Skipped)
+		}
+		for _, file := range result.Files {
+			if strings.HasPrefix(file.Name, "transfers") {
+				t.Errorf("jobs %d: the failed table should have no file, got %s", jobs, file.Name)
+			}
+		}
+		findFile(t, result.Files, "accounts.go")
+	}
+}
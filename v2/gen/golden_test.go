@@ -0,0 +1,281 @@
+package gen_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// go test ./gen -update rewrites the golden files after a deliberate change to the generated code
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+const goldenFolder = "testdata/golden"
+
+// the packages imported by the generated code, other than the standard library, are type checked
+// against the stubs in this folder, one sub-folder per import path
+const stubsFolder = "testdata/stubs"
+
+// goldenSources are the schemas rendered by TestGoldenOutput, each with its golden files in a
+// sub-folder of testdata/golden named after it
+var goldenSources = []struct {
+	name    string
+	target  string
+	ctxOnly bool
+	source  func(t *testing.T) schema.Source
+}{
+	// a pg_dump --schema-only file
+	{"ddl", gen.TARGET_PGX4, false, func(t *testing.T) schema.Source {
+		ddl, err := ioutil.ReadFile("testdata/schema.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schema.DDLSource{DDL: string(ddl), Schema: "public"}
+	}},
+
+	// the schema the way Introspect reads it from a live database: enums, domains, arrays,
+	// json, views, a materialized view, overloaded and set returning functions
+	{"fixture", gen.TARGET_PGX4, false, loadFixture},
+
+	// the same schema for pgx v5
+	{"pgx5", gen.TARGET_PGX5, false, loadFixture},
+
+	// and for database/sql
+	{"sql", gen.TARGET_SQL, false, loadFixture},
+
+	// with the context-first methods only
+	{"ctx", gen.TARGET_PGX5, true, loadFixture},
+}
+
+func loadFixture(t *testing.T) schema.Source {
+	db, err := schema.LoadFixture("testdata/fixture.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema.MemorySource{Database: db}
+}
+
+// TestGoldenOutput renders each schema twice, the second time concurrently and from a schema
+// listed in another order, the way another server could list it. Both runs must produce the same
+// files, byte for byte, which must match the golden files and type check.
+func TestGoldenOutput(t *testing.T) {
+
+	for _, goldenSource := range goldenSources {
+		goldenSource := goldenSource
+
+		t.Run(goldenSource.name, func(t *testing.T) {
+
+			source := goldenSource.source(t)
+			first := renderSchema(t, source, goldenSource.target, goldenSource.ctxOnly, 1, false)
+			second := renderSchema(t, source, goldenSource.target, goldenSource.ctxOnly, 8, true)
+
+			if names(first) != names(second) {
+				t.Fatalf("the two runs rendered different files:\n%s\n%s", names(first), names(second))
+			}
+			for name, content := range first {
+				if !bytes.Equal(content, second[name]) {
+					t.Errorf("%s differs between the two runs", name)
+				}
+			}
+
+			compareGolden(t, filepath.Join(goldenFolder, goldenSource.name), first)
+			typeCheck(t, first)
+		})
+	}
+}
+
+// compareGolden compares the rendered files with the golden files of the folder,
+// after rewriting them with -update
+func compareGolden(t *testing.T, folder string, files map[string][]byte) {
+
+	if *update {
+		os.RemoveAll(folder)
+		if err := os.MkdirAll(folder, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for name, content := range files {
+			if err := ioutil.WriteFile(filepath.Join(folder, name), content, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	fileInfos, err := ioutil.ReadDir(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := map[string][]byte{}
+	for _, fileInfo := range fileInfos {
+		if golden[fileInfo.Name()], err = ioutil.ReadFile(filepath.Join(folder, fileInfo.Name())); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if names(files) != names(golden) {
+		t.Fatalf("the rendered files do not match the golden files, run go test ./gen -update if the change is deliberate:\n%s\n%s",
+			names(files), names(golden))
+	}
+	for name, content := range files {
+		if !bytes.Equal(content, golden[name]) {
+			t.Errorf("%s differs from the golden file, run go test ./gen -update if the change is deliberate", name)
+		}
+	}
+}
+
+// renderSchema reads the schema from the source and renders it for the target. With reversed, the tables, views,
+// functions and unique constraints are put in the reverse order before being sorted again.
+func renderSchema(t *testing.T, source schema.Source, target string, ctxOnly bool, jobs int, reversed bool) map[string][]byte {
+
+	db, err := source.ReadSchema(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reversed {
+		for i, j := 0, len(db.Tables)-1; i < j; i, j = i+1, j-1 {
+			db.Tables[i], db.Tables[j] = db.Tables[j], db.Tables[i]
+		}
+		for i, j := 0, len(db.Views)-1; i < j; i, j = i+1, j-1 {
+			db.Views[i], db.Views[j] = db.Views[j], db.Views[i]
+		}
+		for i, j := 0, len(db.Functions)-1; i < j; i, j = i+1, j-1 {
+			db.Functions[i], db.Functions[j] = db.Functions[j], db.Functions[i]
+		}
+		for _, table := range db.Tables {
+			constraints := table.UniqueConstraints
+			for i, j := 0, len(constraints)-1; i < j; i, j = i+1, j-1 {
+				constraints[i], constraints[j] = constraints[j], constraints[i]
+			}
+		}
+		db.Sort()
+	}
+
+	options := gen.Options{
+		PackageName: "models",
+
+		GenerateFunctions:   true,
+		GeneratePKGetters:   true,
+		GenerateUQGetters:   true,
+		GenerateGuidGetters: true,
+
+		GenerateRepositories: true,
+
+		// the views of the ddl and fixture schemas paged by their key
+		TableConfigs: map[string]*gen.TableConfig{
+			"user_roles":       {Key: []string{"id", "name"}},
+			"account_balances": {Key: []string{"account_id"}},
+		},
+
+		CtxOnly: ctxOnly,
+
+		Jobs: jobs,
+	}
+	if err := options.SetTarget(target); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := gen.Render(db, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return files
+}
+
+// typeCheck type checks the rendered files as one package
+func typeCheck(t *testing.T, files map[string][]byte) {
+
+	fset := token.NewFileSet()
+
+	var parsedFiles []*ast.File
+	for _, name := range strings.Fields(names(files)) {
+		parsedFile, err := parser.ParseFile(fset, name, files[name], 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsedFiles = append(parsedFiles, parsedFile)
+	}
+
+	var typeErrors []string
+	config := types.Config{
+		Importer: &stubImporter{fset: fset, std: importer.Default(), packages: map[string]*types.Package{}},
+		Error:    func(err error) { typeErrors = append(typeErrors, err.Error()) },
+	}
+	config.Check("models", fset, parsedFiles, nil)
+
+	for _, typeError := range typeErrors {
+		t.Error(typeError)
+	}
+}
+
+// stubImporter imports the standard library packages the usual way and the other ones
+// from their stubs in testdata/stubs
+type stubImporter struct {
+	fset     *token.FileSet
+	std      types.Importer
+	packages map[string]*types.Package
+}
+
+func (imp *stubImporter) Import(path string) (*types.Package, error) {
+
+	if pkg, found := imp.packages[path]; found {
+		return pkg, nil
+	}
+
+	folder := filepath.Join(stubsFolder, filepath.FromSlash(path))
+	if _, err := os.Stat(folder); err != nil {
+		return imp.std.Import(path)
+	}
+
+	parsedPackages, err := parser.ParseDir(imp.fset, folder, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedFiles []*ast.File
+	for _, parsedPackage := range parsedPackages {
+		for _, parsedFile := range parsedPackage.Files {
+			parsedFiles = append(parsedFiles, parsedFile)
+		}
+	}
+
+	config := types.Config{Importer: imp}
+	pkg, err := config.Check(path, imp.fset, parsedFiles, nil)
+	if err != nil {
+		return nil, err
+	}
+	imp.packages[path] = pkg
+
+	return pkg, nil
+}
+
+// names returns the sorted file names, one per line
+func names(files map[string][]byte) string {
+
+	var fileNames []string
+	for name := range files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	var buffer bytes.Buffer
+	for _, name := range fileNames {
+		buffer.WriteString(name + "\n")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,99 @@
+package gen
+
+import "fmt"
+
+/* Keyset pagination: the unique keys ordering the SelectAfter pages */
+
+// KeysetKeys returns the keys the SelectAfter pages of the table can be ordered by: the key
+// set in the configuration file, the primary key and the unique constraints whose columns
+// are all NOT NULL. There is no SelectAfter method without any.
+func (tbl *Table) KeysetKeys() ([][]Column, error) {
+
+	configuredKey, err := keyColumns(tbl.Config, tbl.Columns)
+	if err != nil {
+		return nil, fmt.Errorf("for table %s, %s", tbl.DbName, err)
+	}
+
+	var keys [][]Column
+	if configuredKey != nil {
+		keys = append(keys, configuredKey)
+	}
+	if len(tbl.PKColumns) > 0 {
+		keys = append(keys, tbl.PKColumns)
+	}
+
+	for _, constraint := range tbl.UniqueConstraints {
+		notNull := len(constraint.Columns) > 0
+		for i := range constraint.Columns {
+			if constraint.Columns[i].Nullable {
+				notNull = false
+			}
+		}
+		if notNull {
+			keys = append(keys, constraint.Columns)
+		}
+	}
+
+	return keys, nil
+}
+
+// KeysetColumns returns the columns the SelectAfter pages of the table can be ordered by
+func (tbl *Table) KeysetColumns() []Column {
+	return keysetColumns(tbl.Config, tbl.Columns)
+}
+
+// KeysetKeys returns the key set in the configuration file, the only key the SelectAfter
+// pages of the view can be ordered by
+func (v *View) KeysetKeys() ([][]Column, error) {
+
+	configuredKey, err := keyColumns(v.Config, v.Columns)
+	if err != nil {
+		return nil, fmt.Errorf("for view %s, %s", v.DbName, err)
+	}
+
+	if configuredKey == nil {
+		return nil, nil
+	}
+	return [][]Column{configuredKey}, nil
+}
+
+// KeysetColumns returns the columns the SelectAfter pages of the view can be ordered by
+func (v *View) KeysetColumns() []Column {
+	return keysetColumns(v.Config, v.Columns)
+}
+
+// keyColumns returns the columns of the key set in the configuration file, nil if there is none
+func keyColumns(config *TableConfig, columns []Column) ([]Column, error) {
+
+	if config == nil || len(config.Key) == 0 {
+		return nil, nil
+	}
+
+	var key []Column
+	for _, dbName := range config.Key {
+		found := false
+		for i := range columns {
+			if columns[i].DbName == dbName {
+				key = append(key, columns[i])
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the key column %s is not one of the columns", dbName)
+		}
+	}
+	return key, nil
+}
+
+// keysetColumns returns the NOT NULL columns and the ones of the configured key, which the
+// keyset pages can be ordered by since they never compare to NULL
+func keysetColumns(config *TableConfig, columns []Column) []Column {
+
+	var orderColumns []Column
+	for i := range columns {
+		if !columns[i].Nullable || (config != nil && stringInSlice(columns[i].DbName, config.Key)) {
+			orderColumns = append(orderColumns, columns[i])
+		}
+	}
+	return orderColumns
+}
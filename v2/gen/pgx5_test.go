@@ -0,0 +1,61 @@
+//go:build pgx5
+// +build pgx5
+
+package gen_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// the pgx v5 release the golden files are built against, PGX5_VERSION picks another one
+const defaultPgx5Version = "v5.7.5"
+
+// TestGoldenPgx5Build builds and vets the pgx5 golden files against the real pgx v5 module, of which the
+// stubs in testdata/stubs only declare the part the type check needs. It needs the go command and the
+// module, from the module proxy or the module cache:
+//
+//	go test -tags pgx5 ./gen -run TestGoldenPgx5Build
+func TestGoldenPgx5Build(t *testing.T) {
+
+	goCommand, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go command is not available")
+	}
+
+	pgx5Version := os.Getenv("PGX5_VERSION")
+	if pgx5Version == "" {
+		pgx5Version = defaultPgx5Version
+	}
+
+	moduleFolder := tempDir(t)
+
+	// the generated code in the models package, and the uuid stub standing in for github.com/silviucm/uuid
+	copyFiles(t, filepath.Join(goldenFolder, "pgx5"), filepath.Join(moduleFolder, "models"))
+	copyFiles(t, filepath.Join(stubsFolder, "github.com", "silviucm", "uuid"), filepath.Join(moduleFolder, "uuid"))
+	writeFile(t, filepath.Join(moduleFolder, "uuid", "go.mod"), "module github.com/silviucm/uuid\n")
+
+	writeFile(t, filepath.Join(moduleFolder, "go.mod"), `module goldenpgx5
+
+go 1.21
+
+require (
+	github.com/jackc/pgx/v5 `+pgx5Version+`
+	github.com/silviucm/uuid v0.0.0
+)
+
+replace github.com/silviucm/uuid => ./uuid
+`)
+
+	for _, args := range [][]string{{"mod", "tidy"}, {"vet", "./..."}} {
+		command := exec.Command(goCommand, args...)
+		command.Dir = moduleFolder
+		command.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+		if output, err := command.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %s\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+}
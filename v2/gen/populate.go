@@ -0,0 +1,270 @@
+package gen
+
+import (
+	"strconv"
+
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// Populate resolves the Go types and names of the tables, views and functions of the schema,
+// applying the configuration overrides and the type mappings. The objects left out are listed
+// in the report.
+func (g *Generator) Populate(db *schema.Database) error {
+
+	// the base file lists all the tables and views
+	g.generatorHash = g.generatorFingerprint()
+	g.baseFingerprint = g.checkFingerprint(g.baseFileName(), hashJSON(db, g.TableConfigs, g.FunctionConfigs))
+
+	for i := range db.Tables {
+		if err := g.populateTable(&db.Tables[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range db.Views {
+		g.populateView(&db.Views[i])
+	}
+
+	if !g.GenerateFunctions {
+		g.logln("Skipping collecting functions...")
+		return nil
+	}
+
+	// what the functions file is generated from: the functions and the tables and views they return
+	var functionDefinitions []interface{}
+
+	duplicateFuncNameMap := make(map[string]int)
+	for i := range db.Functions {
+
+		dbFunction := &db.Functions[i]
+		functionConfig := g.FunctionConfigFor(dbFunction.Name).WithAnnotations(dbFunction.Comment)
+		if functionConfig.IsSkipped() {
+			g.Report.AddSkipped(OBJECT_FUNCTION, dbFunction.Name, SKIPPED_BY_CONFIG)
+			continue
+		}
+
+		functionDefinitions = append(functionDefinitions, dbFunction, functionConfig)
+		if dbFunction.ReturnDataType == "USER-DEFINED" {
+			functionDefinitions = append(functionDefinitions, db.Table(dbFunction.ReturnUdtName), db.View(dbFunction.ReturnUdtName),
+				g.TableConfigFor(dbFunction.ReturnUdtName))
+		}
+
+		count := duplicateFuncNameMap[dbFunction.Name] + 1
+
+		currentFunction := g.newFunction(dbFunction, count)
+		if currentFunction != nil {
+			g.Functions = append(g.Functions, *currentFunction)
+			duplicateFuncNameMap[dbFunction.Name] = count
+		}
+	}
+
+	g.functionsFingerprint = g.checkFingerprint(g.functionsFileName(), hashJSON(functionDefinitions...))
+
+	return nil
+}
+
+func (g *Generator) populateTable(dt *schema.Table) error {
+
+	tableConfig := g.TableConfigFor(dt.Name).WithAnnotations(dt.Comment)
+	if tableConfig.IsSkipped() {
+		g.Report.AddSkipped(OBJECT_TABLE, dt.Name, SKIPPED_BY_CONFIG)
+		return nil
+	}
+
+	// instantiate a table struct
+	currentTable := &Table{
+		DbName:            dt.Name,
+		GoFriendlyName:    tableConfig.GoNameOr(GetGoFriendlyNameForTable(dt.Name)),
+		Config:            tableConfig,
+		Options:           g,
+		GeneratedTemplate: GeneratedSource{},
+		DbComments:        dt.Comment,
+		IsTable:           true,
+	}
+
+	currentTable.GoTypesToImport = make(map[string]string)
+	currentTable.fingerprint = g.checkFingerprint(currentTable.fileName(), hashJSON(dt, tableConfig))
+
+	for _, dc := range dt.Columns {
+		if err := currentTable.AddColumn(dc.Name, dc.DataType, dc.UdtName, dc.DomainName, dc.Comment, dc.Nullable,
+			dc.Default, dc.MaxLength, dc.Position); err != nil {
+			return g.handleFailure(OBJECT_TABLE, dt.Name, err)
+		}
+	}
+
+	if currentTable.Columns != nil {
+		// get all columns and all params string friendly
+		currentTable.ColumnsString = currentTable.getSqlFriendlyColumnList(false, false)
+		currentTable.ColumnsStringGoSafe = currentTable.getSqlFriendlyColumnList(false, true)
+		currentTable.ParamString = currentTable.getSqlFriendlyParameters(false)
+	}
+
+	currentTable.SetPrimaryKeyColumns(dt.PrimaryKey)
+
+	for _, constraint := range dt.UniqueConstraints {
+		currentTable.AddUniqueConstraint(constraint.Name, "UNIQUE", constraint.Columns)
+	}
+
+	// generate the typical select sql queries
+	currentTable.CreateGenericQueries()
+
+	g.Tables = append(g.Tables, *currentTable)
+
+	return nil
+}
+
+func (g *Generator) populateView(dv *schema.View) {
+
+	viewConfig := g.TableConfigFor(dv.Name).WithAnnotations(dv.Comment)
+	if viewConfig.IsSkipped() {
+		g.Report.AddSkipped(OBJECT_VIEW, dv.Name, SKIPPED_BY_CONFIG)
+		return
+	}
+
+	// instantiate a view struct
+	currentView := &View{
+		DbName:            dv.Name,
+		GoFriendlyName:    viewConfig.GoNameOr(GetGoFriendlyNameForTable(dv.Name)),
+		Config:            viewConfig,
+		Options:           g,
+		GeneratedTemplate: GeneratedSource{},
+		DbComments:        dv.Comment,
+		IsMaterialized:    dv.Materialized,
+		IsTable:           false,
+	}
+
+	currentView.GoTypesToImport = make(map[string]string)
+	currentView.fingerprint = g.checkFingerprint(currentView.fileName(), hashJSON(dv, viewConfig))
+
+	for _, dc := range dv.Columns {
+		if goType, _, _ := GetGoTypeForColumn(dc.DataType, true, dc.UdtName); goType == "" && g.TypeMappingFor(dv.Name, dc.Name, dc.DataType, dc.UdtName, dc.DomainName, dc.Comment) == nil {
+			g.Report.AddWarning("view " + dv.Name + ", column " + dc.Name + ": could not resolve type " + dc.DataType + ", the column is skipped")
+			continue
+		}
+		currentView.AddColumn(dc.Name, dc.DataType, dc.UdtName, dc.DomainName, dc.Comment, dc.Nullable, dc.Default, dc.MaxLength)
+	}
+
+	if currentView.Columns == nil {
+		g.Report.AddSkipped(OBJECT_VIEW, dv.Name, "none of its columns could be resolved")
+		return
+	}
+
+	currentView.ColumnsString = currentView.getSqlFriendlyColumnList()
+	currentView.ParamString = currentView.getSqlFriendlyParameters()
+
+	// generate the typical select sql queries
+	currentView.CreateGenericQueries()
+
+	g.Views = append(g.Views, *currentView)
+}
+
+// newFunction returns nil for the functions which cannot be mapped, after adding them to the report
+func (g *Generator) newFunction(df *schema.Function, duplicateCount int) *Function {
+
+	newFunction := &Function{
+		Options:           g,
+		DbName:            df.Name,
+		DbSpecificName:    df.SpecificName,
+		GoFriendlyName:    g.FunctionConfigFor(df.Name).GoNameOr(GetGoFriendlyNameForFunction(df.Name)),
+		DbComments:        df.Comment,
+		IsReturnASet:      df.ReturnsSet,
+		IsReturnARecord:   (df.ReturnsSet == false),
+		GeneratedTemplate: GeneratedSource{},
+	}
+
+	if duplicateCount > 1 {
+		newFunction.GoFriendlyName = newFunction.GoFriendlyName + "_" + strconv.Itoa(duplicateCount)
+	}
+
+	switch {
+
+	case df.ReturnDataType == "USER-DEFINED":
+		found := false
+		// iterate through the list of tables and views and see if they match the UDT type provided
+		for _, currentTable := range g.Tables {
+			if currentTable.DbName == df.ReturnUdtName {
+				found = true
+				newFunction.ReturnType = currentTable.DbName
+				newFunction.ReturnGoType = currentTable.GoFriendlyName
+				newFunction.Columns = currentTable.Columns
+			}
+		}
+		for _, currentView := range g.Views {
+			if currentView.DbName == df.ReturnUdtName {
+				found = true
+				newFunction.ReturnType = currentView.DbName
+				newFunction.ReturnGoType = currentView.GoFriendlyName
+				newFunction.Columns = currentView.Columns
+			}
+		}
+		if found == false {
+			g.Report.AddSkipped(OBJECT_FUNCTION, df.Name, "USER-DEFINED return type, but no table or view named "+df.ReturnUdtName)
+			return nil
+		}
+		newFunction.IsReturnUserDefined = true
+
+	case df.ReturnDataType == "void":
+		newFunction.IsReturnVoid = true
+
+	default:
+		newFunction.ReturnType = df.ReturnDataType
+
+		// get the corresponding go type
+		correspondingGoType, nullableType, goTypeToImport := g.GoTypeForColumn(df.ReturnDataType, true, "")
+
+		if correspondingGoType == "" {
+			// empty go type, means type could not be identified
+			g.Report.AddSkipped(OBJECT_FUNCTION, df.Name, "unable to identify the Go type for the return type "+df.ReturnDataType)
+			return nil
+		}
+
+		if goTypeToImport != "" {
+			newFunction.addGoTypeToImport(goTypeToImport)
+			// the functions share one file, which imports what all of them need
+			g.functionGoTypesToImport[goTypeToImport] = goTypeToImport
+		}
+
+		newFunction.ReturnGoType = correspondingGoType
+		newFunction.ReturnNullableType = nullableType
+	}
+
+	for i, param := range df.Parameters {
+
+		resolvedGoType, nullableType, goTypeToImport := g.GoTypeForColumn(param.DataType, false, "")
+		if resolvedGoType == "" {
+			continue
+		}
+
+		if goTypeToImport != "" {
+			newFunction.addGoTypeToImport(goTypeToImport)
+			g.functionGoTypesToImport[goTypeToImport] = goTypeToImport
+		}
+
+		// unnamed parameters get a positional name
+		goFriendlyName := GetGoFriendlyNameForFunctionParam(param.Name)
+		if param.Name == "" {
+			goFriendlyName = "Arg" + strconv.Itoa(i+1)
+		}
+
+		newFunction.Parameters = append(newFunction.Parameters, FunctionParameter{
+			DbName:         param.Name,
+			Mode:           functionParameterModes[param.Mode],
+			Type:           param.DataType,
+			DefaultValue:   param.Default,
+			IsOptional:     param.Default != "",
+			GoFriendlyName: goFriendlyName,
+			GoType:         resolvedGoType,
+			GoNullableType: nullableType,
+		})
+	}
+
+	return newFunction
+}
+
+// the FUNC_PARAM_TYPE_ values of the schema parameter modes
+var functionParameterModes = map[string]string{
+	schema.PARAMETER_MODE_IN:       FUNC_PARAM_TYPE_INPUT,
+	schema.PARAMETER_MODE_OUT:      FUNC_PARAM_TYPE_OUTPUT,
+	schema.PARAMETER_MODE_INOUT:    FUNC_PARAM_TYPE_INOUT,
+	schema.PARAMETER_MODE_VARIADIC: FUNC_PARAM_TYPE_VARIANT,
+}
@@ -0,0 +1,163 @@
+package gen
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// the kinds of database objects the tool generates code for
+const (
+	OBJECT_TABLE    = "table"
+	OBJECT_VIEW     = "view"
+	OBJECT_FUNCTION = "function"
+)
+
+// the reason given in the report for the objects left out by the configuration
+const SKIPPED_BY_CONFIG = "excluded by the configuration file or a @pgtogogen:skip annotation"
+
+// GenerationReport keeps track of what a run generated and of what it skipped, and why.
+// The command line tool prints it at the end of the run.
+type GenerationReport struct {
+	Generated []GeneratedObject
+	Unchanged []GeneratedObject // the objects whose file of the previous run is up to date
+	Skipped   []SkippedObject
+	Removed   []string // the stale generated files deleted from the output folder
+	Warnings  []string
+}
+
+// GeneratedObject is a table, view or function the code was generated for
+type GeneratedObject struct {
+	Kind string
+	Name string
+	// why it was generated again, one of the REGENERATED_ values, empty when
+	// the generation does not compare with a previous run
+	Reason string
+}
+
+// SkippedObject is a table, view or function left out of the generated code
+type SkippedObject struct {
+	Kind   string
+	Name   string
+	Reason string
+	// true if the object was skipped because of an error (with -keep-going),
+	// false if it was left out on purpose, e.g. by the configuration file
+	Failed bool
+}
+
+// AddGenerated records a table, view or function as written
+func (r *GenerationReport) AddGenerated(kind, name, reason string) {
+	r.Generated = append(r.Generated, GeneratedObject{Kind: kind, Name: name, Reason: reason})
+}
+
+// AddUnchanged records a table, view or function whose file is left as the previous run wrote it
+func (r *GenerationReport) AddUnchanged(kind, name string) {
+	r.Unchanged = append(r.Unchanged, GeneratedObject{Kind: kind, Name: name})
+}
+
+// AddSkipped records a table, view or function left out on purpose
+func (r *GenerationReport) AddSkipped(kind, name, reason string) {
+	r.Skipped = append(r.Skipped, SkippedObject{Kind: kind, Name: name, Reason: reason})
+}
+
+// AddFailed records a table, view or function left out because of an error.
+// If the object was already recorded as generated, e.g. when writing its file failed, that record is dropped.
+func (r *GenerationReport) AddFailed(kind, name string, err error) {
+
+	for i, generated := range r.Generated {
+		if generated.Kind == kind && generated.Name == name {
+			r.Generated = append(r.Generated[:i], r.Generated[i+1:]...)
+			break
+		}
+	}
+
+	r.Skipped = append(r.Skipped, SkippedObject{Kind: kind, Name: name, Reason: err.Error(), Failed: true})
+}
+
+// AddRemoved records a stale generated file deleted from the output folder
+func (r *GenerationReport) AddRemoved(filePath string) {
+	r.Removed = append(r.Removed, filePath)
+}
+
+// AddWarning records a problem that did not stop the generation, but needs attention
+func (r *GenerationReport) AddWarning(warning string) {
+	r.Warnings = append(r.Warnings, warning)
+}
+
+// HasFailures returns true if any table, view or function was skipped because of an error
+func (r *GenerationReport) HasFailures() bool {
+	for _, skipped := range r.Skipped {
+		if skipped.Failed {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes the summary of the run
+func (r *GenerationReport) Print(w io.Writer) {
+
+	fmt.Fprintln(w, "--------------------------------------------------------------------------------------------")
+	fmt.Fprintln(w, "Generation report")
+	fmt.Fprintln(w, "--------------------------------------------------------------------------------------------")
+
+	// the reason is given once when it is the same for all, e.g. on the first run
+	sameReason := true
+	for _, generated := range r.Generated {
+		sameReason = sameReason && generated.Reason == r.Generated[0].Reason
+	}
+
+	if sameReason && len(r.Generated) > 0 && r.Generated[0].Reason != "" {
+		fmt.Fprintln(w, "Generated: "+countObjects(r.Generated)+" ("+r.Generated[0].Reason+").")
+	} else {
+		fmt.Fprintln(w, "Generated: "+countObjects(r.Generated)+".")
+	}
+	if !sameReason {
+		for _, generated := range r.Generated {
+			fmt.Fprintf(w, "  %s %s: %s\n", generated.Kind, generated.Name, generated.Reason)
+		}
+	}
+
+	if len(r.Unchanged) > 0 {
+		fmt.Fprintln(w, "Unchanged: "+countObjects(r.Unchanged)+".")
+	}
+
+	if len(r.Skipped) == 0 {
+		fmt.Fprintln(w, "Skipped: none.")
+	} else {
+		fmt.Fprintln(w, "Skipped: "+strconv.Itoa(len(r.Skipped))+".")
+		for _, skipped := range r.Skipped {
+			status := "skipped"
+			if skipped.Failed {
+				status = "FAILED"
+			}
+			fmt.Fprintf(w, "  %s %s (%s): %s\n", skipped.Kind, skipped.Name, status, skipped.Reason)
+		}
+	}
+
+	if len(r.Removed) > 0 {
+		fmt.Fprintln(w, "Removed stale files: "+strconv.Itoa(len(r.Removed))+".")
+		for _, filePath := range r.Removed {
+			fmt.Fprintln(w, "  "+filePath)
+		}
+	}
+
+	if len(r.Warnings) > 0 {
+		fmt.Fprintln(w, "Warnings: "+strconv.Itoa(len(r.Warnings))+".")
+		for _, warning := range r.Warnings {
+			fmt.Fprintln(w, "  "+warning)
+		}
+	}
+}
+
+// countObjects returns e.g. "2 tables, 1 views, 0 functions"
+func countObjects(objects []GeneratedObject) string {
+
+	counts := map[string]int{}
+	for _, object := range objects {
+		counts[object.Kind]++
+	}
+
+	return strconv.Itoa(counts[OBJECT_TABLE]) + " tables, " + strconv.Itoa(counts[OBJECT_VIEW]) + " views, " +
+		strconv.Itoa(counts[OBJECT_FUNCTION]) + " functions"
+}
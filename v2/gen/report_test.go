@@ -0,0 +1,58 @@
+package gen_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestGenerationReportPrint(t *testing.T) {
+
+	var report gen.GenerationReport
+	report.AddGenerated(gen.OBJECT_TABLE, "users", gen.REGENERATED_NEW)
+	report.AddGenerated(gen.OBJECT_VIEW, "active_users", gen.REGENERATED_NEW)
+	report.AddGenerated(gen.OBJECT_TABLE, "orders", gen.REGENERATED_NEW)
+	report.AddUnchanged(gen.OBJECT_FUNCTION, "add_em")
+	report.AddSkipped(gen.OBJECT_TABLE, "audit_log", gen.SKIPPED_BY_CONFIG)
+	report.AddFailed(gen.OBJECT_TABLE, "orders", errors.New("unsupported type"))
+	report.AddRemoved("models/old.go")
+	report.AddWarning("check this")
+
+	if !report.HasFailures() {
+		t.Errorf("the failed table should count as a failure")
+	}
+
+	var output bytes.Buffer
+	report.Print(&output)
+
+	want := `Generated: 1 tables, 1 views, 0 functions (no up-to-date file from a previous run).
+Unchanged: 0 tables, 0 views, 1 functions.
+Skipped: 2.
+  table audit_log (skipped): ` + gen.SKIPPED_BY_CONFIG + `
+  table orders (FAILED): unsupported type
+Removed stale files: 1.
+  models/old.go
+Warnings: 1.
+  check this
+`
+	if !strings.HasSuffix(output.String(), want) {
+		t.Errorf("got\n%s\nwant it to end with\n%s", output.String(), want)
+	}
+
+	// the reasons are listed one by one when they differ
+	report = gen.GenerationReport{}
+	report.AddGenerated(gen.OBJECT_TABLE, "users", gen.REGENERATED_DEFINITION)
+	report.AddGenerated(gen.OBJECT_TABLE, "orders", gen.REGENERATED_NEW)
+
+	output.Reset()
+	report.Print(&output)
+	if !strings.Contains(output.String(), "Generated: 2 tables, 0 views, 0 functions.\n  table users: "+gen.REGENERATED_DEFINITION+"\n  table orders: "+gen.REGENERATED_NEW+"\nSkipped: none.\n") {
+		t.Errorf("got\n%s", output.String())
+	}
+	if report.HasFailures() {
+		t.Errorf("no failures expected")
+	}
+}
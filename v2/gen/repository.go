@@ -0,0 +1,265 @@
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// RepositoryMethod is a method of the t<Name>Utils type of a table or view, the way the
+// REPOSITORY_TEMPLATE declares it in the <Name>Repository interface and implements it in the mock
+type RepositoryMethod struct {
+	Name string
+
+	// the parameters, named p0, p1... when the method does not name them,
+	// e.g. "ctx context.Context, where Predicate, options ...SelectOption"
+	Params string
+
+	// the results, e.g. "([]Users, error)", and the same named result0, result1...
+	// so that the mock returns the zero values. Both are empty when there are none.
+	Results      string
+	NamedResults string
+
+	// the parameter names, e.g. "ctx, where, options" to record the call
+	// and "ctx, where, options..." to pass it on
+	Args     string
+	CallArgs string
+
+	// the named result holding the error, e.g. "result1", empty when the last result is not an error
+	ErrorResult string
+}
+
+// utilsMethods returns the exported methods of the utilsType type declared in the source,
+// in their order of declaration
+func utilsMethods(source []byte, utilsType string) ([]RepositoryMethod, error) {
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []RepositoryMethod
+	for _, decl := range file.Decls {
+
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 || !funcDecl.Name.IsExported() {
+			continue
+		}
+
+		recvType := funcDecl.Recv.List[0].Type
+		if star, ok := recvType.(*ast.StarExpr); ok {
+			recvType = star.X
+		}
+		if ident, ok := recvType.(*ast.Ident); !ok || ident.Name != utilsType {
+			continue
+		}
+
+		methods = append(methods, newRepositoryMethod(funcDecl.Name.Name, funcDecl.Type))
+	}
+
+	return methods, nil
+}
+
+func newRepositoryMethod(name string, funcType *ast.FuncType) RepositoryMethod {
+
+	method := RepositoryMethod{Name: name}
+
+	var params, args, callArgs []string
+	for _, field := range funcType.Params.List {
+
+		names := fieldNames(field)
+		if len(names) == 0 {
+			names = []string{""}
+		}
+
+		for _, paramName := range names {
+			if paramName == "" || paramName == "_" {
+				paramName = "p" + strconv.Itoa(len(params))
+			}
+
+			params = append(params, paramName+" "+types.ExprString(field.Type))
+			args = append(args, paramName)
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				paramName += "..."
+			}
+			callArgs = append(callArgs, paramName)
+		}
+	}
+
+	method.Params = strings.Join(params, ", ")
+	method.Args = strings.Join(args, ", ")
+	method.CallArgs = strings.Join(callArgs, ", ")
+
+	if funcType.Results == nil {
+		return method
+	}
+
+	var results, namedResults []string
+	for _, field := range funcType.Results.List {
+
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+
+		for i := 0; i < count; i++ {
+			results = append(results, types.ExprString(field.Type))
+			namedResults = append(namedResults, "result"+strconv.Itoa(len(namedResults))+" "+types.ExprString(field.Type))
+		}
+	}
+
+	method.Results = "(" + strings.Join(results, ", ") + ")"
+	if results[len(results)-1] == "error" {
+		method.ErrorResult = "result" + strconv.Itoa(len(results)-1)
+	}
+	method.NamedResults = "(" + strings.Join(namedResults, ", ") + ")"
+
+	return method
+}
+
+func fieldNames(field *ast.Field) []string {
+
+	names := make([]string, len(field.Names))
+	for i, ident := range field.Names {
+		names[i] = ident.Name
+	}
+
+	return names
+}
+
+// GenerateRepository appends the <Name>Repository interface, holding the methods generated
+// so far on t<Name>Utils, its mock and the <Name>Fake in-memory table
+func (tbl *Table) GenerateRepository() error {
+
+	methods, err := utilsMethods(tbl.GeneratedTemplate.Bytes(), "t"+tbl.GoFriendlyName+"Utils")
+	if err != nil {
+		// the syntax error is reported with the template causing it when the file is formatted
+		return nil
+	}
+	tbl.RepositoryMethods = methods
+
+	err = tbl.generateAndAppendTemplate("REPOSITORY_TEMPLATE", REPOSITORY_TEMPLATE,
+		fmt.Sprintf("Repository interface generated, %d methods.", len(methods)))
+	if err != nil {
+		return err
+	}
+
+	return tbl.generateAndAppendTemplate("FAKE_TEMPLATE", FAKE_TEMPLATE, "In-memory fake generated.")
+}
+
+// GenerateRepository appends the <Name>Repository interface, holding the methods generated
+// so far on t<Name>Utils, and its mock
+func (v *View) GenerateRepository() error {
+
+	methods, err := utilsMethods(v.GeneratedTemplate.Bytes(), "t"+v.GoFriendlyName+"Utils")
+	if err != nil {
+		// the syntax error is reported with the template causing it when the file is formatted
+		return nil
+	}
+	v.RepositoryMethods = methods
+
+	return v.generateAndAppendTemplate("REPOSITORY_TEMPLATE", REPOSITORY_TEMPLATE,
+		fmt.Sprintf("Repository interface generated, %d methods.", len(methods)))
+}
+
+// fakeDelegatedMethods are the methods the <Name>Fake passes on to the t<Name>Utils,
+// as they do not use the database
+var fakeDelegatedMethods = []string{"New", "CreateFromHttpRequest", "CreateFromHttpRequestIgnoreErrors",
+	"ToDbFieldName", "ToDbFieldTypeFromColName"}
+
+// fakeMethods returns the methods the FAKE_TEMPLATE implements in memory, without the Ctx suffix
+func (tbl *Table) fakeMethods() []string {
+
+	methods := []string{"Insert", "CopyFromSlice", "Select", "SelectUnion", "SelectUnionAll", "SelectCached",
+		"SelectPage", "SelectPageCached", "SelectAll", "SelectAllOrderBy", "SelectAllPage", "SelectAfter", "Single",
+		"Count", "Update", "UpdateWithMask", "Delete", "DeleteAll"}
+
+	if len(tbl.PKColumns) > 0 {
+		methods = append(methods, "DeleteInstance", tbl.PKGetterName())
+	}
+	for _, constraint := range tbl.UniqueConstraints {
+		methods = append(methods, constraint.GetterName())
+	}
+
+	return methods
+}
+
+// HasFakeMethod tells whether the FAKE_TEMPLATE implements the method, named without the Ctx suffix:
+// it does when the repository has it
+func (tbl *Table) HasFakeMethod(name string) bool {
+
+	for _, method := range tbl.RepositoryMethods {
+		if method.Name == name+tbl.Options.CtxSuffix() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFakeMethod tells whether the repository method, with or without the Ctx suffix,
+// is implemented in memory by the FAKE_TEMPLATE
+func (tbl *Table) IsFakeMethod(name string) bool {
+
+	for _, method := range tbl.fakeMethods() {
+		if name == method || name == method+tbl.Options.CtxSuffix() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFakeDelegated tells whether the <Name>Fake passes the repository method on to the t<Name>Utils
+func (tbl *Table) IsFakeDelegated(name string) bool {
+
+	for _, method := range fakeDelegatedMethods {
+		if name == method {
+			return true
+		}
+	}
+	return false
+}
+
+// PKGetterName returns the name of the primary key getter, without the Ctx suffix
+func (tbl *Table) PKGetterName() string {
+
+	var names []string
+	for _, column := range tbl.PKColumns {
+		names = append(names, column.GoName)
+	}
+	return "GetBy" + strings.Join(names, "And")
+}
+
+// GetterName returns the name of the unique constraint getter, without the Ctx suffix
+func (c *Constraint) GetterName() string {
+
+	var names []string
+	for _, column := range c.Columns {
+		names = append(names, column.GoName)
+	}
+	return "GetByUnique" + strings.Join(names, "And")
+}
+
+// NilableNotNullColumns returns the NOT NULL columns whose Go type can hold nil, the ones the
+// <Name>Fake checks: the NULL of the other columns is their _IsNotNull field
+func (tbl *Table) NilableNotNullColumns() []Column {
+
+	var columns []Column
+	for _, column := range tbl.Columns {
+		if !column.Nullable && column.IsNilable() {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// IsNilable tells whether the Go type of the column can hold nil
+func (col *Column) IsNilable() bool {
+
+	return strings.HasPrefix(col.GoType, "[]") || strings.HasPrefix(col.GoType, "*") ||
+		strings.HasPrefix(col.GoType, "map[") || col.GoType == "interface{}"
+}
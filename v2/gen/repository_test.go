@@ -0,0 +1,96 @@
+package gen_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedRepositories runs the tests of testdata/repository in the models package of the sql golden
+// files, against the generated repository mocks and in-memory fakes, in a module of its own with the uuid stub standing in for
+// github.com/silviucm/uuid. It needs the go command, but no module download.
+func TestGeneratedRepositories(t *testing.T) {
+
+	goCommand, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go command is not available")
+	}
+
+	moduleFolder := tempDir(t)
+
+	copyFiles(t, filepath.Join(goldenFolder, "sql"), filepath.Join(moduleFolder, "models"))
+	copyFiles(t, filepath.Join("testdata", "repository"), filepath.Join(moduleFolder, "models"))
+	copyFiles(t, filepath.Join(stubsFolder, "github.com", "silviucm", "uuid"), filepath.Join(moduleFolder, "uuid"))
+	writeFile(t, filepath.Join(moduleFolder, "uuid", "go.mod"), "module github.com/silviucm/uuid\n")
+
+	writeFile(t, filepath.Join(moduleFolder, "go.mod"), `module goldenrepository
+
+go 1.13
+
+require github.com/silviucm/uuid v0.0.0
+
+replace github.com/silviucm/uuid => ./uuid
+`)
+
+	command := exec.Command(goCommand, "test", "./models")
+	command.Dir = moduleFolder
+	command.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	if output, err := command.CombinedOutput(); err != nil {
+		t.Fatalf("go test: %s\n%s", err, output)
+	}
+}
+
+// testFolder holds the temporary folders of the tests, removed once they ran
+var testFolder string
+
+func TestMain(m *testing.M) {
+
+	var err error
+	if testFolder, err = ioutil.TempDir("", "pgtogogen-test"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	os.RemoveAll(testFolder)
+	os.Exit(code)
+}
+
+// tempDir returns a new empty folder in testFolder, standing in for the t.TempDir of go 1.15
+func tempDir(t *testing.T) string {
+
+	folder, err := ioutil.TempDir(testFolder, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return folder
+}
+
+// copyFiles copies the files of a folder to another one, which gets created
+func copyFiles(t *testing.T, from, to string) {
+
+	fileInfos, err := ioutil.ReadDir(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(to, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, fileInfo := range fileInfos {
+		content, err := ioutil.ReadFile(filepath.Join(from, fileInfo.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, filepath.Join(to, fileInfo.Name()), string(content))
+	}
+}
+
+func writeFile(t *testing.T, filePath, content string) {
+	if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
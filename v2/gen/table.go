@@ -0,0 +1,697 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* Table Section */
+
+type Table struct {
+	Options *Generator
+
+	Columns           []Column
+	ColumnsString     string
+	ColumnsStringNoPK string
+
+	// Go-safe column sequence, prefixed by the underscore character. For example, the column "type" would fail in Go, because "type" is
+	// a reserved keyword. Appending an underscore solves this problem.
+	ColumnsStringGoSafe     string
+	ColumnsStringNoPKGoSafe string
+
+	PKColumns       []Column
+	PKColumnsString string
+
+	FKColumns       []Column
+	FKColumnsString string
+
+	UniqueConstraints []Constraint
+
+	DbName         string
+	GoFriendlyName string
+	DbComments     string
+
+	GoTypesToImport map[string]string
+
+	GeneratedTemplate GeneratedSource
+
+	// the methods of the t<Name>Utils type, for the REPOSITORY_TEMPLATE
+	RepositoryMethods []RepositoryMethod
+
+	// holds a typical SELECT FROM with all the db columns without any WHERE condition
+	GenericSelectQuery string
+
+	// holds a typical INSERT query, postgres style
+	GenericInsertQuery     string
+	GenericInsertQueryNoPK string
+
+	// holds the parameters comma-separated
+	ParamString     string
+	ParamStringNoPK string
+
+	// this value is true for tables, false for views
+	IsTable bool
+
+	// the overrides from the configuration file, nil if there are none
+	Config *TableConfig
+
+	// set when the generation failed and the table is skipped, with -keep-going
+	failed bool
+
+	// the progress messages, printed in order once all the objects are generated
+	progress progressLog
+
+	// the fingerprint of the generated file, and whether it needs to be rendered again
+	fingerprint fingerprintCheck
+}
+
+// AddColumn resolves the Go type of a database column and appends the column to the
+// table. The columns introspected from a database and the ones parsed from a DDL file
+// both go through here, so the resulting Column values are identical regardless of
+// where the schema came from.
+// The domainName is empty for the columns not based on a domain, the comment is the
+// column comment, which may hold a @gotype annotation.
+func (tbl *Table) AddColumn(columnName, dataType, udtName, domainName, comment string, nullable bool, columnDefault *string, maxLength int, ordinalPosition int) error {
+
+	columnConfig := tbl.Config.ColumnConfigFor(columnName).WithAnnotations(comment)
+	if columnConfig.IsSkipped() {
+		return nil
+	}
+
+	typeMapping := tbl.Options.TypeMappingFor(tbl.DbName, columnName, dataType, udtName, domainName, comment)
+
+	resolvedGoType, nullableType, goTypeToImport := tbl.Options.GoTypeForColumn(dataType, nullable, udtName)
+
+	if resolvedGoType == "" && typeMapping == nil {
+		return fmt.Errorf("for table %s, column %s could not resolve type %s", tbl.DbName, columnName, dataType)
+	}
+
+	if goTypeToImport != "" && typeMapping == nil {
+		tbl.AddGoTypeToImport(goTypeToImport)
+	}
+
+	currentGoName := GetGoFriendlyNameForColumn(columnName)
+	// instantiate a column struct
+	currentColumn := &Column{
+		DbName:          columnName,
+		DbComments:      comment,
+		OrdinalPosition: ordinalPosition,
+		Type:            dataType,
+		DefaultValue:    columnDefault,
+		Nullable:        nullable,
+		MaxLength:       maxLength,
+		IsSequence:      DecodeIsColumnSequence(columnDefault),
+
+		IsCompositePK: false, IsPK: false, IsFK: false,
+
+		GoName:          currentGoName,
+		GoNameForInsert: GetGoInsertNameForColumn(currentGoName, resolvedGoType),
+		GoType:          resolvedGoType,
+		GoNullableType:  nullableType,
+
+		Options: tbl.Options,
+		IsGuid:  (dataType == "uuid"),
+	}
+
+	// switch to the custom Go type, if any
+	if typeMapping != nil {
+		for _, goTypeToImport := range typeMapping.applyTo(currentColumn) {
+			tbl.AddGoTypeToImport(goTypeToImport)
+		}
+	}
+
+	columnConfig.applyTo(currentColumn)
+
+	if currentColumn.IsSoftDelete {
+		if currentColumn.SoftDeleteValue() == "" || (dataType != "boolean" && !nullable) {
+			return fmt.Errorf("for table %s, the soft delete column %s must be a boolean, or a nullable date or timestamp column", tbl.DbName, columnName)
+		}
+		if tbl.SoftDeleteColumn() != nil {
+			return fmt.Errorf("for table %s, only one soft delete column is supported, found %s and %s", tbl.DbName, tbl.SoftDeleteColumn().DbName, columnName)
+		}
+	}
+
+	tbl.Columns = append(tbl.Columns, *currentColumn)
+
+	return nil
+}
+
+// SetPrimaryKeyColumns flags the given columns as the primary key of the table (in the
+// given order) and regenerates the PK-dependent column strings.
+func (tbl *Table) SetPrimaryKeyColumns(columnNames []string) {
+
+	var numberOfPKs int = 0
+
+	pkColumnsString := ""
+	for _, currentColumnName := range columnNames {
+		for i := range tbl.Columns {
+			if tbl.Columns[i].DbName == currentColumnName {
+				tbl.Columns[i].IsPK = true
+				tbl.Columns[i].Nullable = false
+				tbl.Columns[i].IsCompositePK = false
+				numberOfPKs = numberOfPKs + 1
+
+				// add this column to the tables's PK columns slice
+				tbl.PKColumns = append(tbl.PKColumns, tbl.Columns[i])
+
+				// and to the pk columns string
+				pkColumnsString = pkColumnsString + currentColumnName + ", "
+			}
+		}
+	}
+
+	// just in case ignoring sequence columns happened to produce a situation where there is a
+	// comma followed by space at the end of the string, let's strip it
+	if strings.HasSuffix(pkColumnsString, ", ") {
+		pkColumnsString = strings.TrimSuffix(pkColumnsString, ", ")
+	}
+	tbl.PKColumnsString = pkColumnsString
+
+	// if we have more than one PK we need to iterate again and set the
+	// composite PK flag wherever IsPK is true
+	if numberOfPKs > 1 {
+		for i := range tbl.Columns {
+			if tbl.Columns[i].IsPK == true {
+				tbl.Columns[i].IsCompositePK = true
+			}
+		}
+	}
+
+	// let's generate the PK-dependent strings properly
+	tbl.ColumnsString = tbl.getSqlFriendlyColumnList(false, false)
+	tbl.ColumnsStringGoSafe = tbl.getSqlFriendlyColumnList(false, true)
+	tbl.ColumnsStringNoPK = tbl.getSqlFriendlyColumnList(true, false)
+	tbl.ColumnsStringNoPKGoSafe = tbl.getSqlFriendlyColumnList(true, true)
+}
+
+// AddUniqueConstraint appends a unique constraint (or unique index) made of the given
+// columns to the table's UniqueConstraints slice.
+func (tbl *Table) AddUniqueConstraint(constraintName, constraintType string, columnNames []string) {
+
+	newConstraint := Constraint{}
+	newConstraint.Options = tbl.Options
+	newConstraint.ParentTable = tbl
+
+	newConstraint.DbName = constraintName
+	newConstraint.IsUnique = true
+	newConstraint.Type = constraintType
+
+	for _, currentColumnName := range columnNames {
+		for i := range tbl.Columns {
+			if tbl.Columns[i].DbName == currentColumnName {
+				// add the column to the Columns slice of the constraint
+				newConstraint.Columns = append(newConstraint.Columns, tbl.Columns[i])
+			}
+		}
+	}
+
+	tbl.UniqueConstraints = append(tbl.UniqueConstraints, newConstraint)
+}
+
+func (tbl *Table) AddGoTypeToImport(goTypeToImport string) {
+
+	if tbl.GoTypesToImport == nil {
+		tbl.GoTypesToImport = make(map[string]string)
+	}
+
+	tbl.GoTypesToImport[goTypeToImport] = goTypeToImport
+}
+
+func (tbl *Table) CreateGenericQueries() {
+
+	// BEGIN Create the generic SELECT query
+	if tbl.Columns != nil {
+		genericSelectQueryBuffer := bytes.Buffer{}
+
+		// The SELECT prefix
+		genericSelectQueryBuffer.WriteString("SELECT ")
+
+		// the column names, comma-separated
+		var ignoreSerialColumns bool = false
+		var appendUnderscorePrefix bool = false
+		genericSelectQueryBuffer.WriteString(tbl.getSqlFriendlyColumnList(ignoreSerialColumns, appendUnderscorePrefix))
+
+		// The FROM section
+		genericSelectQueryBuffer.WriteString(" FROM " + tbl.SelectSource() + " ")
+		tbl.GenericSelectQuery = genericSelectQueryBuffer.String()
+	}
+	// END Create the generic SELECT query
+
+	// BEGIN Create the generic INSERT query
+	if tbl.Columns != nil {
+		genericInsertQueryAllColumnsBuffer := bytes.Buffer{}
+		genericInsertQueryNonPKColumnsBuffer := bytes.Buffer{}
+
+		// The INSERT prefix
+		genericInsertQueryNonPKColumnsBuffer.WriteString("INSERT INTO " + tbl.DbName + "(")
+
+		genericInsertQueryAllColumnsBuffer.WriteString("INSERT INTO " + tbl.DbName + "(")
+
+		// the column names, comma-separated
+		var ignoreSerialColumns bool = true
+		var appendUnderscorePrefix bool = false
+		genericInsertQueryNonPKColumnsBuffer.WriteString(tbl.getSqlFriendlyColumnList(ignoreSerialColumns, appendUnderscorePrefix))
+
+		ignoreSerialColumns = false
+		genericInsertQueryAllColumnsBuffer.WriteString(tbl.getSqlFriendlyColumnList(ignoreSerialColumns, appendUnderscorePrefix))
+
+		// The VALUES section
+		ignoreSerialColumns = true
+		genericInsertQueryNonPKColumnsBuffer.WriteString(") VALUES(" + tbl.getSqlFriendlyParameters(ignoreSerialColumns) + ") ")
+
+		ignoreSerialColumns = false
+		genericInsertQueryAllColumnsBuffer.WriteString(") VALUES(" + tbl.getSqlFriendlyParameters(ignoreSerialColumns) + ") ")
+		tbl.GenericInsertQuery = genericInsertQueryAllColumnsBuffer.String()
+		tbl.GenericInsertQueryNoPK = genericInsertQueryNonPKColumnsBuffer.String()
+
+		tbl.ParamString = tbl.getSqlFriendlyParameters(false)
+		tbl.ParamStringNoPK = tbl.getSqlFriendlyParameters(true)
+	}
+	// END Create the generic INSERT query
+
+}
+
+// returns a string of comma separated database column names, as they are used in SELECT
+// or INSERT sql statements (e.g. "username, first_name, last_name")
+// if ignoreSequenceColumns is true, it checks which columns are auto-generated via
+// sequences and does not include those.
+func (tbl *Table) getSqlFriendlyColumnList(ignoreSequenceColumns bool, appendUnderscorePrefix bool) string {
+
+	var underscorePrefix string = "_"
+	if appendUnderscorePrefix == false {
+		underscorePrefix = ""
+	}
+
+	genericQueryFriendlyColumnsBuffer := bytes.Buffer{}
+
+	var totalNumberOfColumns int = len(tbl.Columns) - 1
+	var colNameToWriteToBuffer string = ""
+
+	for colRange := range tbl.Columns {
+
+		if ignoreSequenceColumns == true && tbl.Columns[colRange].IsSequence == true {
+			continue
+		}
+
+		if totalNumberOfColumns == colRange {
+			colNameToWriteToBuffer = underscorePrefix + tbl.Columns[colRange].DbName
+		} else {
+			colNameToWriteToBuffer = underscorePrefix + tbl.Columns[colRange].DbName + ", "
+		}
+
+		genericQueryFriendlyColumnsBuffer.WriteString(colNameToWriteToBuffer)
+	}
+
+	finalString := genericQueryFriendlyColumnsBuffer.String()
+
+	// just in case ignoring sequence columns happened to produce a situation where there is a
+	// comma followed by space at the end of the string, let's strip it
+	if strings.HasSuffix(finalString, ", ") {
+		finalString = strings.TrimSuffix(finalString, ", ")
+	}
+
+	return finalString
+}
+
+// Returns a string of comma separated parameters, incremented by 1, Postgres style,
+// but taking into account if some columns are have default sequence autogeneration,
+// hence should not be inserted
+func (tbl *Table) getSqlFriendlyParameters(ignoreSequenceColumns bool) string {
+
+	genericQueryFriendlyParamsBuffer := bytes.Buffer{}
+
+	var totalNumberOfColumns int = len(tbl.Columns) - 1
+	var paramToWriteToBuffer string = ""
+
+	var realParamCount int = 1
+
+	for colRange := range tbl.Columns {
+
+		if ignoreSequenceColumns == true && tbl.Columns[colRange].IsSequence == true {
+			continue
+		}
+
+		// we cannot rely on the colRange iterator because we may skip columns
+		// which are sequence based, so we would have a situation such as
+		// "$1, $3, $4, etc" with $2 missing due to the continue statement above
+		var currentParamCount string = "$" + strconv.Itoa(realParamCount)
+		realParamCount = realParamCount + 1
+
+		if totalNumberOfColumns == colRange {
+			paramToWriteToBuffer = currentParamCount
+		} else {
+			paramToWriteToBuffer = currentParamCount + ", "
+		}
+
+		genericQueryFriendlyParamsBuffer.WriteString(paramToWriteToBuffer)
+	}
+
+	finalString := genericQueryFriendlyParamsBuffer.String()
+
+	// just in case ignoring sequence columns happened to produce a situation where there is a
+	// comma followed by space at the end of the string, let's strip it
+	if strings.HasSuffix(finalString, ", ") {
+		finalString = strings.TrimSuffix(finalString, ", ")
+	}
+
+	return finalString
+}
+
+func (tbl *Table) GenerateTableStruct() error {
+
+	return tbl.generateAndAppendTemplate("TABLE_TEMPLATE", TABLE_TEMPLATE, "Table structure generated.")
+}
+
+// ShouldGenerate returns true unless the configuration file turns off the given template group.
+// The bulk copy is never generated for the database/sql target, which cannot copy.
+func (tbl *Table) ShouldGenerate(group string) bool {
+
+	if group == TEMPLATE_GROUP_COPY && tbl.Options.IsSql() {
+		return false
+	}
+	return tbl.Config.ShouldGenerate(group)
+}
+
+// IsCacheDisabled returns true if the configuration file turns off caching for the table
+func (tbl *Table) IsCacheDisabled() bool {
+	return tbl.Config != nil && tbl.Config.NoCache
+}
+
+// UsesContext returns true if any of the generated methods need the context package
+func (tbl *Table) UsesContext() bool {
+
+	for _, group := range []string{TEMPLATE_GROUP_SELECT, TEMPLATE_GROUP_INSERT, TEMPLATE_GROUP_COPY, TEMPLATE_GROUP_UPDATE, TEMPLATE_GROUP_DELETE} {
+		if tbl.ShouldGenerate(group) {
+			return true
+		}
+	}
+
+	if !tbl.ShouldGenerate(TEMPLATE_GROUP_GETTERS) {
+		return false
+	}
+	return (tbl.Options.GeneratePKGetters && len(tbl.PKColumns) > 0) || (tbl.Options.GenerateUQGetters && len(tbl.UniqueConstraints) > 0)
+}
+
+// SoftDeleteColumn returns the column flagging the soft-deleted rows, or nil
+func (tbl *Table) SoftDeleteColumn() *Column {
+
+	for i := range tbl.Columns {
+		if tbl.Columns[i].IsSoftDelete {
+			return &tbl.Columns[i]
+		}
+	}
+	return nil
+}
+
+// SelectSource returns what the select queries read from: the table itself, or the
+// rows not soft-deleted
+func (tbl *Table) SelectSource() string {
+
+	softDeleteColumn := tbl.SoftDeleteColumn()
+	if softDeleteColumn == nil {
+		return tbl.DbName
+	}
+	return "(SELECT * FROM " + tbl.DbName + " WHERE " + softDeleteColumn.NotDeletedCondition() + ") AS " + tbl.DbName
+}
+
+// DeleteQueryPrefix returns the start of the delete query, up to the condition. With a soft
+// delete column, the condition has to be followed by a closing parenthesis.
+func (tbl *Table) DeleteQueryPrefix() string {
+
+	softDeleteColumn := tbl.SoftDeleteColumn()
+	if softDeleteColumn == nil {
+		return "DELETE FROM " + tbl.DbName + " WHERE "
+	}
+	return tbl.DeleteAllQuery() + " AND ("
+}
+
+// DeleteAllQuery returns the query deleting all the rows
+func (tbl *Table) DeleteAllQuery() string {
+
+	softDeleteColumn := tbl.SoftDeleteColumn()
+	if softDeleteColumn == nil {
+		return "DELETE FROM " + tbl.DbName
+	}
+	return "UPDATE " + tbl.DbName + " SET " + softDeleteColumn.DbName + " = " + softDeleteColumn.SoftDeleteValue() +
+		" WHERE " + softDeleteColumn.NotDeletedCondition()
+}
+
+// SensitiveColumns returns the columns left out of String()
+func (tbl *Table) SensitiveColumns() []Column {
+
+	var sensitiveColumns []Column
+	for i := range tbl.Columns {
+		if tbl.Columns[i].IsSensitive {
+			sensitiveColumns = append(sensitiveColumns, tbl.Columns[i])
+		}
+	}
+	return sensitiveColumns
+}
+
+// JSONColumns returns the json and jsonb columns of the table
+func (tbl *Table) JSONColumns() []Column {
+
+	var jsonColumns []Column
+	for i := range tbl.Columns {
+		if tbl.Columns[i].IsJSON() {
+			jsonColumns = append(jsonColumns, tbl.Columns[i])
+		}
+	}
+	return jsonColumns
+}
+
+func (tbl *Table) GenerateSelectFunctions() error {
+
+	// the cache is part of the table structure, so it always gets generated
+	if !tbl.ShouldGenerate(TEMPLATE_GROUP_SELECT) {
+		return tbl.generateAndAppendTemplate("TABLE_TEMPLATE_CACHE", TABLE_TEMPLATE_CACHE, "")
+	}
+
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_WHERE", SELECT_TEMPLATE_WHERE, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_ALL", SELECT_TEMPLATE_ALL, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_WHERE_TX", SELECT_TEMPLATE_WHERE_TX, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_ALL_TX", SELECT_TEMPLATE_ALL_TX, ""); err != nil {
+		return err
+	}
+
+	// generate the caching functionality
+	if err := tbl.generateAndAppendTemplate("TABLE_TEMPLATE_CACHE", TABLE_TEMPLATE_CACHE, ""); err != nil {
+		return err
+	}
+
+	// generate the extra functionality (count, first, last, single)
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_COUNT", SELECT_TEMPLATE_COUNT, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_SINGLE_ATOMIC", SELECT_TEMPLATE_SINGLE_ATOMIC, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_SINGLE_TX", SELECT_TEMPLATE_SINGLE_TX, ""); err != nil {
+		return err
+	}
+
+	// generate the json document queries
+	if len(tbl.JSONColumns()) > 0 {
+		if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_JSON_PATH", SELECT_TEMPLATE_JSON_PATH, ""); err != nil {
+			return err
+		}
+	}
+
+	// generate the keyset pagination, when there is a key to page by
+	keys, err := tbl.KeysetKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		if err := tbl.generateAndAppendTemplate("SELECT_TEMPLATE_AFTER", SELECT_TEMPLATE_AFTER, ""); err != nil {
+			return err
+		}
+	}
+
+	tbl.progress.println("Table select functions generated.")
+
+	return nil
+}
+
+func (tbl *Table) GenerateInsertFunctions() error {
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_INSERT_TEMPLATE_ATOMIC", TABLE_STATIC_INSERT_TEMPLATE_ATOMIC, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_INSERT_TEMPLATE_TX", TABLE_STATIC_INSERT_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	tbl.progress.println("Table insert functions generated.")
+
+	return nil
+}
+
+func (tbl *Table) GenerateBulkCopyFunctions() error {
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_BULK_COPY_TEMPLATE", TABLE_STATIC_BULK_COPY_TEMPLATE, ""); err != nil {
+		return err
+	}
+
+	tbl.progress.println("Table bulk copy functions generated.")
+
+	return nil
+}
+
+func (tbl *Table) GenerateUpdateFunctions() error {
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_UPDATE_TEMPLATE", TABLE_STATIC_UPDATE_TEMPLATE, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_UPDATE_TEMPLATE_TX", TABLE_STATIC_UPDATE_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_UPDATE_WITH_MASK", TABLE_STATIC_UPDATE_WITH_MASK, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_UPDATE_WITH_MASK_TX", TABLE_STATIC_UPDATE_WITH_MASK_TX, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("TABLE_INSTANCE_UPDATE_TEMPLATE", TABLE_INSTANCE_UPDATE_TEMPLATE, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_INSTANCE_UPDATE_TEMPLATE_TX", TABLE_INSTANCE_UPDATE_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	tbl.progress.println("Table update functions generated.")
+
+	return nil
+}
+
+func (tbl *Table) GenerateDeleteFunctions() error {
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_TEMPLATE", TABLE_STATIC_DELETE_TEMPLATE, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_TEMPLATE_TX", TABLE_STATIC_DELETE_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_INSTANCE_TEMPLATE", TABLE_STATIC_DELETE_INSTANCE_TEMPLATE, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_INSTANCE_TEMPLATE_TX", TABLE_STATIC_DELETE_INSTANCE_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_ALL_TEMPLATE", TABLE_STATIC_DELETE_ALL_TEMPLATE, ""); err != nil {
+		return err
+	}
+	if err := tbl.generateAndAppendTemplate("TABLE_STATIC_DELETE_ALL_TEMPLATE_TX", TABLE_STATIC_DELETE_ALL_TEMPLATE_TX, ""); err != nil {
+		return err
+	}
+
+	tbl.progress.println("Table delete functions generated.")
+
+	return nil
+}
+
+// RenderFile returns the formatted generated code of the table, once all the templates ran
+func (tbl *Table) RenderFile() (File, error) {
+
+	file := File{Name: tbl.fileName(), Kind: OBJECT_TABLE, Object: tbl.DbName, GoName: tbl.GoFriendlyName, Fingerprint: &tbl.fingerprint.Fingerprint}
+
+	// the file of the previous run is up to date
+	if tbl.fingerprint.unchanged {
+		file.Unchanged = true
+		return file, nil
+	}
+
+	source, err := tbl.GeneratedTemplate.Format(file.Name)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderFile(): error formatting the generated code for table %s: %s", tbl.DbName, err)
+	}
+	file.Content = source
+
+	return file, nil
+}
+
+func (tbl *Table) fileName() string {
+	return CamelCase(tbl.GoFriendlyName) + ".go"
+}
+
+// RenderCustomFile returns the custom file of the table, which is only written if it is not already in the folder.
+// Custom files are ideal to place your own methods in addition to the auto-generated ones.
+// If the generated files is named user.go, the custom file would be named: user-custom.go
+func (tbl *Table) RenderCustomFile() (File, error) {
+
+	tmpl, err := tbl.Options.parsedTemplate("TABLE_TEMPLATE_CUSTOM", TABLE_TEMPLATE_CUSTOM)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error parsing the custom file template for table %s: %s", tbl.DbName, err)
+	}
+
+	var generatedCustomFileTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedCustomFileTemplate, tbl)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error running the custom file template for table %s: %s", tbl.DbName, err)
+	}
+
+	var customFileName string = CamelCase(tbl.GoFriendlyName) + "-custom.go"
+
+	source, err := FormatGoSource(customFileName, generatedCustomFileTemplate.Bytes())
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error formatting the custom file for table %s: %s", tbl.DbName, err)
+	}
+
+	return File{Name: customFileName, Content: source, Kind: OBJECT_TABLE, Object: tbl.DbName, GoName: tbl.GoFriendlyName, WriteOnce: true}, nil
+}
+
+// GenerateUserTemplates renders the additional table templates from the -templates folder,
+// in the order of their file names, and appends them to the generated code of the table
+func (tbl *Table) GenerateUserTemplates() error {
+
+	if tbl.Options.Templates == nil {
+		return nil
+	}
+
+	for _, userTemplate := range tbl.Options.Templates.TableTemplates {
+		if err := tbl.generateAndAppendTemplate(userTemplate.Name, userTemplate.Content, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tbl *Table) generateAndAppendTemplate(templateName string, templateContent string, taskCompletionMessage string) error {
+
+	// parsed once per run, a template with the same name in the -templates folder replaces the built-in one
+	tmpl, err := tbl.Options.parsedTemplate(templateName, templateContent)
+	if err != nil {
+		return fmt.Errorf("error parsing the %s template for table %s: %s", templateName, tbl.DbName, err)
+	}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, tbl)
+	if err != nil {
+		return fmt.Errorf("error running the %s template for table %s: %s", templateName, tbl.DbName, err)
+	}
+
+	if _, err = tbl.GeneratedTemplate.WriteTemplate(templateName, generatedTemplate.Bytes()); err != nil {
+		return fmt.Errorf("error writing the output of the %s template for table %s: %s", templateName, tbl.DbName, err)
+	}
+
+	if taskCompletionMessage != "" {
+		tbl.progress.println(taskCompletionMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package gen
+
+import (
+	"sync"
+	"text/template"
+)
+
+// templateCache holds the templates parsed during a run, so that each one is parsed once
+// instead of once per table, view or function. It is safe for concurrent use, and so are
+// the parsed templates.
+type templateCache struct {
+	mutex  sync.Mutex
+	parsed map[string]cachedTemplate
+}
+
+type cachedTemplate struct {
+	tmpl *template.Template
+	err  error
+}
+
+// parsedTemplate returns the named template, parsed with the template functions. The content is the
+// built-in one, or its replacement from the -templates folder. A template that fails to parse is
+// not parsed again: every object using it gets the same error.
+func (g *Generator) parsedTemplate(templateName, builtinContent string) (*template.Template, error) {
+
+	g.templateCache.mutex.Lock()
+	defer g.templateCache.mutex.Unlock()
+
+	if cached, found := g.templateCache.parsed[templateName]; found {
+		return cached.tmpl, cached.err
+	}
+
+	if g.templateCache.parsed == nil {
+		g.templateCache.parsed = make(map[string]cachedTemplate)
+	}
+
+	tmpl, err := template.New(templateName).Funcs(fns).Parse(g.Templates.Lookup(templateName, builtinContent))
+	g.templateCache.parsed[templateName] = cachedTemplate{tmpl: tmpl, err: err}
+
+	return tmpl, err
+}
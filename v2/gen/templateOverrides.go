@@ -0,0 +1,201 @@
+package gen
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// the extension of the template files read from the -templates folder and written by -dump-templates
+const TEMPLATE_FILE_EXTENSION = ".tmpl"
+
+// the prefixes of the additional templates, rendered for each table, view or function
+// and appended to its generated code, e.g. table.audit.tmpl
+const (
+	USER_TEMPLATE_PREFIX_TABLE    = "table."
+	USER_TEMPLATE_PREFIX_VIEW     = "view."
+	USER_TEMPLATE_PREFIX_FUNCTION = "function."
+)
+
+// builtinTemplates are the templates that can be replaced by a file with the same name in the -templates folder.
+// The COMMON_CODE_ fragments are not listed: they are compiled into the templates that use them.
+// The _PGX5 and _SQL templates replace the ones of the same name for the pgx v5 and database/sql targets.
+var builtinTemplates = map[string]string{
+	"BASE_TEMPLATE":             BASE_TEMPLATE,
+	"BASE_TEMPLATE_SETTINGS":    BASE_TEMPLATE_SETTINGS,
+	"BASE_TEMPLATE_COLLECTIONS": BASE_TEMPLATE_COLLECTIONS,
+	"BASE_TEMPLATE_FORMS":       BASE_TEMPLATE_FORMS,
+	"BASE_TRANSACTIONS":         BASE_TRANSACTIONS,
+	"BASE_DB_TYPES":             BASE_DB_TYPES,
+	"BASE_BULK_COPY":            BASE_BULK_COPY,
+	"BASE_DB_TYPES_PGX5":        BASE_DB_TYPES_PGX5,
+	"BASE_BULK_COPY_PGX5":       BASE_BULK_COPY_PGX5,
+	"BASE_DB_TYPES_SQL":         BASE_DB_TYPES_SQL,
+
+	"TABLE_TEMPLATE":         TABLE_TEMPLATE,
+	"TABLE_TEMPLATE_CUSTOM":  TABLE_TEMPLATE_CUSTOM,
+	"TABLE_TEMPLATE_CACHE":   TABLE_TEMPLATE_CACHE,
+	"VIEW_TEMPLATE":          VIEW_TEMPLATE,
+	"VIEW_TEMPLATE_CUSTOM":   VIEW_TEMPLATE_CUSTOM,
+	"REPOSITORY_TEMPLATE":    REPOSITORY_TEMPLATE,
+	"FAKE_TEMPLATE":          FAKE_TEMPLATE,
+	"QUERY_COLUMNS_TEMPLATE": QUERY_COLUMNS_TEMPLATE,
+
+	"SELECT_TEMPLATE_WHERE":         SELECT_TEMPLATE_WHERE,
+	"SELECT_TEMPLATE_WHERE_TX":      SELECT_TEMPLATE_WHERE_TX,
+	"SELECT_TEMPLATE_ALL":           SELECT_TEMPLATE_ALL,
+	"SELECT_TEMPLATE_ALL_TX":        SELECT_TEMPLATE_ALL_TX,
+	"SELECT_TEMPLATE_COUNT":         SELECT_TEMPLATE_COUNT,
+	"SELECT_TEMPLATE_SINGLE_ATOMIC": SELECT_TEMPLATE_SINGLE_ATOMIC,
+	"SELECT_TEMPLATE_SINGLE_TX":     SELECT_TEMPLATE_SINGLE_TX,
+	"SELECT_TEMPLATE_JSON_PATH":     SELECT_TEMPLATE_JSON_PATH,
+	"SELECT_TEMPLATE_AFTER":         SELECT_TEMPLATE_AFTER,
+
+	"TABLE_STATIC_INSERT_TEMPLATE_ATOMIC":      TABLE_STATIC_INSERT_TEMPLATE_ATOMIC,
+	"TABLE_STATIC_INSERT_TEMPLATE_TX":          TABLE_STATIC_INSERT_TEMPLATE_TX,
+	"TABLE_STATIC_BULK_COPY_TEMPLATE":          TABLE_STATIC_BULK_COPY_TEMPLATE,
+	"TABLE_STATIC_UPDATE_TEMPLATE":             TABLE_STATIC_UPDATE_TEMPLATE,
+	"TABLE_STATIC_UPDATE_TEMPLATE_TX":          TABLE_STATIC_UPDATE_TEMPLATE_TX,
+	"TABLE_STATIC_UPDATE_WITH_MASK":            TABLE_STATIC_UPDATE_WITH_MASK,
+	"TABLE_STATIC_UPDATE_WITH_MASK_TX":         TABLE_STATIC_UPDATE_WITH_MASK_TX,
+	"TABLE_INSTANCE_UPDATE_TEMPLATE":           TABLE_INSTANCE_UPDATE_TEMPLATE,
+	"TABLE_INSTANCE_UPDATE_TEMPLATE_TX":        TABLE_INSTANCE_UPDATE_TEMPLATE_TX,
+	"TABLE_STATIC_DELETE_TEMPLATE":             TABLE_STATIC_DELETE_TEMPLATE,
+	"TABLE_STATIC_DELETE_TEMPLATE_TX":          TABLE_STATIC_DELETE_TEMPLATE_TX,
+	"TABLE_STATIC_DELETE_ALL_TEMPLATE":         TABLE_STATIC_DELETE_ALL_TEMPLATE,
+	"TABLE_STATIC_DELETE_ALL_TEMPLATE_TX":      TABLE_STATIC_DELETE_ALL_TEMPLATE_TX,
+	"TABLE_STATIC_DELETE_INSTANCE_TEMPLATE":    TABLE_STATIC_DELETE_INSTANCE_TEMPLATE,
+	"TABLE_STATIC_DELETE_INSTANCE_TEMPLATE_TX": TABLE_STATIC_DELETE_INSTANCE_TEMPLATE_TX,
+
+	"PK_GETTER_TEMPLATE_ATOMIC": PK_GETTER_TEMPLATE_ATOMIC,
+	"PK_GETTER_TEMPLATE_TX":     PK_GETTER_TEMPLATE_TX,
+	"UQ_GETTER_TEMPLATE_ATOMIC": UQ_GETTER_TEMPLATE_ATOMIC,
+	"UQ_GETTER_TEMPLATE_TX":     UQ_GETTER_TEMPLATE_TX,
+
+	"FUNCTION_TEMPLATE_PREFIX": FUNCTION_TEMPLATE_PREFIX,
+	"FUNCTION_TEMPLATE":        FUNCTION_TEMPLATE,
+}
+
+// TemplateSet holds the templates read from the -templates folder: the replacements
+// of the built-in templates, and the additional templates rendered for each table, view or function
+type TemplateSet struct {
+	Folder            string
+	Overrides         map[string]string // built-in template name -> replacement content
+	TableTemplates    []UserTemplate
+	ViewTemplates     []UserTemplate
+	FunctionTemplates []UserTemplate
+}
+
+// UserTemplate is an additional template from the -templates folder
+type UserTemplate struct {
+	Name    string // the file name, e.g. table.audit.tmpl
+	Content string
+}
+
+// LoadTemplates reads the .tmpl files in the folder. Each file is named either after
+// a built-in template, which it replaces, or starts with table., view. or function.
+// Every template is parsed right away, so that a mistake is reported before anything gets generated.
+func LoadTemplates(folder string) (*TemplateSet, error) {
+
+	fileInfos, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return nil, fmt.Errorf("LoadTemplates(): error reading the templates folder %s: %s", folder, err)
+	}
+
+	templateSet := &TemplateSet{Folder: folder, Overrides: map[string]string{}}
+
+	// ReadDir sorts by file name, so the additional templates are rendered in that order
+	for _, fileInfo := range fileInfos {
+
+		fileName := fileInfo.Name()
+		if fileInfo.IsDir() || !strings.HasSuffix(fileName, TEMPLATE_FILE_EXTENSION) {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(filepath.Join(folder, fileName))
+		if err != nil {
+			return nil, fmt.Errorf("LoadTemplates(): error reading the template %s: %s", fileName, err)
+		}
+
+		if _, err := template.New(fileName).Funcs(fns).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("LoadTemplates(): error parsing the template %s: %s", fileName, err)
+		}
+
+		name := strings.TrimSuffix(fileName, TEMPLATE_FILE_EXTENSION)
+		userTemplate := UserTemplate{Name: fileName, Content: string(content)}
+
+		if _, ok := builtinTemplates[name]; ok {
+			templateSet.Overrides[name] = string(content)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(fileName, USER_TEMPLATE_PREFIX_TABLE):
+			templateSet.TableTemplates = append(templateSet.TableTemplates, userTemplate)
+		case strings.HasPrefix(fileName, USER_TEMPLATE_PREFIX_VIEW):
+			templateSet.ViewTemplates = append(templateSet.ViewTemplates, userTemplate)
+		case strings.HasPrefix(fileName, USER_TEMPLATE_PREFIX_FUNCTION):
+			templateSet.FunctionTemplates = append(templateSet.FunctionTemplates, userTemplate)
+		default:
+			return nil, fmt.Errorf("LoadTemplates(): the template %s is not named after a built-in template, "+
+				"and does not start with %q, %q or %q", fileName, USER_TEMPLATE_PREFIX_TABLE, USER_TEMPLATE_PREFIX_VIEW, USER_TEMPLATE_PREFIX_FUNCTION)
+		}
+	}
+
+	return templateSet, nil
+}
+
+// Lookup returns the content of the named built-in template, or its replacement
+// from the -templates folder. It can be called on a nil set, when there is no such folder.
+func (s *TemplateSet) Lookup(templateName, builtinContent string) string {
+
+	if s != nil {
+		if content, ok := s.Overrides[templateName]; ok {
+			return content
+		}
+	}
+
+	return builtinContent
+}
+
+// DumpTemplates writes the built-in templates to the folder, one .tmpl file each,
+// as a starting point for a -templates folder. Existing files are not overwritten.
+// The progress messages go to the log writer, if not nil.
+func DumpTemplates(folder string, log io.Writer) error {
+
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return fmt.Errorf("DumpTemplates(): error creating the folder %s: %s", folder, err)
+	}
+
+	var templateNames []string
+	for templateName := range builtinTemplates {
+		templateNames = append(templateNames, templateName)
+	}
+	sort.Strings(templateNames)
+
+	for _, templateName := range templateNames {
+
+		filePath := filepath.Join(folder, templateName+TEMPLATE_FILE_EXTENSION)
+		if FileExists(filePath) {
+			if log != nil {
+				fmt.Fprintln(log, "Skipping the "+templateName+" template. Filepath: "+filePath+" already exists.")
+			}
+			continue
+		}
+
+		if err := ioutil.WriteFile(filePath, []byte(builtinTemplates[templateName]), 0644); err != nil {
+			return fmt.Errorf("DumpTemplates(): error writing the %s template: %s", templateName, err)
+		}
+	}
+
+	if log != nil {
+		fmt.Fprintln(log, "Finished writing the built-in templates. Folder: "+folder)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package gen_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// fixtureDatabase reads the schema of testdata/fixture.json
+func fixtureDatabase(t *testing.T) *schema.Database {
+
+	db, err := schema.LoadFixture("testdata/fixture.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// writeTemplates writes the templates to a temporary -templates folder and loads it
+func writeTemplates(t *testing.T, templates map[string]string) *gen.TemplateSet {
+
+	folder := tempDir(t)
+	for name, content := range templates {
+		if err := ioutil.WriteFile(filepath.Join(folder, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	templateSet, err := gen.LoadTemplates(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return templateSet
+}
+
+// findFile returns the rendered file with the given name, failing the test if there is none
+func findFile(t *testing.T, files []gen.File, name string) gen.File {
+
+	for _, file := range files {
+		if file.Name == name {
+			return file
+		}
+	}
+	t.Fatalf("no file %s was rendered", name)
+	return gen.File{}
+}
+
+func TestRenderFilesTemplateOverrides(t *testing.T) {
+
+	options := gen.Options{PackageName: "models", Templates: writeTemplates(t, map[string]string{
+		"TABLE_TEMPLATE_CUSTOM.tmpl": "package {{.Options.PackageName}}\n\n// {{.GoFriendlyName}} is ours\n",
+		"view.describe.tmpl":         "\n// Describe{{.GoFriendlyName}} is added to every view\nfunc Describe{{.GoFriendlyName}}() string { return \"{{.DbName}}\" }\n",
+	})}
+
+	result, err := gen.RenderFiles(fixtureDatabase(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if custom := findFile(t, result.Files, "accounts-custom.go"); string(custom.Content) != "package models\n\n// Accounts is ours\n" {
+		t.Errorf("the built-in template was not replaced, got\n%s", custom.Content)
+	}
+	if view := findFile(t, result.Files, "accountBalances.go"); !bytes.Contains(view.Content, []byte(`func DescribeAccountBalances() string { return "account_balances" }`)) {
+		t.Errorf("the view template was not appended")
+	}
+	if table := findFile(t, result.Files, "accounts.go"); bytes.Contains(table.Content, []byte("Describe")) {
+		t.Errorf("the view template should not be rendered for the tables")
+	}
+
+	// a syntax error in the output is reported against the template that produced it
+	options.Templates = writeTemplates(t, map[string]string{"table.broken.tmpl": "\nfunc Broken{{.GoFriendlyName}}( {\n"})
+	_, err = gen.RenderFiles(fixtureDatabase(t), options)
+	if err == nil || !strings.Contains(err.Error(), "(template table.broken.tmpl): invalid generated code") {
+		t.Errorf("got error %v, want one naming the template", err)
+	}
+}
+
+func TestLoadTemplatesErrors(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"unknown.tmpl", "", "is not named after a built-in template"},
+		{"table.bad.tmpl", "{{if}}", "error parsing the template table.bad.tmpl"},
+		{"BASE_TEMPLATE.tmpl", "{{end}}", "error parsing the template BASE_TEMPLATE.tmpl"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			folder := tempDir(t)
+			if err := ioutil.WriteFile(filepath.Join(folder, test.name), []byte(test.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			// the other files are ignored
+			if err := ioutil.WriteFile(filepath.Join(folder, "README.md"), []byte("notes"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := gen.LoadTemplates(folder); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want %q", err, test.want)
+			}
+		})
+	}
+
+	if _, err := gen.LoadTemplates(filepath.Join(tempDir(t), "missing")); err == nil {
+		t.Errorf("a missing folder should be an error")
+	}
+}
+
+func TestDumpTemplates(t *testing.T) {
+
+	folder := filepath.Join(tempDir(t), "templates")
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(folder, "BASE_TEMPLATE.tmpl"), []byte("mine"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	if err := gen.DumpTemplates(folder, &log); err != nil {
+		t.Fatal(err)
+	}
+
+	if content, _ := ioutil.ReadFile(filepath.Join(folder, "BASE_TEMPLATE.tmpl")); string(content) != "mine" {
+		t.Errorf("an existing template should not be overwritten")
+	}
+	if !strings.Contains(log.String(), "Skipping the BASE_TEMPLATE template") {
+		t.Errorf("got the log\n%s", log.String())
+	}
+
+	// the dumped templates load back as overrides of the built-in ones
+	templateSet, err := gen.LoadTemplates(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if templateSet.Lookup("BASE_TEMPLATE", "builtin") != "mine" || templateSet.Lookup("TABLE_TEMPLATE", "builtin") != gen.TABLE_TEMPLATE {
+		t.Errorf("the dumped templates do not load back")
+	}
+	if len(templateSet.TableTemplates) != 0 {
+		t.Errorf("got additional templates %+v", templateSet.TableTemplates)
+	}
+
+	var noTemplates *gen.TemplateSet
+	if noTemplates.Lookup("BASE_TEMPLATE", "builtin") != "builtin" {
+		t.Errorf("a nil set should return the built-in template")
+	}
+}
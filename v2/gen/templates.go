@@ -1,4 +1,4 @@
-package main
+package gen
 
 import (
 	"strings"
@@ -40,20 +40,20 @@ const TABLE_TEMPLATE = `package {{.Options.PackageName}}
 /* *********************************************************** */
 
 import (
-	"bytes"
-	"context"
-	"io"
-	"net/http"
-	"sync"
-	pgx "{{.Options.PgxImport}}"
-	pgtype "{{.Options.PgTypeImport}}"
+	{{if or (.ShouldGenerate "update") (.ShouldGenerate "delete")}}"bytes"
+	{{end}}{{if .UsesContext}}"context"
+	{{end}}{{if and (.ShouldGenerate "select") (or .JSONColumns .KeysetKeys)}}"encoding/json"
+	{{end}}{{if or .SensitiveColumns .Options.GenerateRepositories (and (.ShouldGenerate "select") .KeysetKeys)}}"fmt"
+	{{end}}{{if .ShouldGenerate "copy"}}"io"
+	{{end}}{{if .ShouldGenerate "http"}}"net/http"
+	{{end}}{{if .Options.GenerateRepositories}}"sort"
+	{{end}}"sync"
+	{{if or (.ShouldGenerate "copy") .Options.IsPgx5}}pgx "{{.Options.PgxImport}}"
+	{{end}}{{if .Options.IsSql}}"database/sql"{{else}}pgtype "{{.Options.PgTypeImport}}"{{end}}
 	{{range $key, $value := .GoTypesToImport}}"{{$value}}"
 	{{end}}	
 )
 
-// this is a dummy variable, just to use the pgtypes package
-const pgtypesDummy{{.GoFriendlyName}} = pgtype.Present
-
 const {{.GoFriendlyName}}_DB_TABLE_NAME string = "{{.DbName}}"
 
 {{if ne .DbComments ""}}/*{{.GoFriendlyName}} is a struct that corresponds to the {{.DbName}} table.
@@ -62,7 +62,7 @@ Database comments: {{.DbComments}} */{{else}}
 type {{.GoFriendlyName}} struct {
 	{{range .Columns}}
 	{{if ne .DbComments ""}}/* {{.DbComments}} */{{end}}
-	{{.GoName}} {{.GoType}} // database field name: {{.DbName}}, IsPK: {{.IsPK}} , IsCompositePK: {{.IsCompositePK}}, IsFK: {{.IsFK}}
+	{{.GoName}} {{.GoType}}{{if ne .JSONTag ""}} `+"`"+`json:"{{.JSONTag}}"`+"`"+`{{end}} // database field name: {{.DbName}}, IsPK: {{.IsPK}} , IsCompositePK: {{.IsCompositePK}}, IsFK: {{.IsFK}}
 	{{if .Nullable}}{{.GoName}}_IsNotNull bool // if true, it means the value is not null{{end}}
 	{{end}}	
 	
@@ -76,11 +76,19 @@ type {{.GoFriendlyName}} struct {
 	// Set this to true if you want New or Create operations to automatically
 	// set all Guid fields to a new guid
 	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
 	
 }
 
 {{ $tableGoName := .GoFriendlyName}}
-{{range .Columns}}// Set{{.GoName}} sets the {{.GoName}} field to val.
+{{if .SensitiveColumns}}// String renders the {{.GoFriendlyName}} fields, leaving out the values of the sensitive ones
+func (t {{$tableGoName}}) String() string {
+	return fmt.Sprintf("{{$tableGoName}}{{"{"}}{{range $i, $e := .Columns}}{{if $i}}, {{end}}{{$e.GoName}}: {{if $e.IsSensitive}}[REDACTED]{{else}}%v{{end}}{{end}}}"` +
+	`{{range .Columns}}{{if not .IsSensitive}}, t.{{.GoName}}{{end}}{{end}})
+}
+{{end}}{{range .Columns}}// Set{{.GoName}} sets the {{.GoName}} field to val.
 func (t *{{$tableGoName}}) Set{{.GoName}}(val {{.GoType}} {{if .Nullable}}, notNull bool{{end}}) {
 	t.{{.GoName}} = val
 	{{if .Nullable}}t.{{.GoName}}_IsNotNull = notNull{{end}}
@@ -96,6 +104,11 @@ func (t *{{$tableGoName}}) IsNull_{{.GoName}}() bool {
 }
 {{end}}
 {{end}}
+// MarkAllColumnsLoaded lets the instance Update of a {{$tableGoName}} selected WithColumns write back all
+// its fields, the zero values of the columns left out included
+func (t *{{$tableGoName}}) MarkAllColumnsLoaded() {
+	t.pgToGo_projection = nil
+}
 
 {{ $tableGoName := .GoFriendlyName}}
 /* {{$tableGoName}} slice manipulation helpers */
@@ -149,15 +162,15 @@ func (cc {{$tableGoName}}Slice) FilterInto(filterFunc {{$tableGoName}}SliceFilte
 
 {{ $tableGoName := .GoFriendlyName}}
 /* Sorting helper containers */
-{{range $i, $e := .Columns}}
+{{range $i, $e := .Columns}}{{if $e.HasLessComparator}}
 // Sort{{$tableGoName}}By{{$e.GoName}} implements sort.Interface for []{{$tableGoName}} based on
 // the {{$e.GoName}} field. Usage: sort.Sort(Sort{{$tableGoName}}By{{$e.GoName}}(anyGiven{{$tableGoName}}Slice))
 type Sort{{$tableGoName}}By{{$e.GoName}} []{{$tableGoName}}
 
 func (a Sort{{$tableGoName}}By{{$e.GoName}}) Len() int           { return len(a) }
 func (a Sort{{$tableGoName}}By{{$e.GoName}}) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a Sort{{$tableGoName}}By{{$e.GoName}}) Less(i, j int) bool { return LessComparatorFor_{{$e.GoType}}(a[i].{{$e.GoName}},a[j].{{$e.GoName}}) }
-{{end}}
+func (a Sort{{$tableGoName}}By{{$e.GoName}}) Less(i, j int) bool { return {{$e.LessExpr (print "a[i]." $e.GoName) (print "a[j]." $e.GoName)}} }
+{{end}}{{end}}
 
 // Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
 // and global, single-instance settings
@@ -165,6 +178,9 @@ type t{{.GoFriendlyName}}Utils struct {
 	
 	utilMutex sync.RWMutex
 	
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+	
 	// instance of a CacheFor{{.GoFriendlyName}} structure
 	Cache CacheFor{{.GoFriendlyName}}
 }
@@ -186,7 +202,7 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}() *{{.GoFriendlyName
 	return {{$structInstanceName}}
 }
 
-{{$colCount := len .Columns}}{{$functionName := "CreateFromHttpRequest"}}
+{{if .ShouldGenerate "http"}}{{$colCount := len .Columns}}{{$functionName := "CreateFromHttpRequest"}}
 // Creates a new pointer to a {{.GoFriendlyName}} from an Http Request.
 // The parameters are expected to match the struct field names
 func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(req *http.Request) (*{{.GoFriendlyName}}, error) {
@@ -202,7 +218,9 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(req *http.Request) (
 	
 	{{$structInstanceName}}.CloneGlobalSettings()
 	
-	{{range $i, $e := .Columns}}{{if eq $e.GoType "time.Time"}}{{$structInstanceName}}.{{$e.GoName}}, err = To_Time_FromString(req.FormValue("{{$e.GoName}}"))
+	{{range $i, $e := .Columns}}{{if not $e.HasFromString}}// {{$e.GoName}} has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	{{else if $e.TypeMapping}}{{$structInstanceName}}.{{$e.GoName}}, err = {{$e.FromStringExpr (print "req.FormValue(\"" $e.GoName "\")")}}
+	{{else if eq $e.GoType "time.Time"}}{{$structInstanceName}}.{{$e.GoName}}, err = To_Time_FromString(req.FormValue("{{$e.GoName}}"))
 	{{else if eq $e.GoType "string"}}{{$structInstanceName}}.{{$e.GoName}} = req.FormValue("{{$e.GoName}}") 
 	{{else}}{{$structInstanceName}}.{{$e.GoName}}, err = To_{{$e.GoType}}_FromString(req.FormValue("{{$e.GoName}}")) 
 	{{end}}if err != nil { return nil, NewModelsError(errorPrefix, err) } {{if .Nullable}}
@@ -234,7 +252,9 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(req *http.Request) (
 	
 	{{$structInstanceName}}.CloneGlobalSettings()
 	
-	{{range $i, $e := .Columns}}{{if eq $e.GoType "time.Time"}}{{$structInstanceName}}.{{$e.GoName}}, currentError = To_Time_FromString(req.FormValue("{{$e.GoName}}"))	
+	{{range $i, $e := .Columns}}{{if not $e.HasFromString}}// {{$e.GoName}} has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	{{else if $e.TypeMapping}}{{$structInstanceName}}.{{$e.GoName}}, currentError = {{$e.FromStringExpr (print "req.FormValue(\"" $e.GoName "\")")}}
+	{{else if eq $e.GoType "time.Time"}}{{$structInstanceName}}.{{$e.GoName}}, currentError = To_Time_FromString(req.FormValue("{{$e.GoName}}"))	
 	{{else if eq $e.GoType "string"}}{{$structInstanceName}}.{{$e.GoName}} = req.FormValue("{{$e.GoName}}")	
 	{{else}}{{$structInstanceName}}.{{$e.GoName}}, currentError = To_{{$e.GoType}}_FromString(req.FormValue("{{$e.GoName}}"))
 	{{end}} {{if .Nullable}}
@@ -246,7 +266,7 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(req *http.Request) (
 	
 	return {{$structInstanceName}}, errors
 }
-
+{{end}}
 {{$colCount := len .Columns}}{{$functionName := "CloneGlobalSettings"}}{{$structInstanceName := print "instance" .GoFriendlyName}}
 // {{$functionName}} assigns the global settings for operations to the control fields 
 // of this instance. An example would be:
@@ -281,7 +301,7 @@ func (utilRef *t{{.GoFriendlyName}}Utils) ToDbFieldTypeFromColName(fieldDbOrGoNa
 	return ""
 }
 
-`
+` + COMMON_CODE_SCAN_FUNCTIONS
 
 const TABLE_TEMPLATE_CUSTOM = `package {{.Options.PackageName}}
 
@@ -331,6 +351,9 @@ type CacheFor{{.GoFriendlyName}} struct {
 	all      []{{.GoFriendlyName}}
 	allMutex sync.RWMutex
 
+	// the utilities EnableAndLoadAllRows selects the rows with, {{if .IsTable}}Tables{{else}}Views{{end}}.{{.GoFriendlyName}} when nil
+	utils *t{{.GoFriendlyName}}Utils
+
 	CacheProvider ICacheProvider
 }
 
@@ -357,9 +380,11 @@ func (c *CacheFor{{.GoFriendlyName}}) IsEnabled() bool {
 }
 
 func (c *CacheFor{{.GoFriendlyName}}) Enable() {
-	
+	{{if .IsCacheDisabled}}
+	// caching is turned off for {{.DbName}} in the pgtogogen configuration
+	{{else}}
 	c.enabled = true	
-	c.Init()	
+	c.Init()	{{end}}
 }
 
 func (c *CacheFor{{.GoFriendlyName}}) Disable() {
@@ -369,19 +394,28 @@ func (c *CacheFor{{.GoFriendlyName}}) Disable() {
 	
 }
 
-// Enables caching for {{.DbName}} and loads all rows inside the cache.
+{{if not .Options.CtxOnly}}// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheFor{{.GoFriendlyName}}) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+{{end}}// Enables caching for {{.DbName}} and loads all rows inside the cache.
 // This should only be used for small-sized lookup tables, not for tables that can 
 // grow to huge numbers of records. Since the result set is unordered, please use
 // the SortBy functionality to sort the result set when needed
-func (c *CacheFor{{.GoFriendlyName}}) EnableAndLoadAllRows() {
+func (c *CacheFor{{.GoFriendlyName}}) EnableAndLoadAllRows{{.Options.CtxSuffix}}(ctx context.Context) {
 	
 	c.Enable()
-	
-	allRows, err := {{if .IsTable}}Tables{{else}}Views{{end}}.{{.GoFriendlyName}}.SelectAll()
+	{{if and (.ShouldGenerate "select") (not .IsCacheDisabled)}}
+	utils := c.utils
+	if utils == nil {
+		utils = &{{if .IsTable}}Tables{{else}}Views{{end}}.{{.GoFriendlyName}}
+	}
+	allRows, err := utils.SelectAll{{.Options.CtxSuffix}}(ctx)
 	if err == nil && len(allRows) > 0 {
 		c.SetAllRows(allRows)
 	}
-	
+	{{end}}
 }
 
 func (c *CacheFor{{.GoFriendlyName}}) GetAllRows() ([]{{.GoFriendlyName}}, bool) {
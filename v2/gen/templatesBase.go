@@ -0,0 +1,2346 @@
+package gen
+
+/* Base Templates */
+
+const BASE_TEMPLATE = `package {{.PackageName}}
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	{{if .IsSql}}"database/sql"
+	{{else}}pgx "{{.PgxImport}}"
+	pgxpool "{{.PgxPoolImport}}"
+	pgconn "{{.PgConnImport}}"
+	{{end}}"bytes"	
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+ 	"github.com/silviucm/uuid"
+	"reflect"
+)
+
+// ICacheProvider is an interface that describes caching operations. It allows using a cache 
+// provider other than the default, in-memory cache
+type ICacheProvider interface {
+	Get(key string) (interface{}, error)
+	Set(key string, value interface{})
+	Exists(key string) bool
+}
+
+// Caching flags to allow functions and methods be supplied with caching behaviour options
+const (
+	// PgToGoFlagCacheDisable disables any caching
+	PgToGoFlagCacheDisable int = 0
+	
+	// PgToGoFlagCacheUse uses the cache already present, otherwise populates data from database
+	PgToGoFlagCacheUse int = 1
+	
+	// PgToGoFlagCacheReload forces a cache refresh from the database
+	PgToGoFlagCacheReload int = 2
+	
+	// PgToGoFlagCacheDelete forces the cached entry deletion, preventing further use
+	PgToGoFlagCacheDelete int = 4
+)
+
+
+// PgToGoOptionPanicOnInitDbErr defines the exit behaviour when, during a GetDb lazy-init,
+// the InitDatabase function attempts to connect to the datasource and fails. 
+// When set to true, the system panics. Otherwise, GetDb() will simply return nil.
+var PgToGoOptionPanicOnInitDbErr = true
+
+// variables that mimick the database driver standard errors, so
+// we don't need to import that package in the generated table-to-struct files
+// or any other package, such as pgx - the import would only reside here, in the base file
+var (
+	// ErrNoRows occurs when rows are expected but none are returned.
+	ErrNoRows = {{if .IsSql}}sql{{else}}pgx{{end}}.ErrNoRows
+	
+	// ErrTxClosed occurs on an attempt to use an already closed transaction.
+	ErrTxClosed = {{if .IsSql}}sql.ErrTxDone{{else}}pgx.ErrTxClosed{{end}}
+	
+	// ErrNotificationTimeout occurs in case of a notification timeout.
+	ErrNotificationTimeout = errors.New("notification timeout")
+	
+	// ErrTooManyRows occurs when a db query returns more than one row for an operation
+	// expecting one row at most.
+	ErrTooManyRows =  errors.New("More than one row returned")
+)
+
+// debug mode flag
+var isDebugMode = false
+
+{{if .IsSql}}var dbHandle *SqlDB
+
+// GetDb returns the handle for the underlying data source.
+// If the handle is nil, it attempts a lazy initialization.
+func GetDb() *SqlDB {
+	
+	if dbHandle != nil {
+		return dbHandle
+	}
+
+	dbSettings, err := GetDefaultDbSettings()
+	if err != nil {
+		if PgToGoOptionPanicOnInitDbErr {
+			panic("FORCED PANIC: models.GetDb() -> GetDefaultDbSettings() fatal error configuring the db: " + err.Error())
+		} else {
+			return nil
+		}
+	}
+	
+	newHandle, err := OpenDatabase(dbSettings)	
+	if err != nil {
+		if PgToGoOptionPanicOnInitDbErr {
+			panic("FORCED PANIC: models.GetDb() -> OpenDatabase() fatal error connecting to the database: " + err.Error())
+		} else {
+			return nil
+		}
+	}
+	
+	dbHandle = newHandle	
+	return dbHandle	
+}
+
+// GetDefaultDbSettings returns a connection string based on
+// the default singleton values in models_pgtogogen_db.go
+// Set those before calling this method.
+func GetDefaultDbSettings() (string, error) {
+	
+	return fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=%s",
+		DB_USER, DB_PASS, DB_HOST, DB_PORT, DB_NAME, DB_SSL), nil
+}
+
+// OpenDatabase opens the datasource with the DB_DRIVER database/sql driver, which must be
+// registered (e.g. by importing github.com/jackc/pgx/v5/stdlib or github.com/lib/pq), and
+// the connection string. It then calls InitDatabase.
+func OpenDatabase(dataSourceName string) (*SqlDB, error) {
+
+	db, err := sql.Open(DB_DRIVER, dataSourceName)
+	if err != nil {
+		return nil, NewModelsError("models.OpenDatabase() -> sql.Open", err)
+	}
+	db.SetMaxOpenConns(DB_POOL_MAX_CONNECTIONS)
+
+	return InitDatabase(db)
+}
+
+// InitDatabase checks the connection of an already opened *sql.DB, e.g. one of sqlmock, and
+// makes it the handle of the generated code. It then calls PrepareDbCollections to initialize
+// the structures and set default behaviours.
+func InitDatabase(db *sql.DB) (*SqlDB, error) {
+
+	if err := db.PingContext(context.Background()); err != nil {
+		return nil, NewModelsError("models.InitDatabase() -> db.PingContext", err)
+	}
+
+	// prepare the Tables, Views, Functions collections with whatever
+	// initialization or default behavior necessary
+	PrepareDbCollections()
+
+	dbHandle = &SqlDB{DB: db}
+	return dbHandle, nil
+}
+
+// InitDatabaseMinimal is a wrapper over OpenDatabase, and allows direct values to be
+// specied for the database connection parameters.
+func InitDatabaseMinimal(host string, port uint16, user, pass, dbName string, poolMaxConnections int) (*SqlDB, error) {
+
+	DB_HOST = host
+	DB_USER = user
+	DB_PASS = pass
+	DB_NAME = dbName
+	DB_PORT = port
+	DB_POOL_MAX_CONNECTIONS = poolMaxConnections
+	
+	dbSettings, err := GetDefaultDbSettings()
+	if err != nil {
+		return nil, err
+	}
+	
+	return OpenDatabase(dbSettings)
+
+}
+
+// SqlDB wraps the *sql.DB with the context-first Query, QueryRow and Exec methods the
+// generated code calls, the way it calls the pgx pool on the other targets.
+// The *sql.DB itself remains available, e.g. for the shared middleware.
+type SqlDB struct {
+	DB *sql.DB
+}
+
+// Query runs a query returning rows, typically a SELECT
+func (db *SqlDB) Query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRow runs a query expected to return at most one row
+func (db *SqlDB) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// Exec runs a query without returning any rows
+func (db *SqlDB) Exec(ctx context.Context, query string, args ...interface{}) (CommandTag, error) {
+	return toCommandTag(db.DB.ExecContext(ctx, query, args...))
+}
+
+// Begin starts a transaction with the default options
+func (db *SqlDB) Begin(ctx context.Context) (*SqlTx, error) {
+	return db.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with the given options
+func (db *SqlDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*SqlTx, error) {
+
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &SqlTx{Tx: tx}, nil
+}
+
+// SqlTx wraps the *sql.Tx the way SqlDB wraps the *sql.DB
+type SqlTx struct {
+	Tx *sql.Tx
+}
+
+// Query runs a query returning rows, typically a SELECT
+func (tx *SqlTx) Query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRow runs a query expected to return at most one row
+func (tx *SqlTx) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return tx.Tx.QueryRowContext(ctx, query, args...)
+}
+
+// Exec runs a query without returning any rows
+func (tx *SqlTx) Exec(ctx context.Context, query string, args ...interface{}) (CommandTag, error) {
+	return toCommandTag(tx.Tx.ExecContext(ctx, query, args...))
+}
+
+// Commit commits the transaction. database/sql takes no context here, it uses the one of BeginTx.
+func (tx *SqlTx) Commit(ctx context.Context) error {
+	return tx.Tx.Commit()
+}
+
+// Rollback rolls the transaction back. As with pgx, it is safe to call once the transaction
+// is committed, ErrTxClosed is not reported.
+func (tx *SqlTx) Rollback(ctx context.Context) error {
+
+	if err := tx.Tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return err
+	}
+	return nil
+}
+
+// CommandTag is the outcome of an Exec, the counterpart of the pgx command tag
+type CommandTag struct {
+	rowsAffected int64
+}
+
+// RowsAffected returns the number of rows the Exec affected
+func (ct CommandTag) RowsAffected() int64 {
+	return ct.rowsAffected
+}
+
+// toCommandTag returns the CommandTag of an Exec result, along with the error of
+// either the Exec or the count of the affected rows
+func toCommandTag(result sql.Result, err error) (CommandTag, error) {
+
+	if err != nil {
+		return CommandTag{}, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	return CommandTag{rowsAffected: rowsAffected}, err
+}
+
+{{else}}var dbHandle *pgxpool.Pool
+
+// GetDb returns the connection pool handle for the underlying data source.
+// If the handle is nil, it attempts a lazy initialization.
+func GetDb() *pgxpool.Pool {
+	
+	if dbHandle != nil {
+		return dbHandle
+	}
+
+	dbSettings, err := GetDefaultDbSettings()
+	if err != nil {
+		if PgToGoOptionPanicOnInitDbErr {
+			panic("FORCED PANIC: models.GetDb() -> GetDefaultDbSettings() fatal error configuring the db: " + err.Error())
+		} else {
+			return nil
+		}
+	}
+	
+	newHandle, err := InitDatabase(dbSettings)	
+	if err != nil {
+		if PgToGoOptionPanicOnInitDbErr {
+			panic("FORCED PANIC: models.GetDb() -> InitDatabase() fatal error connecting to the database: " + err.Error())
+		} else {
+			return nil
+		}
+	}
+	
+	dbHandle = newHandle	
+	return dbHandle	
+}
+
+// GetDefaultDbSettings returns a connection pool config structure based on
+// the default singleton values in models_pgtogogen_db.go
+// Set those before calling this method.
+func GetDefaultDbSettings() (*pgxpool.Config, error) {
+	
+	c := fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=%s pool_max_conns=%d",
+		DB_USER, DB_PASS, DB_HOST, DB_PORT, DB_NAME, DB_SSL, DB_POOL_MAX_CONNECTIONS)
+
+	return pgxpool.ParseConfig(c)	
+}
+
+// InitDatabase initializes the connection to the datasource using a pgxpool.Config
+// instance. It then calls PrepareDbCollections to initialize the structures and set
+// default behaviours.
+//
+// At minimum, the pgxpool.Config expects these values to be set:
+//
+// config.Host = dbHostStringVar
+// config.User = dbUserStringVar
+// config.Password = dbPassStringVar
+// config.Database = dbNameStringVar
+// config.Port = dbPortUInt16Var
+//
+// You can use the GetDefaultDbSettings() and modify the variables at the beginning
+// of this class accordingly.
+func InitDatabase(dbConfig *pgxpool.Config) (*pgxpool.Pool, error) {
+
+	{{if .IsPgx5}}connPool, err := pgxpool.NewWithConfig(context.Background(), dbConfig)
+	if err != nil {
+		return nil, NewModelsError("models.InitDatabase() -> pgxpool.NewWithConfig", err)
+	{{else}}connPool, err := pgxpool.ConnectConfig(context.Background(), dbConfig)
+	if err != nil {
+		return nil, NewModelsError("models.InitDatabase() -> pgxpool.ConnectConfig", err)
+	{{end}}
+	}
+
+	// prepare the Tables, Views, Functions collections with whatever
+	// initialization or default behavior necessary
+	PrepareDbCollections()
+
+	dbHandle = connPool
+	return dbHandle, nil
+}
+
+// InitDatabaseMinimal is a wrapper over InitDatabase, and allows direct values to be
+// specied for the database connection parameters.
+func InitDatabaseMinimal(host string, port uint16, user, pass, dbName string, poolMaxConnections int) (*pgxpool.Pool, error) {
+
+	DB_HOST = host
+	DB_USER = user
+	DB_PASS = pass
+	DB_NAME = dbName
+	DB_PORT = port
+	DB_POOL_MAX_CONNECTIONS = poolMaxConnections
+	
+	dbConfig, err := GetDefaultDbSettings()
+	if err != nil {
+		return nil, err
+	}
+	
+	return InitDatabase(dbConfig)
+
+}
+{{end}}
+// InitDatabaseSetSSLMode allows setting the ssl mode for subsequent db initialization.
+// It should be called right before InitDatabaseMinimal.
+// The sslmode value can be "allow", "prefer", "require", "verify-ca" or "verify-full".
+// An empty string or "disable" signify no SSL. Passing an unknown value is the same 
+// as passing an empty string.
+func InitDatabaseSetSSLMode(sslmode string) {
+	DB_SSL = sslmode
+}
+
+/* BEGIN Querier and DB */
+
+// Querier runs the queries of the generated code. {{if .IsSql}}*SqlDB, *SqlTx and *Transaction satisfy it{{else}}The connection pool, a single *pgx.Conn,
+// a pgx.Tx and *Transaction satisfy it{{end}}.
+type Querier interface {
+	{{if .IsSql}}Query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row
+	Exec(ctx context.Context, query string, args ...interface{}) (CommandTag, error){{else}}Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error){{end}}
+}
+
+// txBeginner is a Querier transactions can begin on
+type txBeginner interface {
+	Begin(ctx context.Context) ({{if .IsSql}}*SqlTx{{else}}pgx.Tx{{end}}, error)
+}
+
+// dbQuerier returns the querier, or the GetDb() handle when the querier is nil.
+// It returns nil if GetDb() does.
+func dbQuerier(querier Querier) Querier {
+
+	if querier != nil {
+		return querier
+	}
+	if currentDbHandle := GetDb(); currentDbHandle != nil {
+		return currentDbHandle
+	}
+	return nil
+}
+
+// DB holds the table, view and function utilities bound to a Querier, so that the same
+// db.<Table>.Select{{.CtxSuffix}}(...) call runs on the pool or inside a transaction.
+// Unlike the Tables, Views and Functions singletons, which run on GetDb(), any number of
+// DB values can coexist, e.g. one per database or one per test.
+type DB struct {
+	Querier Querier
+	{{range .Tables}}
+	{{.GoFriendlyName}} *t{{.GoFriendlyName}}Utils{{end}}{{range .Views}}
+	{{.GoFriendlyName}} *t{{.GoFriendlyName}}Utils{{end}}{{if .Functions}}
+
+	Functions *tFunctionUtils{{end}}
+}
+
+// NewDB returns a DB running on the querier, e.g. a pool, a connection or a transaction.
+// A nil querier stands for the GetDb() handle. The caches of the returned utilities are
+// its own, disabled until enabled.
+func NewDB(querier Querier) *DB {
+
+	db := &DB{Querier: querier}
+	{{range .Tables}}
+	db.{{.GoFriendlyName}} = &t{{.GoFriendlyName}}Utils{querier: querier}
+	db.{{.GoFriendlyName}}.Cache.utils = db.{{.GoFriendlyName}}{{end}}{{range .Views}}
+	db.{{.GoFriendlyName}} = &t{{.GoFriendlyName}}Utils{querier: querier}
+	db.{{.GoFriendlyName}}.Cache.utils = db.{{.GoFriendlyName}}{{end}}{{if .Functions}}
+	db.Functions = &tFunctionUtils{querier: querier}{{end}}
+
+	return db
+}
+
+{{if not .CtxOnly}}// TxWrap is TxWrapCtx with the background context
+func (db *DB) TxWrap(wrapperFunc func(txDb *DB) error) error {
+	return db.TxWrapCtx(context.Background(), wrapperFunc)
+}
+
+{{end}}// TxWrap{{.CtxSuffix}} begins a transaction on the querier of db and passes wrapperFunc a DB
+// running on it. The transaction is committed if wrapperFunc returns nil, rolled back otherwise.
+// {{if .IsSql}}The querier must be able to begin a transaction, as *SqlDB does.{{else}}The querier must be able to begin a transaction, a pgx.Tx begins a savepoint.{{end}}
+func (db *DB) TxWrap{{.CtxSuffix}}(ctx context.Context, wrapperFunc func(txDb *DB) error) error {
+
+	var errorPrefix = "DB.TxWrap() ERROR: "
+
+	beginner, ok := dbQuerier(db.Querier).(txBeginner)
+	if !ok {
+		return NewModelsErrorLocal(errorPrefix, "the querier cannot begin a transaction")
+	}
+
+	realTx, err := beginner.Begin(ctx)
+	if err != nil {
+		return NewModelsError(errorPrefix+"Begin() error: ", err)
+	}
+
+	// Rollback is a no-op once the transaction is committed
+	defer realTx.Rollback(ctx)
+
+	if err := wrapperFunc(NewDB(realTx)); err != nil {
+		return NewModelsError(errorPrefix+"inner wrapperFunc() error - will return and rollback: ", err)
+	}
+
+	if err := realTx.Commit(ctx); err != nil {
+		return NewModelsError(errorPrefix+"tx.Commit() error: ", err)
+	}
+
+	return nil
+}
+
+// DB returns a DB running on the transaction, e.g. tx.DB().<Table>.Select{{.CtxSuffix}}(...)
+func (t *Transaction) DB() *DB {
+	return NewDB(t)
+}
+
+// Query runs a query returning rows in the transaction. With QueryRow and Exec{{if not .IsSql}} and CopyFrom{{end}},
+// it makes *Transaction a Querier.
+func (t *Transaction) Query(ctx context.Context, query string, args ...interface{}) ({{if .IsSql}}*sql.Rows{{else}}pgx.Rows{{end}}, error) {
+	return t.Tx.Query(ctx, query, args...)
+}
+
+// QueryRow runs a query expected to return at most one row in the transaction
+func (t *Transaction) QueryRow(ctx context.Context, query string, args ...interface{}) {{if .IsSql}}*sql.Row{{else}}pgx.Row{{end}} {
+	return t.Tx.QueryRow(ctx, query, args...)
+}
+
+// Exec runs a query without returning any rows in the transaction
+func (t *Transaction) Exec(ctx context.Context, query string, args ...interface{}) ({{if .IsSql}}CommandTag{{else}}pgconn.CommandTag{{end}}, error) {
+	return t.Tx.Exec(ctx, query, args...)
+}
+{{if not .IsSql}}
+// CopyFrom bulk copies the rows of rowSrc in the transaction
+func (t *Transaction) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return t.Tx.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+{{end}}
+
+/* END Querier and DB */
+
+/* BEGIN Transactions utility functions */
+
+{{if not .CtxOnly}}// TxBegin is TxBeginCtx with the background context
+func TxBegin() (*Transaction, error) {
+	return TxBeginCtx(context.Background())
+}
+
+// TxBeginIso is TxBeginIsoCtx with the background context
+func TxBeginIso(isolationLevel {{if .IsSql}}sql.IsolationLevel{{else}}pgx.TxIsoLevel{{end}}) (*Transaction, error) {
+	return TxBeginIsoCtx(context.Background(), isolationLevel)
+}
+
+// TxWrap is TxWrapCtx with the background context
+func TxWrap(wrapperFunc func(tx *Transaction, args ...interface{}) (interface{}, error), arguments ...interface{}) (interface{}, error) {
+	return TxWrapCtx(context.Background(), wrapperFunc, arguments...)
+}
+
+{{end}}// TxBegin{{.CtxSuffix}} begins and returns a transaction using the default isolation level.
+// Unlike TxWrap, it is the responsibility of the caller to commit and
+// rollback the transaction if necessary.
+func TxBegin{{.CtxSuffix}}(ctx context.Context) (*Transaction, error) {
+
+	txWrapper := &Transaction{}
+	tx, err := GetDb().Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	} 
+	txWrapper.Tx = tx
+	return txWrapper, nil
+}
+
+// TxBeginIso{{.CtxSuffix}} begins and returns a transaction using the specified isolation level.
+// The following global constants can be passed (residing in the same package):
+//  IsoLevelSerializable
+//  IsoLevelRepeatableRead
+//  IsoLevelReadCommitted
+//  IsoLevelReadUncommitted
+{{if .IsSql}}func TxBeginIso{{.CtxSuffix}}(ctx context.Context, isolationLevel sql.IsolationLevel) (*Transaction, error) {
+
+	txWrapper := &Transaction{}
+	tx, err := GetDb().BeginTx(ctx, &sql.TxOptions{Isolation: isolationLevel}){{else}}func TxBeginIso{{.CtxSuffix}}(ctx context.Context, isolationLevel pgx.TxIsoLevel) (*Transaction, error) {
+
+	txWrapper := &Transaction{}
+	tx, err := GetDb().BeginTx(ctx, pgx.TxOptions{IsoLevel: isolationLevel}){{end}}
+
+	if err != nil {
+		return nil, err
+	} 
+	txWrapper.Tx = tx
+	return txWrapper, nil	
+}
+
+/*TxWrap{{.CtxSuffix}} helps wrap the transaction inside a closure function. Additional 
+ arguments can be passed along to the closure via a variadic list of 
+ interface{} parameters. TxWrap automatically handles commit and rollback, 
+ in case of error. It returns an error in case of failure, or nil, if successful.
+ The transaction runs with the ctx context, which the closure passes on to the tx methods.
+
+ Example:
+
+	// define the transaction functionlity in this wrapper closure
+	var transactionFunc = func(tx *models.Transaction, arguments ...interface{}) (interface{}, error) {
+
+		// assuming the generated package is named models and
+		// there is a TestEvent struct corresponding to a test_event table in the database
+		newTestEvent := models.Tables.TestEvent.New()
+
+		// load the event name as passed via the variadic arguments
+		newTestEvent.SetEventName(arguments[0].(string))
+		newTestEvent.SetEventOverview(arguments[1].(string), true)
+
+		newTestEvent, err := tx.InsertTestEvent{{.CtxSuffix}}(ctx, newTestEvent)
+		if err != nil {
+			return nil, models.NewModelsError("insert event tx error:", err)
+		}
+
+		// any other transaction operations...
+
+		// at the end, we return nil for a successful operation
+		return newTestEvent, nil
+	}
+
+	// define some parameters to be passed inside the transaction
+	eventName := "Donald Duck Anniversary"
+	eventDescription := "Where is the party ?"
+
+	// we defined the transaction functionality, let's run it with the event name argument
+	returnedNewEvent, err := models.TxWrap{{.CtxSuffix}}(ctx, transactionFunc, eventName, eventDescription)
+	if err != nil {
+		fmt.Println("FAIL:", err.Error())
+	} else {
+		if returnedNewEvent == nil {
+			fmt.Printf("OK. But newlyInsertedEvent is nil \r\n")
+		} else {
+			// we need to make sure to convert the resulting type to the needs of this particular transaction
+			fmt.Printf("OK. newlyInsertedEvent overview: " + returnedNewEvent.(*models.TestEvent).EventOverview + "  \r\n")
+		}
+	} */
+func TxWrap{{.CtxSuffix}}(ctx context.Context, wrapperFunc func(tx *Transaction, args ...interface{}) (interface{}, error), arguments ...interface{}) (interface{}, error) {
+
+	var errorPrefix = "TxWrap() ERROR: "
+
+	realTx, err := GetDb().Begin(ctx)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+"GetDb().Begin() error: ", err)
+	}
+
+	// pgx package note: Rollback is safe to call even if the tx is already closed,
+	// so if the tx commits successfully, this is a no-op
+	defer realTx.Rollback(ctx)
+
+	// wrap the real tx into our wrapper
+	tx := &Transaction{Tx: realTx}
+
+	result, err := wrapperFunc(tx, arguments...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+"inner wrapperFunc() error - will return and rollback: ", err)
+	}
+
+	err = realTx.Commit(ctx)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+"tx.Commit() error: ", err)
+	}
+
+	return result, nil
+}
+
+/* END Transactions utility functions */
+
+/* BEGIN Query builder */
+
+// QueryColumn is a column of a table or view, e.g. UsersCols.Email, whose methods build
+// the predicates of the methods taking a condition
+type QueryColumn struct {
+	name string
+}
+
+// Name returns the database name of the column, e.g. for an update mask
+func (column QueryColumn) Name() string {
+	return column.name
+}
+
+// identifier returns the quoted name of the column, which the queries refer to it by
+func (column QueryColumn) identifier() string {
+	return quoteIdentifier(column.name)
+}
+
+// quoteIdentifier quotes the database name, so that the mixed case names and the reserved words
+// can be used as they are
+func quoteIdentifier(dbName string) string {
+	return "\"" + strings.Replace(dbName, "\"", "\"\"", -1) + "\""
+}
+
+// Predicate is the condition of the select, count, update and delete methods, built by the QueryColumn methods,
+// Raw, And, Or and Not. The methods number its placeholders, so that it does not depend on the other parameters
+// of the query. The zero Predicate is no condition.
+type Predicate struct {
+	write func(query *predicateQuery)
+
+	// And and Or put the compound predicates they combine in parentheses
+	compound bool
+
+	// the form the in-memory fakes evaluate: the operator, e.g. "=", "IN", "IS NULL", "AND" or "RAW",
+	// the column and the values it is compared with, the predicates combined by And, Or and Not,
+	// and the condition of Raw, whose params are the values
+	operator   string
+	column     string
+	values     []interface{}
+	predicates []Predicate
+	raw        string
+}
+
+// predicateQuery is the condition being written, and its parameters
+type predicateQuery struct {
+	condition strings.Builder
+	params    []interface{}
+	startAt   int
+}
+
+// placeholder writes the numbered placeholder of the parameter
+func (query *predicateQuery) placeholder(param interface{}) {
+	query.params = append(query.params, param)
+	query.condition.WriteString("$" + strconv.Itoa(query.startAt+len(query.params)-1))
+}
+
+// IsZero tells whether the predicate is no condition
+func (predicate Predicate) IsZero() bool {
+	return predicate.write == nil
+}
+
+// Condition returns the condition of the predicate, with its placeholders numbered from $startAt,
+// and its parameters
+func (predicate Predicate) Condition(startAt int) (string, []interface{}) {
+
+	if predicate.IsZero() {
+		return "", nil
+	}
+
+	query := predicateQuery{startAt: startAt}
+	predicate.write(&query)
+	return query.condition.String(), query.params
+}
+
+func (column QueryColumn) compare(operator string, param interface{}) Predicate {
+	return Predicate{operator: operator, column: column.name, values: []interface{}{param}, write: func(query *predicateQuery) {
+		query.condition.WriteString(column.identifier() + " " + operator + " ")
+		query.placeholder(param)
+	}}
+}
+
+// Eq is column = value
+func (column QueryColumn) Eq(value interface{}) Predicate { return column.compare("=", value) }
+
+// NotEq is column <> value
+func (column QueryColumn) NotEq(value interface{}) Predicate { return column.compare("<>", value) }
+
+// Lt is column < value
+func (column QueryColumn) Lt(value interface{}) Predicate { return column.compare("<", value) }
+
+// Lte is column <= value
+func (column QueryColumn) Lte(value interface{}) Predicate { return column.compare("<=", value) }
+
+// Gt is column > value
+func (column QueryColumn) Gt(value interface{}) Predicate { return column.compare(">", value) }
+
+// Gte is column >= value
+func (column QueryColumn) Gte(value interface{}) Predicate { return column.compare(">=", value) }
+
+// Like is column LIKE pattern
+func (column QueryColumn) Like(pattern string) Predicate { return column.compare("LIKE", pattern) }
+
+// ILike is column ILIKE pattern, the case insensitive LIKE
+func (column QueryColumn) ILike(pattern string) Predicate { return column.compare("ILIKE", pattern) }
+
+// In is column IN (values...), false for no values
+func (column QueryColumn) In(values ...interface{}) Predicate {
+	return Predicate{operator: "IN", column: column.name, values: values, write: func(query *predicateQuery) {
+
+		if len(values) == 0 {
+			query.condition.WriteString("FALSE")
+			return
+		}
+
+		query.condition.WriteString(column.identifier() + " IN (")
+		for i, value := range values {
+			if i > 0 {
+				query.condition.WriteString(", ")
+			}
+			query.placeholder(value)
+		}
+		query.condition.WriteString(")")
+	}}
+}
+
+// Between is column BETWEEN low AND high
+func (column QueryColumn) Between(low interface{}, high interface{}) Predicate {
+	return Predicate{operator: "BETWEEN", column: column.name, values: []interface{}{low, high}, write: func(query *predicateQuery) {
+		query.condition.WriteString(column.identifier() + " BETWEEN ")
+		query.placeholder(low)
+		query.condition.WriteString(" AND ")
+		query.placeholder(high)
+	}}
+}
+
+// IsNull is column IS NULL
+func (column QueryColumn) IsNull() Predicate {
+	return Predicate{operator: "IS NULL", column: column.name, write: func(query *predicateQuery) {
+		query.condition.WriteString(column.identifier() + " IS NULL")
+	}}
+}
+
+// IsNotNull is column IS NOT NULL
+func (column QueryColumn) IsNotNull() Predicate {
+	return Predicate{operator: "IS NOT NULL", column: column.name, write: func(query *predicateQuery) {
+		query.condition.WriteString(column.identifier() + " IS NOT NULL")
+	}}
+}
+
+// rawPlaceholder is a $n placeholder of a Raw condition
+var rawPlaceholder = regexp.MustCompile("\\$[0-9]+")
+
+// Raw is the condition written by hand, without the WHERE keyword, e.g. Raw("lower(email) = $1", email),
+// its placeholders numbered from $1 and its params following them. Combined with the other predicates,
+// its placeholders are renumbered after theirs, the $n inside its string literals included.
+func Raw(condition string, params ...interface{}) Predicate {
+
+	if condition == "" {
+		return Predicate{}
+	}
+
+	return Predicate{compound: true, operator: "RAW", raw: condition, values: params, write: func(query *predicateQuery) {
+
+		shift := query.startAt + len(query.params) - 1
+		if shift == 0 {
+			query.condition.WriteString(condition)
+		} else {
+			query.condition.WriteString(rawPlaceholder.ReplaceAllStringFunc(condition, func(placeholder string) string {
+				number, _ := strconv.Atoi(placeholder[1:])
+				return "$" + strconv.Itoa(number+shift)
+			}))
+		}
+		query.params = append(query.params, params...)
+	}}
+}
+
+// And matches the rows matching all the predicates. The zero predicates are left out,
+// so that the optional filters can be passed as they are.
+func And(predicates ...Predicate) Predicate {
+	return combinePredicates("AND", predicates)
+}
+
+// Or matches the rows matching any of the predicates. The zero predicates are left out.
+func Or(predicates ...Predicate) Predicate {
+	return combinePredicates("OR", predicates)
+}
+
+// Not matches the rows the predicate does not match
+func Not(predicate Predicate) Predicate {
+
+	if predicate.IsZero() {
+		return predicate
+	}
+
+	return Predicate{operator: "NOT", predicates: []Predicate{predicate}, write: func(query *predicateQuery) {
+		query.condition.WriteString("NOT (")
+		predicate.write(query)
+		query.condition.WriteString(")")
+	}}
+}
+
+func combinePredicates(operator string, predicates []Predicate) Predicate {
+
+	var combined []Predicate
+	for _, predicate := range predicates {
+		if !predicate.IsZero() {
+			combined = append(combined, predicate)
+		}
+	}
+
+	switch len(combined) {
+	case 0:
+		return Predicate{}
+	case 1:
+		return combined[0]
+	}
+
+	return Predicate{compound: true, operator: operator, predicates: combined, write: func(query *predicateQuery) {
+		for i, predicate := range combined {
+			if i > 0 {
+				query.condition.WriteString(" " + operator + " ")
+			}
+			if predicate.compound {
+				query.condition.WriteString("(")
+				predicate.write(query)
+				query.condition.WriteString(")")
+			} else {
+				predicate.write(query)
+			}
+		}
+	}}
+}
+
+/* END Query builder */
+
+/* BEGIN Select options */
+
+// SelectOption orders, limits, offsets, locks or projects the rows of the select methods, which take the options
+// last, e.g. Select(UsersCols.Active.Eq(true), OrderBy(UsersCols.Email.Desc()), Limit(10)).
+type SelectOption struct {
+	apply func(options *selectOptions)
+}
+
+// OrderTerm is a column of the ORDER BY, built by the QueryColumn Asc and Desc methods
+type OrderTerm struct {
+	column     string
+	descending bool
+	nulls      string
+}
+
+// Asc orders by the column, in ascending order
+func (column QueryColumn) Asc() OrderTerm {
+	return OrderTerm{column: column.name}
+}
+
+// Desc orders by the column, in descending order
+func (column QueryColumn) Desc() OrderTerm {
+	return OrderTerm{column: column.name, descending: true}
+}
+
+// NullsFirst puts the NULL values first, the default of Desc
+func (term OrderTerm) NullsFirst() OrderTerm {
+	term.nulls = "NULLS FIRST"
+	return term
+}
+
+// NullsLast puts the NULL values last, the default of Asc
+func (term OrderTerm) NullsLast() OrderTerm {
+	term.nulls = "NULLS LAST"
+	return term
+}
+
+// nullsFirst tells whether the NULL values come first, as they do in the database
+func (term OrderTerm) nullsFirst() bool {
+	if term.nulls == "" {
+		return term.descending
+	}
+	return term.nulls == "NULLS FIRST"
+}
+
+// selectOptions are the options of a select method
+type selectOptions struct {
+	orderBy []OrderTerm
+
+	// orderByText is the orderBy parameter of SelectAllOrderBy and SelectAllPage, which cannot be validated
+	orderByText string
+
+	limit   int
+	offset  int
+	locking string
+
+	// projection holds the columns of WithColumns, nil for all of them
+	projection *columnProjection
+
+	err error
+}
+
+// OrderBy orders the rows by the terms, e.g. OrderBy(UsersCols.LastName.Asc(), UsersCols.Age.Desc().NullsLast()).
+// The columns must belong to the table or view selected from.
+func OrderBy(terms ...OrderTerm) SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		options.orderBy = append(options.orderBy, terms...)
+	}}
+}
+
+// Limit returns at most limit rows, which must be greater than or equal to 1
+func Limit(limit int) SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		if limit < 1 {
+			options.err = errors.New("the limit must be greater than or equal to 1")
+			return
+		}
+		options.limit = limit
+	}}
+}
+
+// Offset skips the first offset rows
+func Offset(offset int) SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		if offset < 0 {
+			options.err = errors.New("the offset must be greater than or equal to 0")
+			return
+		}
+		options.offset = offset
+	}}
+}
+
+// ForUpdate locks the rows selected against the concurrent updates, until the end of the transaction.
+// The cached methods and the unions refuse it.
+func ForUpdate() SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		options.locking = "FOR UPDATE"
+	}}
+}
+
+// ForShare locks the rows selected against the concurrent updates, letting the other transactions
+// share the lock, until the end of the transaction. The cached methods and the unions refuse it.
+func ForShare() SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		options.locking = "FOR SHARE"
+	}}
+}
+
+// WithColumns selects only the columns, e.g. WithColumns(UsersCols.Id, UsersCols.Email), the other fields
+// of the rows keeping their zero values. The rows record the columns they were selected with, see their
+// LoadedColumns method, and the instance Update refuses to write them back, ErrColumnsNotLoaded.
+func WithColumns(columns ...QueryColumn) SelectOption {
+	return SelectOption{apply: func(options *selectOptions) {
+		if len(columns) == 0 {
+			options.err = errors.New("WithColumns takes at least one column")
+			return
+		}
+
+		dbNames := make([]string, len(columns))
+		for i := range columns {
+			dbNames[i] = columns[i].name
+		}
+		options.projection = options.projection.including(dbNames)
+	}}
+}
+
+// ErrColumnsNotLoaded is returned by the instance Update of a row selected WithColumns, which would
+// overwrite the columns left out with the zero values of their fields
+var ErrColumnsNotLoaded = errors.New("the row was selected with only some of its columns, the others would be overwritten")
+
+// columnProjection holds the columns selected by WithColumns, a nil projection selecting all of them.
+// The rows selected together share it, and it is never modified.
+type columnProjection struct {
+	columns []string
+}
+
+// including returns a projection of its columns and the dbNames
+func (projection *columnProjection) including(dbNames []string) *columnProjection {
+
+	included := &columnProjection{}
+	if projection != nil {
+		included.columns = append(included.columns, projection.columns...)
+	}
+	for _, dbName := range dbNames {
+		if !included.loads(dbName) {
+			included.columns = append(included.columns, dbName)
+		}
+	}
+	return included
+}
+
+// loads tells whether the column is selected
+func (projection *columnProjection) loads(dbName string) bool {
+
+	if projection == nil {
+		return true
+	}
+	for _, column := range projection.columns {
+		if column == dbName {
+			return true
+		}
+	}
+	return false
+}
+
+// loadedColumns returns a copy of the columns selected, nil for all of them
+func (projection *columnProjection) loadedColumns() []string {
+
+	if projection == nil {
+		return nil
+	}
+	return append([]string(nil), projection.columns...)
+}
+
+// applySelectOptions returns the options of a select method
+func applySelectOptions(options []SelectOption) selectOptions {
+
+	var applied selectOptions
+	for _, option := range options {
+		if option.apply != nil {
+			option.apply(&applied)
+		}
+	}
+	return applied
+}
+
+// page sets the LIMIT and OFFSET of the page, the paged methods refusing the Limit and Offset options
+func (options *selectOptions) page(pageNumber int, pageSize int) error {
+
+	if options.limit > 0 || options.offset > 0 {
+		return errors.New("the paged methods do not take the Limit and Offset options")
+	}
+
+	options.limit = pageSize
+	options.offset = pageSize * (pageNumber - 1)
+	return nil
+}
+
+// orderedBy sets the orderBy parameter of SelectAllOrderBy and SelectAllPage, which the OrderBy option cannot also set
+func (options *selectOptions) orderedBy(orderBy string) error {
+
+	if orderBy != "" {
+		if len(options.orderBy) > 0 {
+			return errors.New("the orderBy parameter and the OrderBy option cannot be both used")
+		}
+		options.orderByText = orderBy
+	}
+	return nil
+}
+
+// cached refuses the locking options, which the rows returned from the cache would not honour
+func (options selectOptions) cached() error {
+
+	if options.locking != "" {
+		return errors.New("the cached methods do not take the ForUpdate and ForShare options")
+	}
+	return nil
+}
+
+// union refuses the locking options, which PostgreSQL does not allow with UNION
+func (options selectOptions) union() error {
+
+	if options.locking != "" {
+		return errors.New("the unions do not take the ForUpdate and ForShare options")
+	}
+	return nil
+}
+
+// plain tells whether the options leave the rows as the cache of all the rows holds them:
+// with all their columns, in no particular order
+func (options selectOptions) plain() bool {
+	return options.err == nil && len(options.orderBy) == 0 && options.orderByText == "" && options.limit == 0 &&
+		options.offset == 0 && options.locking == "" && options.projection == nil
+}
+
+// query returns the select query up to the condition, genericSelectQuery unless the options select
+// only some of the columns from the selectSource
+func (options selectOptions) query(genericSelectQuery string, selectSource string) string {
+
+	if options.projection == nil {
+		return genericSelectQuery
+	}
+	columns := make([]string, len(options.projection.columns))
+	for i, column := range options.projection.columns {
+		columns[i] = quoteIdentifier(column)
+	}
+	return "SELECT " + strings.Join(columns, ", ") + " FROM " + selectSource + " "
+}
+
+// clause returns the ORDER BY, LIMIT, OFFSET and locking clauses of the options, following the condition,
+// once the ordering and the projection are checked against the columns of the table or view
+func (options selectOptions) clause(isColumn func(dbName string) bool) (string, error) {
+
+	if options.err != nil {
+		return "", options.err
+	}
+
+	if options.projection != nil {
+		for _, column := range options.projection.columns {
+			if !isColumn(column) {
+				return "", errors.New("cannot select " + column + ", which is not one of the columns")
+			}
+		}
+	}
+
+	var clause strings.Builder
+
+	if options.orderByText != "" {
+		clause.WriteString(" ORDER BY " + options.orderByText)
+	}
+	for i, term := range options.orderBy {
+		if !isColumn(term.column) {
+			return "", errors.New("cannot order by " + term.column + ", which is not one of the columns")
+		}
+
+		if i == 0 {
+			clause.WriteString(" ORDER BY ")
+		} else {
+			clause.WriteString(", ")
+		}
+		clause.WriteString(quoteIdentifier(term.column))
+		if term.descending {
+			clause.WriteString(" DESC")
+		}
+		if term.nulls != "" {
+			clause.WriteString(" " + term.nulls)
+		}
+	}
+
+	if options.limit > 0 {
+		clause.WriteString(" LIMIT " + strconv.Itoa(options.limit))
+	}
+	if options.offset > 0 {
+		clause.WriteString(" OFFSET " + strconv.Itoa(options.offset))
+	}
+	if options.locking != "" {
+		clause.WriteString(" " + options.locking)
+	}
+
+	return clause.String(), nil
+}
+
+// option returns the options as a single SelectOption, for the methods calling the select methods
+func (options selectOptions) option() SelectOption {
+	return SelectOption{apply: func(applied *selectOptions) {
+		*applied = options
+	}}
+}
+
+/* END Select options */
+
+/* BEGIN Keyset pagination */
+
+// ErrInvalidCursor is returned by the SelectAfter methods for a cursor they did not return,
+// or returned for another ordering
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// keyset holds the unique keys of a table or view, and the columns its SelectAfter pages can be ordered by
+type keyset struct {
+	keys    [][]string
+	columns []string
+}
+
+// keysetCursor is the content of the cursors: the ordering, and the values of its columns in the last row of the page
+type keysetCursor struct {
+	Order  string
+	Values map[string]json.RawMessage
+}
+
+// order checks the ordering of the options, by the first key when there is none, and returns it,
+// e.g. "-created_at,id" for created_at DESC, id
+func (set keyset) order(options *selectOptions) (string, error) {
+
+	if len(options.orderBy) == 0 {
+		for _, column := range set.keys[0] {
+			options.orderBy = append(options.orderBy, OrderTerm{column: column})
+		}
+	}
+
+	var order []string
+	ordered := make(map[string]bool)
+	for _, term := range options.orderBy {
+
+		found := false
+		for _, column := range set.columns {
+			found = found || column == term.column
+		}
+		if !found {
+			return "", errors.New("cannot page by " + term.column + ", which is not one of the NOT NULL columns")
+		}
+
+		ordered[term.column] = true
+		if term.descending {
+			order = append(order, "-"+term.column)
+		} else {
+			order = append(order, term.column)
+		}
+	}
+
+	for _, key := range set.keys {
+		unique := true
+		for _, column := range key {
+			unique = unique && ordered[column]
+		}
+		if unique {
+			return strings.Join(order, ","), nil
+		}
+	}
+
+	return "", errors.New("the ordering of the pages must include one of the unique keys")
+}
+
+// after returns the condition of the rows following the cursor, the empty one for the first page.
+// It sets the ordering of the page, and its limit to one row more than pageSize, which tells
+// whether there is a next page. decode returns the value of a column from its json.
+func (set keyset) after(cursor string, pageSize int, options *selectOptions, decode func(dbName string, value json.RawMessage) (interface{}, error)) (Predicate, error) {
+
+	if pageSize < 1 {
+		return Predicate{}, errors.New("the pageSize parameter must be greater than or equal to 1")
+	}
+	if options.limit > 0 || options.offset > 0 {
+		return Predicate{}, errors.New("the keyset pages do not take the Limit and Offset options")
+	}
+
+	order, err := set.order(options)
+	if err != nil {
+		return Predicate{}, err
+	}
+	options.limit = pageSize + 1
+
+	// the cursor of the next page holds the ordering columns of the last row, which WithColumns cannot leave out
+	if options.projection != nil {
+		ordering := make([]string, len(options.orderBy))
+		for i, term := range options.orderBy {
+			ordering[i] = term.column
+		}
+		options.projection = options.projection.including(ordering)
+	}
+
+	if cursor == "" {
+		return Predicate{}, nil
+	}
+
+	var content keysetCursor
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(decoded, &content)
+	}
+	if err != nil {
+		return Predicate{}, ErrInvalidCursor
+	}
+	if content.Order != order {
+		return Predicate{}, fmt.Errorf("%w, it belongs to the pages ordered by %s", ErrInvalidCursor, content.Order)
+	}
+
+	values := make([]interface{}, len(options.orderBy))
+	for i, term := range options.orderBy {
+		value, found := content.Values[term.column]
+		if !found {
+			return Predicate{}, fmt.Errorf("%w, it has no %s", ErrInvalidCursor, term.column)
+		}
+		if values[i], err = decode(term.column, value); err != nil {
+			return Predicate{}, fmt.Errorf("%w, its %s cannot be decoded: %v", ErrInvalidCursor, term.column, err)
+		}
+	}
+
+	return keysetPredicate(options.orderBy, values), nil
+}
+
+// cursor returns the cursor of the page following the row, value returning the row's columns
+func (set keyset) cursor(options selectOptions, value func(dbName string) interface{}) (string, error) {
+
+	order, err := set.order(&options)
+	if err != nil {
+		return "", err
+	}
+
+	content := keysetCursor{Order: order, Values: make(map[string]json.RawMessage)}
+	for _, term := range options.orderBy {
+		if content.Values[term.column], err = json.Marshal(value(term.column)); err != nil {
+			return "", err
+		}
+	}
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// keysetPredicate matches the rows following the values in the ordering of the terms: the row comparison
+// (a, b) > ($1, $2), which the indexes serve, when all the terms have the same direction, and otherwise
+// a > $1 OR (a = $1 AND b < $2)
+func keysetPredicate(terms []OrderTerm, values []interface{}) Predicate {
+
+	operator := func(term OrderTerm) string {
+		if term.descending {
+			return "<"
+		}
+		return ">"
+	}
+
+	sameDirection := true
+	for _, term := range terms {
+		sameDirection = sameDirection && term.descending == terms[0].descending
+	}
+
+	if len(terms) == 1 {
+		return QueryColumn{name: terms[0].column}.compare(operator(terms[0]), values[0])
+	}
+
+	var following []Predicate
+	for i, term := range terms {
+		var equal []Predicate
+		for j := 0; j < i; j++ {
+			equal = append(equal, QueryColumn{name: terms[j].column}.Eq(values[j]))
+		}
+		following = append(following, And(append(equal, QueryColumn{name: term.column}.compare(operator(term), values[i]))...))
+	}
+	predicate := Or(following...)
+
+	// the row comparison, which the in-memory fakes evaluate as the OR it is equivalent to
+	if sameDirection {
+		predicate.compound = false
+		predicate.write = func(query *predicateQuery) {
+			query.condition.WriteString("(")
+			for i, term := range terms {
+				if i > 0 {
+					query.condition.WriteString(", ")
+				}
+				query.condition.WriteString(quoteIdentifier(term.column))
+			}
+			query.condition.WriteString(") " + operator(terms[0]) + " (")
+			for i, value := range values {
+				if i > 0 {
+					query.condition.WriteString(", ")
+				}
+				query.placeholder(value)
+			}
+			query.condition.WriteString(")")
+		}
+	}
+	return predicate
+}
+
+/* END Keyset pagination */
+{{if .GenerateRepositories}}
+/* BEGIN Repository mocks */
+
+// MockCall is a call recorded by a <Name>RepositoryMock: the method name and the arguments,
+// the variadic ones as a slice
+type MockCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// MockCalls records the calls of a <Name>RepositoryMock, it is safe for concurrent use
+type MockCalls struct {
+	mutex sync.Mutex
+	calls []MockCall
+}
+
+// Record appends a call
+func (m *MockCalls) Record(method string, args ...interface{}) {
+	m.mutex.Lock()
+	m.calls = append(m.calls, MockCall{Method: method, Args: args})
+	m.mutex.Unlock()
+}
+
+// Calls returns the recorded calls, in their order
+func (m *MockCalls) Calls() []MockCall {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]MockCall(nil), m.calls...)
+}
+
+// CallsTo returns the recorded calls of the method, in their order
+func (m *MockCalls) CallsTo(method string) []MockCall {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var calls []MockCall
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets the recorded calls
+func (m *MockCalls) ResetCalls() {
+	m.mutex.Lock()
+	m.calls = nil
+	m.mutex.Unlock()
+}
+
+/* END Repository mocks */
+
+/* BEGIN Repository fakes */
+
+// ErrFakeNotSupported is returned by the methods the <Name>Fake in-memory tables do not implement,
+// and for the conditions they cannot evaluate: the Raw conditions other than column = $n, column IS NULL
+// and column IS NOT NULL comparisons joined by AND, and the comparisons of the values they cannot order
+var ErrFakeNotSupported = errors.New("not supported by the in-memory fake")
+
+var (
+	fakeConditionAnd  = regexp.MustCompile("(?i)\\s+AND\\s+")
+	fakeConditionTerm = regexp.MustCompile("(?i)^\\s*(?:\"?\\w+\"?\\.)?(\"?)(\\w+)\"?\\s*(?:=\\s*\\$(\\d+)|IS\\s+(NOT\\s+)?NULL)\\s*$")
+	fakeOrderByTerm   = regexp.MustCompile("(?i)^\\s*(?:\"?\\w+\"?\\.)?(\"?)(\\w+)\"?(?:\\s+(ASC|DESC))?(?:\\s+NULLS\\s+(FIRST|LAST))?\\s*$")
+)
+
+// fakeRaw returns the predicate of a Raw condition, the in-memory fakes evaluating the column = $n,
+// column IS NULL and column IS NOT NULL comparisons joined by AND
+func fakeRaw(condition string, params []interface{}) (Predicate, error) {
+
+	var terms []Predicate
+	for _, part := range fakeConditionAnd.Split(strings.TrimSpace(condition), -1) {
+
+		match := fakeConditionTerm.FindStringSubmatch(part)
+		if match == nil {
+			return Predicate{}, fmt.Errorf("the condition %q is %w", condition, ErrFakeNotSupported)
+		}
+
+		column := QueryColumn{name: match[2]}
+		if match[1] == "" {
+			// the unquoted identifiers are case insensitive
+			column.name = strings.ToLower(column.name)
+		}
+
+		switch {
+		case match[3] != "":
+			index, _ := strconv.Atoi(match[3])
+			if index < 1 || index > len(params) {
+				return Predicate{}, fmt.Errorf("the condition %q uses $%s, which has no parameter", condition, match[3])
+			}
+			terms = append(terms, column.Eq(params[index-1]))
+		case match[4] != "":
+			terms = append(terms, column.IsNotNull())
+		default:
+			terms = append(terms, column.IsNull())
+		}
+	}
+
+	return And(terms...), nil
+}
+
+// fakePredicate returns the predicate with its Raw conditions parsed by fakeRaw, once its columns are checked
+func fakePredicate(predicate Predicate, isColumn func(dbName string) bool) (Predicate, error) {
+
+	switch predicate.operator {
+	case "":
+		return predicate, nil
+	case "RAW":
+		parsed, err := fakeRaw(predicate.raw, predicate.values)
+		if err != nil {
+			return Predicate{}, err
+		}
+		return fakePredicate(parsed, isColumn)
+	case "AND", "OR", "NOT":
+		checked := predicate
+		checked.predicates = make([]Predicate, len(predicate.predicates))
+		for i := range predicate.predicates {
+			var err error
+			if checked.predicates[i], err = fakePredicate(predicate.predicates[i], isColumn); err != nil {
+				return Predicate{}, err
+			}
+		}
+		return checked, nil
+	}
+
+	if !isColumn(predicate.column) {
+		return Predicate{}, fmt.Errorf("the condition uses the unknown column %s", predicate.column)
+	}
+	return predicate, nil
+}
+
+// fakeTruth is the value of a condition in the three-valued logic of the database, where a comparison
+// with NULL is unknown, and so is its negation. Only the rows for which it is true match.
+type fakeTruth int
+
+const (
+	fakeFalse fakeTruth = iota
+	fakeUnknown
+	fakeTrue
+)
+
+func fakeTruthOf(condition bool) fakeTruth {
+	if condition {
+		return fakeTrue
+	}
+	return fakeFalse
+}
+
+// fakeEvaluate returns the value of a predicate returned by fakePredicate for a row, column returning
+// the value of the row's column, nil for NULL. The zero predicate is true.
+func fakeEvaluate(predicate Predicate, column func(dbName string) interface{}) (fakeTruth, error) {
+
+	switch predicate.operator {
+	case "":
+		return fakeTrue, nil
+	case "AND", "OR":
+		// AND is the least of the values, OR the greatest
+		truth := fakeTrue
+		if predicate.operator == "OR" {
+			truth = fakeFalse
+		}
+		for _, combined := range predicate.predicates {
+			combinedTruth, err := fakeEvaluate(combined, column)
+			if err != nil {
+				return fakeFalse, err
+			}
+			if predicate.operator == "AND" && combinedTruth < truth || predicate.operator == "OR" && combinedTruth > truth {
+				truth = combinedTruth
+			}
+		}
+		return truth, nil
+	case "NOT":
+		truth, err := fakeEvaluate(predicate.predicates[0], column)
+		return fakeTrue - truth, err
+	}
+
+	value := column(predicate.column)
+	switch predicate.operator {
+	case "IS NULL":
+		return fakeTruthOf(value == nil), nil
+	case "IS NOT NULL":
+		return fakeTruthOf(value != nil), nil
+	case "IN":
+		truth := fakeFalse
+		for _, param := range predicate.values {
+			switch {
+			case value == nil || param == nil:
+				truth = fakeUnknown
+			case fakeEqual(value, param):
+				return fakeTrue, nil
+			}
+		}
+		return truth, nil
+	}
+
+	for _, param := range predicate.values {
+		if value == nil || param == nil {
+			return fakeUnknown, nil
+		}
+	}
+
+	switch predicate.operator {
+	case "=":
+		return fakeTruthOf(fakeEqual(value, predicate.values[0])), nil
+	case "<>":
+		return fakeTruthOf(!fakeEqual(value, predicate.values[0])), nil
+	case "LIKE", "ILIKE":
+		text := reflect.ValueOf(value)
+		if text.Kind() != reflect.String {
+			return fakeFalse, fmt.Errorf("%s %s, the column being a %T, is %w", predicate.column, predicate.operator, value, ErrFakeNotSupported)
+		}
+		pattern, _ := predicate.values[0].(string)
+		matched, err := fakeLike(text.String(), pattern, predicate.operator == "ILIKE")
+		return fakeTruthOf(matched), err
+	}
+
+	// the orderings, <, <=, > and >=, and BETWEEN
+	orders := make([]int, len(predicate.values))
+	for i, param := range predicate.values {
+		order, ok := fakeCompareValues(value, param)
+		if !ok {
+			return fakeFalse, fmt.Errorf("comparing %s, a %T, with a %T is %w", predicate.column, value, param, ErrFakeNotSupported)
+		}
+		orders[i] = order
+	}
+
+	switch predicate.operator {
+	case "<":
+		return fakeTruthOf(orders[0] < 0), nil
+	case "<=":
+		return fakeTruthOf(orders[0] <= 0), nil
+	case ">":
+		return fakeTruthOf(orders[0] > 0), nil
+	case ">=":
+		return fakeTruthOf(orders[0] >= 0), nil
+	case "BETWEEN":
+		return fakeTruthOf(orders[0] >= 0 && orders[1] <= 0), nil
+	}
+	return fakeFalse, fmt.Errorf("the operator %s is %w", predicate.operator, ErrFakeNotSupported)
+}
+
+// fakeLike tells whether the text matches the LIKE pattern, where % matches any characters, _ any single
+// one and \ escapes the next one, ignoring the case for ILIKE
+func fakeLike(text string, pattern string, insensitive bool) (bool, error) {
+
+	expression := "(?s)^"
+	if insensitive {
+		expression = "(?is)^"
+	}
+
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			expression += regexp.QuoteMeta(string(r))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			expression += ".*"
+		case r == '_':
+			expression += "."
+		default:
+			expression += regexp.QuoteMeta(string(r))
+		}
+	}
+	if escaped {
+		return false, fmt.Errorf("the LIKE pattern %q ends with the escape character", pattern)
+	}
+
+	return regexp.MustCompile(expression + "$").MatchString(text), nil
+}
+
+// fakeOrderedBy sets the orderBy parameter of SelectAllOrderBy and SelectAllPage as orderedBy does, as the
+// terms the in-memory fakes order by: columns followed by ASC or DESC, and NULLS FIRST or NULLS LAST
+func (options *selectOptions) fakeOrderedBy(orderBy string) error {
+
+	if err := options.orderedBy(orderBy); err != nil || orderBy == "" {
+		return err
+	}
+
+	var terms []OrderTerm
+	for _, part := range strings.Split(orderBy, ",") {
+
+		match := fakeOrderByTerm.FindStringSubmatch(part)
+		if match == nil {
+			return fmt.Errorf("ordering by %q is %w", orderBy, ErrFakeNotSupported)
+		}
+
+		term := OrderTerm{column: match[2], descending: strings.EqualFold(match[3], "DESC")}
+		if match[1] == "" {
+			term.column = strings.ToLower(term.column)
+		}
+		if match[4] != "" {
+			term.nulls = "NULLS " + strings.ToUpper(match[4])
+		}
+		terms = append(terms, term)
+	}
+
+	options.orderBy, options.orderByText = terms, ""
+	return nil
+}
+
+// fakeEqual compares a column value with a parameter the way the database would:
+// NULL equals nothing, the times are compared as instants, the numbers by value
+// and the named types with their underlying ones
+func fakeEqual(value interface{}, param interface{}) bool {
+
+	if value == nil || param == nil {
+		return false
+	}
+	if reflect.DeepEqual(value, param) {
+		return true
+	}
+
+	if valueTime, ok := value.(time.Time); ok {
+		paramTime, ok := param.(time.Time)
+		return ok && valueTime.Equal(paramTime)
+	}
+
+	reflectValue, reflectParam := reflect.ValueOf(value), reflect.ValueOf(param)
+	if valueInt, ok := fakeInt(reflectValue); ok {
+		if paramInt, ok := fakeInt(reflectParam); ok {
+			return valueInt == paramInt
+		}
+	}
+	if valueFloat, ok := fakeFloat(reflectValue); ok {
+		if paramFloat, ok := fakeFloat(reflectParam); ok {
+			return valueFloat == paramFloat
+		}
+	}
+
+	if reflectValue.Kind() == reflectParam.Kind() && reflectParam.Type().ConvertibleTo(reflectValue.Type()) {
+		return reflect.DeepEqual(value, reflectParam.Convert(reflectValue.Type()).Interface())
+	}
+
+	return false
+}
+
+func fakeInt(value reflect.Value) (int64, bool) {
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value.Uint() <= 1<<63-1 {
+			return int64(value.Uint()), true
+		}
+	}
+	return 0, false
+}
+
+func fakeFloat(value reflect.Value) (float64, bool) {
+
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	if valueInt, ok := fakeInt(value); ok {
+		return float64(valueInt), true
+	}
+	return 0, false
+}
+
+// fakeCompare orders two column values the way the ORDER BY term does, nil for NULL,
+// and returns false for the values it cannot compare
+func fakeCompare(a interface{}, b interface{}, term OrderTerm) (int, bool) {
+
+	switch {
+	case a == nil && b == nil:
+		return 0, true
+	case a == nil || b == nil:
+		if (a == nil) == term.nullsFirst() {
+			return -1, true
+		}
+		return 1, true
+	}
+
+	order, ok := fakeCompareValues(a, b)
+	if term.descending {
+		order = -order
+	}
+	return order, ok
+}
+
+// fakeCompareValues orders the times, the numbers, the strings and the booleans
+func fakeCompareValues(a interface{}, b interface{}) (int, bool) {
+
+	if aTime, ok := a.(time.Time); ok {
+		bTime, ok := b.(time.Time)
+		return fakeOrder(aTime.Before(bTime), aTime.After(bTime)), ok
+	}
+
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if aInt, ok := fakeInt(aValue); ok {
+		if bInt, ok := fakeInt(bValue); ok {
+			return fakeOrder(aInt < bInt, aInt > bInt), true
+		}
+	}
+	if aFloat, ok := fakeFloat(aValue); ok {
+		if bFloat, ok := fakeFloat(bValue); ok {
+			return fakeOrder(aFloat < bFloat, aFloat > bFloat), true
+		}
+	}
+
+	switch {
+	case aValue.Kind() == reflect.String && bValue.Kind() == reflect.String:
+		return strings.Compare(aValue.String(), bValue.String()), true
+	case aValue.Kind() == reflect.Bool && bValue.Kind() == reflect.Bool:
+		return fakeOrder(!aValue.Bool() && bValue.Bool(), aValue.Bool() && !bValue.Bool()), true
+	}
+
+	return 0, false
+}
+
+func fakeOrder(less bool, greater bool) int {
+
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+/* END Repository fakes */
+{{end}}
+/* BEGIN Error and Logging utility functions */
+
+// NewModelsError wraps an already existing error with a localized prefix.
+{{if .IsSql}}// If the error is a driver error carrying a Postgres error code, that code is
+// automatically transferred to the wrapper error.{{else}}// If the error is of type *pgconn.PgError then its Code field value is 
+// automatically transferred to the wrapper error.{{end}}
+func NewModelsError(errorPrefix string, originalError error) error {
+
+	{{if .IsSql}}var pgErr sqlStateError
+	if errors.As(originalError, &pgErr) {
+		return &pgToGoGenError{
+			Err:           errorPrefix + ": " + originalError.Error(),
+			OriginalError: originalError,
+			Code:          pgErr.SQLState(),
+		}
+	}{{else}}{{if .IsPgx5}}var pgErr *pgconn.PgError
+	if errors.As(originalError, &pgErr){{else}}if pgErr, ok := originalError.(*pgconn.PgError); ok{{end}} {
+		return &pgToGoGenError{
+			Err:           errorPrefix + ": " + originalError.Error(),
+			OriginalError: originalError,
+			Code:          pgErr.Code,
+		}
+	}{{end}}
+	return &pgToGoGenError{
+		Err:           errorPrefix + ": " + originalError.Error(),
+		OriginalError: originalError,
+	}
+}
+
+// NewModelsErrorWithCode wraps an already existing error with a localized prefix.
+// A code can be specified, which could be the code of the original error.
+func NewModelsErrorWithCode(errorPrefix string, originalError error, code string) error {
+	return &pgToGoGenError{
+		Err:           errorPrefix + ": " + originalError.Error(),
+		OriginalError: originalError,
+		Code:          code,
+	}
+}
+
+// NewModelsErrorLocal wraps locally occuring errors in a standardized error format,
+// without the needing of an already existing error.
+func NewModelsErrorLocal(errorPrefix string, localError string) error {
+	return &pgToGoGenError{
+		Err:           errorPrefix + ": " + localError,
+		OriginalError: nil,
+	}
+}
+
+// NewModelsErrorLocalWithCode wraps locally occuring errors in a standardized error format,
+// along with an established code, without the needing of an already existing error.
+func NewModelsErrorLocalWithCode(errorPrefix string, localError string, code string) error {
+	return &pgToGoGenError{
+		Err:           errorPrefix + ": " + localError,
+		OriginalError: nil,
+		Code:          code,
+	}
+}
+
+// GetOriginalError attempts to retrieve the original, embedded error if there is one
+// in the wrapper error. It returns an error or nil if no original error found.
+func GetOriginalError(err error) error {
+	if pgtgErr, ok := err.(*pgToGoGenError); ok {
+		if pgtgErr.OriginalError != nil {
+			return pgtgErr.OriginalError
+		}
+	}
+	return nil
+}
+
+// GetPostgresErrorCode attempts to retrieve the Postgres error code as defined at:
+// https://www.postgresql.org/docs/current/static/errcodes-appendix.html
+// To obtain the code it attempts to detect if the supplied error is either
+// a locally defined *pgToGoGenError or a {{if .IsSql}}driver error with a SQLState method{{else}}pgx-defined *pgconn.PgError{{end}}.
+// The latter has priority.
+// It returns the code or empty string if it cannot find it.
+func GetPostgresErrorCode(err error) string {
+	{{if .IsSql}}// Assume an error wrapper first
+	if pgtgErr, ok := err.(*pgToGoGenError); ok {
+		var pgErr sqlStateError
+		if errors.As(pgtgErr.OriginalError, &pgErr) {
+			return pgErr.SQLState()
+		}
+		return pgtgErr.Code
+	}
+	// Look for a driver error with a SQLSTATE in the chain of errors
+	var pgErr sqlStateError
+	if errors.As(err, &pgErr) {
+		return pgErr.SQLState()
+	}
+	return ""
+	{{else if .IsPgx5}}// Assume an error wrapper first
+	if pgtgErr, ok := err.(*pgToGoGenError); ok {
+		var pgErr *pgconn.PgError
+		if errors.As(pgtgErr.OriginalError, &pgErr) {
+			return pgErr.Code
+		}
+		return pgtgErr.Code
+	}
+	// Look for a *pgconn.PgError in the chain of errors
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+	{{else}}// Assume an error wrapper first
+	if pgtgErr, ok := err.(*pgToGoGenError); ok {
+		if pgtgErr.OriginalError != nil {
+			if pgErr, ok := err.(*pgconn.PgError); ok {
+				return pgErr.Code
+			}
+		}
+		return pgtgErr.Code
+	}
+	// Attempt a type assertion to *pgconn.PgError directly
+	if pgErr, ok := err.(*pgconn.PgError); ok {
+		return pgErr.Code
+	}
+	return ""{{end}}
+}
+
+// Debug logs the info using the runtime log package if debug mode is on.
+func Debug(v ...interface{}) {
+	if isDebugMode {
+		log.Println(v...)
+	}
+}
+
+// SetDebugMode sets the debug mode to true or false.
+func SetDebugMode(debugMode bool) {
+	isDebugMode = debugMode
+}
+
+// IsDebugMode returns true if debug mode is set to on.
+func IsDebugMode() bool {
+	return isDebugMode
+}
+
+{{if .IsSql}}// sqlStateError is the error of a database/sql driver carrying the Postgres error code,
+// e.g. the *pgconn.PgError of pgx or the *pq.Error of lib/pq
+type sqlStateError interface {
+	error
+	SQLState() string
+}
+
+{{end}}type pgToGoGenError struct {
+	Err           string
+	Code          string
+	OriginalError error
+}
+
+func (pErr *pgToGoGenError) Error() string {
+	return pErr.Err
+}
+
+/* END Error and Logging utility functions */
+
+func GetGoTypeForColumn(columnType string) (typeReturn string, goTypeToImport string) {
+
+	typeReturn = ""
+	goTypeToImport = ""
+
+	switch columnType {
+	case "character varying":
+		typeReturn = "string"
+	case "integer", "serial":
+		typeReturn = "int32"
+	case "boolean":
+		typeReturn = "bool"
+	case "uuid":
+		typeReturn = "string"
+	case "bigint":
+		typeReturn = "int64"
+	case "timestamp with time zone":
+		typeReturn = "time.Time"
+		goTypeToImport = "time"
+	}
+
+	return typeReturn, goTypeToImport
+}
+
+// Returns the string composed of the condition parameter and the stringified
+// param variadic list interface{} members
+func GetHashFromConditionAndParams(condition string, params ...interface{}) (string, error) {
+	
+	var errorPrefix = "GetHashFromConditionAndParams() ERROR: "
+	
+	// define the delete query
+	hashBuffer := bytes.Buffer{}
+	_, writeErr := hashBuffer.WriteString(condition)
+	if writeErr != nil {
+		return "", NewModelsError(errorPrefix + "hashBuffer.WriteString error (condition parameter):",writeErr)
+	}
+
+	for _,currentParam := range params {
+		
+		switch currentParam.(type) {
+		case int:
+			_, writeErr = hashBuffer.WriteString(Itoa(currentParam.(int)))
+			if writeErr != nil {
+				return "", NewModelsError(errorPrefix + "queryBuffer.WriteString error:",writeErr)
+			}
+		case float64:
+			_, writeErr = hashBuffer.WriteString(strconv.FormatFloat(currentParam.(float64), 'f', 6, 64))
+			if writeErr != nil {
+				return "", NewModelsError(errorPrefix + "queryBuffer.WriteString error:",writeErr)
+			}
+		case string:
+			_, writeErr = hashBuffer.WriteString(currentParam.(string))
+			if writeErr != nil {
+				return "", NewModelsError(errorPrefix + "queryBuffer.WriteString error:",writeErr)
+			}
+		case time.Time:
+			_, writeErr = hashBuffer.WriteString((currentParam.(time.Time)).Format(time.RFC3339))
+			if writeErr != nil {
+				return "", NewModelsError(errorPrefix + "queryBuffer.WriteString error:",writeErr)
+			}				    
+		default:
+			return "", NewModelsErrorLocal(errorPrefix, "undetermined interface type: " + reflect.TypeOf(currentParam).String())			
+		}						
+	}
+
+	return hashBuffer.String(), nil
+	
+}
+
+// JSONColumn receives a nullable json or jsonb column bound to a Go type, and encodes
+// the Go value back. When scanning, Target must point to a value of the Go type: every
+// Scan points it to a new one. When encoding, Target holds the Go value.
+type JSONColumn struct {
+	Target interface{}
+	Valid  bool
+}
+
+// Scan implements the sql.Scanner interface, unmarshalling the json document into a new Target
+func (j *JSONColumn) Scan(src interface{}) error {
+
+	targetType := reflect.TypeOf(j.Target)
+	if targetType == nil || targetType.Kind() != reflect.Ptr {
+		return fmt.Errorf("JSONColumn.Scan: the target must be a pointer, got %T", j.Target)
+	}
+	j.Target = reflect.New(targetType.Elem()).Interface()
+
+	var document []byte
+	switch src := src.(type) {
+	case nil:
+		j.Valid = false
+		return nil
+	case string:
+		document = []byte(src)
+	case []byte:
+		document = src
+	default:
+		return fmt.Errorf("JSONColumn.Scan: cannot scan a %T", src)
+	}
+
+	j.Valid = true
+	return json.Unmarshal(document, j.Target)
+}
+{{if not (or .IsPgx5 .IsSql)}}
+// Get returns the Go value, or nil for NULL. pgx marshals the value it returns.
+func (j JSONColumn) Get() interface{} {
+	if !j.Valid {
+		return nil
+	}
+	return j.Target
+}
+{{end}}
+// Value implements the driver.Valuer interface, marshalling the Go value
+func (j JSONColumn) Value() (driver.Value, error) {
+
+	if !j.Valid {
+		return nil, nil
+	}
+
+	document, err := json.Marshal(j.Target)
+	if err != nil {
+		return nil, err
+	}
+	return string(document), nil
+}
+{{if .IsSql}}
+// textArray binds a []string as a text[] parameter, which the database/sql drivers do not convert by themselves
+type textArray []string
+
+// Value implements the driver.Valuer interface, writing the array literal, e.g. {"a","b"}
+func (a textArray) Value() (driver.Value, error) {
+
+	if a == nil {
+		return nil, nil
+	}
+
+	var literal strings.Builder
+	literal.WriteString("{")
+	for i, element := range a {
+		if i > 0 {
+			literal.WriteString(",")
+		}
+		literal.WriteString("\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(element) + "\"")
+	}
+	literal.WriteString("}")
+
+	return literal.String(), nil
+}
+{{end}}
+// Now is a wrapper over the time package Now method.
+func Now() time.Time {
+	return time.Now()
+}
+
+// utcTime converts the provided time.Time to an UTC location.
+// Necessary for pgtype.Timestamp (without timezone)
+func utcTime(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), value.Hour(), value.Minute(), value.Second(), value.Nanosecond(), time.UTC)
+}
+
+// NewGuid returns a new Guid
+func NewGuid() string {
+	return uuid.NewV4().String()
+}
+
+// Itoa is a wrapper over strconv package Itoa method.
+func Itoa(intValue int) string {
+	return strconv.Itoa(intValue)
+}
+
+// Contains is a wrapper over the strings package Contains method.
+func Contains(source string, subStr string) bool {
+	return strings.Contains(source, subStr)
+}
+
+// JoinStringParts is a wrapper over strings.Join
+func JoinStringParts(sourceSlice []string, separator string) string {
+	return strings.Join(sourceSlice, separator)
+}
+
+// Sort comparator for string type
+func LessComparatorFor_string(first, second string) bool { return first < second }
+
+// Sort comparator for int type
+func LessComparatorFor_int(first, second int) bool { return first < second }
+
+// Sort comparator for int32 type
+func LessComparatorFor_int32(first, second int32) bool { return first < second }
+
+// Sort comparator for int64 type
+func LessComparatorFor_int64(first, second int64) bool { return first < second }
+
+// Sort comparator for float64 type
+func LessComparatorFor_float64(first, second float64) bool { return first < second }
+
+// Sort comparator for bool type
+func LessComparatorFor_bool(first, second bool) bool { return first == false }
+
+// Because LessComparatorFor_time.Time would break the compiler if a function would be
+// defined as such (due to the dot) we need to create a fake struct
+type tLessComparatorFor_time struct {}
+var LessComparatorFor_time *tLessComparatorFor_time
+func (t *tLessComparatorFor_time) Time(first, second time.Time) bool {  return first.Before(second) }
+
+/* BEGIN conversion methods */
+
+func BoolToNilInterface(boolVal bool) interface{} {
+	return nil
+}
+
+const (
+	// See http://golang.org/pkg/time/#Parse
+	comparisonTimeFormat = "2006-01-02 15:04:05 MST"
+)
+
+// To be able to be properly parsed, the string must be in the following format
+// "YYYY-MM-DD HH:MM:SS" (e.g. 2014-12-22 18:24:43)
+func To_Time_FromString(timeDateStr string) (time.Time, error) {
+	
+	var errorPrefix = "To_Time_FromString() ERROR: "
+	
+	if timeDateStr == "" {
+		return time.Now(), NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}		
+	
+	return time.Parse(comparisonTimeFormat, timeDateStr)
+}
+
+func To_bool_FromString(boolStr string) (bool, error) {
+
+	var errorPrefix = "To_bool_FromString() ERROR: "
+	
+	if boolStr == "" {
+		return false, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}	
+	
+	if boolStr == "0" || boolStr == "n" || boolStr == "N" || boolStr == "No" || boolStr == "no" || boolStr == "NO" || boolStr == "false" || boolStr == "FALSE" || boolStr == "False" || boolStr == "f" || boolStr == "F" { return false, nil }
+	if boolStr == "1" || boolStr == "y" || boolStr == "Y" || boolStr == "Yes" || boolStr == "yes" || boolStr == "YES" || boolStr == "true" || boolStr == "TRUE" || boolStr == "True" || boolStr == "t" || boolStr == "T" { return true, nil }
+	
+	return false, NewModelsErrorLocal(errorPrefix, "The input string parameter cannot be converted to bool type.")
+}
+
+func To_int32_FromString(int32Str string) (int32, error) {
+	
+	var errorPrefix = "To_int32_FromString() ERROR: "
+	
+	if int32Str == "" {
+		return -1, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}	
+	
+	i, err := strconv.ParseInt(int32Str, 10, 32)
+	if err != nil {return -1, err }
+	
+	return int32(i), nil
+}
+
+
+func To_int64_FromString(int64Str string) (int64, error) {
+	
+	var errorPrefix = "To_int64_FromString() ERROR: "
+	
+	if int64Str == "" {
+		return -1, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}		
+		
+	return strconv.ParseInt(int64Str, 10, 64)
+
+}
+
+func To_float64_FromString(float64Str string) (float64, error) {
+	
+	var errorPrefix = "To_float64_FromString() ERROR: "
+	
+	if float64Str == "" {
+		return -1, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}		
+		
+	return strconv.ParseFloat(float64Str, 64)
+
+}
+`
+
+const BASE_TEMPLATE_SETTINGS = `package {{.PackageName}}
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+// Database settings variables, with initial, dummy values
+
+var DB_HOST string = "localhost"
+var DB_PORT uint16 = 5432
+var DB_USER string = "testuser"
+var DB_PASS string = "testuser"
+var DB_NAME string = "testdb"
+var DB_POOL_MAX_CONNECTIONS int = 100
+var DB_SSL string = ""{{if .IsSql}}
+
+// the name of the database/sql driver, registered by an import such as
+// _ "github.com/jackc/pgx/v5/stdlib" ("pgx") or _ "github.com/lib/pq" ("postgres")
+var DB_DRIVER string = "pgx"{{end}}
+`
+
+const BASE_TEMPLATE_COLLECTIONS = `package {{.PackageName}}
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+{{if and .CtxOnly .Tables (lt 0 (len .Tables))}}import "context"
+{{end}}
+{{if and .Tables (lt 0 (len .Tables))}}
+// Container struct for table collections
+type stTables struct {
+	{{range .Tables}}{{.GoFriendlyName}} t{{.GoFriendlyName}}Utils
+	{{end}}
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool // set this to true if you want Inserts to ignore the PK fields
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool 
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+}
+
+var Tables stTables
+
+// CacheLookupTables iterates through all tables prefixed with "lookup" 
+// (case-insensitive) and enables caching on them, then loads all rows inside 
+// each respective cache.
+func (t *stTables) CacheLookupTables({{if .CtxOnly}}ctx context.Context{{end}}) {
+	
+	{{range .Tables}}{{if startsWith .GoFriendlyName "lookup"}} t.{{.GoFriendlyName}}.Cache.EnableAndLoadAllRows({{if $.CtxOnly}}ctx{{end}})
+	{{end}}{{end}}	
+}
+{{end}}
+
+{{if and .Views (lt 0 (len .Views))}}
+// Container struct for view collections
+type stViews struct {
+	{{range .Views}}{{.GoFriendlyName}} t{{.GoFriendlyName}}Utils
+	{{end}}	
+}
+
+// Views is a singleton utility container for view operations.
+var Views stViews
+{{end}}
+
+// PrepareDbCollections gets called in case of a successful InitDatabase() call.
+// Customize what happens inside as necessary.
+func PrepareDbCollections() {
+
+	{{if and .Tables (lt 0 (len .Tables))}}
+	// Tables-specific default settings
+	
+	// by setting this to true, the inserts will assume PKs are inserted by the database
+	// so whatever PK id is set in the structure will be ignored for insert operations
+	Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = true
+
+	// by setting this to true, whenever a New() or CreateFrom...() method is called
+	// to generate a new table instance struct, the time.Time fields will be automatically
+	// populate to time.Now()
+	Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords = true
+
+	// by setting this to true, whenever a New() or CreateFrom...() method is called
+	// to generate a new table instance struct, the Guid fields will be automatically
+	// populated with a newly generated Guid
+	Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords = true
+		
+	{{end}}
+}
+
+`
+
+const BASE_TEMPLATE_FORMS = `package {{.PackageName}}
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+// Validator enables structs that implement it to return the validation 
+// state for that particular instance.
+type Validator interface {
+	Validate() (bool, []error)
+}
+
+`
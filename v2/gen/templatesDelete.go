@@ -0,0 +1,163 @@
+package gen
+
+/* Delete Functions Templates */
+
+const TABLE_STATIC_DELETE_TEMPLATE = `{{$colCount := len .Columns}}{{$pkColCount := len .PKColumns}}
+{{$functionName := "Delete"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where Predicate) (int64,  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where)
+}
+
+{{end}}// Deletes the rows from the {{.DbName}} table matching the predicate{{if .Columns}}, e.g.
+// {{.GoFriendlyName}}Cols.{{(index .Columns 0).GoName}}.Eq(value), or Raw(condition, params...){{end}}.
+// Returns the number of deleted rows (zero if no rows found for that condition), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.{{if .SoftDeleteColumn}}
+// The rows are soft-deleted: the {{.SoftDeleteColumn.DbName}} column flags them, and the select methods leave them out.{{end}}
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate) (int64,  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from {{.DbName}}")
+	}
+	condition, params := where.Condition(1)
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the delete query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("{{.DeleteQueryPrefix}}")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix + "queryBuffer.WriteString error:",writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString(condition{{if .SoftDeleteColumn}} + ")"{{end}})
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix + "queryBuffer.WriteString (condition param) error:",writeErr)
+	}	
+	
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), params...)
+	if err != nil {
+		return 0, NewModelsError(errorPrefix + "db.Exec error:",err)
+	}
+	
+	n := r.RowsAffected()
+	return n, nil	
+	
+}
+`
+
+const TABLE_STATIC_DELETE_TEMPLATE_TX = `
+{{$functionName := print "Delete" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(where Predicate) (int64,  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), where)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs Delete{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.Delete{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate) (int64,  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.Delete{{.Options.CtxSuffix}}(ctx, where)
+}
+`
+
+const TABLE_STATIC_DELETE_ALL_TEMPLATE = `{{$colCount := len .Columns}}{{$pkColCount := len .PKColumns}}
+{{$functionName := "DeleteAll"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}() (int64,  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background())
+}
+
+{{end}}// Deletes all existing rows from the {{.DbName}} table.
+// Returns the number of deleted rows (zero if no rows found), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.{{if .SoftDeleteColumn}}
+// The rows are soft-deleted: the {{.SoftDeleteColumn.DbName}} column flags them, and the select methods leave them out.{{end}}
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context) (int64,  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+	
+	r, err := currentDbHandle.Exec(ctx, "{{.DeleteAllQuery}}")
+	if err != nil {
+		return 0, NewModelsError(errorPrefix + "currentDbHandle.Exec error:",err)
+	}
+	
+	n := r.RowsAffected()
+	return n, nil	
+	
+}
+`
+
+const TABLE_STATIC_DELETE_ALL_TEMPLATE_TX = `
+{{$functionName := print "DeleteAll" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}() (int64,  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background())
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs DeleteAll{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.DeleteAll{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context) (int64,  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.DeleteAll{{.Options.CtxSuffix}}(ctx)
+}
+`
+
+const TABLE_STATIC_DELETE_INSTANCE_TEMPLATE = `{{$colCount := len .Columns}}{{$pkColCount := len .PKColumns}}
+{{$functionName := "DeleteInstance"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}({{$sourceStructName}} *{{.GoFriendlyName}}) (bool,  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), {{$sourceStructName}})
+}
+
+{{end}}// Deletes the row from the {{.DbName}} table, corresponding to the primary key fields
+// inside the {{$sourceStructName}} parameter.
+// Returns true if the row was deleted, or false and nil error if no such PK value was found in the database.
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, {{$sourceStructName}} *{{.GoFriendlyName}}) (bool,  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	if {{$sourceStructName}} == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the {{$sourceStructName}} pointer is nil")
+	}	
+
+	// define the condition based on the PK columns
+	deleteInstanceCondition := And({{range .PKColumns}}
+		{{$.GoFriendlyName}}Cols.{{.GoName}}.Eq({{$sourceStructName}}.{{.GoName}}),{{end}}
+	)
+
+	rowCount, err := utilRef.Delete{{.Options.CtxSuffix}}(ctx, deleteInstanceCondition)
+	if err != nil {
+		return false, NewModelsError(errorPrefix,err)
+	}
+	
+	if rowCount > 1 {
+		return false, NewModelsError(errorPrefix + ": FATAL ERROR: Too many rows deleted !! : ",err)
+	}	
+	
+	return rowCount == 1, nil
+	
+}
+`
+
+const TABLE_STATIC_DELETE_INSTANCE_TEMPLATE_TX = `
+{{$functionName := print "DeleteInstance" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}({{$sourceStructName}} *{{.GoFriendlyName}}) (bool,  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), {{$sourceStructName}})
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs DeleteInstance{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.DeleteInstance{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, {{$sourceStructName}} *{{.GoFriendlyName}}) (bool,  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.DeleteInstance{{.Options.CtxSuffix}}(ctx, {{$sourceStructName}})
+}
+`
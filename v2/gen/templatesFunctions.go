@@ -1,4 +1,4 @@
-package main
+package gen
 
 const FUNCTION_TEMPLATE_PREFIX = `package {{.Options.PackageName}}
 
@@ -9,24 +9,26 @@ const FUNCTION_TEMPLATE_PREFIX = `package {{.Options.PackageName}}
 
 import (
 	"context"
-	pgx "{{.Options.PgxImport}}"
+	{{if .Options.IsSql}}"database/sql"
+	{{else}}pgx "{{.Options.PgxImport}}"
 	pgtype "{{.Options.PgTypeImport}}"
-	{{range $key, $value := .GoTypesToImport}}"{{$value}}"
+	{{end}}	{{range $key, $value := .GoTypesToImport}}"{{$value}}"
 	{{end}}	
 )
 
-// this is a dummy variable, just to use the pgtypes package
-const pgtypesDummyFnPlaceholder = pgtype.Present
-
 // Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
 // and global, single-instance settings
-type tFunctionUtils struct {}
+type tFunctionUtils struct {
+
+	// the querier the functions run on, GetDb() when nil, see NewDB
+	querier Querier
+}
 
 var Functions tFunctionUtils
 
 `
 
-const COMMON_CODE_FUNCTION_QUERY = `rows, err := currentDbHandle.Query(context.Background(), JoinStringParts(queryParts,""), {{range $i, $e := .Parameters}}param{{.GoFriendlyName}}{{if ne (plus1 $i) $paramCount}},{{end}} {{end}})	
+const COMMON_CODE_FUNCTION_QUERY = `rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts,""), {{range $i, $e := .Parameters}}param{{.GoFriendlyName}}{{if ne (plus1 $i) $paramCount}},{{end}} {{end}})	
 	
 	if err != nil {
 		return {{if not .IsReturnVoid}}returnVal,{{end}} NewModelsError(errorPrefix + " fatal error running the function statement:", err)
@@ -37,9 +39,35 @@ const COMMON_CODE_FUNCTION_QUERY = `rows, err := currentDbHandle.Query(context.B
 	// this function returns void, do not attempt doing anything else
 	return nil
 	{{else}}//BEGIN: non void operations
+	{{if .Options.IsPgx5}}
+	// CollectRows closes the rows once done
+	returnVal, err = pgx.CollectRows(rows, {{if .IsReturnUserDefined}}func(row pgx.CollectableRow) ({{.ReturnGoType}}, error) {
+		{{$colCount := len .Columns}}{{$instanceVarName := print "current" .ReturnGoType}}
+		var {{$instanceVarName}} {{.ReturnGoType}}
+
+		// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+		{{range $i, $e := .Columns}}{{if .Nullable}}var nullable{{$e.GoName}} {{$e.GoNullableType}}{{$e.NullableInitExpr}} 
+		{{end}}{{end}}
+		// END: if any nullable fields, create temporary nullable variables to receive null values
+
+		err := row.Scan({{range $i, $e := .Columns}}{{if .Nullable}}&nullable{{$e.GoName}}{{else}}&{{$instanceVarName}}.{{$e.GoName}}{{end}}{{if ne (plus1 $i) $colCount}},{{end}}{{end}})
+		if err != nil {
+			return {{$instanceVarName}}, err
+		}
+
+		// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
+		{{range $i, $e := .Columns}}{{if .Nullable}} {{$instanceVarName}}.Set{{.GoName}}({{$e.ScanValueExpr (print "nullable" $e.GoName)}}, {{$e.ScanNotNullExpr (print "nullable" $e.GoName)}})
+		{{end}}{{end}}
+		// END: assign any nullable values to the nullable fields inside the struct appropriately
 
+		return {{$instanceVarName}}, nil
+	}{{else}}pgx.RowTo[{{.ReturnGoType}}]{{end}})
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix + " error collecting the rows:", err)
+	}
+	{{else}}
 	{{if .IsReturnUserDefined}}// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
-	{{range $i, $e := .Columns}}{{if .Nullable}}var nullable{{$e.GoName}} {{$e.GoNullableType}} 
+	{{range $i, $e := .Columns}}{{if .Nullable}}var nullable{{$e.GoName}} {{$e.GoNullableType}}{{$e.NullableInitExpr}} 
 	{{end}}{{end}}
 	// END: if any nullable fields, create temporary nullable variables to receive null values{{end}}
 
@@ -57,7 +85,7 @@ const COMMON_CODE_FUNCTION_QUERY = `rows, err := currentDbHandle.Query(context.B
 		}
 		
 		// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
-		{{range $i, $e := .Columns}}{{if .Nullable}} {{$instanceVarName}}.Set{{.GoName}}(nullable{{$e.GoName}}.GetValue(), nullable{{$e.GoName}}.Valid)
+		{{range $i, $e := .Columns}}{{if .Nullable}} {{$instanceVarName}}.Set{{.GoName}}({{$e.ScanValueExpr (print "nullable" $e.GoName)}}, {{$e.ScanNotNullExpr (print "nullable" $e.GoName)}})
 		{{end}}{{end}}
 		// END: assign any nullable values to the nullable fields inside the struct appropriately	
 		
@@ -79,19 +107,19 @@ const COMMON_CODE_FUNCTION_QUERY = `rows, err := currentDbHandle.Query(context.B
 	if err != nil {
 		return returnVal, NewModelsError(errorPrefix + " error during rows.Next() iterations:", err)
 	}
-	
+	{{end}}
 	{{end}} //END: non void operations
 	
 	return {{if not .IsReturnVoid}}returnVal,{{end}} nil
 `
 
 const COMMON_CODE_FUNCTION_QUERYROW = `	// we are aiming for a single row so we will use Query Row	
-	{{range $i, $e := .Columns}}var nullable{{$e.GoName}} {{getNullableType $e.GoType}} 
+	{{range $i, $e := .Columns}}var nullable{{$e.GoName}} {{$e.RowHolderType}}{{if $e.GoNullableType}}{{$e.NullableInitExpr}}{{end}} 
 	{{end -}}
 	
 	{{- if not .IsReturnVoid}}{{if .IsReturnUserDefined}}{{$pointerSymbol := ""}}returnVal = new({{.ReturnGoType}}){{else}}{{$pointerSymbol := "&"}}{{end}}{{end}}
 	
-	err = currentDbHandle.QueryRow(JoinStringParts(queryParts,""), {{range $i, $e := .Parameters}}param{{.GoFriendlyName}}{{if ne (plus1 $i) $paramCount}},{{end}} {{end}})` +
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts,""), {{range $i, $e := .Parameters}}param{{.GoFriendlyName}}{{if ne (plus1 $i) $paramCount}},{{end}} {{end}})` +
 	`{{if not .IsReturnVoid}}` +
 	`.Scan({{if .IsReturnUserDefined}}{{$colCount := len .Columns}}` +
 	`{{range $i, $e := .Columns}}&nullable{{$e.GoName}}{{if ne (plus1 $i) $colCount}},{{end}}{{end}}` +
@@ -118,10 +146,9 @@ const COMMON_CODE_FUNCTION_QUERYROW = `	// we are aiming for a single row so we
 			// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
 			var isNullRecord bool = true
 			{{range $i, $e := .Columns}}
-				{{if .Nullable}}returnVal.Set{{.GoName}}(nullable{{$e.GoName}}.GetValue(), nullable{{$e.GoName}}.Valid)
-				if nullable{{$e.GoName}}.Valid { isNullRecord = false }
-				{{else}}returnVal.Set{{.GoName}}(nullable{{$e.GoName}}.GetValue())
-				if nullable{{$e.GoName}}.Valid { isNullRecord = false }{{end}}
+				{{if .Nullable}}returnVal.Set{{.GoName}}({{$e.RowValueExpr (print "nullable" $e.GoName)}}, {{$e.RowNotNullExpr (print "nullable" $e.GoName)}})
+				{{else}}returnVal.Set{{.GoName}}({{$e.RowValueExpr (print "nullable" $e.GoName)}}){{end}}
+				{{with $e.RowNotNullExpr (print "nullable" $e.GoName)}}if {{.}} { isNullRecord = false }{{end}}
 			{{end}}
 			
 			if isNullRecord == true {
@@ -138,18 +165,32 @@ const COMMON_CODE_FUNCTION_QUERYROW = `	// we are aiming for a single row so we
     }	
 `
 
+// FUNCTION_PARAMS are the parameters of a function wrapper, after the context
+const FUNCTION_PARAMS = `{{range $i, $e := .Parameters}}, param{{.GoFriendlyName}} {{.GoType}}{{end}}`
+
+// FUNCTION_ARGS passes the parameters of a function wrapper on, after the context
+const FUNCTION_ARGS = `{{range $i, $e := .Parameters}}, param{{.GoFriendlyName}}{{end}}`
+
+// FUNCTION_RESULTS are the named results of a function wrapper
+const FUNCTION_RESULTS = `{{if not .IsReturnVoid}}returnVal {{if .IsReturnASet}}[]{{else}}{{if .IsReturnUserDefined}}*{{end}}{{end}}{{.ReturnGoType}},{{end}} err error{{if not .IsReturnVoid}}{{if not .IsReturnASet}}{{if not .IsReturnUserDefined}}, isDbNull bool{{end}}{{end}}{{end}}`
+
 const FUNCTION_TEMPLATE = `{{$paramCount := len .Parameters}}
 {{$functionName := .GoFriendlyName}}
-// Wrapper over the function named {{.DbName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *tFunctionUtils) {{$functionName}}({{range $i, $e := .Parameters}}{{if $i}}, {{end}}param{{.GoFriendlyName}} {{.GoType}}{{end}}) (` + FUNCTION_RESULTS + `) {
+	return utilRef.{{$functionName}}Ctx(context.Background()` + FUNCTION_ARGS + `)
+}
+
+{{end}}// Wrapper over the function named {{.DbName}}{{if ne .DbComments ""}}
+/* Database comments: {{.DbComments}} */{{end}}
 {{if not .IsReturnASet}}{{if not .IsReturnUserDefined}}// For pure Go return types, a true isDbNull return parameter indicates that 
 // the actual value returned from the database was nil, not the default value of the Go type{{end}}{{end}}
-func (utilRef *tFunctionUtils) {{$functionName}}(` +
-	`{{range $i, $e := .Parameters}}param{{.GoFriendlyName}} {{.GoType}}{{if ne (plus1 $i) $paramCount}},{{end}} {{end}})` +
-	` ({{if not .IsReturnVoid}}returnVal {{if .IsReturnASet}}[]{{else}}{{if .IsReturnUserDefined}}*{{end}}{{end}}{{.ReturnGoType}},{{end}} err error{{if not .IsReturnVoid}}{{if not .IsReturnASet}}{{if not .IsReturnUserDefined}}, isDbNull bool{{end}}{{end}}{{end}}) {
+func (utilRef *tFunctionUtils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context` + FUNCTION_PARAMS + `)` +
+	` (` + FUNCTION_RESULTS + `) {
 						
 	var errorPrefix = "tFunctionUtils.{{$functionName}}() ERROR: "
 	
-	currentDbHandle := GetDb()
+	currentDbHandle := dbQuerier(utilRef.querier)
 	if currentDbHandle == nil {
 		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
 		return
@@ -0,0 +1,167 @@
+package gen
+
+/* Select Single Rows by Columns */
+
+/* The getter names are made of the key columns, and the wrappers without a context repeat them */
+
+// GETTER_PK_NAME is the primary key part of a getter name, e.g. AccountId or UserIdAndRoleId
+const GETTER_PK_NAME = `{{if gt $pkColCount 1}}` +
+	`{{range $i, $e := .ParentTable.PKColumns}}{{$e.GoName}}{{if ne (plus1 $i) $pkColCount}}And{{end}}{{end}}` +
+	`{{else}}{{range $i, $e := .ParentTable.PKColumns}}{{$e.GoName}}{{end}}` +
+	`{{end}}`
+
+// GETTER_PK_PARAMS are the parameters of a primary key getter
+const GETTER_PK_PARAMS = `{{range $i, $e := .ParentTable.PKColumns}}input{{$e.GoName}} {{$e.GoType}} {{if ne (plus1 $i) $pkColCount}},{{end}}{{end}}`
+
+// GETTER_PK_ARGS passes the parameters of a primary key getter on
+const GETTER_PK_ARGS = `{{range $i, $e := .ParentTable.PKColumns}}input{{$e.GoName}}{{if ne (plus1 $i) $pkColCount}},{{end}}{{end}}`
+
+// GETTER_UQ_NAME is the unique constraint part of a getter name
+const GETTER_UQ_NAME = `{{if gt $uqColCount 1}}` +
+	`{{range $i, $e := .Columns}}{{$e.GoName}}{{if ne (plus1 $i) $uqColCount}}And{{end}}{{end}}` +
+	`{{else}}{{range $i, $e := .Columns}}{{$e.GoName}}{{end}}` +
+	`{{end}}`
+
+// GETTER_UQ_PARAMS are the parameters of a unique constraint getter
+const GETTER_UQ_PARAMS = `{{range $i, $e := .Columns}}input{{$e.GoName}} {{$e.GoType}} {{if ne (plus1 $i) $uqColCount}},{{end}}{{end}}`
+
+// GETTER_UQ_ARGS passes the parameters of a unique constraint getter on
+const GETTER_UQ_ARGS = `{{range $i, $e := .Columns}}input{{$e.GoName}}{{if ne (plus1 $i) $uqColCount}},{{end}}{{end}}`
+
+/* BEGIN: Primary Key Getter Templates */
+
+const PK_GETTER_TEMPLATE_ATOMIC = `{{$colCount := len .ParentTable.Columns}}{{$pkColCount := len .ParentTable.PKColumns}}{{$functionName := "GetBy"}}
+{{if not .ParentTable.Options.CtxOnly}}// {{$functionName}}` + GETTER_PK_NAME + ` is {{$functionName}}` + GETTER_PK_NAME + `Ctx with the background context
+func (utilRef *t{{.ParentTable.GoFriendlyName}}Utils) {{$functionName}}` + GETTER_PK_NAME + `(` + GETTER_PK_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return utilRef.{{$functionName}}` + GETTER_PK_NAME + `Ctx(context.Background(), ` + GETTER_PK_ARGS + `)
+}
+
+{{end}}// Queries the database for a single row based on the specified single or multi-column primary key.
+// Returns a pointer to a {{.ParentTable.GoFriendlyName}} structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *t{{.ParentTable.GoFriendlyName}}Utils) {{$functionName}}` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx context.Context, ` + GETTER_PK_PARAMS + `)` +
+	` (returnStruct *{{.ParentTable.GoFriendlyName}}, err error) {
+	
+	returnStruct = nil
+	err = nil
+	
+	var errorPrefix = "{{.ParentTable.GoFriendlyName}}GetBy{{.GoName}}() ERROR: "
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	{{range $e := .ParentTable.Columns}}{{if .Nullable}}var param{{.GoName}} {{$e.GoNullableType}}{{$e.NullableInitExpr}}
+	{{else}}var param{{.GoName}} {{.GoType}}
+	{{end}}{{end}}
+
+	// define the select query
+	var query = "{{.ParentTable.GenericSelectQuery}} WHERE {{range $i, $e := .ParentTable.PKColumns}}{{.DbName}} = ${{print (plus1 $i)}}{{if ne (plus1 $i) $pkColCount}} AND {{end}}{{end}}";
+
+	// we are aiming for a single row so we will use Query Row	
+	err = currentDbHandle.QueryRow(ctx, query, ` +
+	GETTER_PK_ARGS +
+	`).Scan({{range $i, $e := .ParentTable.Columns}}&param{{$e.GoName}}{{if ne (plus1 $i) $colCount}},{{end}}{{end}})
+    switch {
+    case err == ErrNoRows:
+            // no such row found, return nil and nil
+			return nil, nil
+    case err != nil:
+            return nil, NewModelsError(errorPrefix + "fatal error running the query:", err)
+    default:
+           	// create the return structure as a pointer of the type
+			returnStruct = &{{.ParentTable.GoFriendlyName}}{
+				{{range .ParentTable.Columns}}{{if not .Nullable}}{{.GoName}}: param{{.GoName}},
+				{{end}}{{end}}
+			}
+			{{range $e := .ParentTable.Columns}}{{if $e.Nullable}}returnStruct.Set{{.GoName}}({{$e.ScanValueExpr (print "param" $e.GoName)}}, {{$e.ScanNotNullExpr (print "param" $e.GoName)}})
+			{{end}}{{end}}			
+			// return the structure
+			return returnStruct, nil
+    }			
+}
+`
+
+const PK_GETTER_TEMPLATE_TX = `{{$pkColCount := len .ParentTable.PKColumns}}{{$functionName := print "Get" .ParentTable.GoFriendlyName "By"}}
+{{if not .ParentTable.Options.CtxOnly}}// {{$functionName}}` + GETTER_PK_NAME + ` is {{$functionName}}` + GETTER_PK_NAME + `Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}` + GETTER_PK_NAME + `(` + GETTER_PK_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return txWrapper.{{$functionName}}` + GETTER_PK_NAME + `Ctx(context.Background(), ` + GETTER_PK_ARGS + `)
+}
+
+{{end}}// {{$functionName}}` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}} runs GetBy` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}} of {{.ParentTable.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.ParentTable.GoFriendlyName}}.GetBy` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx context.Context, ` + GETTER_PK_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return NewDB(txWrapper).{{.ParentTable.GoFriendlyName}}.GetBy` + GETTER_PK_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx, ` + GETTER_PK_ARGS + `)
+}
+`
+
+/* BEGIN: Unique Constraints Getter Templates */
+
+const UQ_GETTER_TEMPLATE_ATOMIC = `{{$colCount := len .ParentTable.Columns}}{{$uqColCount := len .Columns}}{{$functionName := "GetByUnique"}}
+{{if not .ParentTable.Options.CtxOnly}}// {{$functionName}}` + GETTER_UQ_NAME + ` is {{$functionName}}` + GETTER_UQ_NAME + `Ctx with the background context
+func (utilRef *t{{.ParentTable.GoFriendlyName}}Utils) {{$functionName}}` + GETTER_UQ_NAME + `(` + GETTER_UQ_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return utilRef.{{$functionName}}` + GETTER_UQ_NAME + `Ctx(context.Background(), ` + GETTER_UQ_ARGS + `)
+}
+
+{{end}}// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a {{.ParentTable.GoFriendlyName}} structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *t{{.ParentTable.GoFriendlyName}}Utils) {{$functionName}}` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx context.Context, ` + GETTER_UQ_PARAMS + `)` +
+	` (returnStruct *{{.ParentTable.GoFriendlyName}}, err error) {
+	
+	returnStruct = nil
+	err = nil
+	
+	var errorPrefix = "{{.ParentTable.GoFriendlyName}}GetByUnique ERROR: "
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	{{range $e := .ParentTable.Columns}}{{if .Nullable}}var param{{.GoName}} {{$e.GoNullableType}}{{$e.NullableInitExpr}}
+	{{else}}var param{{.GoName}} {{.GoType}}
+	{{end}}{{end}}
+
+	// define the select query
+	var query = "{{.ParentTable.GenericSelectQuery}} WHERE {{range $i, $e := .Columns}}{{.DbName}} = ${{print (plus1 $i)}}{{if ne (plus1 $i) $uqColCount}} AND {{end}}{{end}}";
+
+	// we are aiming for a single row so we will use Query Row	
+	err = currentDbHandle.QueryRow(ctx, query, ` +
+	GETTER_UQ_ARGS +
+	`).Scan({{range $i, $e := .ParentTable.Columns}}&param{{$e.GoName}}{{if ne (plus1 $i) $colCount}},{{end}}{{end}})
+    switch {
+    case err == ErrNoRows:
+            // no such row found, return nil and nil
+			return nil, nil
+    case err != nil:
+            return nil, NewModelsError(errorPrefix + "fatal error running the query:", err)
+    default:
+           	// create the return structure as a pointer of the type
+			returnStruct = &{{.ParentTable.GoFriendlyName}}{
+				{{range .ParentTable.Columns}}{{if not .Nullable}}{{.GoName}}: param{{.GoName}},
+				{{end}}{{end}}
+			}
+			{{range $e := .ParentTable.Columns}}{{if $e.Nullable}}returnStruct.Set{{.GoName}}({{$e.ScanValueExpr (print "param" $e.GoName)}}, {{$e.ScanNotNullExpr (print "param" $e.GoName)}})
+			{{end}}{{end}}			
+			// return the structure
+			return returnStruct, nil
+    }			
+}
+`
+
+const UQ_GETTER_TEMPLATE_TX = `{{$uqColCount := len .Columns}}{{$functionName := print "Get" .ParentTable.GoFriendlyName "ByUnique"}}
+{{if not .ParentTable.Options.CtxOnly}}// {{$functionName}}` + GETTER_UQ_NAME + ` is {{$functionName}}` + GETTER_UQ_NAME + `Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}` + GETTER_UQ_NAME + `(` + GETTER_UQ_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return txWrapper.{{$functionName}}` + GETTER_UQ_NAME + `Ctx(context.Background(), ` + GETTER_UQ_ARGS + `)
+}
+
+{{end}}// {{$functionName}}` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}} runs GetByUnique` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}} of {{.ParentTable.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.ParentTable.GoFriendlyName}}.GetByUnique` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx context.Context, ` + GETTER_UQ_PARAMS + `) (*{{.ParentTable.GoFriendlyName}}, error) {
+	return NewDB(txWrapper).{{.ParentTable.GoFriendlyName}}.GetByUnique` + GETTER_UQ_NAME + `{{.ParentTable.Options.CtxSuffix}}(ctx, ` + GETTER_UQ_ARGS + `)
+}
+`
@@ -0,0 +1,80 @@
+package gen
+
+/* Keyset pagination: the SelectAfter methods, paging by the unique keys */
+
+const SELECT_TEMPLATE_AFTER = `{{$name := .GoFriendlyName}}
+// keyset{{$name}} holds the unique keys of {{.DbName}}, and the columns its SelectAfter pages can be ordered by
+var keyset{{$name}} = keyset{
+	keys: [][]string{ {{range .KeysetKeys}}
+		{ {{range $i, $e := .}}{{if $i}}, {{end}}"{{$e.DbName}}"{{end}} },{{end}}
+	},
+	columns: []string{ {{range $i, $e := .KeysetColumns}}{{if $i}}, {{end}}"{{$e.DbName}}"{{end}} },
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *t{{$name}}Utils) keysetValue(row *{{$name}}, dbName string) interface{} {
+
+	switch dbName { {{range .KeysetColumns}}
+	case "{{.DbName}}":
+		return row.{{.GoName}}{{end}}
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *t{{$name}}Utils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName { {{range .KeysetColumns}}
+	case "{{.DbName}}":
+		var param {{.GoType}}
+		err := json.Unmarshal(value, &param)
+		return param, err{{end}}
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+{{$functionName := "SelectAfter"}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{$name}}Utils) {{$functionName}}(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]{{$name}}, string, error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where, cursor, pageSize, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the page of at most pageSize rows from {{.DbName}} matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of {{.DbName}}
+// ({{range $i, $e := .KeysetKeys}}{{if $i}}; {{end}}{{range $j, $c := $e}}{{if $j}}, {{end}}{{$c.DbName}}{{end}}{{end}}) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *t{{$name}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]{{$name}}, string, error) {
+
+	var errorPrefix = "{{$name}}Utils.{{$functionName}}() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keyset{{$name}}.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.Select{{.Options.CtxSuffix}}(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keyset{{$name}}.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+`
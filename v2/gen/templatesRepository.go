@@ -0,0 +1,844 @@
+package gen
+
+/* Repositories */
+
+const REPOSITORY_TEMPLATE = `
+// {{.GoFriendlyName}}Repository holds the methods of {{if .IsTable}}Tables{{else}}Views{{end}}.{{.GoFriendlyName}}, so that the code using them
+// can depend on the interface, and be unit tested with a {{.GoFriendlyName}}RepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type {{.GoFriendlyName}}Repository interface {
+	{{range .RepositoryMethods}}{{.Name}}({{.Params}}) {{.Results}}
+	{{end}}
+}
+
+var _ {{.GoFriendlyName}}Repository = (*t{{.GoFriendlyName}}Utils)(nil)
+
+// {{.GoFriendlyName}}RepositoryMock is a {{.GoFriendlyName}}Repository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type {{.GoFriendlyName}}RepositoryMock struct {
+	MockCalls
+	{{range .RepositoryMethods}}
+	{{.Name}}Func func({{.Params}}) {{.Results}}{{end}}
+}
+
+var _ {{.GoFriendlyName}}Repository = (*{{.GoFriendlyName}}RepositoryMock)(nil)
+{{range .RepositoryMethods}}
+// {{.Name}} records the call and runs {{.Name}}Func
+func (mock *{{$.GoFriendlyName}}RepositoryMock) {{.Name}}({{.Params}}) {{.NamedResults}} {
+	mock.Record("{{.Name}}"{{if .Args}}, {{.Args}}{{end}})
+	if mock.{{.Name}}Func != nil {
+		{{if .Results}}return {{end}}mock.{{.Name}}Func({{.CallArgs}})
+	}
+	return
+}
+{{end}}
+`
+
+/* In-memory fakes */
+
+const FAKE_TEMPLATE = `{{$name := .GoFriendlyName}}{{$source := print "source" .GoFriendlyName}}{{$softDelete := .SoftDeleteColumn}}
+// {{$name}}Fake is an in-memory {{$name}}Repository for the unit tests, its zero value an empty {{.DbName}} table.
+// It assigns the serial columns, and enforces the primary key, the unique constraints and the NOT NULL
+// columns with the errors the database methods return. It evaluates the predicates the way the database
+// does, NULL included, but only the Raw conditions made of column = $n, column IS NULL and column IS NOT NULL
+// comparisons joined by AND. The select options apply, the locking ones having no effect, and the cached
+// selects cache nothing. The methods working on the database only, e.g. CountImprecise, return
+// ErrFakeNotSupported. It is safe for concurrent use.
+type {{$name}}Fake struct {
+	mutex sync.RWMutex
+	rows  []{{$name}}
+	{{range .Columns}}{{if .IsSequence}}
+	sequence{{.GoName}} {{.GoType}}{{end}}{{end}}
+
+	utils t{{$name}}Utils
+}
+
+var _ {{$name}}Repository = (*{{$name}}Fake)(nil)
+
+// Rows returns a copy of the rows{{if $softDelete}}, the soft-deleted ones included{{end}}, in their order of insertion
+func (fake *{{$name}}Fake) Rows() []{{$name}} {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return append([]{{$name}}(nil), fake.rows...)
+}
+
+// column returns the value of the row's column, nil for NULL, and false for an unknown column
+func (fake *{{$name}}Fake) column(row *{{$name}}, dbName string) (interface{}, bool) {
+
+	switch dbName { {{range .Columns}}
+	case "{{.DbName}}":{{if .Nullable}}
+		if !row.{{.GoName}}_IsNotNull {
+			return nil, true
+		}{{end}}{{if .IsNilable}}
+		if row.{{.GoName}} == nil {
+			return nil, true
+		}{{end}}
+		return row.{{.GoName}}, true{{end}}
+	}
+	return nil, false
+}
+
+// assign sets the field of the row, named by its Go or database name, to the one of the source
+func (fake *{{$name}}Fake) assign(row *{{$name}}, {{$source}} *{{$name}}, field string) bool {
+
+	switch field { {{range .Columns}}
+	case "{{.GoName}}"{{if ne .GoName .DbName}}, "{{.DbName}}"{{end}}:
+		row.{{.GoName}} = {{$source}}.{{.GoName}}{{if .Nullable}}
+		row.{{.GoName}}_IsNotNull = {{$source}}.{{.GoName}}_IsNotNull{{end}}{{end}}
+	default:
+		return false
+	}
+	return true
+}
+
+// sameKey tells whether the rows have the same values in the columns, NULL equal to nothing
+func (fake *{{$name}}Fake) sameKey(a *{{$name}}, b *{{$name}}, dbNames ...string) bool {
+
+	for _, dbName := range dbNames {
+		aValue, _ := fake.column(a, dbName)
+		bValue, _ := fake.column(b, dbName)
+		if !fakeEqual(aValue, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// deleted tells whether the row is soft-deleted
+func (fake *{{$name}}Fake) deleted(row *{{$name}}) bool {
+	{{if $softDelete}}
+	value, _ := fake.column(row, "{{$softDelete.DbName}}")
+	return {{if eq $softDelete.Type "boolean"}}value == true{{else}}value != nil{{end}}{{else}}
+	return false{{end}}
+}
+
+// nextSequenceValues assigns the serial columns of the row
+func (fake *{{$name}}Fake) nextSequenceValues(row *{{$name}}) {
+	{{range .Columns}}{{if .IsSequence}}
+	fake.sequence{{.GoName}}++
+	row.{{.GoName}} = fake.sequence{{.GoName}}{{end}}{{end}}
+}
+
+// validate checks the changed rows against the constraints of the table
+func (fake *{{$name}}Fake) validate(rows []{{$name}}, changed []int) error {
+	{{if or .PKColumns .UniqueConstraints .NilableNotNullColumns}}
+	for _, i := range changed { {{range .NilableNotNullColumns}}
+		if rows[i].{{.GoName}} == nil {
+			return NewModelsErrorLocalWithCode("Not null constraint violation:", "{{.DbName}}", "23502")
+		}{{end}}{{if or .PKColumns .UniqueConstraints}}
+		for j := range rows {
+			if j == i {
+				continue
+			}{{if .PKColumns}}
+			if fake.sameKey(&rows[i], &rows[j]{{range .PKColumns}}, "{{.DbName}}"{{end}}) {
+				return NewModelsErrorLocalWithCode("Unique constraint violation:", "{{.DbName}} primary key", "23505")
+			}{{end}}{{range .UniqueConstraints}}
+			if fake.sameKey(&rows[i], &rows[j]{{range .Columns}}, "{{.DbName}}"{{end}}) {
+				return Err{{$name}}_UQ_{{.DbName}}
+			}{{end}}
+		}{{end}}
+	}
+	{{end}}
+	return nil
+}
+
+// insertRows appends the rows, unless one of them violates a constraint
+func (fake *{{$name}}Fake) insertRows(rows ...{{$name}}) error {
+
+	all := append(append([]{{$name}}(nil), fake.rows...), rows...)
+
+	changed := make([]int, len(rows))
+	for i := range rows {
+		changed[i] = len(fake.rows) + i
+	}
+	if err := fake.validate(all, changed); err != nil {
+		return err
+	}
+
+	fake.rows = all
+	return nil
+}
+
+// updateRows updates the rows at the indexes, unless one of them then violates a constraint
+func (fake *{{$name}}Fake) updateRows(indexes []int, update func(row *{{$name}})) (int64, error) {
+
+	rows := append([]{{$name}}(nil), fake.rows...)
+	for _, i := range indexes {
+		update(&rows[i])
+	}
+	if err := fake.validate(rows, indexes); err != nil {
+		return 0, err
+	}
+
+	fake.rows = rows
+	return int64(len(indexes)), nil
+}
+
+// matching returns the indexes of the rows matching the predicate, the zero one matching all of them{{if $softDelete}},
+// leaving out the soft-deleted ones unless withDeleted is set{{end}}
+func (fake *{{$name}}Fake) matching(errorPrefix string, where Predicate, withDeleted bool) ([]int, error) {
+
+	predicate, err := fakePredicate(where, is{{$name}}Column)
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	var indexes []int
+	for i := range fake.rows {
+		row := &fake.rows[i]
+		if !withDeleted && fake.deleted(row) {
+			continue
+		}
+
+		truth, err := fakeEvaluate(predicate, func(dbName string) interface{} {
+			value, _ := fake.column(row, dbName)
+			return value
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", errorPrefix, err)
+		}
+		if truth == fakeTrue {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// rowsAt returns copies of the rows at the indexes
+func (fake *{{$name}}Fake) rowsAt(indexes []int) []{{$name}} {
+
+	rows := make([]{{$name}}, len(indexes))
+	for i, index := range indexes {
+		rows[i] = fake.rows[index]
+	}
+	return rows
+}
+
+// selectRows returns copies of the rows at the indexes, ordered, offset, limited and projected by the options
+func (fake *{{$name}}Fake) selectRows(errorPrefix string, indexes []int, options selectOptions) ([]{{$name}}, error) {
+
+	if _, err := options.clause(is{{$name}}Column); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	rows := fake.rowsAt(indexes)
+
+	var orderErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, term := range options.orderBy {
+			a, _ := fake.column(&rows[i], term.column)
+			b, _ := fake.column(&rows[j], term.column)
+			order, ok := fakeCompare(a, b, term)
+			if !ok {
+				orderErr = fmt.Errorf("%sordering by %s is %w", errorPrefix, term.column, ErrFakeNotSupported)
+			}
+			if order != 0 {
+				return order < 0
+			}
+		}
+		return false
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+
+	if options.offset > len(rows) {
+		options.offset = len(rows)
+	}
+	rows = rows[options.offset:]
+	if options.limit > 0 && options.limit < len(rows) {
+		rows = rows[:options.limit]
+	}
+
+	if options.projection != nil {
+		for i := range rows {
+			rows[i] = fake.project(&rows[i], options.projection)
+		}
+	}
+	return rows, nil
+}
+
+// selectMatching returns copies of the rows matching the predicate, ordered, offset, limited and projected by the options
+func (fake *{{$name}}Fake) selectMatching(errorPrefix string, where Predicate, options selectOptions) ([]{{$name}}, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return nil, err
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// project returns the row with only the columns of the projection, as WithColumns selects them
+func (fake *{{$name}}Fake) project(row *{{$name}}, projection *columnProjection) {{$name}} {
+
+	projected := {{$name}}{pgToGo_projection: projection}
+	{{range .Columns}}if projection.loads("{{.DbName}}") {
+		projected.{{.GoName}} = row.{{.GoName}}{{if .Nullable}}
+		projected.{{.GoName}}_IsNotNull = row.{{.GoName}}_IsNotNull{{end}}
+	}
+	{{end}}
+	return projected
+}
+
+// deleteRows deletes the rows at the indexes{{if $softDelete}}, flagging them in the {{$softDelete.DbName}} column{{end}}
+func (fake *{{$name}}Fake) deleteRows(indexes []int) int64 {
+	{{if $softDelete}}
+	for _, i := range indexes { {{if eq $softDelete.Type "boolean"}}{{if eq $softDelete.GoType "bool"}}
+		fake.rows[i].{{$softDelete.GoName}} = true{{end}}{{else if eq $softDelete.GoType "time.Time"}}
+		fake.rows[i].{{$softDelete.GoName}} = Now(){{end}}{{if $softDelete.Nullable}}
+		fake.rows[i].{{$softDelete.GoName}}_IsNotNull = true{{end}}
+	}
+	return int64(len(indexes)){{else}}
+	kept := fake.rows[:0]
+	for i, row := range fake.rows {
+		if len(indexes) > 0 && indexes[0] == i {
+			indexes = indexes[1:]
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(fake.rows) - len(kept))
+	fake.rows = kept
+	return deleted{{end}}
+}
+{{$suffix := .Options.CtxSuffix}}
+{{if .HasFakeMethod "Insert"}}
+{{if not .Options.CtxOnly}}// Insert is InsertCtx with the background context
+func (fake *{{$name}}Fake) Insert({{$source}} *{{$name}}) (*{{$name}}, error) {
+	return fake.InsertCtx(context.Background(), {{$source}})
+}
+
+{{end}}// Insert{{$suffix}} inserts a copy of the row the way Tables.{{$name}}.Insert{{$suffix}} does, assigning the serial
+// columns when PgToGo_IgnorePKValuesWhenInsertingAndUseSequence is set.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+func (fake *{{$name}}Fake) Insert{{$suffix}}(ctx context.Context, {{$source}} *{{$name}}) (*{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.Insert() ERROR: "
+
+	if {{$source}} == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the {{$source}} pointer is nil")
+	}
+
+	if {{$source}}.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		{{range .Columns}}{{if eq .GoType "time.Time"}}{{$source}}.{{.GoName}} = Now()
+		{{end}}{{end}}
+	}
+
+	if {{$source}}.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+		{{range .Columns}}{{if .IsGuid}}{{$source}}.{{.GoName}} = {{.NewGuidExpr}}
+		{{end}}{{end}}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	row := *{{$source}}
+	if {{$source}}.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		fake.nextSequenceValues(&row)
+	}
+
+	if err := fake.insertRows(row); err != nil {
+		return nil, err
+	}
+
+	{{range .Columns}}{{if .IsSequence}}{{$source}}.{{.GoName}} = row.{{.GoName}}
+	{{end}}{{end}}
+	return {{$source}}, nil
+}
+{{end}}{{if .HasFakeMethod "CopyFromSlice"}}
+{{if not .Options.CtxOnly}}// CopyFromSlice is CopyFromSliceCtx with the background context
+func (fake *{{$name}}Fake) CopyFromSlice(records []{{$name}}, includeSequenceCols bool) (int64, error) {
+	return fake.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+{{end}}// CopyFromSlice{{$suffix}} inserts copies of the records, all of them or none.
+// Unless includeSequenceCols is true, the serial columns are assigned.
+func (fake *{{$name}}Fake) CopyFromSlice{{$suffix}}(ctx context.Context, records []{{$name}}, includeSequenceCols bool) (int64, error) {
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	rows := append([]{{$name}}(nil), records...)
+	if !includeSequenceCols {
+		for i := range rows {
+			fake.nextSequenceValues(&rows[i])
+		}
+	}
+
+	if err := fake.insertRows(rows...); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+{{end}}{{if .HasFakeMethod "Select"}}
+{{if not .Options.CtxOnly}}// Select is SelectCtx with the background context
+func (fake *{{$name}}Fake) Select(where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectCtx(context.Background(), where, options...)
+}
+
+{{end}}// Select{{$suffix}} returns copies of the rows matching the predicate, ordered and limited by the options
+func (fake *{{$name}}Fake) Select{{$suffix}}(ctx context.Context, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+
+	return fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+}
+{{end}}{{if .HasFakeMethod "Single"}}
+{{if not .Options.CtxOnly}}// Single is SingleCtx with the background context
+func (fake *{{$name}}Fake) Single(where Predicate, options ...SelectOption) (*{{$name}}, error) {
+	return fake.SingleCtx(context.Background(), where, options...)
+}
+
+{{end}}// Single{{$suffix}} returns a copy of the row matching the predicate, nil if there is none,
+// and ErrTooManyRows if there are several
+func (fake *{{$name}}Fake) Single{{$suffix}}(ctx context.Context, where Predicate, options ...SelectOption) (*{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.Single() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	switch len(rows) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &rows[0], nil
+	}
+	return nil, ErrTooManyRows
+}
+{{end}}{{if .HasFakeMethod "SelectAll"}}
+{{if not .Options.CtxOnly}}// SelectAll is SelectAllCtx with the background context
+func (fake *{{$name}}Fake) SelectAll(options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectAllCtx(context.Background(), options...)
+}
+
+{{end}}// SelectAll{{$suffix}} returns copies of all the rows{{if .SoftDeleteColumn}} not soft-deleted{{end}}, ordered and limited by the options
+func (fake *{{$name}}Fake) SelectAll{{$suffix}}(ctx context.Context, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.selectMatching("{{$name}}Fake.SelectAll() ERROR: ", Predicate{}, applySelectOptions(options))
+}
+{{end}}{{if .HasFakeMethod "SelectUnion"}}
+{{if not .Options.CtxOnly}}// SelectUnion is SelectUnionCtx with the background context
+func (fake *{{$name}}Fake) SelectUnion(where []Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectUnionCtx(context.Background(), where, options...)
+}
+
+{{end}}// SelectUnion{{$suffix}} returns copies of the rows matching any of the predicates, the ones matching several
+// of them once, ordered and limited by the options as a whole
+func (fake *{{$name}}Fake) SelectUnion{{$suffix}}(ctx context.Context, where []Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.selectUnion("{{$name}}Fake.SelectUnion() ERROR: ", where, false, applySelectOptions(options))
+}
+{{end}}{{if .HasFakeMethod "SelectUnionAll"}}
+{{if not .Options.CtxOnly}}// SelectUnionAll is SelectUnionAllCtx with the background context
+func (fake *{{$name}}Fake) SelectUnionAll(where []Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+{{end}}// SelectUnionAll{{$suffix}} returns copies of the rows matching each of the predicates, the ones matching several
+// of them as many times, ordered and limited by the options as a whole
+func (fake *{{$name}}Fake) SelectUnionAll{{$suffix}}(ctx context.Context, where []Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.selectUnion("{{$name}}Fake.SelectUnionAll() ERROR: ", where, true, applySelectOptions(options))
+}
+{{end}}{{if or (.HasFakeMethod "SelectUnion") (.HasFakeMethod "SelectUnionAll")}}
+// selectUnion returns copies of the rows matching each of the predicates, only once unless all is set
+func (fake *{{$name}}Fake) selectUnion(errorPrefix string, where []Predicate, all bool, options selectOptions) ([]{{$name}}, error) {
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+	if err := options.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	var indexes []int
+	selected := make(map[int]bool)
+	for _, predicate := range where {
+		if predicate.IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+		}
+
+		matching, err := fake.matching(errorPrefix, predicate, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range matching {
+			if all || !selected[i] {
+				indexes = append(indexes, i)
+				selected[i] = true
+			}
+		}
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+{{end}}{{if .HasFakeMethod "SelectCached"}}
+{{if not .Options.CtxOnly}}// SelectCached is SelectCachedCtx with the background context
+func (fake *{{$name}}Fake) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+{{end}}// SelectCached{{$suffix}} returns copies of the rows matching the predicate as Select{{$suffix}} does, caching nothing
+func (fake *{{$name}}Fake) SelectCached{{$suffix}}(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+{{end}}{{if .HasFakeMethod "SelectPage"}}
+{{if not .Options.CtxOnly}}// SelectPage is SelectPageCtx with the background context
+func (fake *{{$name}}Fake) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+{{end}}// SelectPage{{$suffix}} returns copies of the rows of the page, from 1, matching the predicate
+func (fake *{{$name}}Fake) SelectPage{{$suffix}}(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.selectPage("{{$name}}Fake.SelectPage() ERROR: ", pageNumber, pageSize, where, false, options)
+}
+{{end}}{{if .HasFakeMethod "SelectPageCached"}}
+{{if not .Options.CtxOnly}}// SelectPageCached is SelectPageCachedCtx with the background context
+func (fake *{{$name}}Fake) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+{{end}}// SelectPageCached{{$suffix}} returns copies of the rows of the page as SelectPage{{$suffix}} does, caching nothing
+func (fake *{{$name}}Fake) SelectPageCached{{$suffix}}(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.selectPage("{{$name}}Fake.SelectPageCached() ERROR: ", pageNumber, pageSize, where, true, options)
+}
+{{end}}{{if or (.HasFakeMethod "SelectPage") (.HasFakeMethod "SelectPageCached")}}
+// selectPage returns copies of the rows of the page matching the predicate, refusing the locking options when cached
+func (fake *{{$name}}Fake) selectPage(errorPrefix string, pageNumber int, pageSize int, where Predicate, cached bool, options []SelectOption) ([]{{$name}}, error) {
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	if cached {
+		if err := selectOptions.cached(); err != nil {
+			return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+		}
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+{{end}}{{if .HasFakeMethod "SelectAllOrderBy"}}
+{{if not .Options.CtxOnly}}// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (fake *{{$name}}Fake) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+{{end}}// SelectAllOrderBy{{$suffix}} returns copies of all the rows{{if .SoftDeleteColumn}} not soft-deleted{{end}}, ordered by the orderBy parameter,
+// columns followed by ASC or DESC and NULLS FIRST or NULLS LAST, or by the OrderBy option
+func (fake *{{$name}}Fake) SelectAllOrderBy{{$suffix}}(ctx context.Context, orderBy string, options ...SelectOption) ([]{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.SelectAllOrderBy() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+{{end}}{{if .HasFakeMethod "SelectAllPage"}}
+{{if not .Options.CtxOnly}}// SelectAllPage is SelectAllPageCtx with the background context
+func (fake *{{$name}}Fake) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]{{$name}}, error) {
+	return fake.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+{{end}}// SelectAllPage{{$suffix}} returns copies of the rows of the page, from 1, ordered as SelectAllOrderBy{{$suffix}} orders them
+func (fake *{{$name}}Fake) SelectAllPage{{$suffix}}(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]{{$name}}, error) {
+
+	var errorPrefix = "{{$name}}Fake.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+{{end}}{{if .HasFakeMethod "SelectAfter"}}
+{{if not .Options.CtxOnly}}// SelectAfter is SelectAfterCtx with the background context
+func (fake *{{$name}}Fake) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]{{$name}}, string, error) {
+	return fake.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+{{end}}// SelectAfter{{$suffix}} returns copies of the rows of the page following the cursor, and the cursor of the next page,
+// as Tables.{{$name}}.SelectAfter{{$suffix}} does
+func (fake *{{$name}}Fake) SelectAfter{{$suffix}}(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]{{$name}}, string, error) {
+
+	var errorPrefix = "{{$name}}Fake.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keyset{{$name}}.after(cursor, pageSize, &selectOptions, fake.utils.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, And(where, after), selectOptions)
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keyset{{$name}}.cursor(selectOptions, func(dbName string) interface{} {
+		return fake.utils.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+{{end}}{{if .HasFakeMethod "Count"}}
+{{if not .Options.CtxOnly}}// Count is CountCtx with the background context
+func (fake *{{$name}}Fake) Count(where ...Predicate) (int64, error) {
+	return fake.CountCtx(context.Background(), where...)
+}
+
+{{end}}// Count{{$suffix}} returns the number of rows{{if .SoftDeleteColumn}} not soft-deleted{{end}} matching all the predicates
+func (fake *{{$name}}Fake) Count{{$suffix}}(ctx context.Context, where ...Predicate) (int64, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching("{{$name}}Fake.Count() ERROR: ", And(where...), false)
+	if err != nil {
+		return -1, err
+	}
+	return int64(len(indexes)), nil
+}
+{{end}}{{if .HasFakeMethod "Update"}}
+{{if not .Options.CtxOnly}}// Update is UpdateCtx with the background context
+func (fake *{{$name}}Fake) Update({{$source}} *{{$name}}, where Predicate) (int64, error) {
+	return fake.UpdateCtx(context.Background(), {{$source}}, where)
+}
+
+{{end}}// Update{{$suffix}} sets all the fields of the rows matching the predicate to the ones of the source.
+// Returns the number of affected rows.
+func (fake *{{$name}}Fake) Update{{$suffix}}(ctx context.Context, {{$source}} *{{$name}}, where Predicate) (int64, error) {
+
+	var errorPrefix = "{{$name}}Fake.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside {{.DbName}}")
+	}
+	if {{$source}} == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the {{$source}} pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *{{$name}}) {
+		{{range .Columns}}fake.assign(row, {{$source}}, "{{.GoName}}")
+		{{end}}
+	})
+}
+{{end}}{{if .HasFakeMethod "UpdateWithMask"}}
+{{if not .Options.CtxOnly}}// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (fake *{{$name}}Fake) UpdateWithMask({{$source}} *{{$name}}, updateMask []string, where Predicate) (int64, error) {
+	return fake.UpdateWithMaskCtx(context.Background(), {{$source}}, updateMask, where)
+}
+
+{{end}}// UpdateWithMask{{$suffix}} sets the fields in the mask, Go or database names, of the rows matching the predicate
+// to the ones of the source. Returns the number of affected rows.
+func (fake *{{$name}}Fake) UpdateWithMask{{$suffix}}(ctx context.Context, {{$source}} *{{$name}}, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "{{$name}}Fake.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside {{.DbName}}")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if {{$source}} == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the {{$source}} pointer is nil")
+	}
+	for _, field := range updateMask {
+		if !fake.assign(&{{$name}}{}, {{$source}}, field) {
+			return 0, NewModelsErrorLocal(errorPrefix, "the update mask field "+field+" is not a column of {{.DbName}}")
+		}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *{{$name}}) {
+		for _, field := range updateMask {
+			fake.assign(row, {{$source}}, field)
+		}
+	})
+}
+{{end}}{{if .HasFakeMethod "Delete"}}
+{{if not .Options.CtxOnly}}// Delete is DeleteCtx with the background context
+func (fake *{{$name}}Fake) Delete(where Predicate) (int64, error) {
+	return fake.DeleteCtx(context.Background(), where)
+}
+
+{{end}}// Delete{{$suffix}} deletes the rows matching the predicate{{if .SoftDeleteColumn}}, soft-deleting them{{end}}.
+// Returns the number of deleted rows.
+func (fake *{{$name}}Fake) Delete{{$suffix}}(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "{{$name}}Fake.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from {{.DbName}}")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return 0, err
+	}
+	return fake.deleteRows(indexes), nil
+}
+{{end}}{{if and .PKColumns (.HasFakeMethod "DeleteInstance")}}
+{{if not .Options.CtxOnly}}// DeleteInstance is DeleteInstanceCtx with the background context
+func (fake *{{$name}}Fake) DeleteInstance({{$source}} *{{$name}}) (bool, error) {
+	return fake.DeleteInstanceCtx(context.Background(), {{$source}})
+}
+
+{{end}}// DeleteInstance{{$suffix}} deletes the row with the primary key of the source{{if .SoftDeleteColumn}}, soft-deleting it{{end}}.
+// Returns true if the row was found.
+func (fake *{{$name}}Fake) DeleteInstance{{$suffix}}(ctx context.Context, {{$source}} *{{$name}}) (bool, error) {
+
+	var errorPrefix = "{{$name}}Fake.DeleteInstance() ERROR: "
+
+	if {{$source}} == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the {{$source}} pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	var indexes []int
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], {{$source}}{{range .PKColumns}}, "{{.DbName}}"{{end}}) {
+			indexes = append(indexes, i)
+		}
+	}
+	return fake.deleteRows(indexes) == 1, nil
+}
+{{end}}{{if .HasFakeMethod "DeleteAll"}}
+{{if not .Options.CtxOnly}}// DeleteAll is DeleteAllCtx with the background context
+func (fake *{{$name}}Fake) DeleteAll() (int64, error) {
+	return fake.DeleteAllCtx(context.Background())
+}
+
+{{end}}// DeleteAll{{$suffix}} deletes all the rows{{if .SoftDeleteColumn}}, soft-deleting them{{end}}.
+// Returns the number of deleted rows.
+func (fake *{{$name}}Fake) DeleteAll{{$suffix}}(ctx context.Context) (int64, error) {
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	// the zero predicate matches all the rows, without an error
+	indexes, _ := fake.matching("", Predicate{}, false)
+	return fake.deleteRows(indexes), nil
+}
+{{end}}
+// get returns a copy of the row{{if .SoftDeleteColumn}}, not soft-deleted,{{end}} with the values of the key in the columns, nil if there is none
+func (fake *{{$name}}Fake) get(key *{{$name}}, dbNames ...string) *{{$name}} {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], key, dbNames...) {
+			row := fake.rows[i]
+			return &row
+		}
+	}
+	return nil
+}
+{{if and .PKColumns (.HasFakeMethod .PKGetterName)}}{{$getter := .PKGetterName}}
+{{if not .Options.CtxOnly}}// {{$getter}} is {{$getter}}Ctx with the background context
+func (fake *{{$name}}Fake) {{$getter}}({{range $i, $e := .PKColumns}}{{if $i}}, {{end}}input{{.GoName}} {{.GoType}}{{end}}) (*{{$name}}, error) {
+	return fake.{{$getter}}Ctx(context.Background(){{range .PKColumns}}, input{{.GoName}}{{end}})
+}
+
+{{end}}// {{$getter}}{{$suffix}} returns a copy of the row with the primary key, nil if there is none
+func (fake *{{$name}}Fake) {{$getter}}{{$suffix}}(ctx context.Context{{range .PKColumns}}, input{{.GoName}} {{.GoType}}{{end}}) (*{{$name}}, error) {
+	return fake.get(&{{$name}}{ {{range .PKColumns}}{{.GoName}}: input{{.GoName}}, {{if .Nullable}}{{.GoName}}_IsNotNull: true, {{end}}{{end}} }{{range .PKColumns}}, "{{.DbName}}"{{end}}), nil
+}
+{{end}}{{range .UniqueConstraints}}{{if $.HasFakeMethod .GetterName}}{{$getter := .GetterName}}
+{{if not $.Options.CtxOnly}}// {{$getter}} is {{$getter}}Ctx with the background context
+func (fake *{{$name}}Fake) {{$getter}}({{range $i, $e := .Columns}}{{if $i}}, {{end}}input{{.GoName}} {{.GoType}}{{end}}) (*{{$name}}, error) {
+	return fake.{{$getter}}Ctx(context.Background(){{range .Columns}}, input{{.GoName}}{{end}})
+}
+
+{{end}}// {{$getter}}{{$suffix}} returns a copy of the row with the values of the {{.DbName}} unique constraint, nil if there is none
+func (fake *{{$name}}Fake) {{$getter}}{{$suffix}}(ctx context.Context{{range .Columns}}, input{{.GoName}} {{.GoType}}{{end}}) (*{{$name}}, error) {
+	return fake.get(&{{$name}}{ {{range .Columns}}{{.GoName}}: input{{.GoName}}, {{if .Nullable}}{{.GoName}}_IsNotNull: true, {{end}}{{end}} }{{range .Columns}}, "{{.DbName}}"{{end}}), nil
+}
+{{end}}{{end}}{{range .RepositoryMethods}}{{if $.IsFakeDelegated .Name}}
+// {{.Name}} is Tables.{{$name}}.{{.Name}}
+func (fake *{{$name}}Fake) {{.Name}}({{.Params}}) {{.Results}} {
+	{{if .Results}}return {{end}}fake.utils.{{.Name}}({{.CallArgs}})
+}
+{{else if not ($.IsFakeMethod .Name)}}
+// {{.Name}} is not supported by the in-memory fake{{if .ErrorResult}}, it returns ErrFakeNotSupported{{end}}
+func (fake *{{$name}}Fake) {{.Name}}({{.Params}}) {{.NamedResults}} {
+	{{if .ErrorResult}}{{.ErrorResult}} = ErrFakeNotSupported
+	{{end}}return
+}
+{{end}}{{end}}
+`
@@ -0,0 +1,705 @@
+package gen
+
+/* Select Functions Templates */
+
+// COMMON_CODE_SCAN_FUNCTIONS scans the rows of a table or view, and records the columns they were selected with
+const COMMON_CODE_SCAN_FUNCTIONS = `{{$name := .GoFriendlyName}}
+// LoadedColumns returns the columns the {{$name}} was selected with by WithColumns, nil when it holds all of them
+func (t *{{$name}}) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+{{if .ShouldGenerate "select"}}{{$colCount := len .Columns}}{{$instanceVarName := print "current" .GoFriendlyName}}
+// scan{{$name}} scans a row of the columns of the projection, all of them when it is nil, into a new {{$name}}
+func scan{{$name}}(scan func(dest ...interface{}) error, projection *columnProjection) ({{$name}}, error) {
+
+	{{$instanceVarName}} := {{$name}}{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	{{range $i, $e := .Columns}}{{if .Nullable}}var nullable{{$e.GoName}} {{$e.GoNullableType}}{{$e.NullableInitExpr}} 
+	{{end}}{{end}}
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{ {{range $i, $e := .Columns}}{{if .Nullable}}&nullable{{$e.GoName}}{{else}}&{{$instanceVarName}}.{{$e.GoName}}{{end}}{{if ne (plus1 $i) $colCount}},{{end}}{{end}} }
+	} else {
+		for _, column := range projection.columns {
+			switch column { {{range $i, $e := .Columns}}
+			case "{{$e.DbName}}":
+				dest = append(dest, {{if .Nullable}}&nullable{{$e.GoName}}{{else}}&{{$instanceVarName}}.{{$e.GoName}}{{end}}){{end}}
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return {{$instanceVarName}}, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	{{range $i, $e := .Columns}}{{if .Nullable}}if projection.loads("{{$e.DbName}}") {
+		{{$instanceVarName}}.Set{{.GoName}}({{$e.ScanValueExpr (print "nullable" $e.GoName)}}, {{$e.ScanNotNullExpr (print "nullable" $e.GoName)}})
+	}
+	{{end}}{{end}}
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return {{$instanceVarName}}, nil
+}
+{{end}}{{if and .Options.IsPgx5 (.ShouldGenerate "select")}}
+// rowTo{{$name}} scans a row of all the columns into a new {{$name}}
+func rowTo{{$name}}(row pgx.CollectableRow) ({{$name}}, error) {
+	return scan{{$name}}(row.Scan, nil)
+}
+{{end}}`
+
+/* ************************************************ */
+/* BEGIN: Atomic (non-transaction) Select Templates */
+/* ************************************************ */
+
+const COMMON_CODE_SELECT_QUERY_WHERE = `{{if .Options.IsPgx5}}if err != nil {
+		return nil, NewModelsError(errorPrefix + " fatal error running the query:", err)
+	}
+
+	// scan{{.GoFriendlyName}} scans each row, CollectRows closes the rows once done
+	sliceOf{{.GoFriendlyName}}, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) ({{.GoFriendlyName}}, error) {
+		return scan{{.GoFriendlyName}}(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " error collecting the rows:", err)
+	}
+{{else}}if err != nil {
+		return nil, NewModelsError(errorPrefix + " fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOf{{.GoFriendlyName}} []{{.GoFriendlyName}}
+
+	for rows.Next() {
+
+		// scan{{.GoFriendlyName}} creates a new instance of {{.GoFriendlyName}} {{$instanceVarName := print "current" .GoFriendlyName}}
+		{{$instanceVarName}}, err := scan{{.GoFriendlyName}}(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix + " error during rows.Scan():", err)
+		}
+		
+		sliceOf{{.GoFriendlyName}} = append(sliceOf{{.GoFriendlyName}}, current{{.GoFriendlyName}})
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " error during rows.Next() iterations:", err)
+	}
+{{end}}`
+
+// COMMON_CODE_SELECT_OPTIONS numbers the condition of the predicate, and turns the options
+// into the selectClause following it
+const COMMON_CODE_SELECT_OPTIONS = `
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+`
+
+// COMMON_CODE_SELECT_OPTIONS_PAGED is COMMON_CODE_SELECT_OPTIONS, the page setting the LIMIT and OFFSET
+const COMMON_CODE_SELECT_OPTIONS_PAGED = `
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+`
+
+// COMMON_CODE_SELECT_OPTIONS_CACHED follows COMMON_CODE_SELECT_OPTIONS in the cached methods, which cannot lock the rows
+const COMMON_CODE_SELECT_OPTIONS_CACHED = `
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+`
+
+// COMMON_CODE_SELECT_OPTIONS_UNION turns the options of the union, which cannot lock the rows, into the selectClause
+const COMMON_CODE_SELECT_OPTIONS_UNION = `
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+`
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC = `
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+	
+	queryParts = append(queryParts, selectQuery + "WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+		
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts,""), params...)	
+` + COMMON_CODE_SELECT_QUERY_WHERE
+
+const COMMON_CODE_SELECT_UNION_TEMPLATE_WHERE_ATOMIC = `
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+` + COMMON_CODE_SELECT_OPTIONS_UNION + `	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+	
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery + "WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+		
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts,""), params...)	
+` + COMMON_CODE_SELECT_QUERY_WHERE
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC_PAGED = `
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+	
+	queryParts = append(queryParts, selectQuery + "WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+		
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts,""), params...)
+
+` + COMMON_CODE_SELECT_QUERY_WHERE
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_PAGED_CONDITION_HEADER = `
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+	
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+`
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_HEADER_NON_PAGED = `
+	var whereClauseHash string = ""
+	var hashErr error = nil	
+	
+	if cacheOption > PgToGoFlagCacheDisable {
+		
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+			
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery + condition + selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix + "GetHashFromConditionAndParams() error:",hashErr)
+			}			
+		}
+		
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+						
+			// try to get the rows from cache, if enabled and valid
+			if current{{.GoFriendlyName}}RowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash) ; cacheValid == true {
+				
+				return current{{.GoFriendlyName}}RowsFromCache, nil
+			} 
+		}
+	}
+`
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_HEADER_PAGED = `
+	var whereClauseHash string = ""
+	var hashErr error = nil	
+
+	if cacheOption > PgToGoFlagCacheDisable {
+		
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+			
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery + condition + selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix + "GetHashFromConditionAndParams() error:",hashErr)
+			}
+			
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string {whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated,  Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated,"")		
+			
+		}
+		
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+						
+			// try to get the rows from cache, if enabled and valid
+			if current{{.GoFriendlyName}}RowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash) ; cacheValid == true {
+				
+				return current{{.GoFriendlyName}}RowsFromCache, nil
+			} 
+		}
+	}
+`
+
+const COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_FOOTER = `
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+		
+		if cacheOption != PgToGoFlagCacheDelete {			
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOf{{.GoFriendlyName}})	
+		}
+	}
+`
+
+const SELECT_TEMPLATE_WHERE = `{{$colCount := len .Columns}}
+{{$functionName := "Select"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the rows from {{.DbName}} matching the predicate{{if .Columns}}, e.g.
+// {{.GoFriendlyName}}Cols.{{(index .Columns 0).GoName}}.Eq(value), or Raw(condition, params...){{end}}.
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+	
+	` + COMMON_CODE_SELECT_OPTIONS + `
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC + `		
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectUnion"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where []Predicate, options ...SelectOption) ([]{{.GoFriendlyName}}, error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} performs a union between select queries from {{.DbName}}, 
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where []Predicate, options ...SelectOption) ([]{{.GoFriendlyName}}, error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+	
+	var isUnionAll = false
+	
+	` + COMMON_CODE_SELECT_UNION_TEMPLATE_WHERE_ATOMIC + `		
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectUnionAll"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where []Predicate, options ...SelectOption) ([]{{.GoFriendlyName}}, error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} performs a union between select queries from {{.DbName}}, 
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where []Predicate, options ...SelectOption) ([]{{.GoFriendlyName}}, error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	var isUnionAll = true
+	
+	` + COMMON_CODE_SELECT_UNION_TEMPLATE_WHERE_ATOMIC + `		
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectCached"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), cacheOption, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the rows from {{.DbName}} matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from {{.DbName}}")
+	}
+	
+	` + COMMON_CODE_SELECT_OPTIONS + COMMON_CODE_SELECT_OPTIONS_CACHED + `
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_HEADER_NON_PAGED + `
+	
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC + `	
+	
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_FOOTER + `
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectPage"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the paginated rows from {{.DbName}} matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_PAGED_CONDITION_HEADER + `
+	` + COMMON_CODE_SELECT_OPTIONS_PAGED + `
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC_PAGED + `
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectPageCached"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the page of the rows from {{.DbName}} matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_PAGED_CONDITION_HEADER + `	
+	` + COMMON_CODE_SELECT_OPTIONS_PAGED + COMMON_CODE_SELECT_OPTIONS_CACHED + `
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_HEADER_PAGED + `	
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_ATOMIC_PAGED + `		
+	` + COMMON_CODE_SELECT_TEMPLATE_WHERE_CACHED_FOOTER + `
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+`
+
+/* ***************************************** */
+/* BEGIN: Transaction based Select Templates */
+/* ***************************************** */
+
+const SELECT_TEMPLATE_WHERE_TX = `
+{{$functionName := print "Select" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs Select{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.Select{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.Select{{.Options.CtxSuffix}}(ctx, where, options...)
+}
+
+{{$functionName := print "SelectCached" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), cacheOption, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs SelectCached{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.SelectCached{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.SelectCached{{.Options.CtxSuffix}}(ctx, cacheOption, where, options...)
+}
+
+{{$functionName := print "SelectPage" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs SelectPage{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.SelectPage{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.SelectPage{{.Options.CtxSuffix}}(ctx, pageNumber, pageSize, where, options...)
+}
+
+{{$functionName := print "SelectPageCached" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs SelectPageCached{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.SelectPageCached{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.SelectPageCached{{.Options.CtxSuffix}}(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+`
+
+/* **************************************************** */
+/* BEGIN: Atomic (non-transaction) Select All Templates */
+/* **************************************************** */
+
+// COMMON_CODE_SELECT_ALL_OPTIONS turns the options of SelectAll into the selectClause
+const COMMON_CODE_SELECT_ALL_OPTIONS = `
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+`
+
+const SELECT_TEMPLATE_ALL = `{{$colCount := len .Columns}}
+{{$functionName := "SelectAll"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), options...)
+}
+
+{{end}}// Returns all the rows from {{.DbName}}.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+	` + COMMON_CODE_SELECT_ALL_OPTIONS + `
+	// try to get the rows from cache, if enabled and valid
+	if all{{.GoFriendlyName}}RowsFromCache, cacheValid := utilRef.Cache.GetAllRows() ; cacheValid == true && selectClause == "" && selectOptions.projection == nil {		
+		return all{{.GoFriendlyName}}RowsFromCache, nil
+	}
+	
+	rows, err := currentDbHandle.Query(ctx, selectQuery + selectClause)
+
+	` + COMMON_CODE_SELECT_QUERY_WHERE + `
+		
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectAllOrderBy"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(orderBy string, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), orderBy, options...)
+}
+
+{{end}}// Returns all the rows from {{.DbName}} ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty, 
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, orderBy string, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if all{{.GoFriendlyName}}RowsFromCache, cacheValid := utilRef.Cache.GetAllRows() ; cacheValid == true && selectOptions.plain() {		
+		return all{{.GoFriendlyName}}RowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+		
+	rows, err := currentDbHandle.Query(ctx, selectQuery + selectClause)
+
+	` + COMMON_CODE_SELECT_QUERY_WHERE + `
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+
+{{$colCount := len .Columns}}
+{{$functionName := "SelectAllPage"}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+{{end}}// Returns a page of rows from {{.DbName}} equal to pageSize, 
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty, 
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of {{.GoFriendlyName}} instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if all{{.GoFriendlyName}}RowsFromCache, cacheValid := utilRef.Cache.GetAllRows() ; cacheValid == true && selectOptions.plain() {		
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(all{{.GoFriendlyName}}RowsFromCache) {
+			pageStart = len(all{{.GoFriendlyName}}RowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(all{{.GoFriendlyName}}RowsFromCache) {
+			pageEnd = len(all{{.GoFriendlyName}}RowsFromCache)
+		}
+		
+		return all{{.GoFriendlyName}}RowsFromCache[pageStart:pageEnd], nil
+	}
+	
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(is{{.GoFriendlyName}}Column)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("{{.GenericSelectQuery}}", "{{.SelectSource}}")
+		
+	rows, err := currentDbHandle.Query(ctx, selectQuery + selectClause)	
+
+	` + COMMON_CODE_SELECT_QUERY_WHERE + `
+	
+	return sliceOf{{.GoFriendlyName}}, nil
+}
+`
+
+/* ********************************************* */
+/* BEGIN: Transaction based Select All Templates */
+/* ********************************************* */
+
+const SELECT_TEMPLATE_ALL_TX = `
+{{$functionName := print "SelectAll" .GoFriendlyName}}{{$sourceStructName := print "source" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs SelectAll{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.SelectAll{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, options ...SelectOption) ([]{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.SelectAll{{.Options.CtxSuffix}}(ctx, options...)
+}
+`
@@ -0,0 +1,206 @@
+package gen
+
+/* Count, Single, First, Last Functions Templates */
+
+/* ****************************************************** */
+/* BEGIN: Atomic (non-transaction) Select Extra Templates */
+/* ****************************************************** */
+
+const SELECT_TEMPLATE_COUNT = `{{$functionName := "Count"}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where ...Predicate) (int64,  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where...)
+}
+
+{{end}}// Returns the number of rows from {{.DbName}} matching all the predicates{{if .Columns}}, e.g.
+// {{.GoFriendlyName}}Cols.{{(index .Columns 0).GoName}}.Eq(value){{end}}, or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where ...Predicate) (int64,  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "	
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM {{.SelectSource}}"
+	var totalRows int64	
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix + " error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}	
+
+{{$functionName := "CountImprecise"}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}() (int64,  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background())
+}
+
+{{end}}// Returns the number of rows from {{.DbName}}
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context) (int64,  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "	
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = '{{.Options.DbSchema}}.{{.DbName}}'::regclass;"
+	
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+	
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix + " error during QueryRow() or Scan():", err)
+	}
+	
+	return int64(totalRows), nil
+}	
+`
+
+/* BEGIN: Single Templates Section */
+
+const CONST_SELECT_TEMPLATE_SINGLE = `{{$colCount := len .Columns}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(where Predicate, options ...SelectOption) (*{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// Returns the a single record from {{.DbName}} matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate, options ...SelectOption) (*{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "	
+	
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	} 
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+	` + COMMON_CODE_SELECT_OPTIONS + `
+	// define the select query
+	var queryParts []string
+	
+	queryParts = append(queryParts, selectQuery + "WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+		
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts,""), params...)
+	
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOf{{.GoFriendlyName}} *{{.GoFriendlyName}}
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows	
+		}
+
+		// scan{{.GoFriendlyName}} creates a new instance of {{.GoFriendlyName}} {{$instanceVarName := print "current" .GoFriendlyName}}
+		{{$instanceVarName}}, err := scan{{.GoFriendlyName}}(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix + " error during rows.Scan():", err)
+		}
+						
+		instanceOf{{.GoFriendlyName}} = &{{$instanceVarName}}
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " error during single row fetching:", err)
+	}
+
+	return instanceOf{{.GoFriendlyName}}, nil
+}`
+
+const SELECT_TEMPLATE_SINGLE_ATOMIC = `{{$functionName := "Single"}}` + CONST_SELECT_TEMPLATE_SINGLE
+
+const SELECT_TEMPLATE_SINGLE_TX = `
+{{$functionName := print "Single" .GoFriendlyName}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (txWrapper *Transaction) {{$functionName}}(where Predicate, options ...SelectOption) (*{{.GoFriendlyName}},  error) {
+	return txWrapper.{{$functionName}}Ctx(context.Background(), where, options...)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} runs Single{{.Options.CtxSuffix}} of {{.GoFriendlyName}} in the transaction,
+// as txWrapper.DB().{{.GoFriendlyName}}.Single{{.Options.CtxSuffix}}(...) does.
+func (txWrapper *Transaction) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, where Predicate, options ...SelectOption) (*{{.GoFriendlyName}},  error) {
+	return NewDB(txWrapper).{{.GoFriendlyName}}.Single{{.Options.CtxSuffix}}(ctx, where, options...)
+}
+`
+
+/* BEGIN: JSON Path Templates Section */
+
+const SELECT_TEMPLATE_JSON_PATH = `{{$functionName := "SelectWhereJSONPath"}}
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(column string, path []string, value interface{}) ([]{{.GoFriendlyName}},  error) {
+	return utilRef.{{$functionName}}Ctx(context.Background(), column, path, value)
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} returns the rows from {{.DbName}} whose json document in the given column
+// holds the value at the given path (e.g. []string{"address", "city"}).
+// A string value is compared with the text found at the path (column #>> path = value),
+// any other value is matched by containment (column @> {"address": {"city": value}}).
+// The column is the database name of one of the json columns: {{range $i, $e := .JSONColumns}}{{if $i}}, {{end}}{{$e.DbName}}{{end}}.
+// This version is not cached and calls the database directly.
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context, column string, path []string, value interface{}) ([]{{.GoFriendlyName}},  error) {
+						
+	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
+
+	switch column {
+	case {{range $i, $e := .JSONColumns}}{{if $i}}, {{end}}"{{$e.DbName}}"{{end}}:
+	default:
+		return nil, NewModelsErrorLocal(errorPrefix, "not a json column of {{.DbName}}: " + column)
+	}
+
+	if len(path) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the path is empty")
+	}
+
+	if text, isString := value.(string); isString {
+		return utilRef.Select{{.Options.CtxSuffix}}(ctx, Raw(quoteIdentifier(column) + " #>> $1::text[] = $2", {{if .Options.IsSql}}textArray(path){{else}}path{{end}}, text))
+	}
+
+	// nest the value inside the path, from the innermost key outwards
+	document := value
+	for i := len(path) - 1; i >= 0; i-- {
+		document = map[string]interface{}{path[i]: document}
+	}
+
+	documentBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix + " could not marshal the value:", err)
+	}
+
+	return utilRef.Select{{.Options.CtxSuffix}}(ctx, Raw(quoteIdentifier(column) + "::jsonb @> $1::jsonb", string(documentBytes)))
+}
+`
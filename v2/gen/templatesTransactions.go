@@ -0,0 +1,73 @@
+package gen
+
+const BASE_TRANSACTIONS = `package {{.PackageName}}
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"context"
+	{{if .IsSql}}"database/sql"{{else}}pgx "{{.PgxImport}}"{{end}}	
+)
+
+//
+// DB transaction-related types and functionality
+//
+
+{{if .IsSql}}// Transaction isolation levels for the database/sql package
+const (
+	IsoLevelSerializable = sql.LevelSerializable
+	IsoLevelRepeatableRead = sql.LevelRepeatableRead
+	IsoLevelReadCommitted = sql.LevelReadCommitted
+	IsoLevelReadUncommitted = sql.LevelReadUncommitted	
+)
+
+
+// Transaction is a wrapper structure over the database/sql transaction, to avoid importing
+// that package in the generated table-to-struct files.
+type Transaction struct {
+	Tx *SqlTx
+}{{else}}// Transaction isolation levels for the pgx package
+const (
+	IsoLevelSerializable = pgx.Serializable
+	IsoLevelRepeatableRead = pgx.RepeatableRead
+	IsoLevelReadCommitted = pgx.ReadCommitted
+	IsoLevelReadUncommitted = pgx.ReadUncommitted	
+)
+
+
+// Transaction is a wrapper structure over the pgx transaction package, to avoid importing
+// that package in the generated table-to-struct files.
+type Transaction struct {
+	Tx pgx.Tx
+}{{end}}
+
+{{if not .CtxOnly}}// Commit is CommitCtx with the background context
+func (t *Transaction) Commit() error {
+	return t.CommitCtx(context.Background())
+}
+
+// Rollback is RollbackCtx with the background context
+func (t *Transaction) Rollback() error {
+	return t.RollbackCtx(context.Background())
+}
+
+{{end}}// Commit{{.CtxSuffix}} commits the current transaction
+func (t *Transaction) Commit{{.CtxSuffix}}(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Commit()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Commit(ctx)
+}
+
+// Rollback{{.CtxSuffix}} attempts to rollback the current transaction
+func (t *Transaction) Rollback{{.CtxSuffix}}(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Rollback()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Rollback(ctx)
+}
+
+`
@@ -1,4 +1,4 @@
-package main
+package gen
 
 /* Views */
 
@@ -10,40 +10,49 @@ const VIEW_TEMPLATE = `package {{.Options.PackageName}}
 /* *********************************************************** */
 
 import (	
-	"context"
-	"sync"
-	pgx "{{.Options.PgxImport}}"
-	pgtype "{{.Options.PgTypeImport}}"
-	{{range $key, $value := .GoTypesToImport}}"{{$value}}"
+	{{if or .IsMaterialized (.ShouldGenerate "select")}}"context"
+	{{end}}{{if and (.ShouldGenerate "select") .KeysetKeys}}"encoding/json"
+	{{end}}{{if or .SensitiveColumns (and (.ShouldGenerate "select") .KeysetKeys)}}"fmt"
+	{{end}}"sync"
+	{{if .Options.IsSql}}{{if .ShouldGenerate "select"}}"database/sql"
+	{{end}}{{else}}pgx "{{.Options.PgxImport}}"
+	{{if .ShouldGenerate "select"}}pgtype "{{.Options.PgTypeImport}}"
+	{{end}}{{end}}{{range $key, $value := .GoTypesToImport}}"{{$value}}"
 	{{end}}
 )
 
-// this is a dummy variable, just to use the pgx package
-const _pgxDummyFnPlaceholder_{{.GoFriendlyName}} = pgx.BinaryFormatCode
-
 
 const {{.GoFriendlyName}}_DB_VIEW_NAME string = "{{.DbName}}"
 
-// {{.GoFriendlyName}} is a structure that corresponds to the {{.DbName}} view.
+{{if ne .DbComments ""}}/*{{.GoFriendlyName}} is a structure that corresponds to the {{.DbName}} view.
+Database comments: {{.DbComments}} */{{else}}// {{.GoFriendlyName}} is a structure that corresponds to the {{.DbName}} view.{{end}}
 type {{.GoFriendlyName}} struct {
-	{{range .Columns}}// database field name: {{.DbName}}
-	{{.GoName}} {{.GoType}}
+	{{range .Columns}}// database field name: {{.DbName}}{{if ne .DbComments ""}}
+	/* {{.DbComments}} */{{end}}
+	{{.GoName}} {{.GoType}}{{if ne .JSONTag ""}} `+"`"+`json:"{{.JSONTag}}"`+"`"+`{{end}}
 	{{if .Nullable}}{{.GoName}}_IsNotNull bool // if true, it means the value is not null
 	{{end}}
-	{{end}}		
+	{{end}}
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
 }
 
 {{ $tableGoName := .GoFriendlyName}}
-/* Sorting helper containers */
-{{range $i, $e := .Columns}}
+{{if .SensitiveColumns}}// String renders the {{.GoFriendlyName}} fields, leaving out the values of the sensitive ones
+func (t {{$tableGoName}}) String() string {
+	return fmt.Sprintf("{{$tableGoName}}{{"{"}}{{range $i, $e := .Columns}}{{if $i}}, {{end}}{{$e.GoName}}: {{if $e.IsSensitive}}[REDACTED]{{else}}%v{{end}}{{end}}}"` +
+	`{{range .Columns}}{{if not .IsSensitive}}, t.{{.GoName}}{{end}}{{end}})
+}
+{{end}}/* Sorting helper containers */
+{{range $i, $e := .Columns}}{{if $e.HasLessComparator}}
 // Sort{{$tableGoName}}By{{$e.GoName}} implements sort.Interface for []{{$tableGoName}} based on
 // the {{$e.GoName}} field. Usage: sort.Sort(Sort{{$tableGoName}}By{{$e.GoName}}(anyGiven{{$tableGoName}}Slice))
 type Sort{{$tableGoName}}By{{$e.GoName}} []{{$tableGoName}}
 
 func (a Sort{{$tableGoName}}By{{$e.GoName}}) Len() int           { return len(a) }
 func (a Sort{{$tableGoName}}By{{$e.GoName}}) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a Sort{{$tableGoName}}By{{$e.GoName}}) Less(i, j int) bool { return LessComparatorFor_{{$e.GoType}}(a[i].{{$e.GoName}},a[j].{{$e.GoName}}) }
-{{end}}
+func (a Sort{{$tableGoName}}By{{$e.GoName}}) Less(i, j int) bool { return {{$e.LessExpr (print "a[i]." $e.GoName) (print "a[j]." $e.GoName)}} }
+{{end}}{{end}}
 {{range .Columns}}func (t *{{$tableGoName}}) Set{{.GoName}}(val {{.GoType}} {{if .Nullable}}, notNull bool{{end}}) {
 	t.{{.GoName}} = val
 	{{if .Nullable}}t.{{.GoName}}_IsNotNull = notNull{{end}}
@@ -53,6 +62,9 @@ func (a Sort{{$tableGoName}}By{{$e.GoName}}) Less(i, j int) bool { return LessCo
 // fake, internal type to allow a singleton structure that would hold static-like methods
 type t{{.GoFriendlyName}}Utils struct {
 		
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+	
 	// instance of a CacheFor{{.GoFriendlyName}} structure
 	Cache CacheFor{{.GoFriendlyName}}		
 		
@@ -60,18 +72,23 @@ type t{{.GoFriendlyName}}Utils struct {
 
 {{if .IsMaterialized}}
 {{$functionName := "RefreshMaterializedView"}}{{$sourceStructName := print "source" .GoFriendlyName}}
-// {{$functionName}} refreshes the materialized view and updates it with the latest data 
+{{if not .Options.CtxOnly}}// {{$functionName}} is {{$functionName}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}() error {
+	return utilRef.{{$functionName}}Ctx(context.Background())
+}
+
+{{end}}// {{$functionName}}{{.Options.CtxSuffix}} refreshes the materialized view and updates it with the latest data 
 // from the underlying data entities.
-func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}()  error {
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}{{.Options.CtxSuffix}}(ctx context.Context) error {
 						
 	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
 	
-	currentDbHandle := GetDb()
+	currentDbHandle := dbQuerier(utilRef.querier)
 	if currentDbHandle == nil {
 		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
 	}
 	
-	_, err := currentDbHandle.Exec(context.Background(), "REFRESH MATERIALIZED VIEW {{.DbName}};")
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW {{.DbName}};")
 	if err != nil {
 		return NewModelsError(errorPrefix + "currentDbHandle.Exec error:",err)
 	}
@@ -81,21 +98,26 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}()  error {
 }
 
 {{$functionNameConc := "RefreshMaterializedViewConcurrently"}}{{$sourceStructName := print "source" .GoFriendlyName}}
-// {{$functionName}} refreshes the materialized view concurrently, and updates it with the 
+{{if not .Options.CtxOnly}}// {{$functionNameConc}} is {{$functionNameConc}}Ctx with the background context
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionNameConc}}() error {
+	return utilRef.{{$functionNameConc}}Ctx(context.Background())
+}
+
+{{end}}// {{$functionName}} refreshes the materialized view concurrently, and updates it with the 
 // latest data from the underlying data entities. A concurrent refresh means that the view 
 // is accessible to reading by other threads, but it may take longer than the non-concurrent 
 // operation. This refresh mode is only available in Postgres versions 9.4 and higher and 
 // it will fail unless at least one unique index, without a WHERE clause is defined on the view.
-func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionNameConc}}()  error {
+func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionNameConc}}{{.Options.CtxSuffix}}(ctx context.Context) error {
 						
 	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionNameConc}}() ERROR: "
 	
-	currentDbHandle := GetDb()
+	currentDbHandle := dbQuerier(utilRef.querier)
 	if currentDbHandle == nil {
 		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
 	}
 	
-	_, err := currentDbHandle.Exec(context.Background(), "REFRESH MATERIALIZED VIEW CONCURRENTLY {{.DbName}};")
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY {{.DbName}};")
 	if err != nil {
 		return NewModelsError(errorPrefix + "currentDbHandle.Exec error:",err)
 	}
@@ -104,7 +126,7 @@ func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionNameConc}}()  error {
 	
 }
 {{end}}
-`
+` + COMMON_CODE_SCAN_FUNCTIONS
 
 const VIEW_TEMPLATE_CUSTOM = `package {{.Options.PackageName}}
 
@@ -0,0 +1,24 @@
+package gen
+
+/* Query builder: the column descriptors */
+
+const QUERY_COLUMNS_TEMPLATE = `{{if .Columns}}
+// {{.GoFriendlyName}}Cols holds the columns of {{.DbName}}, building the predicates and the ordering of the queries,
+// e.g. {{.GoFriendlyName}}Cols.{{(index .Columns 0).GoName}}.Eq(value)
+var {{.GoFriendlyName}}Cols = struct { {{range .Columns}}
+	{{.GoName}} QueryColumn{{end}}
+}{ {{range .Columns}}
+	{{.GoName}}: QueryColumn{name: "{{.DbName}}"},{{end}}
+}
+{{end}}
+// is{{.GoFriendlyName}}Column tells whether the database name is one of the columns of {{.DbName}}
+func is{{.GoFriendlyName}}Column(dbName string) bool {
+	{{if .Columns}}
+	switch dbName {
+	case {{range $i, $e := .Columns}}{{if $i}}, {{end}}"{{$e.DbName}}"{{end}}:
+		return true
+	}
+	{{end}}
+	return false
+}
+`
@@ -0,0 +1,1578 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+const DailyTotals_DB_VIEW_NAME string = "daily_totals"
+
+// DailyTotals is a structure that corresponds to the daily_totals view.
+type DailyTotals struct {
+	// database field name: day
+	Day           time.Time
+	Day_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: transfers
+	Transfers           int64
+	Transfers_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: total
+	Total           float64
+	Total_IsNotNull bool // if true, it means the value is not null
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+/* Sorting helper containers */
+
+// SortDailyTotalsByDay implements sort.Interface for []DailyTotals based on
+// the Day field. Usage: sort.Sort(SortDailyTotalsByDay(anyGivenDailyTotalsSlice))
+type SortDailyTotalsByDay []DailyTotals
+
+func (a SortDailyTotalsByDay) Len() int      { return len(a) }
+func (a SortDailyTotalsByDay) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortDailyTotalsByDay) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].Day, a[j].Day)
+}
+
+// SortDailyTotalsByTransfers implements sort.Interface for []DailyTotals based on
+// the Transfers field. Usage: sort.Sort(SortDailyTotalsByTransfers(anyGivenDailyTotalsSlice))
+type SortDailyTotalsByTransfers []DailyTotals
+
+func (a SortDailyTotalsByTransfers) Len() int      { return len(a) }
+func (a SortDailyTotalsByTransfers) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortDailyTotalsByTransfers) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].Transfers, a[j].Transfers)
+}
+
+// SortDailyTotalsByTotal implements sort.Interface for []DailyTotals based on
+// the Total field. Usage: sort.Sort(SortDailyTotalsByTotal(anyGivenDailyTotalsSlice))
+type SortDailyTotalsByTotal []DailyTotals
+
+func (a SortDailyTotalsByTotal) Len() int      { return len(a) }
+func (a SortDailyTotalsByTotal) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortDailyTotalsByTotal) Less(i, j int) bool {
+	return LessComparatorFor_float64(a[i].Total, a[j].Total)
+}
+
+func (t *DailyTotals) SetDay(val time.Time, notNull bool) {
+	t.Day = val
+	t.Day_IsNotNull = notNull
+}
+func (t *DailyTotals) SetTransfers(val int64, notNull bool) {
+	t.Transfers = val
+	t.Transfers_IsNotNull = notNull
+}
+func (t *DailyTotals) SetTotal(val float64, notNull bool) {
+	t.Total = val
+	t.Total_IsNotNull = notNull
+}
+
+// fake, internal type to allow a singleton structure that would hold static-like methods
+type tDailyTotalsUtils struct {
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForDailyTotals structure
+	Cache CacheForDailyTotals
+}
+
+// RefreshMaterializedView refreshes the materialized view and updates it with the latest data
+// from the underlying data entities.
+func (utilRef *tDailyTotalsUtils) RefreshMaterializedView(ctx context.Context) error {
+
+	var errorPrefix = "DailyTotalsUtils.RefreshMaterializedView() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW daily_totals;")
+	if err != nil {
+		return NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	return nil
+
+}
+
+// RefreshMaterializedView refreshes the materialized view concurrently, and updates it with the
+// latest data from the underlying data entities. A concurrent refresh means that the view
+// is accessible to reading by other threads, but it may take longer than the non-concurrent
+// operation. This refresh mode is only available in Postgres versions 9.4 and higher and
+// it will fail unless at least one unique index, without a WHERE clause is defined on the view.
+func (utilRef *tDailyTotalsUtils) RefreshMaterializedViewConcurrently(ctx context.Context) error {
+
+	var errorPrefix = "DailyTotalsUtils.RefreshMaterializedViewConcurrently() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY daily_totals;")
+	if err != nil {
+		return NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	return nil
+
+}
+
+// LoadedColumns returns the columns the DailyTotals was selected with by WithColumns, nil when it holds all of them
+func (t *DailyTotals) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanDailyTotals scans a row of the columns of the projection, all of them when it is nil, into a new DailyTotals
+func scanDailyTotals(scan func(dest ...interface{}) error, projection *columnProjection) (DailyTotals, error) {
+
+	currentDailyTotals := DailyTotals{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableDay pgtype.Date
+	var nullableTransfers pgtype.Int8
+	var nullableTotal pgtype.Float8
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&nullableDay, &nullableTransfers, &nullableTotal}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "day":
+				dest = append(dest, &nullableDay)
+			case "transfers":
+				dest = append(dest, &nullableTransfers)
+			case "total":
+				dest = append(dest, &nullableTotal)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentDailyTotals, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("day") {
+		currentDailyTotals.SetDay(nullableDay.Time, nullableDay.Valid)
+	}
+	if projection.loads("transfers") {
+		currentDailyTotals.SetTransfers(nullableTransfers.Int64, nullableTransfers.Valid)
+	}
+	if projection.loads("total") {
+		currentDailyTotals.SetTotal(nullableTotal.Float64, nullableTotal.Valid)
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentDailyTotals, nil
+}
+
+// rowToDailyTotals scans a row of all the columns into a new DailyTotals
+func rowToDailyTotals(row pgx.CollectableRow) (DailyTotals, error) {
+	return scanDailyTotals(row.Scan, nil)
+}
+
+// DailyTotalsCols holds the columns of daily_totals, building the predicates and the ordering of the queries,
+// e.g. DailyTotalsCols.Day.Eq(value)
+var DailyTotalsCols = struct {
+	Day       QueryColumn
+	Transfers QueryColumn
+	Total     QueryColumn
+}{
+	Day:       QueryColumn{name: "day"},
+	Transfers: QueryColumn{name: "transfers"},
+	Total:     QueryColumn{name: "total"},
+}
+
+// isDailyTotalsColumn tells whether the database name is one of the columns of daily_totals
+func isDailyTotalsColumn(dbName string) bool {
+
+	switch dbName {
+	case "day", "transfers", "total":
+		return true
+	}
+
+	return false
+}
+
+// Select returns the rows from daily_totals matching the predicate, e.g.
+// DailyTotalsCols.Day.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) Select(ctx context.Context, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectUnion performs a union between select queries from daily_totals,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectUnion(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectUnionAll performs a union between select queries from daily_totals,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectUnionAll(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectCached returns the rows from daily_totals matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectCached(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentDailyTotalsRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentDailyTotalsRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfDailyTotals)
+		}
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectPage returns the paginated rows from daily_totals matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectPage(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectPageCached returns the page of the rows from daily_totals matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectPageCached(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from daily_totals")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentDailyTotalsRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentDailyTotalsRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfDailyTotals)
+		}
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// Returns all the rows from daily_totals.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectAll(ctx context.Context, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	// try to get the rows from cache, if enabled and valid
+	if allDailyTotalsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allDailyTotalsRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// Returns all the rows from daily_totals ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of DailyTotals instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectAllOrderBy(ctx context.Context, orderBy string, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allDailyTotalsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allDailyTotalsRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// Returns a page of rows from daily_totals equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of DailyTotals instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tDailyTotalsUtils) SelectAllPage(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allDailyTotalsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allDailyTotalsRowsFromCache) {
+			pageStart = len(allDailyTotalsRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allDailyTotalsRowsFromCache) {
+			pageEnd = len(allDailyTotalsRowsFromCache)
+		}
+
+		return allDailyTotalsRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanDailyTotals scans each row, CollectRows closes the rows once done
+	sliceOfDailyTotals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (DailyTotals, error) {
+		return scanDailyTotals(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfDailyTotals, nil
+}
+
+// SelectDailyTotals runs Select of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.Select(...) does.
+func (txWrapper *Transaction) SelectDailyTotals(ctx context.Context, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.Select(ctx, where, options...)
+}
+
+// SelectCachedDailyTotals runs SelectCached of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.SelectCached(...) does.
+func (txWrapper *Transaction) SelectCachedDailyTotals(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.SelectCached(ctx, cacheOption, where, options...)
+}
+
+// SelectPageDailyTotals runs SelectPage of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.SelectPage(...) does.
+func (txWrapper *Transaction) SelectPageDailyTotals(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.SelectPage(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedDailyTotals runs SelectPageCached of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.SelectPageCached(...) does.
+func (txWrapper *Transaction) SelectPageCachedDailyTotals(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.SelectPageCached(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllDailyTotals runs SelectAll of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.SelectAll(...) does.
+func (txWrapper *Transaction) SelectAllDailyTotals(ctx context.Context, options ...SelectOption) ([]DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.SelectAll(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for DailyTotals         */
+/* ************************************************************ */
+
+type CacheForDailyTotals struct {
+	enabled bool // flag to determine if caching is enabled for DailyTotals
+
+	sliceCache      map[string][]DailyTotals
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]DailyTotals
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]DailyTotals
+	singleRowCacheMutex sync.RWMutex
+
+	all      []DailyTotals
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Views.DailyTotals when nil
+	utils *tDailyTotalsUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForDailyTotals) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]DailyTotals)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]DailyTotals)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]DailyTotals)
+	}
+
+}
+
+func (c *CacheForDailyTotals) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForDailyTotals) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForDailyTotals) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForDailyTotals) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// Enables caching for daily_totals and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForDailyTotals) EnableAndLoadAllRows(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Views.DailyTotals
+	}
+	allRows, err := utils.SelectAll(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForDailyTotals) GetAllRows() ([]DailyTotals, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all DailyTotals records in the database.
+func (c *CacheForDailyTotals) SetAllRows(all []DailyTotals) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all DailyTotals records in the database.
+func (c *CacheForDailyTotals) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForDailyTotals) GetWhere(key string) ([]DailyTotals, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wDailyTotals, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wDailyTotals, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForDailyTotals) SetWhere(key string, sliceDailyTotals []DailyTotals) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceDailyTotals != nil {
+
+			whereSliceCopy := make([]DailyTotals, len(sliceDailyTotals))
+			copy(whereSliceCopy, sliceDailyTotals)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForDailyTotals) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of DailyTotals from the cache store based on
+// the given key.
+func (c *CacheForDailyTotals) GetSlice(key string) ([]DailyTotals, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sDailyTotals, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sDailyTotals, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of DailyTotals inside the cache store based and
+// associates it with the given key.
+func (c *CacheForDailyTotals) SetSlice(key string, sliceDailyTotals []DailyTotals) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceDailyTotals != nil {
+
+			sliceCopy := make([]DailyTotals, len(sliceDailyTotals))
+			copy(sliceCopy, sliceDailyTotals)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of DailyTotals from the cache store entry
+// associated with key.
+func (c *CacheForDailyTotals) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *DailyTotals from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForDailyTotals) Get(key string) (*DailyTotals, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a DailyTotals with key, and saves it in the cache store.
+func (c *CacheForDailyTotals) Set(key string, structDailyTotals DailyTotals) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structDailyTotals
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the DailyTotals instance that is associated with key from the
+// cache store.
+func (c *CacheForDailyTotals) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for DailyTotals           */
+/* ************************************************************ */
+
+// Returns the number of rows from daily_totals matching all the predicates, e.g.
+// DailyTotalsCols.Day.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tDailyTotalsUtils) Count(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "DailyTotalsUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM daily_totals"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// Returns the number of rows from daily_totals
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tDailyTotalsUtils) CountImprecise(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "DailyTotalsUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.daily_totals'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Returns the a single record from daily_totals matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tDailyTotalsUtils) Single(ctx context.Context, where Predicate, options ...SelectOption) (*DailyTotals, error) {
+
+	var errorPrefix = "DailyTotalsUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isDailyTotalsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT day, transfers, total FROM daily_totals ", "daily_totals")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfDailyTotals *DailyTotals
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanDailyTotals creates a new instance of DailyTotals
+		currentDailyTotals, err := scanDailyTotals(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfDailyTotals = &currentDailyTotals
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfDailyTotals, nil
+}
+
+// SingleDailyTotals runs Single of DailyTotals in the transaction,
+// as txWrapper.DB().DailyTotals.Single(...) does.
+func (txWrapper *Transaction) SingleDailyTotals(ctx context.Context, where Predicate, options ...SelectOption) (*DailyTotals, error) {
+	return NewDB(txWrapper).DailyTotals.Single(ctx, where, options...)
+}
+
+// DailyTotalsRepository holds the methods of Views.DailyTotals, so that the code using them
+// can depend on the interface, and be unit tested with a DailyTotalsRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type DailyTotalsRepository interface {
+	RefreshMaterializedView(ctx context.Context) error
+	RefreshMaterializedViewConcurrently(ctx context.Context) error
+	Select(ctx context.Context, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectUnion(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectUnionAll(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectCached(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectPage(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectPageCached(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectAll(ctx context.Context, options ...SelectOption) ([]DailyTotals, error)
+	SelectAllOrderBy(ctx context.Context, orderBy string, options ...SelectOption) ([]DailyTotals, error)
+	SelectAllPage(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]DailyTotals, error)
+	Count(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise(ctx context.Context) (int64, error)
+	Single(ctx context.Context, where Predicate, options ...SelectOption) (*DailyTotals, error)
+}
+
+var _ DailyTotalsRepository = (*tDailyTotalsUtils)(nil)
+
+// DailyTotalsRepositoryMock is a DailyTotalsRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type DailyTotalsRepositoryMock struct {
+	MockCalls
+
+	RefreshMaterializedViewFunc             func(ctx context.Context) error
+	RefreshMaterializedViewConcurrentlyFunc func(ctx context.Context) error
+	SelectFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectUnionFunc                         func(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectUnionAllFunc                      func(ctx context.Context, where []Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectCachedFunc                        func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectPageFunc                          func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectPageCachedFunc                    func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]DailyTotals, error)
+	SelectAllFunc                           func(ctx context.Context, options ...SelectOption) ([]DailyTotals, error)
+	SelectAllOrderByFunc                    func(ctx context.Context, orderBy string, options ...SelectOption) ([]DailyTotals, error)
+	SelectAllPageFunc                       func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]DailyTotals, error)
+	CountFunc                               func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                      func(ctx context.Context) (int64, error)
+	SingleFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) (*DailyTotals, error)
+}
+
+var _ DailyTotalsRepository = (*DailyTotalsRepositoryMock)(nil)
+
+// RefreshMaterializedView records the call and runs RefreshMaterializedViewFunc
+func (mock *DailyTotalsRepositoryMock) RefreshMaterializedView(ctx context.Context) (result0 error) {
+	mock.Record("RefreshMaterializedView", ctx)
+	if mock.RefreshMaterializedViewFunc != nil {
+		return mock.RefreshMaterializedViewFunc(ctx)
+	}
+	return
+}
+
+// RefreshMaterializedViewConcurrently records the call and runs RefreshMaterializedViewConcurrentlyFunc
+func (mock *DailyTotalsRepositoryMock) RefreshMaterializedViewConcurrently(ctx context.Context) (result0 error) {
+	mock.Record("RefreshMaterializedViewConcurrently", ctx)
+	if mock.RefreshMaterializedViewConcurrentlyFunc != nil {
+		return mock.RefreshMaterializedViewConcurrentlyFunc(ctx)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *DailyTotalsRepositoryMock) Select(ctx context.Context, where Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("Select", ctx, where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *DailyTotalsRepositoryMock) SelectUnion(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectUnion", ctx, where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *DailyTotalsRepositoryMock) SelectUnionAll(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectUnionAll", ctx, where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *DailyTotalsRepositoryMock) SelectCached(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectCached", ctx, cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *DailyTotalsRepositoryMock) SelectPage(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectPage", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *DailyTotalsRepositoryMock) SelectPageCached(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectPageCached", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *DailyTotalsRepositoryMock) SelectAll(ctx context.Context, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectAll", ctx, options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *DailyTotalsRepositoryMock) SelectAllOrderBy(ctx context.Context, orderBy string, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectAllOrderBy", ctx, orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *DailyTotalsRepositoryMock) SelectAllPage(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []DailyTotals, result1 error) {
+	mock.Record("SelectAllPage", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *DailyTotalsRepositoryMock) Count(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", ctx, where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *DailyTotalsRepositoryMock) CountImprecise(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImprecise", ctx)
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *DailyTotalsRepositoryMock) Single(ctx context.Context, where Predicate, options ...SelectOption) (result0 *DailyTotals, result1 error) {
+	mock.Record("Single", ctx, where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(ctx, where, options...)
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"context"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+//
+// DB transaction-related types and functionality
+//
+
+// Transaction isolation levels for the pgx package
+const (
+	IsoLevelSerializable    = pgx.Serializable
+	IsoLevelRepeatableRead  = pgx.RepeatableRead
+	IsoLevelReadCommitted   = pgx.ReadCommitted
+	IsoLevelReadUncommitted = pgx.ReadUncommitted
+)
+
+// Transaction is a wrapper structure over the pgx transaction package, to avoid importing
+// that package in the generated table-to-struct files.
+type Transaction struct {
+	Tx pgx.Tx
+}
+
+// Commit commits the current transaction
+func (t *Transaction) Commit(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Commit()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Commit(ctx)
+}
+
+// Rollback attempts to rollback the current transaction
+func (t *Transaction) Rollback(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Rollback()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Rollback(ctx)
+}
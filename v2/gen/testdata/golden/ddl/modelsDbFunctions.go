@@ -0,0 +1,150 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+)
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tFunctionUtils struct {
+
+	// the querier the functions run on, GetDb() when nil, see NewDB
+	querier Querier
+}
+
+var Functions tFunctionUtils
+
+// ActiveUsers is ActiveUsersCtx with the background context
+func (utilRef *tFunctionUtils) ActiveUsers() (returnVal []Users, err error) {
+	return utilRef.ActiveUsersCtx(context.Background())
+}
+
+// Wrapper over the function named active_users
+
+func (utilRef *tFunctionUtils) ActiveUsersCtx(ctx context.Context) (returnVal []Users, err error) {
+
+	var errorPrefix = "tFunctionUtils.ActiveUsers() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "active_users")
+	//queryParts = append(queryParts, "(  )")
+	queryParts = append(queryParts, "(  )")
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""))
+
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" fatal error running the function statement:", err)
+	}
+	defer rows.Close()
+
+	//BEGIN: non void operations
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableFirstName pgtype.Text
+	var nullableCurrentMood pgtype.Text
+	var nullableTags pgtype.Text
+	var nullableBalance Numeric
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	for rows.Next() {
+
+		// create a new variable of type Users
+		var currentUsers Users
+
+		// BEGIN: User-defined return type collection (slice)
+
+		err := rows.Scan(&currentUsers.Id, &currentUsers.UserGuid, &currentUsers.Email, &nullableFirstName, &currentUsers.CreatedAt, &nullableCurrentMood, &nullableTags, &nullableBalance, &currentUsers.Profile)
+		if err != nil {
+			return returnVal, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
+		currentUsers.SetFirstName(nullableFirstName.String, boolFromStatus(nullableFirstName.Status))
+		currentUsers.SetCurrentMood(nullableCurrentMood.String, boolFromStatus(nullableCurrentMood.Status))
+		currentUsers.SetTags(nullableTags.String, boolFromStatus(nullableTags.Status))
+		currentUsers.SetBalance(nullableBalance.NumericVal(), boolFromStatus(nullableBalance.Status))
+
+		// END: assign any nullable values to the nullable fields inside the struct appropriately
+
+		// END: User-defined (internal types) return type collection (slice)
+
+		// a set is returned (expect one or more records)
+		returnVal = append(returnVal, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	//END: non void operations
+
+	return returnVal, nil
+
+}
+
+// AddEm is AddEmCtx with the background context
+func (utilRef *tFunctionUtils) AddEm(paramArg1 int32, paramB int32) (returnVal int32, err error, isDbNull bool) {
+	return utilRef.AddEmCtx(context.Background(), paramArg1, paramB)
+}
+
+// Wrapper over the function named add_em
+/* Database comments: adds */
+// For pure Go return types, a true isDbNull return parameter indicates that
+// the actual value returned from the database was nil, not the default value of the Go type
+func (utilRef *tFunctionUtils) AddEmCtx(ctx context.Context, paramArg1 int32, paramB int32) (returnVal int32, err error, isDbNull bool) {
+
+	var errorPrefix = "tFunctionUtils.AddEm() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "add_em")
+	//queryParts = append(queryParts, "(  := $1,b := $2 )")
+	queryParts = append(queryParts, "( $1,$2 )")
+
+	// we are aiming for a single row so we will use Query Row
+
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts, ""), paramArg1, paramB).Scan(&returnVal)
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		err = nil
+		return
+	case err != nil:
+		return
+	default:
+		//BEGIN: non void operations
+
+		//todo
+
+		return
+		//END: non void operations
+	}
+
+}
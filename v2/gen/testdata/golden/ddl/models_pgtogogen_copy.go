@@ -1,6 +1,4 @@
-package main
-
-const BASE_BULK_COPY = `package {{.PackageName}}
+package models
 
 /* ************************************************************* */
 /* This file was automatically generated by pgtogogen.           */
@@ -8,44 +6,44 @@ const BASE_BULK_COPY = `package {{.PackageName}}
 /* ************************************************************* */
 
 import (
-	"bytes"	
+	"bytes"
 	"encoding/csv"
 	"fmt"
 	"io"
 	"strings"
 	"time"
-	
-	pgx "{{.PgxImport}}"	
-	pgtype "{{.PgTypeImport}}"	
+
+	pgtype "github.com/jackc/pgx/pgtype"
+	pgx "github.com/jackc/pgx/v4"
 )
 
 // CopyFromReaderOptions allows customizing the parsing of the bulk copy
 // line input when using CopyFromReader.
 type CopyFromReaderOptions struct {
 	// when not 0, Comment allows skipping lines that start with it (no whitespace allowed before it)
-	Comment rune 
-	
-	Separator rune
+	Comment rune
+
+	Separator        rune
 	NullPlaceholders []string
-	
+
 	// if true, include the PK columns when explicit columns are not specified
-	IncludePKCols bool 
+	IncludePKCols bool
 }
 
 // NewCopyFromReaderOptions instantiates a new *CopyFromReaderOptions with
 // the provided options.
-func NewCopyFromReaderOptions(separator rune, nullPlaceholders []string, 
-				comment rune, includePKCols bool) *CopyFromReaderOptions {
+func NewCopyFromReaderOptions(separator rune, nullPlaceholders []string,
+	comment rune, includePKCols bool) *CopyFromReaderOptions {
 	return &CopyFromReaderOptions{
-		Comment: comment,
-		Separator: separator, 
+		Comment:          comment,
+		Separator:        separator,
 		NullPlaceholders: nullPlaceholders,
-		IncludePKCols: includePKCols,
-	}	 
+		IncludePKCols:    includePKCols,
+	}
 }
 
 var defCommentRune rune = ZeroRune
-var defNullPlaceholders = [2]string {"[null]","[nil]"}
+var defNullPlaceholders = [2]string{"[null]", "[nil]"}
 var defSeparator rune = ','
 var defIncludePKCols = false
 
@@ -66,39 +64,38 @@ var defIncludePKCols = false
 // If a non-nil nullPlaceholder is specified, the db null value will be used
 // for that column if that value is encountered (including the empty string).
 //
-//
 // Example of a valid, parseable line with six columns (varchar, integer, jsonb, text, bool, date),
 // "Hello, friend", 932, "{"age":34}", "No comments", false, "2010-10-20"
 //
 // Example of usage for a table with the above-mentioned columns
 // imported from a comma-separated reader:
 //
-//  nullVals := []string {"[!null]"}
-//	copySourceReader, err := CopyFromReader(r, CommaSeparator, nullVals, ZeroRune,
-// 								"varchar", "integer", "jsonb","text","bool","date")
-//	if err != nil {
-//		return err
-//	}
-//	copyCount, err := currentDbHandle.CopyFrom(context.Background(), pgx.Identifier{"gaga_test_table"},
-//		[]string{"greeting", "age", "meta_info", "comments", "is_enabled", "date_created"}, copySourceReader)
-//	if err != nil {
-//		return err
-//	}
-//	fmt.Println("Records copied:",copyCount)
-func CopyFromReader(rdr io.Reader, separator rune, nullPlaceholders []string, 
-		commentRune rune, dbtypes ...string) (pgx.CopyFromSource, error) {
+//	 nullVals := []string {"[!null]"}
+//		copySourceReader, err := CopyFromReader(r, CommaSeparator, nullVals, ZeroRune,
+//									"varchar", "integer", "jsonb","text","bool","date")
+//		if err != nil {
+//			return err
+//		}
+//		copyCount, err := currentDbHandle.CopyFrom(context.Background(), pgx.Identifier{"gaga_test_table"},
+//			[]string{"greeting", "age", "meta_info", "comments", "is_enabled", "date_created"}, copySourceReader)
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println("Records copied:",copyCount)
+func CopyFromReader(rdr io.Reader, separator rune, nullPlaceholders []string,
+	commentRune rune, dbtypes ...string) (pgx.CopyFromSource, error) {
 
 	if len(dbtypes) == 0 {
 		return nil, fmt.Errorf("CopyFromReader: Missing db types definitions")
 	}
 	c := &copyFromReader{
-		lineReader:        		csv.NewReader(rdr),
-		separator:         		separator,
-		comment:				commentRune,
-		nullPlaceholders:    	nullPlaceholders,
-		useNullPlaceholders: 	(nullPlaceholders != nil && len(nullPlaceholders) > 0),
-		idx:                	-1,
-		dbtypes:            	dbtypes,
+		lineReader:          csv.NewReader(rdr),
+		separator:           separator,
+		comment:             commentRune,
+		nullPlaceholders:    nullPlaceholders,
+		useNullPlaceholders: (nullPlaceholders != nil && len(nullPlaceholders) > 0),
+		idx:                 -1,
+		dbtypes:             dbtypes,
 	}
 	c.lineReader.Comma = separator
 	c.lineReader.Comment = commentRune
@@ -125,15 +122,15 @@ var bEmptyStringSingleQuotes = []byte("''")
 var bDotComparatorSlice = []byte(".")
 
 type copyFromReader struct {
-	lineReader         		*csv.Reader
-	separator				rune
-	comment			    	rune
-	useNullPlaceholders 	bool
-	nullPlaceholders		[]string
-	idx                		int
-	dbtypes            		[]string
-	currRowErr         		error
-	currRow            		[]string
+	lineReader          *csv.Reader
+	separator           rune
+	comment             rune
+	useNullPlaceholders bool
+	nullPlaceholders    []string
+	idx                 int
+	dbtypes             []string
+	currRowErr          error
+	currRow             []string
 }
 
 func (ctr *copyFromReader) Next() bool {
@@ -340,7 +337,7 @@ var pgTypesFuncMap map[string]func(present bool) pgtype.Value = map[string]func(
 	},
 	"character varying": func(present bool) pgtype.Value {
 		return &pgtype.Text{Status: getStatusFromBool(present)}
-	},	
+	},
 	"cid": func(present bool) pgtype.Value { return &pgtype.CID{Status: getStatusFromBool(present)} },
 	"cidr": func(present bool) pgtype.Value {
 		return &pgtype.CIDR{Status: getStatusFromBool(present)}
@@ -435,13 +432,13 @@ var pgTypesFuncMap map[string]func(present bool) pgtype.Value = map[string]func(
 	},
 	"timestamp without time zone": func(present bool) pgtype.Value {
 		return &pgtype.Timestamp{Status: getStatusFromBool(present)}
-	},	
+	},
 	"timestamptz": func(present bool) pgtype.Value {
 		return &pgtype.Timestamptz{Status: getStatusFromBool(present)}
 	},
 	"timestamp with time zone": func(present bool) pgtype.Value {
 		return &pgtype.Timestamptz{Status: getStatusFromBool(present)}
-	},	
+	},
 	"tsrange": func(present bool) pgtype.Value {
 		return &pgtype.Tsrange{Status: getStatusFromBool(present)}
 	},
@@ -470,74 +467,3 @@ func getStatusFromBool(present bool) pgtype.Status {
 		return pgtype.Null
 	}
 }
-`
-
-/* Insert Functions Templates */
-
-const TABLE_STATIC_BULK_COPY_TEMPLATE = `{{$colCount := len .Columns}}{{$pkColCount := len .PKColumns}}
-{{$functionName := "CopyFromReader"}}{{$sourceStructName := print "source" .GoFriendlyName}}
-// {{$functionName}} performs a bulk copy of csv-like content from the specified reader 
-// into the {{.DbName}} table. The opt parameter is a *CopyFromReaderOptions which
-// allows the caller to specify the separator and the null placeholder. 
-// In case nil is passed as null placeholder, for empty strings, the actual empty string value
-// will be inserted, instead of a db null value.
-// The method returns the number of records inserted after a successful copy operation.
-func (utilRef *t{{.GoFriendlyName}}Utils) {{$functionName}}(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
-						
-	var errorPrefix = "{{.GoFriendlyName}}Utils.{{$functionName}}() ERROR: "
-
-	if r == nil {
-		return 0, NewModelsErrorLocal(errorPrefix, "the reader r is nil")
-	}
-	
-	var optSeparator rune
-	var optNullPlaceholders []string
-	var optComment rune
-	var optIncludePKCols bool
-	if opt == nil {
-		optSeparator = defSeparator
-		optNullPlaceholders = defNullPlaceholders[:]
-		optComment = defCommentRune
-		optIncludePKCols = defIncludePKCols
-	} else {
-		optSeparator = opt.Separator
-		optNullPlaceholders = opt.NullPlaceholders
-		optComment = opt.Comment
-		optIncludePKCols = opt.IncludePKCols	
-	}
-	
-	currentDbHandle := GetDb()
-	if currentDbHandle == nil {
-		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
-	}
-		
-	var err error
-
-	// Slices that hold the column database names and their db types
-	var colDbNames []string
-	var colDbTypes []string
-	
-	// If no custom column mask was provided, assume the all the columns are a target
-	if len(columns) == 0 {
-		if optIncludePKCols {
-			colDbNames = []string { {{range $i, $e := .Columns}}"{{$e.DbName}}"{{if ne (plus1 $i) $colCount}},{{end}} {{end}} }
-			colDbTypes = []string { {{range $i, $e := .Columns}}"{{$e.Type}}"{{if ne (plus1 $i) $colCount}},{{end}} {{end}} }
-		} else {
-			colDbNames = []string { {{range $i, $e := .Columns}}{{if and (not $e.IsPK) (not $e.IsCompositePK)}}"{{$e.DbName}}"{{if ne (plus1 $i) $colCount}},{{end}} {{end}}{{end}} }
-			colDbTypes = []string { {{range $i, $e := .Columns}}{{if and (not $e.IsPK) (not $e.IsCompositePK)}}"{{$e.Type}}"{{if ne (plus1 $i) $colCount}},{{end}} {{end}}{{end}} }
-		}		
-	} else {
-		// Range through the custom columns and obtain the db name and db type
-		for i := range columns {	
-			colDbNames = append(colDbNames, Tables.{{.GoFriendlyName}}.ToDbFieldName(columns[i]))
-			colDbTypes = append(colDbTypes, Tables.{{.GoFriendlyName}}.ToDbFieldTypeFromColName(columns[i]))						
-		}
-	}
-	
-	copySourceReader, err := CopyFromReader(r, optSeparator, optNullPlaceholders, optComment, colDbTypes...)
-	if err != nil {
-		return 0, err
-	}
-	return currentDbHandle.CopyFrom(context.Background(), pgx.Identifier{"{{.DbName}}"},colDbNames, copySourceReader)	
-}
-`
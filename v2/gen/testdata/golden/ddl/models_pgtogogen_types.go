@@ -1,6 +1,4 @@
-package main
-
-const BASE_DB_TYPES = `package {{.PackageName}}
+package models
 
 /* ************************************************************* */
 /* This file was automatically generated by pgtogogen.           */
@@ -9,39 +7,39 @@ const BASE_DB_TYPES = `package {{.PackageName}}
 
 import (
 	"math/big"
-	pgtype "{{.PgTypeImport}}"	
+
+	pgtype "github.com/jackc/pgx/pgtype"
 )
 
 //
 // DB custom type, aliases and type-related helper functions
 //
 
-// JSON is a wrapper struct that embeds the pgtype nullable JSON type, 
+// JSON is a wrapper struct that embeds the pgtype nullable JSON type,
 // and offers additional string-rendering methods.
 type JSON struct {
 	pgtype.JSON
 }
 
-func (j *JSON)String() string { return string(j.Bytes) }
+func (j *JSON) String() string { return string(j.Bytes) }
 
-// JSONB is a wrapper struct that embeds the pgtype nullable JSONB type, 
+// JSONB is a wrapper struct that embeds the pgtype nullable JSONB type,
 // and offers additional string-rendering methods.
 type JSONB struct {
 	pgtype.JSONB
 }
 
-func (j *JSONB)String() string { return string(j.Bytes) }
+func (j *JSONB) String() string { return string(j.Bytes) }
 
-// Numeric is a wrapper struct that embeds the pgtype nullable Numeric type, 
+// Numeric is a wrapper struct that embeds the pgtype nullable Numeric type,
 // and offers additional assignment and rendering methods
 type Numeric struct {
 	pgtype.Numeric
 }
 
-func (n *Numeric)NumericVal() Numeric { return *n }
-
-func (n *Numeric)EmbeddedVal() *pgtype.Numeric { return &n.Numeric }
+func (n *Numeric) NumericVal() Numeric { return *n }
 
+func (n *Numeric) EmbeddedVal() *pgtype.Numeric { return &n.Numeric }
 
 // Nullable field status constants
 const cFIELD_VALUE_UNDEFINED pgtype.Status = pgtype.Undefined
@@ -95,7 +93,7 @@ func To_Numeric_FromString(numericStr string) (Numeric, error) {
 	if numericStr == "" {
 		return n, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
 	}
-	
+
 	err := n.Set(numericStr)
 	if err != nil {
 		return n, err
@@ -104,7 +102,7 @@ func To_Numeric_FromString(numericStr string) (Numeric, error) {
 }
 
 // LessComparatorFor_Numeric is a sort comparator function for the Numeric type
-func LessComparatorFor_Numeric(first, second Numeric) bool { return cmpNumeric(first,second) }
+func LessComparatorFor_Numeric(first, second Numeric) bool { return cmpNumeric(first, second) }
 
 var big0 *big.Int = big.NewInt(0)
 var big1 *big.Int = big.NewInt(1)
@@ -115,7 +113,7 @@ func cmpNumeric(first, second Numeric) bool {
 	if first.Status != cFIELD_VALUE_PRESENT {
 		return true
 	}
-	
+
 	if second.Status != cFIELD_VALUE_PRESENT {
 		return false
 	}
@@ -125,9 +123,7 @@ func cmpNumeric(first, second Numeric) bool {
 	//   -1 if x <  y
 	//    0 if x == y
 	//   +1 if x >  y
-	//	
+	//
 	cmpInts := first.Int.Cmp(second.Int)
 	return cmpInts == -1
 }
-
-`
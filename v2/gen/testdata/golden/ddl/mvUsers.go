@@ -0,0 +1,1875 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+	"sync"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+)
+
+const MvUsers_DB_VIEW_NAME string = "mv_users"
+
+// MvUsers is a structure that corresponds to the mv_users view.
+type MvUsers struct {
+	// database field name: id
+	Id           int32
+	Id_IsNotNull bool // if true, it means the value is not null
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+/* Sorting helper containers */
+
+// SortMvUsersById implements sort.Interface for []MvUsers based on
+// the Id field. Usage: sort.Sort(SortMvUsersById(anyGivenMvUsersSlice))
+type SortMvUsersById []MvUsers
+
+func (a SortMvUsersById) Len() int           { return len(a) }
+func (a SortMvUsersById) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortMvUsersById) Less(i, j int) bool { return LessComparatorFor_int32(a[i].Id, a[j].Id) }
+
+func (t *MvUsers) SetId(val int32, notNull bool) {
+	t.Id = val
+	t.Id_IsNotNull = notNull
+}
+
+// fake, internal type to allow a singleton structure that would hold static-like methods
+type tMvUsersUtils struct {
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForMvUsers structure
+	Cache CacheForMvUsers
+}
+
+// RefreshMaterializedView is RefreshMaterializedViewCtx with the background context
+func (utilRef *tMvUsersUtils) RefreshMaterializedView() error {
+	return utilRef.RefreshMaterializedViewCtx(context.Background())
+}
+
+// RefreshMaterializedViewCtx refreshes the materialized view and updates it with the latest data
+// from the underlying data entities.
+func (utilRef *tMvUsersUtils) RefreshMaterializedViewCtx(ctx context.Context) error {
+
+	var errorPrefix = "MvUsersUtils.RefreshMaterializedView() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW mv_users;")
+	if err != nil {
+		return NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	return nil
+
+}
+
+// RefreshMaterializedViewConcurrently is RefreshMaterializedViewConcurrentlyCtx with the background context
+func (utilRef *tMvUsersUtils) RefreshMaterializedViewConcurrently() error {
+	return utilRef.RefreshMaterializedViewConcurrentlyCtx(context.Background())
+}
+
+// RefreshMaterializedView refreshes the materialized view concurrently, and updates it with the
+// latest data from the underlying data entities. A concurrent refresh means that the view
+// is accessible to reading by other threads, but it may take longer than the non-concurrent
+// operation. This refresh mode is only available in Postgres versions 9.4 and higher and
+// it will fail unless at least one unique index, without a WHERE clause is defined on the view.
+func (utilRef *tMvUsersUtils) RefreshMaterializedViewConcurrentlyCtx(ctx context.Context) error {
+
+	var errorPrefix = "MvUsersUtils.RefreshMaterializedViewConcurrently() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	_, err := currentDbHandle.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY mv_users;")
+	if err != nil {
+		return NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	return nil
+
+}
+
+// LoadedColumns returns the columns the MvUsers was selected with by WithColumns, nil when it holds all of them
+func (t *MvUsers) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanMvUsers scans a row of the columns of the projection, all of them when it is nil, into a new MvUsers
+func scanMvUsers(scan func(dest ...interface{}) error, projection *columnProjection) (MvUsers, error) {
+
+	currentMvUsers := MvUsers{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableId pgtype.Int4
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&nullableId}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "id":
+				dest = append(dest, &nullableId)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentMvUsers, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("id") {
+		currentMvUsers.SetId(nullableId.Int, boolFromStatus(nullableId.Status))
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentMvUsers, nil
+}
+
+// MvUsersCols holds the columns of mv_users, building the predicates and the ordering of the queries,
+// e.g. MvUsersCols.Id.Eq(value)
+var MvUsersCols = struct {
+	Id QueryColumn
+}{
+	Id: QueryColumn{name: "id"},
+}
+
+// isMvUsersColumn tells whether the database name is one of the columns of mv_users
+func isMvUsersColumn(dbName string) bool {
+
+	switch dbName {
+	case "id":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tMvUsersUtils) Select(where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from mv_users matching the predicate, e.g.
+// MvUsersCols.Id.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tMvUsersUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from mv_users,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tMvUsersUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from mv_users,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tMvUsersUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from mv_users matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentMvUsersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentMvUsersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfMvUsers)
+		}
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tMvUsersUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from mv_users matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tMvUsersUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from mv_users matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from mv_users")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentMvUsersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentMvUsersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfMvUsers)
+		}
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tMvUsersUtils) SelectAll(options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from mv_users.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	// try to get the rows from cache, if enabled and valid
+	if allMvUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allMvUsersRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tMvUsersUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from mv_users ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of MvUsers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allMvUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allMvUsersRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tMvUsersUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from mv_users equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of MvUsers instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tMvUsersUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allMvUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allMvUsersRowsFromCache) {
+			pageStart = len(allMvUsersRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allMvUsersRowsFromCache) {
+			pageEnd = len(allMvUsersRowsFromCache)
+		}
+
+		return allMvUsersRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfMvUsers []MvUsers
+
+	for rows.Next() {
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfMvUsers = append(sliceOfMvUsers, currentMvUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfMvUsers, nil
+}
+
+// SelectMvUsers is SelectMvUsersCtx with the background context
+func (txWrapper *Transaction) SelectMvUsers(where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return txWrapper.SelectMvUsersCtx(context.Background(), where, options...)
+}
+
+// SelectMvUsersCtx runs SelectCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectMvUsersCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedMvUsers is SelectCachedMvUsersCtx with the background context
+func (txWrapper *Transaction) SelectCachedMvUsers(cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return txWrapper.SelectCachedMvUsersCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedMvUsersCtx runs SelectCachedCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedMvUsersCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageMvUsers is SelectPageMvUsersCtx with the background context
+func (txWrapper *Transaction) SelectPageMvUsers(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return txWrapper.SelectPageMvUsersCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageMvUsersCtx runs SelectPageCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageMvUsersCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedMvUsers is SelectPageCachedMvUsersCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedMvUsers(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return txWrapper.SelectPageCachedMvUsersCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedMvUsersCtx runs SelectPageCachedCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedMvUsersCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllMvUsers is SelectAllMvUsersCtx with the background context
+func (txWrapper *Transaction) SelectAllMvUsers(options ...SelectOption) ([]MvUsers, error) {
+	return txWrapper.SelectAllMvUsersCtx(context.Background(), options...)
+}
+
+// SelectAllMvUsersCtx runs SelectAllCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllMvUsersCtx(ctx context.Context, options ...SelectOption) ([]MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for MvUsers         */
+/* ************************************************************ */
+
+type CacheForMvUsers struct {
+	enabled bool // flag to determine if caching is enabled for MvUsers
+
+	sliceCache      map[string][]MvUsers
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]MvUsers
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]MvUsers
+	singleRowCacheMutex sync.RWMutex
+
+	all      []MvUsers
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Views.MvUsers when nil
+	utils *tMvUsersUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForMvUsers) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]MvUsers)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]MvUsers)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]MvUsers)
+	}
+
+}
+
+func (c *CacheForMvUsers) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForMvUsers) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForMvUsers) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForMvUsers) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForMvUsers) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for mv_users and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForMvUsers) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Views.MvUsers
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForMvUsers) GetAllRows() ([]MvUsers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all MvUsers records in the database.
+func (c *CacheForMvUsers) SetAllRows(all []MvUsers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all MvUsers records in the database.
+func (c *CacheForMvUsers) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForMvUsers) GetWhere(key string) ([]MvUsers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wMvUsers, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wMvUsers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForMvUsers) SetWhere(key string, sliceMvUsers []MvUsers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceMvUsers != nil {
+
+			whereSliceCopy := make([]MvUsers, len(sliceMvUsers))
+			copy(whereSliceCopy, sliceMvUsers)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForMvUsers) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of MvUsers from the cache store based on
+// the given key.
+func (c *CacheForMvUsers) GetSlice(key string) ([]MvUsers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sMvUsers, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sMvUsers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of MvUsers inside the cache store based and
+// associates it with the given key.
+func (c *CacheForMvUsers) SetSlice(key string, sliceMvUsers []MvUsers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceMvUsers != nil {
+
+			sliceCopy := make([]MvUsers, len(sliceMvUsers))
+			copy(sliceCopy, sliceMvUsers)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of MvUsers from the cache store entry
+// associated with key.
+func (c *CacheForMvUsers) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *MvUsers from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForMvUsers) Get(key string) (*MvUsers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a MvUsers with key, and saves it in the cache store.
+func (c *CacheForMvUsers) Set(key string, structMvUsers MvUsers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structMvUsers
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the MvUsers instance that is associated with key from the
+// cache store.
+func (c *CacheForMvUsers) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for MvUsers           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tMvUsersUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from mv_users matching all the predicates, e.g.
+// MvUsersCols.Id.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tMvUsersUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "MvUsersUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM mv_users"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tMvUsersUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from mv_users
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tMvUsersUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "MvUsersUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.mv_users'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tMvUsersUtils) Single(where Predicate, options ...SelectOption) (*MvUsers, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from mv_users matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tMvUsersUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*MvUsers, error) {
+
+	var errorPrefix = "MvUsersUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isMvUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id FROM mv_users ", "mv_users")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfMvUsers *MvUsers
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanMvUsers creates a new instance of MvUsers
+		currentMvUsers, err := scanMvUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfMvUsers = &currentMvUsers
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfMvUsers, nil
+}
+
+// SingleMvUsers is SingleMvUsersCtx with the background context
+func (txWrapper *Transaction) SingleMvUsers(where Predicate, options ...SelectOption) (*MvUsers, error) {
+	return txWrapper.SingleMvUsersCtx(context.Background(), where, options...)
+}
+
+// SingleMvUsersCtx runs SingleCtx of MvUsers in the transaction,
+// as txWrapper.DB().MvUsers.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleMvUsersCtx(ctx context.Context, where Predicate, options ...SelectOption) (*MvUsers, error) {
+	return NewDB(txWrapper).MvUsers.SingleCtx(ctx, where, options...)
+}
+
+// MvUsersRepository holds the methods of Views.MvUsers, so that the code using them
+// can depend on the interface, and be unit tested with a MvUsersRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type MvUsersRepository interface {
+	RefreshMaterializedView() error
+	RefreshMaterializedViewCtx(ctx context.Context) error
+	RefreshMaterializedViewConcurrently() error
+	RefreshMaterializedViewConcurrentlyCtx(ctx context.Context) error
+	Select(where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectAll(options ...SelectOption) ([]MvUsers, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]MvUsers, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*MvUsers, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*MvUsers, error)
+}
+
+var _ MvUsersRepository = (*tMvUsersUtils)(nil)
+
+// MvUsersRepositoryMock is a MvUsersRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type MvUsersRepositoryMock struct {
+	MockCalls
+
+	RefreshMaterializedViewFunc                func() error
+	RefreshMaterializedViewCtxFunc             func(ctx context.Context) error
+	RefreshMaterializedViewConcurrentlyFunc    func() error
+	RefreshMaterializedViewConcurrentlyCtxFunc func(ctx context.Context) error
+	SelectFunc                                 func(where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCtxFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionFunc                            func(where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionCtxFunc                         func(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionAllFunc                         func(where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectUnionAllCtxFunc                      func(ctx context.Context, where []Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCachedFunc                           func(cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectCachedCtxFunc                        func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageFunc                             func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCtxFunc                          func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCachedFunc                       func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectPageCachedCtxFunc                    func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]MvUsers, error)
+	SelectAllFunc                              func(options ...SelectOption) ([]MvUsers, error)
+	SelectAllCtxFunc                           func(ctx context.Context, options ...SelectOption) ([]MvUsers, error)
+	SelectAllOrderByFunc                       func(orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllOrderByCtxFunc                    func(ctx context.Context, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllPageFunc                          func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	SelectAllPageCtxFunc                       func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]MvUsers, error)
+	CountFunc                                  func(where ...Predicate) (int64, error)
+	CountCtxFunc                               func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                         func() (int64, error)
+	CountImpreciseCtxFunc                      func(ctx context.Context) (int64, error)
+	SingleFunc                                 func(where Predicate, options ...SelectOption) (*MvUsers, error)
+	SingleCtxFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) (*MvUsers, error)
+}
+
+var _ MvUsersRepository = (*MvUsersRepositoryMock)(nil)
+
+// RefreshMaterializedView records the call and runs RefreshMaterializedViewFunc
+func (mock *MvUsersRepositoryMock) RefreshMaterializedView() (result0 error) {
+	mock.Record("RefreshMaterializedView")
+	if mock.RefreshMaterializedViewFunc != nil {
+		return mock.RefreshMaterializedViewFunc()
+	}
+	return
+}
+
+// RefreshMaterializedViewCtx records the call and runs RefreshMaterializedViewCtxFunc
+func (mock *MvUsersRepositoryMock) RefreshMaterializedViewCtx(ctx context.Context) (result0 error) {
+	mock.Record("RefreshMaterializedViewCtx", ctx)
+	if mock.RefreshMaterializedViewCtxFunc != nil {
+		return mock.RefreshMaterializedViewCtxFunc(ctx)
+	}
+	return
+}
+
+// RefreshMaterializedViewConcurrently records the call and runs RefreshMaterializedViewConcurrentlyFunc
+func (mock *MvUsersRepositoryMock) RefreshMaterializedViewConcurrently() (result0 error) {
+	mock.Record("RefreshMaterializedViewConcurrently")
+	if mock.RefreshMaterializedViewConcurrentlyFunc != nil {
+		return mock.RefreshMaterializedViewConcurrentlyFunc()
+	}
+	return
+}
+
+// RefreshMaterializedViewConcurrentlyCtx records the call and runs RefreshMaterializedViewConcurrentlyCtxFunc
+func (mock *MvUsersRepositoryMock) RefreshMaterializedViewConcurrentlyCtx(ctx context.Context) (result0 error) {
+	mock.Record("RefreshMaterializedViewConcurrentlyCtx", ctx)
+	if mock.RefreshMaterializedViewConcurrentlyCtxFunc != nil {
+		return mock.RefreshMaterializedViewConcurrentlyCtxFunc(ctx)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *MvUsersRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *MvUsersRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *MvUsersRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *MvUsersRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *MvUsersRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *MvUsersRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *MvUsersRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *MvUsersRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *MvUsersRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *MvUsersRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *MvUsersRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *MvUsersRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *MvUsersRepositoryMock) SelectAll(options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *MvUsersRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *MvUsersRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *MvUsersRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *MvUsersRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *MvUsersRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []MvUsers, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *MvUsersRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *MvUsersRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *MvUsersRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *MvUsersRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *MvUsersRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *MvUsers, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *MvUsersRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *MvUsers, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
@@ -0,0 +1,22 @@
+package models
+
+/* *********************************************************** **/
+/* This file is generated by pgtogogen FIRST-TIME ONLY.         */
+/* It will not subsequently overwrite it if it already exists.  */
+/* Use this file to create your custom extension functionality. */
+/* ************************************************************ */
+
+/*
+import (
+
+)
+*/
+
+// Implements the Validator interface.
+func (t *Roles) Validate() (bool, []error) {
+
+	// Returns true for now.
+	// Todo: modify as needed
+	return true, nil
+
+}
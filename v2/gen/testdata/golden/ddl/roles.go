@@ -0,0 +1,4141 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+	pgx "github.com/jackc/pgx/v4"
+)
+
+const Roles_DB_TABLE_NAME string = "roles"
+
+// Roles is a struct that corresponds to the roles table.
+type Roles struct {
+	RoleId int32 // database field name: role_id, IsPK: true , IsCompositePK: false, IsFK: false
+
+	Name string // database field name: name, IsPK: false , IsCompositePK: false, IsFK: false
+
+	UserId           int32 // database field name: user_id, IsPK: false , IsCompositePK: false, IsFK: false
+	UserId_IsNotNull bool  // if true, it means the value is not null
+
+	Archived bool // database field name: archived, IsPK: false , IsCompositePK: false, IsFK: false
+
+	Priority           float64 // database field name: priority, IsPK: false , IsCompositePK: false, IsFK: false
+	Priority_IsNotNull bool    // if true, it means the value is not null
+
+	// Set this to true if you want Inserts to ignore the PK fields
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+// SetRoleId sets the RoleId field to val.
+func (t *Roles) SetRoleId(val int32) {
+	t.RoleId = val
+
+}
+
+// SetName sets the Name field to val.
+func (t *Roles) SetName(val string) {
+	t.Name = val
+
+}
+
+// SetUserId sets the UserId field to val.
+func (t *Roles) SetUserId(val int32, notNull bool) {
+	t.UserId = val
+	t.UserId_IsNotNull = notNull
+}
+
+// IsNotNull_UserId returns true is the field is not null (a value is present)
+func (t *Roles) IsNotNull_UserId() bool {
+	return t.UserId_IsNotNull
+}
+
+// IsNull_UserId returns true is the field is null (a value is not present)
+func (t *Roles) IsNull_UserId() bool {
+	return !t.UserId_IsNotNull
+}
+
+// SetArchived sets the Archived field to val.
+func (t *Roles) SetArchived(val bool) {
+	t.Archived = val
+
+}
+
+// SetPriority sets the Priority field to val.
+func (t *Roles) SetPriority(val float64, notNull bool) {
+	t.Priority = val
+	t.Priority_IsNotNull = notNull
+}
+
+// IsNotNull_Priority returns true is the field is not null (a value is present)
+func (t *Roles) IsNotNull_Priority() bool {
+	return t.Priority_IsNotNull
+}
+
+// IsNull_Priority returns true is the field is null (a value is not present)
+func (t *Roles) IsNull_Priority() bool {
+	return !t.Priority_IsNotNull
+}
+
+// MarkAllColumnsLoaded lets the instance Update of a Roles selected WithColumns write back all
+// its fields, the zero values of the columns left out included
+func (t *Roles) MarkAllColumnsLoaded() {
+	t.pgToGo_projection = nil
+}
+
+/* Roles slice manipulation helpers */
+
+// RolesSlice allows manipulation of a slice of Roles using the
+// attached methods. Simply cast []Roles to RolesSlice
+// e.g. RolesSlice(anyGivenRolesSlice)
+// and you have various methods available. Please note that these methods do not impact
+// the database in any way.
+type RolesSlice []Roles
+
+type RolesSliceFilterFunc func(c Roles) bool
+
+// Filter filters the original RolesSlice elements using the supplied
+// filterFunc closure. The caller must reassign the returned collection:
+//
+//	newSlice := Filter(filterFunc)
+func (cc RolesSlice) Filter(filterFunc RolesSliceFilterFunc) RolesSlice {
+	if cc == nil {
+		return nil
+	}
+	ccNew := make(RolesSlice, 0, len(cc))
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			ccNew = append(ccNew, cc[i])
+		}
+	}
+	return ccNew
+}
+
+// FilterInto filters the original RolesSlice collection into the supplied
+// dest RolesSlice. Passing the preallocated collection can potentially avoid
+// a huge number of allocations in a fast paced application.
+// The caller must reassign the returned collection to dest:
+//
+//	dest = FilterInto(filterFunc, dest)
+func (cc RolesSlice) FilterInto(filterFunc RolesSliceFilterFunc,
+	dest RolesSlice) RolesSlice {
+	if cc == nil {
+		dest = nil
+		return nil
+	}
+	if dest == nil {
+		return nil
+	}
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			dest = append(dest, cc[i])
+		}
+	}
+	return dest
+}
+
+/* Sorting helper containers */
+
+// SortRolesByRoleId implements sort.Interface for []Roles based on
+// the RoleId field. Usage: sort.Sort(SortRolesByRoleId(anyGivenRolesSlice))
+type SortRolesByRoleId []Roles
+
+func (a SortRolesByRoleId) Len() int      { return len(a) }
+func (a SortRolesByRoleId) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortRolesByRoleId) Less(i, j int) bool {
+	return LessComparatorFor_int32(a[i].RoleId, a[j].RoleId)
+}
+
+// SortRolesByName implements sort.Interface for []Roles based on
+// the Name field. Usage: sort.Sort(SortRolesByName(anyGivenRolesSlice))
+type SortRolesByName []Roles
+
+func (a SortRolesByName) Len() int           { return len(a) }
+func (a SortRolesByName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortRolesByName) Less(i, j int) bool { return LessComparatorFor_string(a[i].Name, a[j].Name) }
+
+// SortRolesByUserId implements sort.Interface for []Roles based on
+// the UserId field. Usage: sort.Sort(SortRolesByUserId(anyGivenRolesSlice))
+type SortRolesByUserId []Roles
+
+func (a SortRolesByUserId) Len() int      { return len(a) }
+func (a SortRolesByUserId) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortRolesByUserId) Less(i, j int) bool {
+	return LessComparatorFor_int32(a[i].UserId, a[j].UserId)
+}
+
+// SortRolesByArchived implements sort.Interface for []Roles based on
+// the Archived field. Usage: sort.Sort(SortRolesByArchived(anyGivenRolesSlice))
+type SortRolesByArchived []Roles
+
+func (a SortRolesByArchived) Len() int      { return len(a) }
+func (a SortRolesByArchived) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortRolesByArchived) Less(i, j int) bool {
+	return LessComparatorFor_bool(a[i].Archived, a[j].Archived)
+}
+
+// SortRolesByPriority implements sort.Interface for []Roles based on
+// the Priority field. Usage: sort.Sort(SortRolesByPriority(anyGivenRolesSlice))
+type SortRolesByPriority []Roles
+
+func (a SortRolesByPriority) Len() int      { return len(a) }
+func (a SortRolesByPriority) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortRolesByPriority) Less(i, j int) bool {
+	return LessComparatorFor_float64(a[i].Priority, a[j].Priority)
+}
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tRolesUtils struct {
+	utilMutex sync.RWMutex
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForRoles structure
+	Cache CacheForRoles
+}
+
+var ErrRoles_UQ_roles_name_key = NewModelsErrorLocalWithCode("Unique constraint violation:", "roles_name_key", "23505")
+
+// Creates a new pointer to a blank Roles structure.
+// Some of the fields, such as the time.Time ones, might be already set to time.Now()
+// based on the Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords setting
+func (utilRef *tRolesUtils) New() *Roles {
+
+	newRoles := &Roles{}
+
+	newRoles.CloneGlobalSettings()
+
+	return newRoles
+}
+
+// Creates a new pointer to a Roles from an Http Request.
+// The parameters are expected to match the struct field names
+func (utilRef *tRolesUtils) CreateFromHttpRequest(req *http.Request) (*Roles, error) {
+
+	var errorPrefix = "RolesUtils.CreateFromHttpRequest() ERROR: "
+
+	if req == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")
+	}
+
+	var err error = nil
+	newRoles := &Roles{}
+
+	newRoles.CloneGlobalSettings()
+
+	newRoles.RoleId, err = To_int32_FromString(req.FormValue("RoleId"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newRoles.Name = req.FormValue("Name")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newRoles.UserId, err = To_int32_FromString(req.FormValue("UserId"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("UserId") != "" {
+		newRoles.UserId_IsNotNull = true
+	}
+
+	newRoles.Archived, err = To_bool_FromString(req.FormValue("Archived"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newRoles.Priority, err = To_float64_FromString(req.FormValue("Priority"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Priority") != "" {
+		newRoles.Priority_IsNotNull = true
+	}
+
+	return newRoles, nil
+}
+
+// Creates a new pointer to a Roles from an Http Request.
+// The parameters are expected to match the struct field names
+// Unlike CreateFromHttpRequest, this method completely does not exit when encountering parsing errors,
+// but accumulates them into an []error slice. Futher Validation is needed.
+func (utilRef *tRolesUtils) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Roles, []error) {
+
+	if req == nil {
+
+		var errorPrefix = "RolesUtils.CreateFromHttpRequestIgnoreErrors() ERROR: "
+		noReqErrors := []error{NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")}
+		return nil, noReqErrors
+	}
+
+	var errors []error
+	var currentError error
+
+	newRoles := &Roles{}
+
+	newRoles.CloneGlobalSettings()
+
+	newRoles.RoleId, currentError = To_int32_FromString(req.FormValue("RoleId"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newRoles.Name = req.FormValue("Name")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newRoles.UserId, currentError = To_int32_FromString(req.FormValue("UserId"))
+
+	if currentError == nil && req.FormValue("UserId") != "" {
+		newRoles.UserId_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newRoles.Archived, currentError = To_bool_FromString(req.FormValue("Archived"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newRoles.Priority, currentError = To_float64_FromString(req.FormValue("Priority"))
+
+	if currentError == nil && req.FormValue("Priority") != "" {
+		newRoles.Priority_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+
+	return newRoles, errors
+}
+
+// CloneGlobalSettings assigns the global settings for operations to the control fields
+// of this instance. An example would be:
+// instanceRoles.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+func (instance *Roles) CloneGlobalSettings() {
+
+	instance.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+	instance.PgToGo_SetDateTimeFieldsToNowForNewRecords = Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords
+	instance.PgToGo_SetGuidFieldsToNewGuidsNewRecords = Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords
+
+}
+
+// Returns the database field name, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tRolesUtils) ToDbFieldName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "RoleId" || fieldDbOrGoName == "role_id" {
+		return "role_id"
+	}
+	if fieldDbOrGoName == "Name" || fieldDbOrGoName == "name" {
+		return "name"
+	}
+	if fieldDbOrGoName == "UserId" || fieldDbOrGoName == "user_id" {
+		return "user_id"
+	}
+	if fieldDbOrGoName == "Archived" || fieldDbOrGoName == "archived" {
+		return "archived"
+	}
+	if fieldDbOrGoName == "Priority" || fieldDbOrGoName == "priority" {
+		return "priority"
+	}
+
+	return ""
+}
+
+// Returns the database field type, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tRolesUtils) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "RoleId" || fieldDbOrGoName == "role_id" {
+		return "integer"
+	}
+	if fieldDbOrGoName == "Name" || fieldDbOrGoName == "name" {
+		return "character varying"
+	}
+	if fieldDbOrGoName == "UserId" || fieldDbOrGoName == "user_id" {
+		return "integer"
+	}
+	if fieldDbOrGoName == "Archived" || fieldDbOrGoName == "archived" {
+		return "boolean"
+	}
+	if fieldDbOrGoName == "Priority" || fieldDbOrGoName == "priority" {
+		return "double precision"
+	}
+
+	return ""
+}
+
+// LoadedColumns returns the columns the Roles was selected with by WithColumns, nil when it holds all of them
+func (t *Roles) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanRoles scans a row of the columns of the projection, all of them when it is nil, into a new Roles
+func scanRoles(scan func(dest ...interface{}) error, projection *columnProjection) (Roles, error) {
+
+	currentRoles := Roles{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableUserId pgtype.Int4
+	var nullablePriority pgtype.Float8
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&currentRoles.RoleId, &currentRoles.Name, &nullableUserId, &currentRoles.Archived, &nullablePriority}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "role_id":
+				dest = append(dest, &currentRoles.RoleId)
+			case "name":
+				dest = append(dest, &currentRoles.Name)
+			case "user_id":
+				dest = append(dest, &nullableUserId)
+			case "archived":
+				dest = append(dest, &currentRoles.Archived)
+			case "priority":
+				dest = append(dest, &nullablePriority)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentRoles, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("user_id") {
+		currentRoles.SetUserId(nullableUserId.Int, boolFromStatus(nullableUserId.Status))
+	}
+	if projection.loads("priority") {
+		currentRoles.SetPriority(nullablePriority.Float, boolFromStatus(nullablePriority.Status))
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentRoles, nil
+}
+
+// RolesCols holds the columns of roles, building the predicates and the ordering of the queries,
+// e.g. RolesCols.RoleId.Eq(value)
+var RolesCols = struct {
+	RoleId   QueryColumn
+	Name     QueryColumn
+	UserId   QueryColumn
+	Archived QueryColumn
+	Priority QueryColumn
+}{
+	RoleId:   QueryColumn{name: "role_id"},
+	Name:     QueryColumn{name: "name"},
+	UserId:   QueryColumn{name: "user_id"},
+	Archived: QueryColumn{name: "archived"},
+	Priority: QueryColumn{name: "priority"},
+}
+
+// isRolesColumn tells whether the database name is one of the columns of roles
+func isRolesColumn(dbName string) bool {
+
+	switch dbName {
+	case "role_id", "name", "user_id", "archived", "priority":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tRolesUtils) Select(where Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from roles matching the predicate, e.g.
+// RolesCols.RoleId.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tRolesUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from roles,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tRolesUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from roles,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tRolesUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from roles matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentRolesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentRolesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfRoles)
+		}
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tRolesUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from roles matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tRolesUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from roles matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentRolesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentRolesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfRoles)
+		}
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tRolesUtils) SelectAll(options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from roles.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	// try to get the rows from cache, if enabled and valid
+	if allRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allRolesRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tRolesUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from roles ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of Roles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allRolesRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tRolesUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from roles equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of Roles instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allRolesRowsFromCache) {
+			pageStart = len(allRolesRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allRolesRowsFromCache) {
+			pageEnd = len(allRolesRowsFromCache)
+		}
+
+		return allRolesRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfRoles []Roles
+
+	for rows.Next() {
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfRoles = append(sliceOfRoles, currentRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfRoles, nil
+}
+
+// SelectRoles is SelectRolesCtx with the background context
+func (txWrapper *Transaction) SelectRoles(where Predicate, options ...SelectOption) ([]Roles, error) {
+	return txWrapper.SelectRolesCtx(context.Background(), where, options...)
+}
+
+// SelectRolesCtx runs SelectCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectRolesCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return NewDB(txWrapper).Roles.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedRoles is SelectCachedRolesCtx with the background context
+func (txWrapper *Transaction) SelectCachedRoles(cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return txWrapper.SelectCachedRolesCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedRolesCtx runs SelectCachedCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedRolesCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return NewDB(txWrapper).Roles.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageRoles is SelectPageRolesCtx with the background context
+func (txWrapper *Transaction) SelectPageRoles(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return txWrapper.SelectPageRolesCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageRolesCtx runs SelectPageCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageRolesCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return NewDB(txWrapper).Roles.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedRoles is SelectPageCachedRolesCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedRoles(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return txWrapper.SelectPageCachedRolesCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedRolesCtx runs SelectPageCachedCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedRolesCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return NewDB(txWrapper).Roles.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllRoles is SelectAllRolesCtx with the background context
+func (txWrapper *Transaction) SelectAllRoles(options ...SelectOption) ([]Roles, error) {
+	return txWrapper.SelectAllRolesCtx(context.Background(), options...)
+}
+
+// SelectAllRolesCtx runs SelectAllCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllRolesCtx(ctx context.Context, options ...SelectOption) ([]Roles, error) {
+	return NewDB(txWrapper).Roles.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for Roles         */
+/* ************************************************************ */
+
+type CacheForRoles struct {
+	enabled bool // flag to determine if caching is enabled for Roles
+
+	sliceCache      map[string][]Roles
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]Roles
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]Roles
+	singleRowCacheMutex sync.RWMutex
+
+	all      []Roles
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Tables.Roles when nil
+	utils *tRolesUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForRoles) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]Roles)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]Roles)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]Roles)
+	}
+
+}
+
+func (c *CacheForRoles) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForRoles) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForRoles) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForRoles) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForRoles) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for roles and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForRoles) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Tables.Roles
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForRoles) GetAllRows() ([]Roles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all Roles records in the database.
+func (c *CacheForRoles) SetAllRows(all []Roles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all Roles records in the database.
+func (c *CacheForRoles) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForRoles) GetWhere(key string) ([]Roles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wRoles, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wRoles, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForRoles) SetWhere(key string, sliceRoles []Roles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceRoles != nil {
+
+			whereSliceCopy := make([]Roles, len(sliceRoles))
+			copy(whereSliceCopy, sliceRoles)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForRoles) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of Roles from the cache store based on
+// the given key.
+func (c *CacheForRoles) GetSlice(key string) ([]Roles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sRoles, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sRoles, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of Roles inside the cache store based and
+// associates it with the given key.
+func (c *CacheForRoles) SetSlice(key string, sliceRoles []Roles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceRoles != nil {
+
+			sliceCopy := make([]Roles, len(sliceRoles))
+			copy(sliceCopy, sliceRoles)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of Roles from the cache store entry
+// associated with key.
+func (c *CacheForRoles) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *Roles from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForRoles) Get(key string) (*Roles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a Roles with key, and saves it in the cache store.
+func (c *CacheForRoles) Set(key string, structRoles Roles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structRoles
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the Roles instance that is associated with key from the
+// cache store.
+func (c *CacheForRoles) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for Roles           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tRolesUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from roles matching all the predicates, e.g.
+// RolesCols.RoleId.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tRolesUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "RolesUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM roles"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tRolesUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from roles
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tRolesUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "RolesUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.roles'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tRolesUtils) Single(where Predicate, options ...SelectOption) (*Roles, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from roles matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tRolesUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Roles, error) {
+
+	var errorPrefix = "RolesUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT role_id, name, user_id, archived, priority FROM roles ", "roles")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfRoles *Roles
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanRoles creates a new instance of Roles
+		currentRoles, err := scanRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfRoles = &currentRoles
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfRoles, nil
+}
+
+// SingleRoles is SingleRolesCtx with the background context
+func (txWrapper *Transaction) SingleRoles(where Predicate, options ...SelectOption) (*Roles, error) {
+	return txWrapper.SingleRolesCtx(context.Background(), where, options...)
+}
+
+// SingleRolesCtx runs SingleCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleRolesCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Roles, error) {
+	return NewDB(txWrapper).Roles.SingleCtx(ctx, where, options...)
+}
+
+// keysetRoles holds the unique keys of roles, and the columns its SelectAfter pages can be ordered by
+var keysetRoles = keyset{
+	keys: [][]string{
+		{"role_id"},
+		{"name"},
+	},
+	columns: []string{"role_id", "name", "archived"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tRolesUtils) keysetValue(row *Roles, dbName string) interface{} {
+
+	switch dbName {
+	case "role_id":
+		return row.RoleId
+	case "name":
+		return row.Name
+	case "archived":
+		return row.Archived
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tRolesUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "role_id":
+		var param int32
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "name":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "archived":
+		var param bool
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tRolesUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from roles matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of roles
+// (role_id; name) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tRolesUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error) {
+
+	var errorPrefix = "RolesUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetRoles.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetRoles.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Insert is InsertCtx with the background context
+func (utilRef *tRolesUtils) Insert(sourceRoles *Roles) (*Roles, error) {
+	return utilRef.InsertCtx(context.Background(), sourceRoles)
+}
+
+// InsertCtx inserts a new row into the roles table, using the values
+// inside the pointer to a Roles structure passed to it.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (utilRef *tRolesUtils) InsertCtx(ctx context.Context, sourceRoles *Roles) (*Roles, error) {
+
+	var errorPrefix = "RolesUtils.Insert() ERROR: "
+
+	if sourceRoles == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define returning PK params for the insert query row execution
+	var paramRoleId int32
+
+	// define the insert query
+	var insertQueryAllColumns = "INSERT INTO roles(role_id, name, user_id, archived, priority) VALUES($1, $2, $3, $4, $5)  RETURNING role_id"
+	var insertQueryNoPKColumns = "INSERT INTO roles(name, user_id, archived, priority) VALUES($1, $2, $3, $4)  RETURNING role_id"
+
+	var query string = insertQueryAllColumns
+
+	if sourceRoles.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		query = insertQueryNoPKColumns
+	}
+
+	// pq does not support the LastInsertId() method of the Result type in database/sql.
+	// To return the identifier of an INSERT (or UPDATE or DELETE), use the Postgres RETURNING clause
+	// with a standard Query or QueryRow call
+
+	var err error
+
+	if sourceRoles.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+
+	}
+
+	if sourceRoles.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+
+	}
+
+	// define the values to be passed, from the structure
+	var _role_id, _name, _user_id, _archived, _priority = sourceRoles.RoleId, sourceRoles.Name, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)}, sourceRoles.Archived, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)}
+
+	// this will print only if debug mode enabled
+	Debug("Insert Query:", query)
+
+	if sourceRoles.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		err = currentDbHandle.QueryRow(ctx, query, _name, _user_id, _archived, _priority).Scan(&paramRoleId)
+	} else {
+		err = currentDbHandle.QueryRow(ctx, query, _role_id, _name, _user_id, _archived, _priority).Scan(&paramRoleId)
+	}
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "roles_name_key") {
+				return nil, ErrRoles_UQ_roles_name_key
+			}
+
+		}
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// populate the returning ids inside the returnStructure pointer
+		sourceRoles.RoleId = paramRoleId
+
+		// return the structure
+		return sourceRoles, nil
+	}
+}
+
+// Insert is InsertCtx with the background context
+func (sourceRoles *Roles) Insert() (*Roles, error) {
+	return sourceRoles.InsertCtx(context.Background())
+}
+
+// InsertCtx inserts a new row into the roles table, corresponding to the provided sourceRoles
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (sourceRoles *Roles) InsertCtx(ctx context.Context) (*Roles, error) {
+
+	return Tables.Roles.InsertCtx(ctx, sourceRoles)
+}
+
+// InsertRoles is InsertRolesCtx with the background context
+func (txWrapper *Transaction) InsertRoles(sourceRoles *Roles) (*Roles, error) {
+	return txWrapper.InsertRolesCtx(context.Background(), sourceRoles)
+}
+
+// InsertRolesCtx runs InsertCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.InsertCtx(...) does.
+func (txWrapper *Transaction) InsertRolesCtx(ctx context.Context, sourceRoles *Roles) (*Roles, error) {
+	return NewDB(txWrapper).Roles.InsertCtx(ctx, sourceRoles)
+}
+
+// CopyFromReader is CopyFromReaderCtx with the background context
+func (utilRef *tRolesUtils) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+	return utilRef.CopyFromReaderCtx(context.Background(), r, opt, columns...)
+}
+
+// CopyFromReaderCtx performs a bulk copy of csv-like content from the specified reader
+// into the roles table. The opt parameter is a *CopyFromReaderOptions which
+// allows the caller to specify the separator and the null placeholder.
+// In case nil is passed as null placeholder, for empty strings, the actual empty string value
+// will be inserted, instead of a db null value.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tRolesUtils) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+
+	var errorPrefix = "RolesUtils.CopyFromReader() ERROR: "
+
+	if r == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the reader r is nil")
+	}
+
+	var optSeparator rune
+	var optNullPlaceholders []string
+	var optComment rune
+	var optIncludePKCols bool
+	if opt == nil {
+		optSeparator = defSeparator
+		optNullPlaceholders = defNullPlaceholders[:]
+		optComment = defCommentRune
+		optIncludePKCols = defIncludePKCols
+	} else {
+		optSeparator = opt.Separator
+		optNullPlaceholders = opt.NullPlaceholders
+		optComment = opt.Comment
+		optIncludePKCols = opt.IncludePKCols
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var err error
+
+	// Slices that hold the column database names and their db types
+	var colDbNames []string
+	var colDbTypes []string
+
+	// If no custom column mask was provided, assume the all the columns are a target
+	if len(columns) == 0 {
+		if optIncludePKCols {
+			colDbNames = []string{"role_id", "name", "user_id", "archived", "priority"}
+			colDbTypes = []string{"integer", "character varying", "integer", "boolean", "double precision"}
+		} else {
+			colDbNames = []string{"name", "user_id", "archived", "priority"}
+			colDbTypes = []string{"character varying", "integer", "boolean", "double precision"}
+		}
+	} else {
+		// Range through the custom columns and obtain the db name and db type
+		for i := range columns {
+			colDbNames = append(colDbNames, Tables.Roles.ToDbFieldName(columns[i]))
+			colDbTypes = append(colDbTypes, Tables.Roles.ToDbFieldTypeFromColName(columns[i]))
+		}
+	}
+
+	copySourceReader, err := CopyFromReader(r, optSeparator, optNullPlaceholders, optComment, colDbTypes...)
+	if err != nil {
+		return 0, err
+	}
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"roles"}, colDbNames, copySourceReader)
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (utilRef *tRolesUtils) CopyFromSlice(records []Roles, includeSequenceCols bool) (int64, error) {
+	return utilRef.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx performs a bulk copy of the given Roles records into the roles table.
+// Unless includeSequenceCols is true, the sequence-backed (serial) columns are left out,
+// so the database fills them in.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tRolesUtils) CopyFromSliceCtx(ctx context.Context, records []Roles, includeSequenceCols bool) (int64, error) {
+
+	var errorPrefix = "RolesUtils.CopyFromSlice() ERROR: "
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var colDbNames []string
+	if includeSequenceCols {
+		colDbNames = []string{"role_id", "name", "user_id", "archived", "priority"}
+	} else {
+		colDbNames = []string{"name", "user_id", "archived", "priority"}
+	}
+
+	rows := make([][]interface{}, len(records))
+	for i := range records {
+		sourceRoles := &records[i]
+		if includeSequenceCols {
+			rows[i] = []interface{}{sourceRoles.RoleId, sourceRoles.Name, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)}, sourceRoles.Archived, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)}}
+		} else {
+			rows[i] = []interface{}{sourceRoles.Name, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)}, sourceRoles.Archived, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)}}
+		}
+	}
+
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"roles"}, colDbNames, pgx.CopyFromRows(rows))
+}
+
+// Update is UpdateCtx with the background context
+func (utilRef *tRolesUtils) Update(sourceRoles *Roles, where Predicate) (int64, error) {
+	return utilRef.UpdateCtx(context.Background(), sourceRoles, where)
+}
+
+// UpdateCtx attempts to update the rows inside the roles table, based on
+// the supplied predicate, whose placeholders are numbered after the ones of the 5 columns.
+// All the fields in the supplied source Roles pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// Returns the number of affected rows (zero if no rows found for that condition),
+// and nil error for a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tRolesUtils) UpdateCtx(ctx context.Context, sourceRoles *Roles, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesUtils.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside roles")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE roles SET role_id = $1,name = $2,user_id = $3,archived = $4,priority = $5 WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(6)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceRoles.RoleId, sourceRoles.Name, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)}, sourceRoles.Archived, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)}}
+
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "roles_name_key") {
+				return 0, ErrRoles_UQ_roles_name_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateRoles is UpdateRolesCtx with the background context
+func (txWrapper *Transaction) UpdateRoles(sourceRoles *Roles, where Predicate) (int64, error) {
+	return txWrapper.UpdateRolesCtx(context.Background(), sourceRoles, where)
+}
+
+// UpdateRolesCtx runs UpdateCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.UpdateCtx(...) does.
+func (txWrapper *Transaction) UpdateRolesCtx(ctx context.Context, sourceRoles *Roles, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Roles.UpdateCtx(ctx, sourceRoles, where)
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (utilRef *tRolesUtils) UpdateWithMask(sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+	return utilRef.UpdateWithMaskCtx(context.Background(), sourceRoles, updateMask, where)
+}
+
+// UpdateWithMaskCtx attempts to update the rows inside the roles table matching the predicate,
+// whose placeholders are numbered after the ones of the update mask.
+// Only the fields in the supplied mask slice of strings will be updated.
+// If the mask is nil, all fields will be updated.
+// Returns the number of affected rows (zero if no rows found for that condition), and nil error
+// in case of a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tRolesUtils) UpdateWithMaskCtx(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesUtils.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside roles")
+	}
+
+	if updateMask == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE roles SET ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	var instanceValuesSlice []interface{}
+	for i, e := range updateMask {
+
+		_, writeErr = queryBuffer.WriteString(utilRef.ToDbFieldName(e))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString("=$")
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString(Itoa(i + 1))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		// add a comma if not the final set
+		if len(updateMask) != (i + 1) {
+			_, writeErr = queryBuffer.WriteString(",")
+			if writeErr != nil {
+				return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+			}
+		}
+
+		if e == "RoleId" || e == "role_id" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceRoles.RoleId)
+		}
+		if e == "Name" || e == "name" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceRoles.Name)
+		}
+		if e == "UserId" || e == "user_id" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)})
+		}
+		if e == "Archived" || e == "archived" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceRoles.Archived)
+		}
+		if e == "Priority" || e == "priority" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)})
+		}
+
+	}
+
+	_, writeErr = queryBuffer.WriteString(" WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(len(updateMask) + 1)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	// append the condition's params to the ones of the setters
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "roles_name_key") {
+				return 0, ErrRoles_UQ_roles_name_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateWithMaskRoles is UpdateWithMaskRolesCtx with the background context
+func (txWrapper *Transaction) UpdateWithMaskRoles(sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+	return txWrapper.UpdateWithMaskRolesCtx(context.Background(), sourceRoles, updateMask, where)
+}
+
+// UpdateWithMaskRolesCtx runs UpdateWithMaskCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.UpdateWithMaskCtx(...) does.
+func (txWrapper *Transaction) UpdateWithMaskRolesCtx(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Roles.UpdateWithMaskCtx(ctx, sourceRoles, updateMask, where)
+}
+
+// Update is UpdateCtx with the background context
+func (sourceRoles *Roles) Update() error {
+	return sourceRoles.UpdateCtx(context.Background())
+}
+
+// UpdateCtx attempts to update the row inside the roles table, corresponding
+// to the PK of the current Roles instance.
+// All the fields in the supplied source Roles pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// An instance selected WithColumns returns ErrColumnsNotLoaded, unless MarkAllColumnsLoaded was called;
+// UpdateWithMask with its LoadedColumns writes back only those.
+// Returns nil error for a successful operation. If operation fails, it returns the error.
+// If more than one row gets updated, it will return an error.
+func (sourceRoles *Roles) UpdateCtx(ctx context.Context) error {
+	return sourceRoles.updateOn(ctx, nil)
+}
+
+// updateOn updates the row of the instance on the querier, GetDb() if nil
+func (sourceRoles *Roles) updateOn(ctx context.Context, querier Querier) error {
+
+	var errorPrefix = "instance of Roles.Update() ERROR: "
+
+	// the fields of the columns left out by WithColumns hold their zero values, not those of the row
+	if sourceRoles.pgToGo_projection != nil {
+		return ErrColumnsNotLoaded
+	}
+
+	currentDbHandle := dbQuerier(querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE roles SET role_id = $1,name = $2,user_id = $3,archived = $4,priority = $5 WHERE ")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString("role_id=$6")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString (instance condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceRoles.RoleId, sourceRoles.Name, &pgtype.Int4{Int: sourceRoles.UserId, Status: statusFromBool(sourceRoles.UserId_IsNotNull)}, sourceRoles.Archived, &pgtype.Float8{Float: sourceRoles.Priority, Status: statusFromBool(sourceRoles.Priority_IsNotNull)}, sourceRoles.RoleId}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), instanceValuesSlice...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "roles_name_key") {
+				return ErrRoles_UQ_roles_name_key
+			}
+
+		}
+
+		return NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	if n > 1 {
+		return NewModelsErrorLocal(errorPrefix, "More than one record was updated: "+Itoa(int(n)))
+	}
+	return nil
+
+}
+
+// UpdateSingleInstanceRoles is UpdateSingleInstanceRolesCtx with the background context
+func (txWrapper *Transaction) UpdateSingleInstanceRoles(sourceRoles *Roles) error {
+	return txWrapper.UpdateSingleInstanceRolesCtx(context.Background(), sourceRoles)
+}
+
+// UpdateSingleInstanceRolesCtx runs the UpdateCtx method of the sourceRoles instance in the transaction.
+func (txWrapper *Transaction) UpdateSingleInstanceRolesCtx(ctx context.Context, sourceRoles *Roles) error {
+	return sourceRoles.updateOn(ctx, txWrapper)
+}
+
+// Delete is DeleteCtx with the background context
+func (utilRef *tRolesUtils) Delete(where Predicate) (int64, error) {
+	return utilRef.DeleteCtx(context.Background(), where)
+}
+
+// Deletes the rows from the roles table matching the predicate, e.g.
+// RolesCols.RoleId.Eq(value), or Raw(condition, params...).
+// Returns the number of deleted rows (zero if no rows found for that condition), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tRolesUtils) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesUtils.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from roles")
+	}
+	condition, params := where.Condition(1)
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the delete query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("DELETE FROM roles WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), params...)
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteRoles is DeleteRolesCtx with the background context
+func (txWrapper *Transaction) DeleteRoles(where Predicate) (int64, error) {
+	return txWrapper.DeleteRolesCtx(context.Background(), where)
+}
+
+// DeleteRolesCtx runs DeleteCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.DeleteCtx(...) does.
+func (txWrapper *Transaction) DeleteRolesCtx(ctx context.Context, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Roles.DeleteCtx(ctx, where)
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (utilRef *tRolesUtils) DeleteInstance(sourceRoles *Roles) (bool, error) {
+	return utilRef.DeleteInstanceCtx(context.Background(), sourceRoles)
+}
+
+// Deletes the row from the roles table, corresponding to the primary key fields
+// inside the sourceRoles parameter.
+// Returns true if the row was deleted, or false and nil error if no such PK value was found in the database.
+// If operation fails, it returns nil and the error.
+func (utilRef *tRolesUtils) DeleteInstanceCtx(ctx context.Context, sourceRoles *Roles) (bool, error) {
+
+	var errorPrefix = "RolesUtils.DeleteInstance() ERROR: "
+
+	if sourceRoles == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+
+	// define the condition based on the PK columns
+	deleteInstanceCondition := And(
+		RolesCols.RoleId.Eq(sourceRoles.RoleId),
+	)
+
+	rowCount, err := utilRef.DeleteCtx(ctx, deleteInstanceCondition)
+	if err != nil {
+		return false, NewModelsError(errorPrefix, err)
+	}
+
+	if rowCount > 1 {
+		return false, NewModelsError(errorPrefix+": FATAL ERROR: Too many rows deleted !! : ", err)
+	}
+
+	return rowCount == 1, nil
+
+}
+
+// DeleteInstanceRoles is DeleteInstanceRolesCtx with the background context
+func (txWrapper *Transaction) DeleteInstanceRoles(sourceRoles *Roles) (bool, error) {
+	return txWrapper.DeleteInstanceRolesCtx(context.Background(), sourceRoles)
+}
+
+// DeleteInstanceRolesCtx runs DeleteInstanceCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.DeleteInstanceCtx(...) does.
+func (txWrapper *Transaction) DeleteInstanceRolesCtx(ctx context.Context, sourceRoles *Roles) (bool, error) {
+	return NewDB(txWrapper).Roles.DeleteInstanceCtx(ctx, sourceRoles)
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (utilRef *tRolesUtils) DeleteAll() (int64, error) {
+	return utilRef.DeleteAllCtx(context.Background())
+}
+
+// Deletes all existing rows from the roles table.
+// Returns the number of deleted rows (zero if no rows found), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tRolesUtils) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "RolesUtils.DeleteAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	r, err := currentDbHandle.Exec(ctx, "DELETE FROM roles")
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteAllRoles is DeleteAllRolesCtx with the background context
+func (txWrapper *Transaction) DeleteAllRoles() (int64, error) {
+	return txWrapper.DeleteAllRolesCtx(context.Background())
+}
+
+// DeleteAllRolesCtx runs DeleteAllCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.DeleteAllCtx(...) does.
+func (txWrapper *Transaction) DeleteAllRolesCtx(ctx context.Context) (int64, error) {
+	return NewDB(txWrapper).Roles.DeleteAllCtx(ctx)
+}
+
+// GetByRoleId is GetByRoleIdCtx with the background context
+func (utilRef *tRolesUtils) GetByRoleId(inputRoleId int32) (*Roles, error) {
+	return utilRef.GetByRoleIdCtx(context.Background(), inputRoleId)
+}
+
+// Queries the database for a single row based on the specified single or multi-column primary key.
+// Returns a pointer to a Roles structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tRolesUtils) GetByRoleIdCtx(ctx context.Context, inputRoleId int32) (returnStruct *Roles, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "RolesGetByRoleId() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramRoleId int32
+	var paramName string
+	var paramUserId pgtype.Int4
+	var paramArchived bool
+	var paramPriority pgtype.Float8
+
+	// define the select query
+	var query = "SELECT role_id, name, user_id, archived, priority FROM roles  WHERE role_id = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputRoleId).Scan(&paramRoleId, &paramName, &paramUserId, &paramArchived, &paramPriority)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Roles{
+			RoleId:   paramRoleId,
+			Name:     paramName,
+			Archived: paramArchived,
+		}
+		returnStruct.SetUserId(paramUserId.Int, boolFromStatus(paramUserId.Status))
+		returnStruct.SetPriority(paramPriority.Float, boolFromStatus(paramPriority.Status))
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetRolesByRoleId is GetRolesByRoleIdCtx with the background context
+func (txWrapper *Transaction) GetRolesByRoleId(inputRoleId int32) (*Roles, error) {
+	return txWrapper.GetRolesByRoleIdCtx(context.Background(), inputRoleId)
+}
+
+// GetRolesByRoleIdCtx runs GetByRoleIdCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.GetByRoleIdCtx(...) does.
+func (txWrapper *Transaction) GetRolesByRoleIdCtx(ctx context.Context, inputRoleId int32) (*Roles, error) {
+	return NewDB(txWrapper).Roles.GetByRoleIdCtx(ctx, inputRoleId)
+}
+
+// GetByUniqueName is GetByUniqueNameCtx with the background context
+func (utilRef *tRolesUtils) GetByUniqueName(inputName string) (*Roles, error) {
+	return utilRef.GetByUniqueNameCtx(context.Background(), inputName)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Roles structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tRolesUtils) GetByUniqueNameCtx(ctx context.Context, inputName string) (returnStruct *Roles, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "RolesGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramRoleId int32
+	var paramName string
+	var paramUserId pgtype.Int4
+	var paramArchived bool
+	var paramPriority pgtype.Float8
+
+	// define the select query
+	var query = "SELECT role_id, name, user_id, archived, priority FROM roles  WHERE name = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputName).Scan(&paramRoleId, &paramName, &paramUserId, &paramArchived, &paramPriority)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Roles{
+			RoleId:   paramRoleId,
+			Name:     paramName,
+			Archived: paramArchived,
+		}
+		returnStruct.SetUserId(paramUserId.Int, boolFromStatus(paramUserId.Status))
+		returnStruct.SetPriority(paramPriority.Float, boolFromStatus(paramPriority.Status))
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetRolesByUniqueName is GetRolesByUniqueNameCtx with the background context
+func (txWrapper *Transaction) GetRolesByUniqueName(inputName string) (*Roles, error) {
+	return txWrapper.GetRolesByUniqueNameCtx(context.Background(), inputName)
+}
+
+// GetRolesByUniqueNameCtx runs GetByUniqueNameCtx of Roles in the transaction,
+// as txWrapper.DB().Roles.GetByUniqueNameCtx(...) does.
+func (txWrapper *Transaction) GetRolesByUniqueNameCtx(ctx context.Context, inputName string) (*Roles, error) {
+	return NewDB(txWrapper).Roles.GetByUniqueNameCtx(ctx, inputName)
+}
+
+// RolesRepository holds the methods of Tables.Roles, so that the code using them
+// can depend on the interface, and be unit tested with a RolesRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type RolesRepository interface {
+	New() *Roles
+	CreateFromHttpRequest(req *http.Request) (*Roles, error)
+	CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Roles, []error)
+	ToDbFieldName(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColName(fieldDbOrGoName string) string
+	Select(where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectAll(options ...SelectOption) ([]Roles, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Roles, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*Roles, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Roles, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error)
+	Insert(sourceRoles *Roles) (*Roles, error)
+	InsertCtx(ctx context.Context, sourceRoles *Roles) (*Roles, error)
+	CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSlice(records []Roles, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtx(ctx context.Context, records []Roles, includeSequenceCols bool) (int64, error)
+	Update(sourceRoles *Roles, where Predicate) (int64, error)
+	UpdateCtx(ctx context.Context, sourceRoles *Roles, where Predicate) (int64, error)
+	UpdateWithMask(sourceRoles *Roles, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtx(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (int64, error)
+	Delete(where Predicate) (int64, error)
+	DeleteCtx(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstance(sourceRoles *Roles) (bool, error)
+	DeleteInstanceCtx(ctx context.Context, sourceRoles *Roles) (bool, error)
+	DeleteAll() (int64, error)
+	DeleteAllCtx(ctx context.Context) (int64, error)
+	GetByRoleId(inputRoleId int32) (*Roles, error)
+	GetByRoleIdCtx(ctx context.Context, inputRoleId int32) (*Roles, error)
+	GetByUniqueName(inputName string) (*Roles, error)
+	GetByUniqueNameCtx(ctx context.Context, inputName string) (*Roles, error)
+}
+
+var _ RolesRepository = (*tRolesUtils)(nil)
+
+// RolesRepositoryMock is a RolesRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type RolesRepositoryMock struct {
+	MockCalls
+
+	NewFunc                               func() *Roles
+	CreateFromHttpRequestFunc             func(req *http.Request) (*Roles, error)
+	CreateFromHttpRequestIgnoreErrorsFunc func(req *http.Request) (*Roles, []error)
+	ToDbFieldNameFunc                     func(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColNameFunc          func(fieldDbOrGoName string) string
+	SelectFunc                            func(where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionFunc                       func(where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionCtxFunc                    func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionAllFunc                    func(where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectUnionAllCtxFunc                 func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCachedFunc                      func(cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectCachedCtxFunc                   func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageFunc                        func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCtxFunc                     func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCachedFunc                  func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectPageCachedCtxFunc               func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error)
+	SelectAllFunc                         func(options ...SelectOption) ([]Roles, error)
+	SelectAllCtxFunc                      func(ctx context.Context, options ...SelectOption) ([]Roles, error)
+	SelectAllOrderByFunc                  func(orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllOrderByCtxFunc               func(ctx context.Context, orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllPageFunc                     func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error)
+	SelectAllPageCtxFunc                  func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error)
+	CountFunc                             func(where ...Predicate) (int64, error)
+	CountCtxFunc                          func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                    func() (int64, error)
+	CountImpreciseCtxFunc                 func(ctx context.Context) (int64, error)
+	SingleFunc                            func(where Predicate, options ...SelectOption) (*Roles, error)
+	SingleCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) (*Roles, error)
+	SelectAfterFunc                       func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error)
+	SelectAfterCtxFunc                    func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error)
+	InsertFunc                            func(sourceRoles *Roles) (*Roles, error)
+	InsertCtxFunc                         func(ctx context.Context, sourceRoles *Roles) (*Roles, error)
+	CopyFromReaderFunc                    func(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtxFunc                 func(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSliceFunc                     func(records []Roles, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtxFunc                  func(ctx context.Context, records []Roles, includeSequenceCols bool) (int64, error)
+	UpdateFunc                            func(sourceRoles *Roles, where Predicate) (int64, error)
+	UpdateCtxFunc                         func(ctx context.Context, sourceRoles *Roles, where Predicate) (int64, error)
+	UpdateWithMaskFunc                    func(sourceRoles *Roles, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtxFunc                 func(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (int64, error)
+	DeleteFunc                            func(where Predicate) (int64, error)
+	DeleteCtxFunc                         func(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstanceFunc                    func(sourceRoles *Roles) (bool, error)
+	DeleteInstanceCtxFunc                 func(ctx context.Context, sourceRoles *Roles) (bool, error)
+	DeleteAllFunc                         func() (int64, error)
+	DeleteAllCtxFunc                      func(ctx context.Context) (int64, error)
+	GetByRoleIdFunc                       func(inputRoleId int32) (*Roles, error)
+	GetByRoleIdCtxFunc                    func(ctx context.Context, inputRoleId int32) (*Roles, error)
+	GetByUniqueNameFunc                   func(inputName string) (*Roles, error)
+	GetByUniqueNameCtxFunc                func(ctx context.Context, inputName string) (*Roles, error)
+}
+
+var _ RolesRepository = (*RolesRepositoryMock)(nil)
+
+// New records the call and runs NewFunc
+func (mock *RolesRepositoryMock) New() (result0 *Roles) {
+	mock.Record("New")
+	if mock.NewFunc != nil {
+		return mock.NewFunc()
+	}
+	return
+}
+
+// CreateFromHttpRequest records the call and runs CreateFromHttpRequestFunc
+func (mock *RolesRepositoryMock) CreateFromHttpRequest(req *http.Request) (result0 *Roles, result1 error) {
+	mock.Record("CreateFromHttpRequest", req)
+	if mock.CreateFromHttpRequestFunc != nil {
+		return mock.CreateFromHttpRequestFunc(req)
+	}
+	return
+}
+
+// CreateFromHttpRequestIgnoreErrors records the call and runs CreateFromHttpRequestIgnoreErrorsFunc
+func (mock *RolesRepositoryMock) CreateFromHttpRequestIgnoreErrors(req *http.Request) (result0 *Roles, result1 []error) {
+	mock.Record("CreateFromHttpRequestIgnoreErrors", req)
+	if mock.CreateFromHttpRequestIgnoreErrorsFunc != nil {
+		return mock.CreateFromHttpRequestIgnoreErrorsFunc(req)
+	}
+	return
+}
+
+// ToDbFieldName records the call and runs ToDbFieldNameFunc
+func (mock *RolesRepositoryMock) ToDbFieldName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldName", fieldDbOrGoName)
+	if mock.ToDbFieldNameFunc != nil {
+		return mock.ToDbFieldNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// ToDbFieldTypeFromColName records the call and runs ToDbFieldTypeFromColNameFunc
+func (mock *RolesRepositoryMock) ToDbFieldTypeFromColName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldTypeFromColName", fieldDbOrGoName)
+	if mock.ToDbFieldTypeFromColNameFunc != nil {
+		return mock.ToDbFieldTypeFromColNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *RolesRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *RolesRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *RolesRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *RolesRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *RolesRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *RolesRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *RolesRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *RolesRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *RolesRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *RolesRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *RolesRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *RolesRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *RolesRepositoryMock) SelectAll(options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *RolesRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *RolesRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *RolesRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *RolesRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *RolesRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Roles, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *RolesRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *RolesRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *RolesRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *RolesRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *RolesRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *Roles, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *RolesRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *Roles, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *RolesRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Roles, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *RolesRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Roles, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// Insert records the call and runs InsertFunc
+func (mock *RolesRepositoryMock) Insert(sourceRoles *Roles) (result0 *Roles, result1 error) {
+	mock.Record("Insert", sourceRoles)
+	if mock.InsertFunc != nil {
+		return mock.InsertFunc(sourceRoles)
+	}
+	return
+}
+
+// InsertCtx records the call and runs InsertCtxFunc
+func (mock *RolesRepositoryMock) InsertCtx(ctx context.Context, sourceRoles *Roles) (result0 *Roles, result1 error) {
+	mock.Record("InsertCtx", ctx, sourceRoles)
+	if mock.InsertCtxFunc != nil {
+		return mock.InsertCtxFunc(ctx, sourceRoles)
+	}
+	return
+}
+
+// CopyFromReader records the call and runs CopyFromReaderFunc
+func (mock *RolesRepositoryMock) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReader", r, opt, columns)
+	if mock.CopyFromReaderFunc != nil {
+		return mock.CopyFromReaderFunc(r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromReaderCtx records the call and runs CopyFromReaderCtxFunc
+func (mock *RolesRepositoryMock) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReaderCtx", ctx, r, opt, columns)
+	if mock.CopyFromReaderCtxFunc != nil {
+		return mock.CopyFromReaderCtxFunc(ctx, r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromSlice records the call and runs CopyFromSliceFunc
+func (mock *RolesRepositoryMock) CopyFromSlice(records []Roles, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSlice", records, includeSequenceCols)
+	if mock.CopyFromSliceFunc != nil {
+		return mock.CopyFromSliceFunc(records, includeSequenceCols)
+	}
+	return
+}
+
+// CopyFromSliceCtx records the call and runs CopyFromSliceCtxFunc
+func (mock *RolesRepositoryMock) CopyFromSliceCtx(ctx context.Context, records []Roles, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSliceCtx", ctx, records, includeSequenceCols)
+	if mock.CopyFromSliceCtxFunc != nil {
+		return mock.CopyFromSliceCtxFunc(ctx, records, includeSequenceCols)
+	}
+	return
+}
+
+// Update records the call and runs UpdateFunc
+func (mock *RolesRepositoryMock) Update(sourceRoles *Roles, where Predicate) (result0 int64, result1 error) {
+	mock.Record("Update", sourceRoles, where)
+	if mock.UpdateFunc != nil {
+		return mock.UpdateFunc(sourceRoles, where)
+	}
+	return
+}
+
+// UpdateCtx records the call and runs UpdateCtxFunc
+func (mock *RolesRepositoryMock) UpdateCtx(ctx context.Context, sourceRoles *Roles, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateCtx", ctx, sourceRoles, where)
+	if mock.UpdateCtxFunc != nil {
+		return mock.UpdateCtxFunc(ctx, sourceRoles, where)
+	}
+	return
+}
+
+// UpdateWithMask records the call and runs UpdateWithMaskFunc
+func (mock *RolesRepositoryMock) UpdateWithMask(sourceRoles *Roles, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMask", sourceRoles, updateMask, where)
+	if mock.UpdateWithMaskFunc != nil {
+		return mock.UpdateWithMaskFunc(sourceRoles, updateMask, where)
+	}
+	return
+}
+
+// UpdateWithMaskCtx records the call and runs UpdateWithMaskCtxFunc
+func (mock *RolesRepositoryMock) UpdateWithMaskCtx(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMaskCtx", ctx, sourceRoles, updateMask, where)
+	if mock.UpdateWithMaskCtxFunc != nil {
+		return mock.UpdateWithMaskCtxFunc(ctx, sourceRoles, updateMask, where)
+	}
+	return
+}
+
+// Delete records the call and runs DeleteFunc
+func (mock *RolesRepositoryMock) Delete(where Predicate) (result0 int64, result1 error) {
+	mock.Record("Delete", where)
+	if mock.DeleteFunc != nil {
+		return mock.DeleteFunc(where)
+	}
+	return
+}
+
+// DeleteCtx records the call and runs DeleteCtxFunc
+func (mock *RolesRepositoryMock) DeleteCtx(ctx context.Context, where Predicate) (result0 int64, result1 error) {
+	mock.Record("DeleteCtx", ctx, where)
+	if mock.DeleteCtxFunc != nil {
+		return mock.DeleteCtxFunc(ctx, where)
+	}
+	return
+}
+
+// DeleteInstance records the call and runs DeleteInstanceFunc
+func (mock *RolesRepositoryMock) DeleteInstance(sourceRoles *Roles) (result0 bool, result1 error) {
+	mock.Record("DeleteInstance", sourceRoles)
+	if mock.DeleteInstanceFunc != nil {
+		return mock.DeleteInstanceFunc(sourceRoles)
+	}
+	return
+}
+
+// DeleteInstanceCtx records the call and runs DeleteInstanceCtxFunc
+func (mock *RolesRepositoryMock) DeleteInstanceCtx(ctx context.Context, sourceRoles *Roles) (result0 bool, result1 error) {
+	mock.Record("DeleteInstanceCtx", ctx, sourceRoles)
+	if mock.DeleteInstanceCtxFunc != nil {
+		return mock.DeleteInstanceCtxFunc(ctx, sourceRoles)
+	}
+	return
+}
+
+// DeleteAll records the call and runs DeleteAllFunc
+func (mock *RolesRepositoryMock) DeleteAll() (result0 int64, result1 error) {
+	mock.Record("DeleteAll")
+	if mock.DeleteAllFunc != nil {
+		return mock.DeleteAllFunc()
+	}
+	return
+}
+
+// DeleteAllCtx records the call and runs DeleteAllCtxFunc
+func (mock *RolesRepositoryMock) DeleteAllCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("DeleteAllCtx", ctx)
+	if mock.DeleteAllCtxFunc != nil {
+		return mock.DeleteAllCtxFunc(ctx)
+	}
+	return
+}
+
+// GetByRoleId records the call and runs GetByRoleIdFunc
+func (mock *RolesRepositoryMock) GetByRoleId(inputRoleId int32) (result0 *Roles, result1 error) {
+	mock.Record("GetByRoleId", inputRoleId)
+	if mock.GetByRoleIdFunc != nil {
+		return mock.GetByRoleIdFunc(inputRoleId)
+	}
+	return
+}
+
+// GetByRoleIdCtx records the call and runs GetByRoleIdCtxFunc
+func (mock *RolesRepositoryMock) GetByRoleIdCtx(ctx context.Context, inputRoleId int32) (result0 *Roles, result1 error) {
+	mock.Record("GetByRoleIdCtx", ctx, inputRoleId)
+	if mock.GetByRoleIdCtxFunc != nil {
+		return mock.GetByRoleIdCtxFunc(ctx, inputRoleId)
+	}
+	return
+}
+
+// GetByUniqueName records the call and runs GetByUniqueNameFunc
+func (mock *RolesRepositoryMock) GetByUniqueName(inputName string) (result0 *Roles, result1 error) {
+	mock.Record("GetByUniqueName", inputName)
+	if mock.GetByUniqueNameFunc != nil {
+		return mock.GetByUniqueNameFunc(inputName)
+	}
+	return
+}
+
+// GetByUniqueNameCtx records the call and runs GetByUniqueNameCtxFunc
+func (mock *RolesRepositoryMock) GetByUniqueNameCtx(ctx context.Context, inputName string) (result0 *Roles, result1 error) {
+	mock.Record("GetByUniqueNameCtx", ctx, inputName)
+	if mock.GetByUniqueNameCtxFunc != nil {
+		return mock.GetByUniqueNameCtxFunc(ctx, inputName)
+	}
+	return
+}
+
+// RolesFake is an in-memory RolesRepository for the unit tests, its zero value an empty roles table.
+// It assigns the serial columns, and enforces the primary key, the unique constraints and the NOT NULL
+// columns with the errors the database methods return. It evaluates the predicates the way the database
+// does, NULL included, but only the Raw conditions made of column = $n, column IS NULL and column IS NOT NULL
+// comparisons joined by AND. The select options apply, the locking ones having no effect, and the cached
+// selects cache nothing. The methods working on the database only, e.g. CountImprecise, return
+// ErrFakeNotSupported. It is safe for concurrent use.
+type RolesFake struct {
+	mutex sync.RWMutex
+	rows  []Roles
+
+	sequenceRoleId int32
+
+	utils tRolesUtils
+}
+
+var _ RolesRepository = (*RolesFake)(nil)
+
+// Rows returns a copy of the rows, in their order of insertion
+func (fake *RolesFake) Rows() []Roles {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return append([]Roles(nil), fake.rows...)
+}
+
+// column returns the value of the row's column, nil for NULL, and false for an unknown column
+func (fake *RolesFake) column(row *Roles, dbName string) (interface{}, bool) {
+
+	switch dbName {
+	case "role_id":
+		return row.RoleId, true
+	case "name":
+		return row.Name, true
+	case "user_id":
+		if !row.UserId_IsNotNull {
+			return nil, true
+		}
+		return row.UserId, true
+	case "archived":
+		return row.Archived, true
+	case "priority":
+		if !row.Priority_IsNotNull {
+			return nil, true
+		}
+		return row.Priority, true
+	}
+	return nil, false
+}
+
+// assign sets the field of the row, named by its Go or database name, to the one of the source
+func (fake *RolesFake) assign(row *Roles, sourceRoles *Roles, field string) bool {
+
+	switch field {
+	case "RoleId", "role_id":
+		row.RoleId = sourceRoles.RoleId
+	case "Name", "name":
+		row.Name = sourceRoles.Name
+	case "UserId", "user_id":
+		row.UserId = sourceRoles.UserId
+		row.UserId_IsNotNull = sourceRoles.UserId_IsNotNull
+	case "Archived", "archived":
+		row.Archived = sourceRoles.Archived
+	case "Priority", "priority":
+		row.Priority = sourceRoles.Priority
+		row.Priority_IsNotNull = sourceRoles.Priority_IsNotNull
+	default:
+		return false
+	}
+	return true
+}
+
+// sameKey tells whether the rows have the same values in the columns, NULL equal to nothing
+func (fake *RolesFake) sameKey(a *Roles, b *Roles, dbNames ...string) bool {
+
+	for _, dbName := range dbNames {
+		aValue, _ := fake.column(a, dbName)
+		bValue, _ := fake.column(b, dbName)
+		if !fakeEqual(aValue, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// deleted tells whether the row is soft-deleted
+func (fake *RolesFake) deleted(row *Roles) bool {
+
+	return false
+}
+
+// nextSequenceValues assigns the serial columns of the row
+func (fake *RolesFake) nextSequenceValues(row *Roles) {
+
+	fake.sequenceRoleId++
+	row.RoleId = fake.sequenceRoleId
+}
+
+// validate checks the changed rows against the constraints of the table
+func (fake *RolesFake) validate(rows []Roles, changed []int) error {
+
+	for _, i := range changed {
+		for j := range rows {
+			if j == i {
+				continue
+			}
+			if fake.sameKey(&rows[i], &rows[j], "role_id") {
+				return NewModelsErrorLocalWithCode("Unique constraint violation:", "roles primary key", "23505")
+			}
+			if fake.sameKey(&rows[i], &rows[j], "name") {
+				return ErrRoles_UQ_roles_name_key
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertRows appends the rows, unless one of them violates a constraint
+func (fake *RolesFake) insertRows(rows ...Roles) error {
+
+	all := append(append([]Roles(nil), fake.rows...), rows...)
+
+	changed := make([]int, len(rows))
+	for i := range rows {
+		changed[i] = len(fake.rows) + i
+	}
+	if err := fake.validate(all, changed); err != nil {
+		return err
+	}
+
+	fake.rows = all
+	return nil
+}
+
+// updateRows updates the rows at the indexes, unless one of them then violates a constraint
+func (fake *RolesFake) updateRows(indexes []int, update func(row *Roles)) (int64, error) {
+
+	rows := append([]Roles(nil), fake.rows...)
+	for _, i := range indexes {
+		update(&rows[i])
+	}
+	if err := fake.validate(rows, indexes); err != nil {
+		return 0, err
+	}
+
+	fake.rows = rows
+	return int64(len(indexes)), nil
+}
+
+// matching returns the indexes of the rows matching the predicate, the zero one matching all of them
+func (fake *RolesFake) matching(errorPrefix string, where Predicate, withDeleted bool) ([]int, error) {
+
+	predicate, err := fakePredicate(where, isRolesColumn)
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	var indexes []int
+	for i := range fake.rows {
+		row := &fake.rows[i]
+		if !withDeleted && fake.deleted(row) {
+			continue
+		}
+
+		truth, err := fakeEvaluate(predicate, func(dbName string) interface{} {
+			value, _ := fake.column(row, dbName)
+			return value
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", errorPrefix, err)
+		}
+		if truth == fakeTrue {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// rowsAt returns copies of the rows at the indexes
+func (fake *RolesFake) rowsAt(indexes []int) []Roles {
+
+	rows := make([]Roles, len(indexes))
+	for i, index := range indexes {
+		rows[i] = fake.rows[index]
+	}
+	return rows
+}
+
+// selectRows returns copies of the rows at the indexes, ordered, offset, limited and projected by the options
+func (fake *RolesFake) selectRows(errorPrefix string, indexes []int, options selectOptions) ([]Roles, error) {
+
+	if _, err := options.clause(isRolesColumn); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	rows := fake.rowsAt(indexes)
+
+	var orderErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, term := range options.orderBy {
+			a, _ := fake.column(&rows[i], term.column)
+			b, _ := fake.column(&rows[j], term.column)
+			order, ok := fakeCompare(a, b, term)
+			if !ok {
+				orderErr = fmt.Errorf("%sordering by %s is %w", errorPrefix, term.column, ErrFakeNotSupported)
+			}
+			if order != 0 {
+				return order < 0
+			}
+		}
+		return false
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+
+	if options.offset > len(rows) {
+		options.offset = len(rows)
+	}
+	rows = rows[options.offset:]
+	if options.limit > 0 && options.limit < len(rows) {
+		rows = rows[:options.limit]
+	}
+
+	if options.projection != nil {
+		for i := range rows {
+			rows[i] = fake.project(&rows[i], options.projection)
+		}
+	}
+	return rows, nil
+}
+
+// selectMatching returns copies of the rows matching the predicate, ordered, offset, limited and projected by the options
+func (fake *RolesFake) selectMatching(errorPrefix string, where Predicate, options selectOptions) ([]Roles, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return nil, err
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// project returns the row with only the columns of the projection, as WithColumns selects them
+func (fake *RolesFake) project(row *Roles, projection *columnProjection) Roles {
+
+	projected := Roles{pgToGo_projection: projection}
+	if projection.loads("role_id") {
+		projected.RoleId = row.RoleId
+	}
+	if projection.loads("name") {
+		projected.Name = row.Name
+	}
+	if projection.loads("user_id") {
+		projected.UserId = row.UserId
+		projected.UserId_IsNotNull = row.UserId_IsNotNull
+	}
+	if projection.loads("archived") {
+		projected.Archived = row.Archived
+	}
+	if projection.loads("priority") {
+		projected.Priority = row.Priority
+		projected.Priority_IsNotNull = row.Priority_IsNotNull
+	}
+
+	return projected
+}
+
+// deleteRows deletes the rows at the indexes
+func (fake *RolesFake) deleteRows(indexes []int) int64 {
+
+	kept := fake.rows[:0]
+	for i, row := range fake.rows {
+		if len(indexes) > 0 && indexes[0] == i {
+			indexes = indexes[1:]
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(fake.rows) - len(kept))
+	fake.rows = kept
+	return deleted
+}
+
+// Insert is InsertCtx with the background context
+func (fake *RolesFake) Insert(sourceRoles *Roles) (*Roles, error) {
+	return fake.InsertCtx(context.Background(), sourceRoles)
+}
+
+// InsertCtx inserts a copy of the row the way Tables.Roles.InsertCtx does, assigning the serial
+// columns when PgToGo_IgnorePKValuesWhenInsertingAndUseSequence is set.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+func (fake *RolesFake) InsertCtx(ctx context.Context, sourceRoles *Roles) (*Roles, error) {
+
+	var errorPrefix = "RolesFake.Insert() ERROR: "
+
+	if sourceRoles == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+
+	if sourceRoles.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+
+	}
+
+	if sourceRoles.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	row := *sourceRoles
+	if sourceRoles.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		fake.nextSequenceValues(&row)
+	}
+
+	if err := fake.insertRows(row); err != nil {
+		return nil, err
+	}
+
+	sourceRoles.RoleId = row.RoleId
+
+	return sourceRoles, nil
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (fake *RolesFake) CopyFromSlice(records []Roles, includeSequenceCols bool) (int64, error) {
+	return fake.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx inserts copies of the records, all of them or none.
+// Unless includeSequenceCols is true, the serial columns are assigned.
+func (fake *RolesFake) CopyFromSliceCtx(ctx context.Context, records []Roles, includeSequenceCols bool) (int64, error) {
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	rows := append([]Roles(nil), records...)
+	if !includeSequenceCols {
+		for i := range rows {
+			fake.nextSequenceValues(&rows[i])
+		}
+	}
+
+	if err := fake.insertRows(rows...); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// Select is SelectCtx with the background context
+func (fake *RolesFake) Select(where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns copies of the rows matching the predicate, ordered and limited by the options
+func (fake *RolesFake) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesFake.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	return fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+}
+
+// Single is SingleCtx with the background context
+func (fake *RolesFake) Single(where Predicate, options ...SelectOption) (*Roles, error) {
+	return fake.SingleCtx(context.Background(), where, options...)
+}
+
+// SingleCtx returns a copy of the row matching the predicate, nil if there is none,
+// and ErrTooManyRows if there are several
+func (fake *RolesFake) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Roles, error) {
+
+	var errorPrefix = "RolesFake.Single() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	switch len(rows) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &rows[0], nil
+	}
+	return nil, ErrTooManyRows
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (fake *RolesFake) SelectAll(options ...SelectOption) ([]Roles, error) {
+	return fake.SelectAllCtx(context.Background(), options...)
+}
+
+// SelectAllCtx returns copies of all the rows, ordered and limited by the options
+func (fake *RolesFake) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Roles, error) {
+	return fake.selectMatching("RolesFake.SelectAll() ERROR: ", Predicate{}, applySelectOptions(options))
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (fake *RolesFake) SelectUnion(where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx returns copies of the rows matching any of the predicates, the ones matching several
+// of them once, ordered and limited by the options as a whole
+func (fake *RolesFake) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.selectUnion("RolesFake.SelectUnion() ERROR: ", where, false, applySelectOptions(options))
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (fake *RolesFake) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx returns copies of the rows matching each of the predicates, the ones matching several
+// of them as many times, ordered and limited by the options as a whole
+func (fake *RolesFake) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.selectUnion("RolesFake.SelectUnionAll() ERROR: ", where, true, applySelectOptions(options))
+}
+
+// selectUnion returns copies of the rows matching each of the predicates, only once unless all is set
+func (fake *RolesFake) selectUnion(errorPrefix string, where []Predicate, all bool, options selectOptions) ([]Roles, error) {
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+	if err := options.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	var indexes []int
+	selected := make(map[int]bool)
+	for _, predicate := range where {
+		if predicate.IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+		}
+
+		matching, err := fake.matching(errorPrefix, predicate, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range matching {
+			if all || !selected[i] {
+				indexes = append(indexes, i)
+				selected[i] = true
+			}
+		}
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (fake *RolesFake) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns copies of the rows matching the predicate as SelectCtx does, caching nothing
+func (fake *RolesFake) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesFake.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (fake *RolesFake) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns copies of the rows of the page, from 1, matching the predicate
+func (fake *RolesFake) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.selectPage("RolesFake.SelectPage() ERROR: ", pageNumber, pageSize, where, false, options)
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (fake *RolesFake) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns copies of the rows of the page as SelectPageCtx does, caching nothing
+func (fake *RolesFake) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Roles, error) {
+	return fake.selectPage("RolesFake.SelectPageCached() ERROR: ", pageNumber, pageSize, where, true, options)
+}
+
+// selectPage returns copies of the rows of the page matching the predicate, refusing the locking options when cached
+func (fake *RolesFake) selectPage(errorPrefix string, pageNumber int, pageSize int, where Predicate, cached bool, options []SelectOption) ([]Roles, error) {
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from roles")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	if cached {
+		if err := selectOptions.cached(); err != nil {
+			return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+		}
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (fake *RolesFake) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// SelectAllOrderByCtx returns copies of all the rows, ordered by the orderBy parameter,
+// columns followed by ASC or DESC and NULLS FIRST or NULLS LAST, or by the OrderBy option
+func (fake *RolesFake) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesFake.SelectAllOrderBy() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (fake *RolesFake) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error) {
+	return fake.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// SelectAllPageCtx returns copies of the rows of the page, from 1, ordered as SelectAllOrderByCtx orders them
+func (fake *RolesFake) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Roles, error) {
+
+	var errorPrefix = "RolesFake.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (fake *RolesFake) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error) {
+	return fake.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns copies of the rows of the page following the cursor, and the cursor of the next page,
+// as Tables.Roles.SelectAfterCtx does
+func (fake *RolesFake) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Roles, string, error) {
+
+	var errorPrefix = "RolesFake.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetRoles.after(cursor, pageSize, &selectOptions, fake.utils.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, And(where, after), selectOptions)
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetRoles.cursor(selectOptions, func(dbName string) interface{} {
+		return fake.utils.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Count is CountCtx with the background context
+func (fake *RolesFake) Count(where ...Predicate) (int64, error) {
+	return fake.CountCtx(context.Background(), where...)
+}
+
+// CountCtx returns the number of rows matching all the predicates
+func (fake *RolesFake) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching("RolesFake.Count() ERROR: ", And(where...), false)
+	if err != nil {
+		return -1, err
+	}
+	return int64(len(indexes)), nil
+}
+
+// Update is UpdateCtx with the background context
+func (fake *RolesFake) Update(sourceRoles *Roles, where Predicate) (int64, error) {
+	return fake.UpdateCtx(context.Background(), sourceRoles, where)
+}
+
+// UpdateCtx sets all the fields of the rows matching the predicate to the ones of the source.
+// Returns the number of affected rows.
+func (fake *RolesFake) UpdateCtx(ctx context.Context, sourceRoles *Roles, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesFake.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside roles")
+	}
+	if sourceRoles == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Roles) {
+		fake.assign(row, sourceRoles, "RoleId")
+		fake.assign(row, sourceRoles, "Name")
+		fake.assign(row, sourceRoles, "UserId")
+		fake.assign(row, sourceRoles, "Archived")
+		fake.assign(row, sourceRoles, "Priority")
+
+	})
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (fake *RolesFake) UpdateWithMask(sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+	return fake.UpdateWithMaskCtx(context.Background(), sourceRoles, updateMask, where)
+}
+
+// UpdateWithMaskCtx sets the fields in the mask, Go or database names, of the rows matching the predicate
+// to the ones of the source. Returns the number of affected rows.
+func (fake *RolesFake) UpdateWithMaskCtx(ctx context.Context, sourceRoles *Roles, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesFake.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside roles")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if sourceRoles == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+	for _, field := range updateMask {
+		if !fake.assign(&Roles{}, sourceRoles, field) {
+			return 0, NewModelsErrorLocal(errorPrefix, "the update mask field "+field+" is not a column of roles")
+		}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Roles) {
+		for _, field := range updateMask {
+			fake.assign(row, sourceRoles, field)
+		}
+	})
+}
+
+// Delete is DeleteCtx with the background context
+func (fake *RolesFake) Delete(where Predicate) (int64, error) {
+	return fake.DeleteCtx(context.Background(), where)
+}
+
+// DeleteCtx deletes the rows matching the predicate.
+// Returns the number of deleted rows.
+func (fake *RolesFake) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "RolesFake.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from roles")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return 0, err
+	}
+	return fake.deleteRows(indexes), nil
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (fake *RolesFake) DeleteInstance(sourceRoles *Roles) (bool, error) {
+	return fake.DeleteInstanceCtx(context.Background(), sourceRoles)
+}
+
+// DeleteInstanceCtx deletes the row with the primary key of the source.
+// Returns true if the row was found.
+func (fake *RolesFake) DeleteInstanceCtx(ctx context.Context, sourceRoles *Roles) (bool, error) {
+
+	var errorPrefix = "RolesFake.DeleteInstance() ERROR: "
+
+	if sourceRoles == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceRoles pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	var indexes []int
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], sourceRoles, "role_id") {
+			indexes = append(indexes, i)
+		}
+	}
+	return fake.deleteRows(indexes) == 1, nil
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (fake *RolesFake) DeleteAll() (int64, error) {
+	return fake.DeleteAllCtx(context.Background())
+}
+
+// DeleteAllCtx deletes all the rows.
+// Returns the number of deleted rows.
+func (fake *RolesFake) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	// the zero predicate matches all the rows, without an error
+	indexes, _ := fake.matching("", Predicate{}, false)
+	return fake.deleteRows(indexes), nil
+}
+
+// get returns a copy of the row with the values of the key in the columns, nil if there is none
+func (fake *RolesFake) get(key *Roles, dbNames ...string) *Roles {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], key, dbNames...) {
+			row := fake.rows[i]
+			return &row
+		}
+	}
+	return nil
+}
+
+// GetByRoleId is GetByRoleIdCtx with the background context
+func (fake *RolesFake) GetByRoleId(inputRoleId int32) (*Roles, error) {
+	return fake.GetByRoleIdCtx(context.Background(), inputRoleId)
+}
+
+// GetByRoleIdCtx returns a copy of the row with the primary key, nil if there is none
+func (fake *RolesFake) GetByRoleIdCtx(ctx context.Context, inputRoleId int32) (*Roles, error) {
+	return fake.get(&Roles{RoleId: inputRoleId}, "role_id"), nil
+}
+
+// GetByUniqueName is GetByUniqueNameCtx with the background context
+func (fake *RolesFake) GetByUniqueName(inputName string) (*Roles, error) {
+	return fake.GetByUniqueNameCtx(context.Background(), inputName)
+}
+
+// GetByUniqueNameCtx returns a copy of the row with the values of the roles_name_key unique constraint, nil if there is none
+func (fake *RolesFake) GetByUniqueNameCtx(ctx context.Context, inputName string) (*Roles, error) {
+	return fake.get(&Roles{Name: inputName}, "name"), nil
+}
+
+// New is Tables.Roles.New
+func (fake *RolesFake) New() *Roles {
+	return fake.utils.New()
+}
+
+// CreateFromHttpRequest is Tables.Roles.CreateFromHttpRequest
+func (fake *RolesFake) CreateFromHttpRequest(req *http.Request) (*Roles, error) {
+	return fake.utils.CreateFromHttpRequest(req)
+}
+
+// CreateFromHttpRequestIgnoreErrors is Tables.Roles.CreateFromHttpRequestIgnoreErrors
+func (fake *RolesFake) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Roles, []error) {
+	return fake.utils.CreateFromHttpRequestIgnoreErrors(req)
+}
+
+// ToDbFieldName is Tables.Roles.ToDbFieldName
+func (fake *RolesFake) ToDbFieldName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldName(fieldDbOrGoName)
+}
+
+// ToDbFieldTypeFromColName is Tables.Roles.ToDbFieldTypeFromColName
+func (fake *RolesFake) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldTypeFromColName(fieldDbOrGoName)
+}
+
+// CountImprecise is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *RolesFake) CountImprecise() (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CountImpreciseCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *RolesFake) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReader is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *RolesFake) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReaderCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *RolesFake) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
@@ -0,0 +1,2063 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+)
+
+const UserRoles_DB_VIEW_NAME string = "user_roles"
+
+// UserRoles is a structure that corresponds to the user_roles view.
+type UserRoles struct {
+	// database field name: id
+	Id           int32
+	Id_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: user_email
+	UserEmail           string
+	UserEmail_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: name
+	Name           string
+	Name_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: total
+	Total           int64
+	Total_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: prio
+	Prio           Numeric
+	Prio_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: lit
+	Lit           string
+	Lit_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: lmail
+	Lmail           string
+	Lmail_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: created_at
+	CreatedAt           time.Time
+	CreatedAt_IsNotNull bool // if true, it means the value is not null
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+/* Sorting helper containers */
+
+// SortUserRolesById implements sort.Interface for []UserRoles based on
+// the Id field. Usage: sort.Sort(SortUserRolesById(anyGivenUserRolesSlice))
+type SortUserRolesById []UserRoles
+
+func (a SortUserRolesById) Len() int           { return len(a) }
+func (a SortUserRolesById) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesById) Less(i, j int) bool { return LessComparatorFor_int32(a[i].Id, a[j].Id) }
+
+// SortUserRolesByUserEmail implements sort.Interface for []UserRoles based on
+// the UserEmail field. Usage: sort.Sort(SortUserRolesByUserEmail(anyGivenUserRolesSlice))
+type SortUserRolesByUserEmail []UserRoles
+
+func (a SortUserRolesByUserEmail) Len() int      { return len(a) }
+func (a SortUserRolesByUserEmail) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByUserEmail) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].UserEmail, a[j].UserEmail)
+}
+
+// SortUserRolesByName implements sort.Interface for []UserRoles based on
+// the Name field. Usage: sort.Sort(SortUserRolesByName(anyGivenUserRolesSlice))
+type SortUserRolesByName []UserRoles
+
+func (a SortUserRolesByName) Len() int      { return len(a) }
+func (a SortUserRolesByName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByName) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Name, a[j].Name)
+}
+
+// SortUserRolesByTotal implements sort.Interface for []UserRoles based on
+// the Total field. Usage: sort.Sort(SortUserRolesByTotal(anyGivenUserRolesSlice))
+type SortUserRolesByTotal []UserRoles
+
+func (a SortUserRolesByTotal) Len() int      { return len(a) }
+func (a SortUserRolesByTotal) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByTotal) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].Total, a[j].Total)
+}
+
+// SortUserRolesByPrio implements sort.Interface for []UserRoles based on
+// the Prio field. Usage: sort.Sort(SortUserRolesByPrio(anyGivenUserRolesSlice))
+type SortUserRolesByPrio []UserRoles
+
+func (a SortUserRolesByPrio) Len() int      { return len(a) }
+func (a SortUserRolesByPrio) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByPrio) Less(i, j int) bool {
+	return LessComparatorFor_Numeric(a[i].Prio, a[j].Prio)
+}
+
+// SortUserRolesByLit implements sort.Interface for []UserRoles based on
+// the Lit field. Usage: sort.Sort(SortUserRolesByLit(anyGivenUserRolesSlice))
+type SortUserRolesByLit []UserRoles
+
+func (a SortUserRolesByLit) Len() int           { return len(a) }
+func (a SortUserRolesByLit) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByLit) Less(i, j int) bool { return LessComparatorFor_string(a[i].Lit, a[j].Lit) }
+
+// SortUserRolesByLmail implements sort.Interface for []UserRoles based on
+// the Lmail field. Usage: sort.Sort(SortUserRolesByLmail(anyGivenUserRolesSlice))
+type SortUserRolesByLmail []UserRoles
+
+func (a SortUserRolesByLmail) Len() int      { return len(a) }
+func (a SortUserRolesByLmail) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByLmail) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Lmail, a[j].Lmail)
+}
+
+// SortUserRolesByCreatedAt implements sort.Interface for []UserRoles based on
+// the CreatedAt field. Usage: sort.Sort(SortUserRolesByCreatedAt(anyGivenUserRolesSlice))
+type SortUserRolesByCreatedAt []UserRoles
+
+func (a SortUserRolesByCreatedAt) Len() int      { return len(a) }
+func (a SortUserRolesByCreatedAt) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUserRolesByCreatedAt) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].CreatedAt, a[j].CreatedAt)
+}
+
+func (t *UserRoles) SetId(val int32, notNull bool) {
+	t.Id = val
+	t.Id_IsNotNull = notNull
+}
+func (t *UserRoles) SetUserEmail(val string, notNull bool) {
+	t.UserEmail = val
+	t.UserEmail_IsNotNull = notNull
+}
+func (t *UserRoles) SetName(val string, notNull bool) {
+	t.Name = val
+	t.Name_IsNotNull = notNull
+}
+func (t *UserRoles) SetTotal(val int64, notNull bool) {
+	t.Total = val
+	t.Total_IsNotNull = notNull
+}
+func (t *UserRoles) SetPrio(val Numeric, notNull bool) {
+	t.Prio = val
+	t.Prio_IsNotNull = notNull
+}
+func (t *UserRoles) SetLit(val string, notNull bool) {
+	t.Lit = val
+	t.Lit_IsNotNull = notNull
+}
+func (t *UserRoles) SetLmail(val string, notNull bool) {
+	t.Lmail = val
+	t.Lmail_IsNotNull = notNull
+}
+func (t *UserRoles) SetCreatedAt(val time.Time, notNull bool) {
+	t.CreatedAt = val
+	t.CreatedAt_IsNotNull = notNull
+}
+
+// fake, internal type to allow a singleton structure that would hold static-like methods
+type tUserRolesUtils struct {
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForUserRoles structure
+	Cache CacheForUserRoles
+}
+
+// LoadedColumns returns the columns the UserRoles was selected with by WithColumns, nil when it holds all of them
+func (t *UserRoles) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanUserRoles scans a row of the columns of the projection, all of them when it is nil, into a new UserRoles
+func scanUserRoles(scan func(dest ...interface{}) error, projection *columnProjection) (UserRoles, error) {
+
+	currentUserRoles := UserRoles{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableId pgtype.Int4
+	var nullableUserEmail pgtype.Text
+	var nullableName pgtype.Text
+	var nullableTotal pgtype.Int8
+	var nullablePrio Numeric
+	var nullableLit pgtype.Text
+	var nullableLmail pgtype.Text
+	var nullableCreatedAt pgtype.Date
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&nullableId, &nullableUserEmail, &nullableName, &nullableTotal, &nullablePrio, &nullableLit, &nullableLmail, &nullableCreatedAt}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "id":
+				dest = append(dest, &nullableId)
+			case "user_email":
+				dest = append(dest, &nullableUserEmail)
+			case "name":
+				dest = append(dest, &nullableName)
+			case "total":
+				dest = append(dest, &nullableTotal)
+			case "prio":
+				dest = append(dest, &nullablePrio)
+			case "lit":
+				dest = append(dest, &nullableLit)
+			case "lmail":
+				dest = append(dest, &nullableLmail)
+			case "created_at":
+				dest = append(dest, &nullableCreatedAt)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentUserRoles, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("id") {
+		currentUserRoles.SetId(nullableId.Int, boolFromStatus(nullableId.Status))
+	}
+	if projection.loads("user_email") {
+		currentUserRoles.SetUserEmail(nullableUserEmail.String, boolFromStatus(nullableUserEmail.Status))
+	}
+	if projection.loads("name") {
+		currentUserRoles.SetName(nullableName.String, boolFromStatus(nullableName.Status))
+	}
+	if projection.loads("total") {
+		currentUserRoles.SetTotal(nullableTotal.Int, boolFromStatus(nullableTotal.Status))
+	}
+	if projection.loads("prio") {
+		currentUserRoles.SetPrio(nullablePrio.NumericVal(), boolFromStatus(nullablePrio.Status))
+	}
+	if projection.loads("lit") {
+		currentUserRoles.SetLit(nullableLit.String, boolFromStatus(nullableLit.Status))
+	}
+	if projection.loads("lmail") {
+		currentUserRoles.SetLmail(nullableLmail.String, boolFromStatus(nullableLmail.Status))
+	}
+	if projection.loads("created_at") {
+		currentUserRoles.SetCreatedAt(nullableCreatedAt.Time, boolFromStatus(nullableCreatedAt.Status))
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentUserRoles, nil
+}
+
+// UserRolesCols holds the columns of user_roles, building the predicates and the ordering of the queries,
+// e.g. UserRolesCols.Id.Eq(value)
+var UserRolesCols = struct {
+	Id        QueryColumn
+	UserEmail QueryColumn
+	Name      QueryColumn
+	Total     QueryColumn
+	Prio      QueryColumn
+	Lit       QueryColumn
+	Lmail     QueryColumn
+	CreatedAt QueryColumn
+}{
+	Id:        QueryColumn{name: "id"},
+	UserEmail: QueryColumn{name: "user_email"},
+	Name:      QueryColumn{name: "name"},
+	Total:     QueryColumn{name: "total"},
+	Prio:      QueryColumn{name: "prio"},
+	Lit:       QueryColumn{name: "lit"},
+	Lmail:     QueryColumn{name: "lmail"},
+	CreatedAt: QueryColumn{name: "created_at"},
+}
+
+// isUserRolesColumn tells whether the database name is one of the columns of user_roles
+func isUserRolesColumn(dbName string) bool {
+
+	switch dbName {
+	case "id", "user_email", "name", "total", "prio", "lit", "lmail", "created_at":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tUserRolesUtils) Select(where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from user_roles matching the predicate, e.g.
+// UserRolesCols.Id.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tUserRolesUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from user_roles,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tUserRolesUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from user_roles,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tUserRolesUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from user_roles matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentUserRolesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentUserRolesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfUserRoles)
+		}
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tUserRolesUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from user_roles matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tUserRolesUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from user_roles matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from user_roles")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentUserRolesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentUserRolesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfUserRoles)
+		}
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tUserRolesUtils) SelectAll(options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from user_roles.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	// try to get the rows from cache, if enabled and valid
+	if allUserRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allUserRolesRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tUserRolesUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from user_roles ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of UserRoles instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allUserRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allUserRolesRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tUserRolesUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from user_roles equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of UserRoles instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tUserRolesUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allUserRolesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allUserRolesRowsFromCache) {
+			pageStart = len(allUserRolesRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allUserRolesRowsFromCache) {
+			pageEnd = len(allUserRolesRowsFromCache)
+		}
+
+		return allUserRolesRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUserRoles []UserRoles
+
+	for rows.Next() {
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUserRoles = append(sliceOfUserRoles, currentUserRoles)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUserRoles, nil
+}
+
+// SelectUserRoles is SelectUserRolesCtx with the background context
+func (txWrapper *Transaction) SelectUserRoles(where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return txWrapper.SelectUserRolesCtx(context.Background(), where, options...)
+}
+
+// SelectUserRolesCtx runs SelectCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectUserRolesCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedUserRoles is SelectCachedUserRolesCtx with the background context
+func (txWrapper *Transaction) SelectCachedUserRoles(cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return txWrapper.SelectCachedUserRolesCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedUserRolesCtx runs SelectCachedCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedUserRolesCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageUserRoles is SelectPageUserRolesCtx with the background context
+func (txWrapper *Transaction) SelectPageUserRoles(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return txWrapper.SelectPageUserRolesCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageUserRolesCtx runs SelectPageCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageUserRolesCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedUserRoles is SelectPageCachedUserRolesCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedUserRoles(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return txWrapper.SelectPageCachedUserRolesCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedUserRolesCtx runs SelectPageCachedCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedUserRolesCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllUserRoles is SelectAllUserRolesCtx with the background context
+func (txWrapper *Transaction) SelectAllUserRoles(options ...SelectOption) ([]UserRoles, error) {
+	return txWrapper.SelectAllUserRolesCtx(context.Background(), options...)
+}
+
+// SelectAllUserRolesCtx runs SelectAllCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllUserRolesCtx(ctx context.Context, options ...SelectOption) ([]UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for UserRoles         */
+/* ************************************************************ */
+
+type CacheForUserRoles struct {
+	enabled bool // flag to determine if caching is enabled for UserRoles
+
+	sliceCache      map[string][]UserRoles
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]UserRoles
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]UserRoles
+	singleRowCacheMutex sync.RWMutex
+
+	all      []UserRoles
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Views.UserRoles when nil
+	utils *tUserRolesUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForUserRoles) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]UserRoles)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]UserRoles)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]UserRoles)
+	}
+
+}
+
+func (c *CacheForUserRoles) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForUserRoles) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForUserRoles) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForUserRoles) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForUserRoles) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for user_roles and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForUserRoles) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Views.UserRoles
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForUserRoles) GetAllRows() ([]UserRoles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all UserRoles records in the database.
+func (c *CacheForUserRoles) SetAllRows(all []UserRoles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all UserRoles records in the database.
+func (c *CacheForUserRoles) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForUserRoles) GetWhere(key string) ([]UserRoles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wUserRoles, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wUserRoles, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForUserRoles) SetWhere(key string, sliceUserRoles []UserRoles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceUserRoles != nil {
+
+			whereSliceCopy := make([]UserRoles, len(sliceUserRoles))
+			copy(whereSliceCopy, sliceUserRoles)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForUserRoles) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of UserRoles from the cache store based on
+// the given key.
+func (c *CacheForUserRoles) GetSlice(key string) ([]UserRoles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sUserRoles, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sUserRoles, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of UserRoles inside the cache store based and
+// associates it with the given key.
+func (c *CacheForUserRoles) SetSlice(key string, sliceUserRoles []UserRoles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceUserRoles != nil {
+
+			sliceCopy := make([]UserRoles, len(sliceUserRoles))
+			copy(sliceCopy, sliceUserRoles)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of UserRoles from the cache store entry
+// associated with key.
+func (c *CacheForUserRoles) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *UserRoles from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForUserRoles) Get(key string) (*UserRoles, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a UserRoles with key, and saves it in the cache store.
+func (c *CacheForUserRoles) Set(key string, structUserRoles UserRoles) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structUserRoles
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the UserRoles instance that is associated with key from the
+// cache store.
+func (c *CacheForUserRoles) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for UserRoles           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tUserRolesUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from user_roles matching all the predicates, e.g.
+// UserRolesCols.Id.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tUserRolesUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "UserRolesUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM user_roles"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tUserRolesUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from user_roles
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tUserRolesUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "UserRolesUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.user_roles'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tUserRolesUtils) Single(where Predicate, options ...SelectOption) (*UserRoles, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from user_roles matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tUserRolesUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*UserRoles, error) {
+
+	var errorPrefix = "UserRolesUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUserRolesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_email, name, total, prio, lit, lmail, created_at FROM user_roles ", "user_roles")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfUserRoles *UserRoles
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanUserRoles creates a new instance of UserRoles
+		currentUserRoles, err := scanUserRoles(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfUserRoles = &currentUserRoles
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfUserRoles, nil
+}
+
+// SingleUserRoles is SingleUserRolesCtx with the background context
+func (txWrapper *Transaction) SingleUserRoles(where Predicate, options ...SelectOption) (*UserRoles, error) {
+	return txWrapper.SingleUserRolesCtx(context.Background(), where, options...)
+}
+
+// SingleUserRolesCtx runs SingleCtx of UserRoles in the transaction,
+// as txWrapper.DB().UserRoles.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleUserRolesCtx(ctx context.Context, where Predicate, options ...SelectOption) (*UserRoles, error) {
+	return NewDB(txWrapper).UserRoles.SingleCtx(ctx, where, options...)
+}
+
+// keysetUserRoles holds the unique keys of user_roles, and the columns its SelectAfter pages can be ordered by
+var keysetUserRoles = keyset{
+	keys: [][]string{
+		{"id", "name"},
+	},
+	columns: []string{"id", "name"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tUserRolesUtils) keysetValue(row *UserRoles, dbName string) interface{} {
+
+	switch dbName {
+	case "id":
+		return row.Id
+	case "name":
+		return row.Name
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tUserRolesUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "id":
+		var param int32
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "name":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tUserRolesUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from user_roles matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of user_roles
+// (id, name) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tUserRolesUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error) {
+
+	var errorPrefix = "UserRolesUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetUserRoles.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetUserRoles.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// UserRolesRepository holds the methods of Views.UserRoles, so that the code using them
+// can depend on the interface, and be unit tested with a UserRolesRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type UserRolesRepository interface {
+	Select(where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectAll(options ...SelectOption) ([]UserRoles, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]UserRoles, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*UserRoles, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*UserRoles, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error)
+}
+
+var _ UserRolesRepository = (*tUserRolesUtils)(nil)
+
+// UserRolesRepositoryMock is a UserRolesRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type UserRolesRepositoryMock struct {
+	MockCalls
+
+	SelectFunc              func(where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCtxFunc           func(ctx context.Context, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionFunc         func(where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionCtxFunc      func(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionAllFunc      func(where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectUnionAllCtxFunc   func(ctx context.Context, where []Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCachedFunc        func(cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectCachedCtxFunc     func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageFunc          func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCtxFunc       func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCachedFunc    func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectPageCachedCtxFunc func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]UserRoles, error)
+	SelectAllFunc           func(options ...SelectOption) ([]UserRoles, error)
+	SelectAllCtxFunc        func(ctx context.Context, options ...SelectOption) ([]UserRoles, error)
+	SelectAllOrderByFunc    func(orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllOrderByCtxFunc func(ctx context.Context, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllPageFunc       func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	SelectAllPageCtxFunc    func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]UserRoles, error)
+	CountFunc               func(where ...Predicate) (int64, error)
+	CountCtxFunc            func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc      func() (int64, error)
+	CountImpreciseCtxFunc   func(ctx context.Context) (int64, error)
+	SingleFunc              func(where Predicate, options ...SelectOption) (*UserRoles, error)
+	SingleCtxFunc           func(ctx context.Context, where Predicate, options ...SelectOption) (*UserRoles, error)
+	SelectAfterFunc         func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error)
+	SelectAfterCtxFunc      func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]UserRoles, string, error)
+}
+
+var _ UserRolesRepository = (*UserRolesRepositoryMock)(nil)
+
+// Select records the call and runs SelectFunc
+func (mock *UserRolesRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *UserRolesRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *UserRolesRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *UserRolesRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *UserRolesRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *UserRolesRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *UserRolesRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *UserRolesRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *UserRolesRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *UserRolesRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *UserRolesRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *UserRolesRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *UserRolesRepositoryMock) SelectAll(options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *UserRolesRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *UserRolesRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *UserRolesRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *UserRolesRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *UserRolesRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []UserRoles, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *UserRolesRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *UserRolesRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *UserRolesRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *UserRolesRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *UserRolesRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *UserRoles, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *UserRolesRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *UserRoles, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *UserRolesRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []UserRoles, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *UserRolesRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []UserRoles, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
@@ -0,0 +1,4610 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+	pgx "github.com/jackc/pgx/v4"
+)
+
+const Users_DB_TABLE_NAME string = "users"
+
+/*
+Users is a struct that corresponds to the users table.
+Database comments: The users; all of them
+*/
+type Users struct {
+	Id int32 // database field name: id, IsPK: true , IsCompositePK: false, IsFK: false
+
+	UserGuid string // database field name: user_guid, IsPK: false , IsCompositePK: false, IsFK: false
+
+	/* Login e-mail */
+	Email string // database field name: email, IsPK: false , IsCompositePK: false, IsFK: false
+
+	FirstName           string // database field name: first_name, IsPK: false , IsCompositePK: false, IsFK: false
+	FirstName_IsNotNull bool   // if true, it means the value is not null
+
+	CreatedAt time.Time // database field name: created_at, IsPK: false , IsCompositePK: false, IsFK: false
+
+	CurrentMood           string // database field name: current_mood, IsPK: false , IsCompositePK: false, IsFK: false
+	CurrentMood_IsNotNull bool   // if true, it means the value is not null
+
+	Tags           string // database field name: tags, IsPK: false , IsCompositePK: false, IsFK: false
+	Tags_IsNotNull bool   // if true, it means the value is not null
+
+	Balance           Numeric // database field name: balance, IsPK: false , IsCompositePK: false, IsFK: false
+	Balance_IsNotNull bool    // if true, it means the value is not null
+
+	/* @gotype:encoding/json.RawMessage */
+	Profile json.RawMessage // database field name: profile, IsPK: false , IsCompositePK: false, IsFK: false
+
+	// Set this to true if you want Inserts to ignore the PK fields
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+// SetId sets the Id field to val.
+func (t *Users) SetId(val int32) {
+	t.Id = val
+
+}
+
+// SetUserGuid sets the UserGuid field to val.
+func (t *Users) SetUserGuid(val string) {
+	t.UserGuid = val
+
+}
+
+// SetEmail sets the Email field to val.
+func (t *Users) SetEmail(val string) {
+	t.Email = val
+
+}
+
+// SetFirstName sets the FirstName field to val.
+func (t *Users) SetFirstName(val string, notNull bool) {
+	t.FirstName = val
+	t.FirstName_IsNotNull = notNull
+}
+
+// IsNotNull_FirstName returns true is the field is not null (a value is present)
+func (t *Users) IsNotNull_FirstName() bool {
+	return t.FirstName_IsNotNull
+}
+
+// IsNull_FirstName returns true is the field is null (a value is not present)
+func (t *Users) IsNull_FirstName() bool {
+	return !t.FirstName_IsNotNull
+}
+
+// SetCreatedAt sets the CreatedAt field to val.
+func (t *Users) SetCreatedAt(val time.Time) {
+	t.CreatedAt = val
+
+}
+
+// SetCurrentMood sets the CurrentMood field to val.
+func (t *Users) SetCurrentMood(val string, notNull bool) {
+	t.CurrentMood = val
+	t.CurrentMood_IsNotNull = notNull
+}
+
+// IsNotNull_CurrentMood returns true is the field is not null (a value is present)
+func (t *Users) IsNotNull_CurrentMood() bool {
+	return t.CurrentMood_IsNotNull
+}
+
+// IsNull_CurrentMood returns true is the field is null (a value is not present)
+func (t *Users) IsNull_CurrentMood() bool {
+	return !t.CurrentMood_IsNotNull
+}
+
+// SetTags sets the Tags field to val.
+func (t *Users) SetTags(val string, notNull bool) {
+	t.Tags = val
+	t.Tags_IsNotNull = notNull
+}
+
+// IsNotNull_Tags returns true is the field is not null (a value is present)
+func (t *Users) IsNotNull_Tags() bool {
+	return t.Tags_IsNotNull
+}
+
+// IsNull_Tags returns true is the field is null (a value is not present)
+func (t *Users) IsNull_Tags() bool {
+	return !t.Tags_IsNotNull
+}
+
+// SetBalance sets the Balance field to val.
+func (t *Users) SetBalance(val Numeric, notNull bool) {
+	t.Balance = val
+	t.Balance_IsNotNull = notNull
+}
+
+// IsNotNull_Balance returns true is the field is not null (a value is present)
+func (t *Users) IsNotNull_Balance() bool {
+	return t.Balance_IsNotNull
+}
+
+// IsNull_Balance returns true is the field is null (a value is not present)
+func (t *Users) IsNull_Balance() bool {
+	return !t.Balance_IsNotNull
+}
+
+// SetProfile sets the Profile field to val.
+func (t *Users) SetProfile(val json.RawMessage) {
+	t.Profile = val
+
+}
+
+// MarkAllColumnsLoaded lets the instance Update of a Users selected WithColumns write back all
+// its fields, the zero values of the columns left out included
+func (t *Users) MarkAllColumnsLoaded() {
+	t.pgToGo_projection = nil
+}
+
+/* Users slice manipulation helpers */
+
+// UsersSlice allows manipulation of a slice of Users using the
+// attached methods. Simply cast []Users to UsersSlice
+// e.g. UsersSlice(anyGivenUsersSlice)
+// and you have various methods available. Please note that these methods do not impact
+// the database in any way.
+type UsersSlice []Users
+
+type UsersSliceFilterFunc func(c Users) bool
+
+// Filter filters the original UsersSlice elements using the supplied
+// filterFunc closure. The caller must reassign the returned collection:
+//
+//	newSlice := Filter(filterFunc)
+func (cc UsersSlice) Filter(filterFunc UsersSliceFilterFunc) UsersSlice {
+	if cc == nil {
+		return nil
+	}
+	ccNew := make(UsersSlice, 0, len(cc))
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			ccNew = append(ccNew, cc[i])
+		}
+	}
+	return ccNew
+}
+
+// FilterInto filters the original UsersSlice collection into the supplied
+// dest UsersSlice. Passing the preallocated collection can potentially avoid
+// a huge number of allocations in a fast paced application.
+// The caller must reassign the returned collection to dest:
+//
+//	dest = FilterInto(filterFunc, dest)
+func (cc UsersSlice) FilterInto(filterFunc UsersSliceFilterFunc,
+	dest UsersSlice) UsersSlice {
+	if cc == nil {
+		dest = nil
+		return nil
+	}
+	if dest == nil {
+		return nil
+	}
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			dest = append(dest, cc[i])
+		}
+	}
+	return dest
+}
+
+/* Sorting helper containers */
+
+// SortUsersById implements sort.Interface for []Users based on
+// the Id field. Usage: sort.Sort(SortUsersById(anyGivenUsersSlice))
+type SortUsersById []Users
+
+func (a SortUsersById) Len() int           { return len(a) }
+func (a SortUsersById) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortUsersById) Less(i, j int) bool { return LessComparatorFor_int32(a[i].Id, a[j].Id) }
+
+// SortUsersByUserGuid implements sort.Interface for []Users based on
+// the UserGuid field. Usage: sort.Sort(SortUsersByUserGuid(anyGivenUsersSlice))
+type SortUsersByUserGuid []Users
+
+func (a SortUsersByUserGuid) Len() int      { return len(a) }
+func (a SortUsersByUserGuid) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByUserGuid) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].UserGuid, a[j].UserGuid)
+}
+
+// SortUsersByEmail implements sort.Interface for []Users based on
+// the Email field. Usage: sort.Sort(SortUsersByEmail(anyGivenUsersSlice))
+type SortUsersByEmail []Users
+
+func (a SortUsersByEmail) Len() int      { return len(a) }
+func (a SortUsersByEmail) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByEmail) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Email, a[j].Email)
+}
+
+// SortUsersByFirstName implements sort.Interface for []Users based on
+// the FirstName field. Usage: sort.Sort(SortUsersByFirstName(anyGivenUsersSlice))
+type SortUsersByFirstName []Users
+
+func (a SortUsersByFirstName) Len() int      { return len(a) }
+func (a SortUsersByFirstName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByFirstName) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].FirstName, a[j].FirstName)
+}
+
+// SortUsersByCreatedAt implements sort.Interface for []Users based on
+// the CreatedAt field. Usage: sort.Sort(SortUsersByCreatedAt(anyGivenUsersSlice))
+type SortUsersByCreatedAt []Users
+
+func (a SortUsersByCreatedAt) Len() int      { return len(a) }
+func (a SortUsersByCreatedAt) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByCreatedAt) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].CreatedAt, a[j].CreatedAt)
+}
+
+// SortUsersByCurrentMood implements sort.Interface for []Users based on
+// the CurrentMood field. Usage: sort.Sort(SortUsersByCurrentMood(anyGivenUsersSlice))
+type SortUsersByCurrentMood []Users
+
+func (a SortUsersByCurrentMood) Len() int      { return len(a) }
+func (a SortUsersByCurrentMood) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByCurrentMood) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].CurrentMood, a[j].CurrentMood)
+}
+
+// SortUsersByTags implements sort.Interface for []Users based on
+// the Tags field. Usage: sort.Sort(SortUsersByTags(anyGivenUsersSlice))
+type SortUsersByTags []Users
+
+func (a SortUsersByTags) Len() int           { return len(a) }
+func (a SortUsersByTags) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByTags) Less(i, j int) bool { return LessComparatorFor_string(a[i].Tags, a[j].Tags) }
+
+// SortUsersByBalance implements sort.Interface for []Users based on
+// the Balance field. Usage: sort.Sort(SortUsersByBalance(anyGivenUsersSlice))
+type SortUsersByBalance []Users
+
+func (a SortUsersByBalance) Len() int      { return len(a) }
+func (a SortUsersByBalance) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortUsersByBalance) Less(i, j int) bool {
+	return LessComparatorFor_Numeric(a[i].Balance, a[j].Balance)
+}
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tUsersUtils struct {
+	utilMutex sync.RWMutex
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForUsers structure
+	Cache CacheForUsers
+}
+
+var ErrUsers_UQ_users_email_key = NewModelsErrorLocalWithCode("Unique constraint violation:", "users_email_key", "23505")
+var ErrUsers_UQ_users_guid_idx = NewModelsErrorLocalWithCode("Unique constraint violation:", "users_guid_idx", "23505")
+
+// Creates a new pointer to a blank Users structure.
+// Some of the fields, such as the time.Time ones, might be already set to time.Now()
+// based on the Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords setting
+func (utilRef *tUsersUtils) New() *Users {
+
+	newUsers := &Users{}
+
+	newUsers.CloneGlobalSettings()
+
+	return newUsers
+}
+
+// Creates a new pointer to a Users from an Http Request.
+// The parameters are expected to match the struct field names
+func (utilRef *tUsersUtils) CreateFromHttpRequest(req *http.Request) (*Users, error) {
+
+	var errorPrefix = "UsersUtils.CreateFromHttpRequest() ERROR: "
+
+	if req == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")
+	}
+
+	var err error = nil
+	newUsers := &Users{}
+
+	newUsers.CloneGlobalSettings()
+
+	newUsers.Id, err = To_int32_FromString(req.FormValue("Id"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newUsers.UserGuid = req.FormValue("UserGuid")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newUsers.Email = req.FormValue("Email")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newUsers.FirstName = req.FormValue("FirstName")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("FirstName") != "" {
+		newUsers.FirstName_IsNotNull = true
+	}
+
+	newUsers.CreatedAt, err = To_Time_FromString(req.FormValue("CreatedAt"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newUsers.CurrentMood = req.FormValue("CurrentMood")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("CurrentMood") != "" {
+		newUsers.CurrentMood_IsNotNull = true
+	}
+
+	newUsers.Tags = req.FormValue("Tags")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Tags") != "" {
+		newUsers.Tags_IsNotNull = true
+	}
+
+	newUsers.Balance, err = To_Numeric_FromString(req.FormValue("Balance"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Balance") != "" {
+		newUsers.Balance_IsNotNull = true
+	}
+
+	// Profile has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+
+	return newUsers, nil
+}
+
+// Creates a new pointer to a Users from an Http Request.
+// The parameters are expected to match the struct field names
+// Unlike CreateFromHttpRequest, this method completely does not exit when encountering parsing errors,
+// but accumulates them into an []error slice. Futher Validation is needed.
+func (utilRef *tUsersUtils) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Users, []error) {
+
+	if req == nil {
+
+		var errorPrefix = "UsersUtils.CreateFromHttpRequestIgnoreErrors() ERROR: "
+		noReqErrors := []error{NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")}
+		return nil, noReqErrors
+	}
+
+	var errors []error
+	var currentError error
+
+	newUsers := &Users{}
+
+	newUsers.CloneGlobalSettings()
+
+	newUsers.Id, currentError = To_int32_FromString(req.FormValue("Id"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.UserGuid = req.FormValue("UserGuid")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.Email = req.FormValue("Email")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.FirstName = req.FormValue("FirstName")
+
+	if req.FormValue("FirstName") != "" {
+		newUsers.FirstName_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.CreatedAt, currentError = To_Time_FromString(req.FormValue("CreatedAt"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.CurrentMood = req.FormValue("CurrentMood")
+
+	if req.FormValue("CurrentMood") != "" {
+		newUsers.CurrentMood_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.Tags = req.FormValue("Tags")
+
+	if req.FormValue("Tags") != "" {
+		newUsers.Tags_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newUsers.Balance, currentError = To_Numeric_FromString(req.FormValue("Balance"))
+
+	if currentError == nil && req.FormValue("Balance") != "" {
+		newUsers.Balance_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	// Profile has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+
+	return newUsers, errors
+}
+
+// CloneGlobalSettings assigns the global settings for operations to the control fields
+// of this instance. An example would be:
+// instanceUsers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+func (instance *Users) CloneGlobalSettings() {
+
+	instance.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+	instance.PgToGo_SetDateTimeFieldsToNowForNewRecords = Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords
+	instance.PgToGo_SetGuidFieldsToNewGuidsNewRecords = Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords
+
+}
+
+// Returns the database field name, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tUsersUtils) ToDbFieldName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "Id" || fieldDbOrGoName == "id" {
+		return "id"
+	}
+	if fieldDbOrGoName == "UserGuid" || fieldDbOrGoName == "user_guid" {
+		return "user_guid"
+	}
+	if fieldDbOrGoName == "Email" || fieldDbOrGoName == "email" {
+		return "email"
+	}
+	if fieldDbOrGoName == "FirstName" || fieldDbOrGoName == "first_name" {
+		return "first_name"
+	}
+	if fieldDbOrGoName == "CreatedAt" || fieldDbOrGoName == "created_at" {
+		return "created_at"
+	}
+	if fieldDbOrGoName == "CurrentMood" || fieldDbOrGoName == "current_mood" {
+		return "current_mood"
+	}
+	if fieldDbOrGoName == "Tags" || fieldDbOrGoName == "tags" {
+		return "tags"
+	}
+	if fieldDbOrGoName == "Balance" || fieldDbOrGoName == "balance" {
+		return "balance"
+	}
+	if fieldDbOrGoName == "Profile" || fieldDbOrGoName == "profile" {
+		return "profile"
+	}
+
+	return ""
+}
+
+// Returns the database field type, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tUsersUtils) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "Id" || fieldDbOrGoName == "id" {
+		return "integer"
+	}
+	if fieldDbOrGoName == "UserGuid" || fieldDbOrGoName == "user_guid" {
+		return "uuid"
+	}
+	if fieldDbOrGoName == "Email" || fieldDbOrGoName == "email" {
+		return "character varying"
+	}
+	if fieldDbOrGoName == "FirstName" || fieldDbOrGoName == "first_name" {
+		return "text"
+	}
+	if fieldDbOrGoName == "CreatedAt" || fieldDbOrGoName == "created_at" {
+		return "timestamp with time zone"
+	}
+	if fieldDbOrGoName == "CurrentMood" || fieldDbOrGoName == "current_mood" {
+		return "text"
+	}
+	if fieldDbOrGoName == "Tags" || fieldDbOrGoName == "tags" {
+		return "ARRAY"
+	}
+	if fieldDbOrGoName == "Balance" || fieldDbOrGoName == "balance" {
+		return "numeric"
+	}
+	if fieldDbOrGoName == "Profile" || fieldDbOrGoName == "profile" {
+		return "jsonb"
+	}
+
+	return ""
+}
+
+// LoadedColumns returns the columns the Users was selected with by WithColumns, nil when it holds all of them
+func (t *Users) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanUsers scans a row of the columns of the projection, all of them when it is nil, into a new Users
+func scanUsers(scan func(dest ...interface{}) error, projection *columnProjection) (Users, error) {
+
+	currentUsers := Users{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableFirstName pgtype.Text
+	var nullableCurrentMood pgtype.Text
+	var nullableTags pgtype.Text
+	var nullableBalance Numeric
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&currentUsers.Id, &currentUsers.UserGuid, &currentUsers.Email, &nullableFirstName, &currentUsers.CreatedAt, &nullableCurrentMood, &nullableTags, &nullableBalance, &currentUsers.Profile}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "id":
+				dest = append(dest, &currentUsers.Id)
+			case "user_guid":
+				dest = append(dest, &currentUsers.UserGuid)
+			case "email":
+				dest = append(dest, &currentUsers.Email)
+			case "first_name":
+				dest = append(dest, &nullableFirstName)
+			case "created_at":
+				dest = append(dest, &currentUsers.CreatedAt)
+			case "current_mood":
+				dest = append(dest, &nullableCurrentMood)
+			case "tags":
+				dest = append(dest, &nullableTags)
+			case "balance":
+				dest = append(dest, &nullableBalance)
+			case "profile":
+				dest = append(dest, &currentUsers.Profile)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentUsers, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("first_name") {
+		currentUsers.SetFirstName(nullableFirstName.String, boolFromStatus(nullableFirstName.Status))
+	}
+	if projection.loads("current_mood") {
+		currentUsers.SetCurrentMood(nullableCurrentMood.String, boolFromStatus(nullableCurrentMood.Status))
+	}
+	if projection.loads("tags") {
+		currentUsers.SetTags(nullableTags.String, boolFromStatus(nullableTags.Status))
+	}
+	if projection.loads("balance") {
+		currentUsers.SetBalance(nullableBalance.NumericVal(), boolFromStatus(nullableBalance.Status))
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentUsers, nil
+}
+
+// UsersCols holds the columns of users, building the predicates and the ordering of the queries,
+// e.g. UsersCols.Id.Eq(value)
+var UsersCols = struct {
+	Id          QueryColumn
+	UserGuid    QueryColumn
+	Email       QueryColumn
+	FirstName   QueryColumn
+	CreatedAt   QueryColumn
+	CurrentMood QueryColumn
+	Tags        QueryColumn
+	Balance     QueryColumn
+	Profile     QueryColumn
+}{
+	Id:          QueryColumn{name: "id"},
+	UserGuid:    QueryColumn{name: "user_guid"},
+	Email:       QueryColumn{name: "email"},
+	FirstName:   QueryColumn{name: "first_name"},
+	CreatedAt:   QueryColumn{name: "created_at"},
+	CurrentMood: QueryColumn{name: "current_mood"},
+	Tags:        QueryColumn{name: "tags"},
+	Balance:     QueryColumn{name: "balance"},
+	Profile:     QueryColumn{name: "profile"},
+}
+
+// isUsersColumn tells whether the database name is one of the columns of users
+func isUsersColumn(dbName string) bool {
+
+	switch dbName {
+	case "id", "user_guid", "email", "first_name", "created_at", "current_mood", "tags", "balance", "profile":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tUsersUtils) Select(where Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from users matching the predicate, e.g.
+// UsersCols.Id.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tUsersUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from users,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tUsersUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from users,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tUsersUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from users matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentUsersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentUsersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfUsers)
+		}
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tUsersUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from users matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tUsersUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from users matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentUsersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentUsersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfUsers)
+		}
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tUsersUtils) SelectAll(options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from users.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	// try to get the rows from cache, if enabled and valid
+	if allUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allUsersRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tUsersUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from users ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of Users instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allUsersRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tUsersUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from users equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of Users instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allUsersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allUsersRowsFromCache) {
+			pageStart = len(allUsersRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allUsersRowsFromCache) {
+			pageEnd = len(allUsersRowsFromCache)
+		}
+
+		return allUsersRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfUsers []Users
+
+	for rows.Next() {
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfUsers = append(sliceOfUsers, currentUsers)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfUsers, nil
+}
+
+// SelectUsers is SelectUsersCtx with the background context
+func (txWrapper *Transaction) SelectUsers(where Predicate, options ...SelectOption) ([]Users, error) {
+	return txWrapper.SelectUsersCtx(context.Background(), where, options...)
+}
+
+// SelectUsersCtx runs SelectCtx of Users in the transaction,
+// as txWrapper.DB().Users.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectUsersCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Users, error) {
+	return NewDB(txWrapper).Users.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedUsers is SelectCachedUsersCtx with the background context
+func (txWrapper *Transaction) SelectCachedUsers(cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return txWrapper.SelectCachedUsersCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedUsersCtx runs SelectCachedCtx of Users in the transaction,
+// as txWrapper.DB().Users.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedUsersCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return NewDB(txWrapper).Users.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageUsers is SelectPageUsersCtx with the background context
+func (txWrapper *Transaction) SelectPageUsers(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return txWrapper.SelectPageUsersCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageUsersCtx runs SelectPageCtx of Users in the transaction,
+// as txWrapper.DB().Users.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageUsersCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return NewDB(txWrapper).Users.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedUsers is SelectPageCachedUsersCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedUsers(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return txWrapper.SelectPageCachedUsersCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedUsersCtx runs SelectPageCachedCtx of Users in the transaction,
+// as txWrapper.DB().Users.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedUsersCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return NewDB(txWrapper).Users.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllUsers is SelectAllUsersCtx with the background context
+func (txWrapper *Transaction) SelectAllUsers(options ...SelectOption) ([]Users, error) {
+	return txWrapper.SelectAllUsersCtx(context.Background(), options...)
+}
+
+// SelectAllUsersCtx runs SelectAllCtx of Users in the transaction,
+// as txWrapper.DB().Users.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllUsersCtx(ctx context.Context, options ...SelectOption) ([]Users, error) {
+	return NewDB(txWrapper).Users.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for Users         */
+/* ************************************************************ */
+
+type CacheForUsers struct {
+	enabled bool // flag to determine if caching is enabled for Users
+
+	sliceCache      map[string][]Users
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]Users
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]Users
+	singleRowCacheMutex sync.RWMutex
+
+	all      []Users
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Tables.Users when nil
+	utils *tUsersUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForUsers) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]Users)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]Users)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]Users)
+	}
+
+}
+
+func (c *CacheForUsers) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForUsers) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForUsers) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForUsers) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForUsers) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for users and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForUsers) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Tables.Users
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForUsers) GetAllRows() ([]Users, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all Users records in the database.
+func (c *CacheForUsers) SetAllRows(all []Users) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all Users records in the database.
+func (c *CacheForUsers) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForUsers) GetWhere(key string) ([]Users, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wUsers, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wUsers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForUsers) SetWhere(key string, sliceUsers []Users) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceUsers != nil {
+
+			whereSliceCopy := make([]Users, len(sliceUsers))
+			copy(whereSliceCopy, sliceUsers)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForUsers) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of Users from the cache store based on
+// the given key.
+func (c *CacheForUsers) GetSlice(key string) ([]Users, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sUsers, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sUsers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of Users inside the cache store based and
+// associates it with the given key.
+func (c *CacheForUsers) SetSlice(key string, sliceUsers []Users) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceUsers != nil {
+
+			sliceCopy := make([]Users, len(sliceUsers))
+			copy(sliceCopy, sliceUsers)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of Users from the cache store entry
+// associated with key.
+func (c *CacheForUsers) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *Users from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForUsers) Get(key string) (*Users, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a Users with key, and saves it in the cache store.
+func (c *CacheForUsers) Set(key string, structUsers Users) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structUsers
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the Users instance that is associated with key from the
+// cache store.
+func (c *CacheForUsers) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for Users           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tUsersUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from users matching all the predicates, e.g.
+// UsersCols.Id.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tUsersUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "UsersUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM users"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tUsersUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from users
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tUsersUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "UsersUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.users'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tUsersUtils) Single(where Predicate, options ...SelectOption) (*Users, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from users matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tUsersUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Users, error) {
+
+	var errorPrefix = "UsersUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isUsersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users ", "users")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfUsers *Users
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanUsers creates a new instance of Users
+		currentUsers, err := scanUsers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfUsers = &currentUsers
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfUsers, nil
+}
+
+// SingleUsers is SingleUsersCtx with the background context
+func (txWrapper *Transaction) SingleUsers(where Predicate, options ...SelectOption) (*Users, error) {
+	return txWrapper.SingleUsersCtx(context.Background(), where, options...)
+}
+
+// SingleUsersCtx runs SingleCtx of Users in the transaction,
+// as txWrapper.DB().Users.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleUsersCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Users, error) {
+	return NewDB(txWrapper).Users.SingleCtx(ctx, where, options...)
+}
+
+// SelectWhereJSONPath is SelectWhereJSONPathCtx with the background context
+func (utilRef *tUsersUtils) SelectWhereJSONPath(column string, path []string, value interface{}) ([]Users, error) {
+	return utilRef.SelectWhereJSONPathCtx(context.Background(), column, path, value)
+}
+
+// SelectWhereJSONPathCtx returns the rows from users whose json document in the given column
+// holds the value at the given path (e.g. []string{"address", "city"}).
+// A string value is compared with the text found at the path (column #>> path = value),
+// any other value is matched by containment (column @> {"address": {"city": value}}).
+// The column is the database name of one of the json columns: profile.
+// This version is not cached and calls the database directly.
+func (utilRef *tUsersUtils) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Users, error) {
+
+	var errorPrefix = "UsersUtils.SelectWhereJSONPath() ERROR: "
+
+	switch column {
+	case "profile":
+	default:
+		return nil, NewModelsErrorLocal(errorPrefix, "not a json column of users: "+column)
+	}
+
+	if len(path) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the path is empty")
+	}
+
+	if text, isString := value.(string); isString {
+		return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+" #>> $1::text[] = $2", path, text))
+	}
+
+	// nest the value inside the path, from the innermost key outwards
+	document := value
+	for i := len(path) - 1; i >= 0; i-- {
+		document = map[string]interface{}{path[i]: document}
+	}
+
+	documentBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" could not marshal the value:", err)
+	}
+
+	return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+"::jsonb @> $1::jsonb", string(documentBytes)))
+}
+
+// keysetUsers holds the unique keys of users, and the columns its SelectAfter pages can be ordered by
+var keysetUsers = keyset{
+	keys: [][]string{
+		{"id"},
+		{"email"},
+		{"user_guid"},
+	},
+	columns: []string{"id", "user_guid", "email", "created_at", "profile"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tUsersUtils) keysetValue(row *Users, dbName string) interface{} {
+
+	switch dbName {
+	case "id":
+		return row.Id
+	case "user_guid":
+		return row.UserGuid
+	case "email":
+		return row.Email
+	case "created_at":
+		return row.CreatedAt
+	case "profile":
+		return row.Profile
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tUsersUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "id":
+		var param int32
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "user_guid":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "email":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "created_at":
+		var param time.Time
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "profile":
+		var param json.RawMessage
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tUsersUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from users matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of users
+// (id; email; user_guid) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tUsersUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error) {
+
+	var errorPrefix = "UsersUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetUsers.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetUsers.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Insert is InsertCtx with the background context
+func (utilRef *tUsersUtils) Insert(sourceUsers *Users) (*Users, error) {
+	return utilRef.InsertCtx(context.Background(), sourceUsers)
+}
+
+// InsertCtx inserts a new row into the users table, using the values
+// inside the pointer to a Users structure passed to it.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (utilRef *tUsersUtils) InsertCtx(ctx context.Context, sourceUsers *Users) (*Users, error) {
+
+	var errorPrefix = "UsersUtils.Insert() ERROR: "
+
+	if sourceUsers == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define returning PK params for the insert query row execution
+	var paramId int32
+
+	// define the insert query
+	var insertQueryAllColumns = "INSERT INTO users(id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)  RETURNING id"
+	var insertQueryNoPKColumns = "INSERT INTO users(user_guid, email, first_name, created_at, current_mood, tags, balance, profile) VALUES($1, $2, $3, $4, $5, $6, $7, $8)  RETURNING id"
+
+	var query string = insertQueryAllColumns
+
+	if sourceUsers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		query = insertQueryNoPKColumns
+	}
+
+	// pq does not support the LastInsertId() method of the Result type in database/sql.
+	// To return the identifier of an INSERT (or UPDATE or DELETE), use the Postgres RETURNING clause
+	// with a standard Query or QueryRow call
+
+	var err error
+
+	if sourceUsers.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceUsers.CreatedAt = Now()
+
+	}
+
+	if sourceUsers.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+		sourceUsers.UserGuid = NewGuid()
+
+	}
+
+	// define the values to be passed, from the structure
+	var _id, _user_guid, _email, _first_name, _created_at, _current_mood, _tags, _balance, _profile = sourceUsers.Id, sourceUsers.UserGuid, sourceUsers.Email, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)}, sourceUsers.CreatedAt, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)}, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)}, toPgxNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull), sourceUsers.Profile
+
+	// this will print only if debug mode enabled
+	Debug("Insert Query:", query)
+
+	if sourceUsers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		err = currentDbHandle.QueryRow(ctx, query, _user_guid, _email, _first_name, _created_at, _current_mood, _tags, _balance, _profile).Scan(&paramId)
+	} else {
+		err = currentDbHandle.QueryRow(ctx, query, _id, _user_guid, _email, _first_name, _created_at, _current_mood, _tags, _balance, _profile).Scan(&paramId)
+	}
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "users_email_key") {
+				return nil, ErrUsers_UQ_users_email_key
+			}
+			if Contains(err.Error(), "users_guid_idx") {
+				return nil, ErrUsers_UQ_users_guid_idx
+			}
+
+		}
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// populate the returning ids inside the returnStructure pointer
+		sourceUsers.Id = paramId
+
+		// return the structure
+		return sourceUsers, nil
+	}
+}
+
+// Insert is InsertCtx with the background context
+func (sourceUsers *Users) Insert() (*Users, error) {
+	return sourceUsers.InsertCtx(context.Background())
+}
+
+// InsertCtx inserts a new row into the users table, corresponding to the provided sourceUsers
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (sourceUsers *Users) InsertCtx(ctx context.Context) (*Users, error) {
+
+	return Tables.Users.InsertCtx(ctx, sourceUsers)
+}
+
+// InsertUsers is InsertUsersCtx with the background context
+func (txWrapper *Transaction) InsertUsers(sourceUsers *Users) (*Users, error) {
+	return txWrapper.InsertUsersCtx(context.Background(), sourceUsers)
+}
+
+// InsertUsersCtx runs InsertCtx of Users in the transaction,
+// as txWrapper.DB().Users.InsertCtx(...) does.
+func (txWrapper *Transaction) InsertUsersCtx(ctx context.Context, sourceUsers *Users) (*Users, error) {
+	return NewDB(txWrapper).Users.InsertCtx(ctx, sourceUsers)
+}
+
+// CopyFromReader is CopyFromReaderCtx with the background context
+func (utilRef *tUsersUtils) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+	return utilRef.CopyFromReaderCtx(context.Background(), r, opt, columns...)
+}
+
+// CopyFromReaderCtx performs a bulk copy of csv-like content from the specified reader
+// into the users table. The opt parameter is a *CopyFromReaderOptions which
+// allows the caller to specify the separator and the null placeholder.
+// In case nil is passed as null placeholder, for empty strings, the actual empty string value
+// will be inserted, instead of a db null value.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tUsersUtils) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+
+	var errorPrefix = "UsersUtils.CopyFromReader() ERROR: "
+
+	if r == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the reader r is nil")
+	}
+
+	var optSeparator rune
+	var optNullPlaceholders []string
+	var optComment rune
+	var optIncludePKCols bool
+	if opt == nil {
+		optSeparator = defSeparator
+		optNullPlaceholders = defNullPlaceholders[:]
+		optComment = defCommentRune
+		optIncludePKCols = defIncludePKCols
+	} else {
+		optSeparator = opt.Separator
+		optNullPlaceholders = opt.NullPlaceholders
+		optComment = opt.Comment
+		optIncludePKCols = opt.IncludePKCols
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var err error
+
+	// Slices that hold the column database names and their db types
+	var colDbNames []string
+	var colDbTypes []string
+
+	// If no custom column mask was provided, assume the all the columns are a target
+	if len(columns) == 0 {
+		if optIncludePKCols {
+			colDbNames = []string{"id", "user_guid", "email", "first_name", "created_at", "current_mood", "tags", "balance", "profile"}
+			colDbTypes = []string{"integer", "uuid", "character varying", "text", "timestamp with time zone", "text", "ARRAY", "numeric", "jsonb"}
+		} else {
+			colDbNames = []string{"user_guid", "email", "first_name", "created_at", "current_mood", "tags", "balance", "profile"}
+			colDbTypes = []string{"uuid", "character varying", "text", "timestamp with time zone", "text", "ARRAY", "numeric", "jsonb"}
+		}
+	} else {
+		// Range through the custom columns and obtain the db name and db type
+		for i := range columns {
+			colDbNames = append(colDbNames, Tables.Users.ToDbFieldName(columns[i]))
+			colDbTypes = append(colDbTypes, Tables.Users.ToDbFieldTypeFromColName(columns[i]))
+		}
+	}
+
+	copySourceReader, err := CopyFromReader(r, optSeparator, optNullPlaceholders, optComment, colDbTypes...)
+	if err != nil {
+		return 0, err
+	}
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"users"}, colDbNames, copySourceReader)
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (utilRef *tUsersUtils) CopyFromSlice(records []Users, includeSequenceCols bool) (int64, error) {
+	return utilRef.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx performs a bulk copy of the given Users records into the users table.
+// Unless includeSequenceCols is true, the sequence-backed (serial) columns are left out,
+// so the database fills them in.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tUsersUtils) CopyFromSliceCtx(ctx context.Context, records []Users, includeSequenceCols bool) (int64, error) {
+
+	var errorPrefix = "UsersUtils.CopyFromSlice() ERROR: "
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var colDbNames []string
+	if includeSequenceCols {
+		colDbNames = []string{"id", "user_guid", "email", "first_name", "created_at", "current_mood", "tags", "balance", "profile"}
+	} else {
+		colDbNames = []string{"user_guid", "email", "first_name", "created_at", "current_mood", "tags", "balance", "profile"}
+	}
+
+	rows := make([][]interface{}, len(records))
+	for i := range records {
+		sourceUsers := &records[i]
+		if includeSequenceCols {
+			rows[i] = []interface{}{sourceUsers.Id, sourceUsers.UserGuid, sourceUsers.Email, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)}, sourceUsers.CreatedAt, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)}, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)}, toPgxNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull), sourceUsers.Profile}
+		} else {
+			rows[i] = []interface{}{sourceUsers.UserGuid, sourceUsers.Email, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)}, sourceUsers.CreatedAt, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)}, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)}, toPgxNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull), sourceUsers.Profile}
+		}
+	}
+
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"users"}, colDbNames, pgx.CopyFromRows(rows))
+}
+
+// Update is UpdateCtx with the background context
+func (utilRef *tUsersUtils) Update(sourceUsers *Users, where Predicate) (int64, error) {
+	return utilRef.UpdateCtx(context.Background(), sourceUsers, where)
+}
+
+// UpdateCtx attempts to update the rows inside the users table, based on
+// the supplied predicate, whose placeholders are numbered after the ones of the 9 columns.
+// All the fields in the supplied source Users pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// Returns the number of affected rows (zero if no rows found for that condition),
+// and nil error for a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tUsersUtils) UpdateCtx(ctx context.Context, sourceUsers *Users, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersUtils.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside users")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE users SET id = $1,user_guid = $2,email = $3,first_name = $4,created_at = $5,current_mood = $6,tags = $7,balance = $8,profile = $9 WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(10)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceUsers.Id, sourceUsers.UserGuid, sourceUsers.Email, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)}, sourceUsers.CreatedAt, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)}, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)}, toNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull), sourceUsers.Profile}
+
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "users_email_key") {
+				return 0, ErrUsers_UQ_users_email_key
+			}
+			if Contains(err.Error(), "users_guid_idx") {
+				return 0, ErrUsers_UQ_users_guid_idx
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateUsers is UpdateUsersCtx with the background context
+func (txWrapper *Transaction) UpdateUsers(sourceUsers *Users, where Predicate) (int64, error) {
+	return txWrapper.UpdateUsersCtx(context.Background(), sourceUsers, where)
+}
+
+// UpdateUsersCtx runs UpdateCtx of Users in the transaction,
+// as txWrapper.DB().Users.UpdateCtx(...) does.
+func (txWrapper *Transaction) UpdateUsersCtx(ctx context.Context, sourceUsers *Users, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Users.UpdateCtx(ctx, sourceUsers, where)
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (utilRef *tUsersUtils) UpdateWithMask(sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+	return utilRef.UpdateWithMaskCtx(context.Background(), sourceUsers, updateMask, where)
+}
+
+// UpdateWithMaskCtx attempts to update the rows inside the users table matching the predicate,
+// whose placeholders are numbered after the ones of the update mask.
+// Only the fields in the supplied mask slice of strings will be updated.
+// If the mask is nil, all fields will be updated.
+// Returns the number of affected rows (zero if no rows found for that condition), and nil error
+// in case of a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tUsersUtils) UpdateWithMaskCtx(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersUtils.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside users")
+	}
+
+	if updateMask == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE users SET ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	var instanceValuesSlice []interface{}
+	for i, e := range updateMask {
+
+		_, writeErr = queryBuffer.WriteString(utilRef.ToDbFieldName(e))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString("=$")
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString(Itoa(i + 1))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		// add a comma if not the final set
+		if len(updateMask) != (i + 1) {
+			_, writeErr = queryBuffer.WriteString(",")
+			if writeErr != nil {
+				return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+			}
+		}
+
+		if e == "Id" || e == "id" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceUsers.Id)
+		}
+		if e == "UserGuid" || e == "user_guid" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceUsers.UserGuid)
+		}
+		if e == "Email" || e == "email" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceUsers.Email)
+		}
+		if e == "FirstName" || e == "first_name" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)})
+		}
+		if e == "CreatedAt" || e == "created_at" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceUsers.CreatedAt)
+		}
+		if e == "CurrentMood" || e == "current_mood" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)})
+		}
+		if e == "Tags" || e == "tags" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)})
+		}
+		if e == "Balance" || e == "balance" {
+			instanceValuesSlice = append(instanceValuesSlice, toNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull))
+		}
+		if e == "Profile" || e == "profile" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceUsers.Profile)
+		}
+
+	}
+
+	_, writeErr = queryBuffer.WriteString(" WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(len(updateMask) + 1)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	// append the condition's params to the ones of the setters
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "users_email_key") {
+				return 0, ErrUsers_UQ_users_email_key
+			}
+			if Contains(err.Error(), "users_guid_idx") {
+				return 0, ErrUsers_UQ_users_guid_idx
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateWithMaskUsers is UpdateWithMaskUsersCtx with the background context
+func (txWrapper *Transaction) UpdateWithMaskUsers(sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+	return txWrapper.UpdateWithMaskUsersCtx(context.Background(), sourceUsers, updateMask, where)
+}
+
+// UpdateWithMaskUsersCtx runs UpdateWithMaskCtx of Users in the transaction,
+// as txWrapper.DB().Users.UpdateWithMaskCtx(...) does.
+func (txWrapper *Transaction) UpdateWithMaskUsersCtx(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Users.UpdateWithMaskCtx(ctx, sourceUsers, updateMask, where)
+}
+
+// Update is UpdateCtx with the background context
+func (sourceUsers *Users) Update() error {
+	return sourceUsers.UpdateCtx(context.Background())
+}
+
+// UpdateCtx attempts to update the row inside the users table, corresponding
+// to the PK of the current Users instance.
+// All the fields in the supplied source Users pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// An instance selected WithColumns returns ErrColumnsNotLoaded, unless MarkAllColumnsLoaded was called;
+// UpdateWithMask with its LoadedColumns writes back only those.
+// Returns nil error for a successful operation. If operation fails, it returns the error.
+// If more than one row gets updated, it will return an error.
+func (sourceUsers *Users) UpdateCtx(ctx context.Context) error {
+	return sourceUsers.updateOn(ctx, nil)
+}
+
+// updateOn updates the row of the instance on the querier, GetDb() if nil
+func (sourceUsers *Users) updateOn(ctx context.Context, querier Querier) error {
+
+	var errorPrefix = "instance of Users.Update() ERROR: "
+
+	// the fields of the columns left out by WithColumns hold their zero values, not those of the row
+	if sourceUsers.pgToGo_projection != nil {
+		return ErrColumnsNotLoaded
+	}
+
+	currentDbHandle := dbQuerier(querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE users SET id = $1,user_guid = $2,email = $3,first_name = $4,created_at = $5,current_mood = $6,tags = $7,balance = $8,profile = $9 WHERE ")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString("id=$10")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString (instance condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceUsers.Id, sourceUsers.UserGuid, sourceUsers.Email, &pgtype.Text{String: sourceUsers.FirstName, Status: statusFromBool(sourceUsers.FirstName_IsNotNull)}, sourceUsers.CreatedAt, &pgtype.Text{String: sourceUsers.CurrentMood, Status: statusFromBool(sourceUsers.CurrentMood_IsNotNull)}, &pgtype.Text{String: sourceUsers.Tags, Status: statusFromBool(sourceUsers.Tags_IsNotNull)}, toNumeric(sourceUsers.Balance, sourceUsers.Balance_IsNotNull), sourceUsers.Profile, sourceUsers.Id}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), instanceValuesSlice...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "users_email_key") {
+				return ErrUsers_UQ_users_email_key
+			}
+			if Contains(err.Error(), "users_guid_idx") {
+				return ErrUsers_UQ_users_guid_idx
+			}
+
+		}
+
+		return NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	if n > 1 {
+		return NewModelsErrorLocal(errorPrefix, "More than one record was updated: "+Itoa(int(n)))
+	}
+	return nil
+
+}
+
+// UpdateSingleInstanceUsers is UpdateSingleInstanceUsersCtx with the background context
+func (txWrapper *Transaction) UpdateSingleInstanceUsers(sourceUsers *Users) error {
+	return txWrapper.UpdateSingleInstanceUsersCtx(context.Background(), sourceUsers)
+}
+
+// UpdateSingleInstanceUsersCtx runs the UpdateCtx method of the sourceUsers instance in the transaction.
+func (txWrapper *Transaction) UpdateSingleInstanceUsersCtx(ctx context.Context, sourceUsers *Users) error {
+	return sourceUsers.updateOn(ctx, txWrapper)
+}
+
+// Delete is DeleteCtx with the background context
+func (utilRef *tUsersUtils) Delete(where Predicate) (int64, error) {
+	return utilRef.DeleteCtx(context.Background(), where)
+}
+
+// Deletes the rows from the users table matching the predicate, e.g.
+// UsersCols.Id.Eq(value), or Raw(condition, params...).
+// Returns the number of deleted rows (zero if no rows found for that condition), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tUsersUtils) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersUtils.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from users")
+	}
+	condition, params := where.Condition(1)
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the delete query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("DELETE FROM users WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), params...)
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteUsers is DeleteUsersCtx with the background context
+func (txWrapper *Transaction) DeleteUsers(where Predicate) (int64, error) {
+	return txWrapper.DeleteUsersCtx(context.Background(), where)
+}
+
+// DeleteUsersCtx runs DeleteCtx of Users in the transaction,
+// as txWrapper.DB().Users.DeleteCtx(...) does.
+func (txWrapper *Transaction) DeleteUsersCtx(ctx context.Context, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Users.DeleteCtx(ctx, where)
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (utilRef *tUsersUtils) DeleteInstance(sourceUsers *Users) (bool, error) {
+	return utilRef.DeleteInstanceCtx(context.Background(), sourceUsers)
+}
+
+// Deletes the row from the users table, corresponding to the primary key fields
+// inside the sourceUsers parameter.
+// Returns true if the row was deleted, or false and nil error if no such PK value was found in the database.
+// If operation fails, it returns nil and the error.
+func (utilRef *tUsersUtils) DeleteInstanceCtx(ctx context.Context, sourceUsers *Users) (bool, error) {
+
+	var errorPrefix = "UsersUtils.DeleteInstance() ERROR: "
+
+	if sourceUsers == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+
+	// define the condition based on the PK columns
+	deleteInstanceCondition := And(
+		UsersCols.Id.Eq(sourceUsers.Id),
+	)
+
+	rowCount, err := utilRef.DeleteCtx(ctx, deleteInstanceCondition)
+	if err != nil {
+		return false, NewModelsError(errorPrefix, err)
+	}
+
+	if rowCount > 1 {
+		return false, NewModelsError(errorPrefix+": FATAL ERROR: Too many rows deleted !! : ", err)
+	}
+
+	return rowCount == 1, nil
+
+}
+
+// DeleteInstanceUsers is DeleteInstanceUsersCtx with the background context
+func (txWrapper *Transaction) DeleteInstanceUsers(sourceUsers *Users) (bool, error) {
+	return txWrapper.DeleteInstanceUsersCtx(context.Background(), sourceUsers)
+}
+
+// DeleteInstanceUsersCtx runs DeleteInstanceCtx of Users in the transaction,
+// as txWrapper.DB().Users.DeleteInstanceCtx(...) does.
+func (txWrapper *Transaction) DeleteInstanceUsersCtx(ctx context.Context, sourceUsers *Users) (bool, error) {
+	return NewDB(txWrapper).Users.DeleteInstanceCtx(ctx, sourceUsers)
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (utilRef *tUsersUtils) DeleteAll() (int64, error) {
+	return utilRef.DeleteAllCtx(context.Background())
+}
+
+// Deletes all existing rows from the users table.
+// Returns the number of deleted rows (zero if no rows found), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tUsersUtils) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "UsersUtils.DeleteAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	r, err := currentDbHandle.Exec(ctx, "DELETE FROM users")
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteAllUsers is DeleteAllUsersCtx with the background context
+func (txWrapper *Transaction) DeleteAllUsers() (int64, error) {
+	return txWrapper.DeleteAllUsersCtx(context.Background())
+}
+
+// DeleteAllUsersCtx runs DeleteAllCtx of Users in the transaction,
+// as txWrapper.DB().Users.DeleteAllCtx(...) does.
+func (txWrapper *Transaction) DeleteAllUsersCtx(ctx context.Context) (int64, error) {
+	return NewDB(txWrapper).Users.DeleteAllCtx(ctx)
+}
+
+// GetById is GetByIdCtx with the background context
+func (utilRef *tUsersUtils) GetById(inputId int32) (*Users, error) {
+	return utilRef.GetByIdCtx(context.Background(), inputId)
+}
+
+// Queries the database for a single row based on the specified single or multi-column primary key.
+// Returns a pointer to a Users structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tUsersUtils) GetByIdCtx(ctx context.Context, inputId int32) (returnStruct *Users, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "UsersGetById() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramId int32
+	var paramUserGuid string
+	var paramEmail string
+	var paramFirstName pgtype.Text
+	var paramCreatedAt time.Time
+	var paramCurrentMood pgtype.Text
+	var paramTags pgtype.Text
+	var paramBalance Numeric
+	var paramProfile json.RawMessage
+
+	// define the select query
+	var query = "SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users  WHERE id = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputId).Scan(&paramId, &paramUserGuid, &paramEmail, &paramFirstName, &paramCreatedAt, &paramCurrentMood, &paramTags, &paramBalance, &paramProfile)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Users{
+			Id:        paramId,
+			UserGuid:  paramUserGuid,
+			Email:     paramEmail,
+			CreatedAt: paramCreatedAt,
+			Profile:   paramProfile,
+		}
+		returnStruct.SetFirstName(paramFirstName.String, boolFromStatus(paramFirstName.Status))
+		returnStruct.SetCurrentMood(paramCurrentMood.String, boolFromStatus(paramCurrentMood.Status))
+		returnStruct.SetTags(paramTags.String, boolFromStatus(paramTags.Status))
+		returnStruct.SetBalance(paramBalance.NumericVal(), boolFromStatus(paramBalance.Status))
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetUsersById is GetUsersByIdCtx with the background context
+func (txWrapper *Transaction) GetUsersById(inputId int32) (*Users, error) {
+	return txWrapper.GetUsersByIdCtx(context.Background(), inputId)
+}
+
+// GetUsersByIdCtx runs GetByIdCtx of Users in the transaction,
+// as txWrapper.DB().Users.GetByIdCtx(...) does.
+func (txWrapper *Transaction) GetUsersByIdCtx(ctx context.Context, inputId int32) (*Users, error) {
+	return NewDB(txWrapper).Users.GetByIdCtx(ctx, inputId)
+}
+
+// GetByUniqueEmail is GetByUniqueEmailCtx with the background context
+func (utilRef *tUsersUtils) GetByUniqueEmail(inputEmail string) (*Users, error) {
+	return utilRef.GetByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Users structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tUsersUtils) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (returnStruct *Users, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "UsersGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramId int32
+	var paramUserGuid string
+	var paramEmail string
+	var paramFirstName pgtype.Text
+	var paramCreatedAt time.Time
+	var paramCurrentMood pgtype.Text
+	var paramTags pgtype.Text
+	var paramBalance Numeric
+	var paramProfile json.RawMessage
+
+	// define the select query
+	var query = "SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users  WHERE email = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputEmail).Scan(&paramId, &paramUserGuid, &paramEmail, &paramFirstName, &paramCreatedAt, &paramCurrentMood, &paramTags, &paramBalance, &paramProfile)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Users{
+			Id:        paramId,
+			UserGuid:  paramUserGuid,
+			Email:     paramEmail,
+			CreatedAt: paramCreatedAt,
+			Profile:   paramProfile,
+		}
+		returnStruct.SetFirstName(paramFirstName.String, boolFromStatus(paramFirstName.Status))
+		returnStruct.SetCurrentMood(paramCurrentMood.String, boolFromStatus(paramCurrentMood.Status))
+		returnStruct.SetTags(paramTags.String, boolFromStatus(paramTags.Status))
+		returnStruct.SetBalance(paramBalance.NumericVal(), boolFromStatus(paramBalance.Status))
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetUsersByUniqueEmail is GetUsersByUniqueEmailCtx with the background context
+func (txWrapper *Transaction) GetUsersByUniqueEmail(inputEmail string) (*Users, error) {
+	return txWrapper.GetUsersByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// GetUsersByUniqueEmailCtx runs GetByUniqueEmailCtx of Users in the transaction,
+// as txWrapper.DB().Users.GetByUniqueEmailCtx(...) does.
+func (txWrapper *Transaction) GetUsersByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Users, error) {
+	return NewDB(txWrapper).Users.GetByUniqueEmailCtx(ctx, inputEmail)
+}
+
+// GetByUniqueUserGuid is GetByUniqueUserGuidCtx with the background context
+func (utilRef *tUsersUtils) GetByUniqueUserGuid(inputUserGuid string) (*Users, error) {
+	return utilRef.GetByUniqueUserGuidCtx(context.Background(), inputUserGuid)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Users structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tUsersUtils) GetByUniqueUserGuidCtx(ctx context.Context, inputUserGuid string) (returnStruct *Users, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "UsersGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramId int32
+	var paramUserGuid string
+	var paramEmail string
+	var paramFirstName pgtype.Text
+	var paramCreatedAt time.Time
+	var paramCurrentMood pgtype.Text
+	var paramTags pgtype.Text
+	var paramBalance Numeric
+	var paramProfile json.RawMessage
+
+	// define the select query
+	var query = "SELECT id, user_guid, email, first_name, created_at, current_mood, tags, balance, profile FROM users  WHERE user_guid = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputUserGuid).Scan(&paramId, &paramUserGuid, &paramEmail, &paramFirstName, &paramCreatedAt, &paramCurrentMood, &paramTags, &paramBalance, &paramProfile)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Users{
+			Id:        paramId,
+			UserGuid:  paramUserGuid,
+			Email:     paramEmail,
+			CreatedAt: paramCreatedAt,
+			Profile:   paramProfile,
+		}
+		returnStruct.SetFirstName(paramFirstName.String, boolFromStatus(paramFirstName.Status))
+		returnStruct.SetCurrentMood(paramCurrentMood.String, boolFromStatus(paramCurrentMood.Status))
+		returnStruct.SetTags(paramTags.String, boolFromStatus(paramTags.Status))
+		returnStruct.SetBalance(paramBalance.NumericVal(), boolFromStatus(paramBalance.Status))
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetUsersByUniqueUserGuid is GetUsersByUniqueUserGuidCtx with the background context
+func (txWrapper *Transaction) GetUsersByUniqueUserGuid(inputUserGuid string) (*Users, error) {
+	return txWrapper.GetUsersByUniqueUserGuidCtx(context.Background(), inputUserGuid)
+}
+
+// GetUsersByUniqueUserGuidCtx runs GetByUniqueUserGuidCtx of Users in the transaction,
+// as txWrapper.DB().Users.GetByUniqueUserGuidCtx(...) does.
+func (txWrapper *Transaction) GetUsersByUniqueUserGuidCtx(ctx context.Context, inputUserGuid string) (*Users, error) {
+	return NewDB(txWrapper).Users.GetByUniqueUserGuidCtx(ctx, inputUserGuid)
+}
+
+// UsersRepository holds the methods of Tables.Users, so that the code using them
+// can depend on the interface, and be unit tested with a UsersRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type UsersRepository interface {
+	New() *Users
+	CreateFromHttpRequest(req *http.Request) (*Users, error)
+	CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Users, []error)
+	ToDbFieldName(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColName(fieldDbOrGoName string) string
+	Select(where Predicate, options ...SelectOption) ([]Users, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectAll(options ...SelectOption) ([]Users, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Users, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*Users, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Users, error)
+	SelectWhereJSONPath(column string, path []string, value interface{}) ([]Users, error)
+	SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Users, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error)
+	Insert(sourceUsers *Users) (*Users, error)
+	InsertCtx(ctx context.Context, sourceUsers *Users) (*Users, error)
+	CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSlice(records []Users, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtx(ctx context.Context, records []Users, includeSequenceCols bool) (int64, error)
+	Update(sourceUsers *Users, where Predicate) (int64, error)
+	UpdateCtx(ctx context.Context, sourceUsers *Users, where Predicate) (int64, error)
+	UpdateWithMask(sourceUsers *Users, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtx(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (int64, error)
+	Delete(where Predicate) (int64, error)
+	DeleteCtx(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstance(sourceUsers *Users) (bool, error)
+	DeleteInstanceCtx(ctx context.Context, sourceUsers *Users) (bool, error)
+	DeleteAll() (int64, error)
+	DeleteAllCtx(ctx context.Context) (int64, error)
+	GetById(inputId int32) (*Users, error)
+	GetByIdCtx(ctx context.Context, inputId int32) (*Users, error)
+	GetByUniqueEmail(inputEmail string) (*Users, error)
+	GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Users, error)
+	GetByUniqueUserGuid(inputUserGuid string) (*Users, error)
+	GetByUniqueUserGuidCtx(ctx context.Context, inputUserGuid string) (*Users, error)
+}
+
+var _ UsersRepository = (*tUsersUtils)(nil)
+
+// UsersRepositoryMock is a UsersRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type UsersRepositoryMock struct {
+	MockCalls
+
+	NewFunc                               func() *Users
+	CreateFromHttpRequestFunc             func(req *http.Request) (*Users, error)
+	CreateFromHttpRequestIgnoreErrorsFunc func(req *http.Request) (*Users, []error)
+	ToDbFieldNameFunc                     func(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColNameFunc          func(fieldDbOrGoName string) string
+	SelectFunc                            func(where Predicate, options ...SelectOption) ([]Users, error)
+	SelectCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionFunc                       func(where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionCtxFunc                    func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionAllFunc                    func(where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectUnionAllCtxFunc                 func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error)
+	SelectCachedFunc                      func(cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectCachedCtxFunc                   func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageFunc                        func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCtxFunc                     func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCachedFunc                  func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectPageCachedCtxFunc               func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error)
+	SelectAllFunc                         func(options ...SelectOption) ([]Users, error)
+	SelectAllCtxFunc                      func(ctx context.Context, options ...SelectOption) ([]Users, error)
+	SelectAllOrderByFunc                  func(orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllOrderByCtxFunc               func(ctx context.Context, orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllPageFunc                     func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error)
+	SelectAllPageCtxFunc                  func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error)
+	CountFunc                             func(where ...Predicate) (int64, error)
+	CountCtxFunc                          func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                    func() (int64, error)
+	CountImpreciseCtxFunc                 func(ctx context.Context) (int64, error)
+	SingleFunc                            func(where Predicate, options ...SelectOption) (*Users, error)
+	SingleCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) (*Users, error)
+	SelectWhereJSONPathFunc               func(column string, path []string, value interface{}) ([]Users, error)
+	SelectWhereJSONPathCtxFunc            func(ctx context.Context, column string, path []string, value interface{}) ([]Users, error)
+	SelectAfterFunc                       func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error)
+	SelectAfterCtxFunc                    func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error)
+	InsertFunc                            func(sourceUsers *Users) (*Users, error)
+	InsertCtxFunc                         func(ctx context.Context, sourceUsers *Users) (*Users, error)
+	CopyFromReaderFunc                    func(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtxFunc                 func(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSliceFunc                     func(records []Users, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtxFunc                  func(ctx context.Context, records []Users, includeSequenceCols bool) (int64, error)
+	UpdateFunc                            func(sourceUsers *Users, where Predicate) (int64, error)
+	UpdateCtxFunc                         func(ctx context.Context, sourceUsers *Users, where Predicate) (int64, error)
+	UpdateWithMaskFunc                    func(sourceUsers *Users, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtxFunc                 func(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (int64, error)
+	DeleteFunc                            func(where Predicate) (int64, error)
+	DeleteCtxFunc                         func(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstanceFunc                    func(sourceUsers *Users) (bool, error)
+	DeleteInstanceCtxFunc                 func(ctx context.Context, sourceUsers *Users) (bool, error)
+	DeleteAllFunc                         func() (int64, error)
+	DeleteAllCtxFunc                      func(ctx context.Context) (int64, error)
+	GetByIdFunc                           func(inputId int32) (*Users, error)
+	GetByIdCtxFunc                        func(ctx context.Context, inputId int32) (*Users, error)
+	GetByUniqueEmailFunc                  func(inputEmail string) (*Users, error)
+	GetByUniqueEmailCtxFunc               func(ctx context.Context, inputEmail string) (*Users, error)
+	GetByUniqueUserGuidFunc               func(inputUserGuid string) (*Users, error)
+	GetByUniqueUserGuidCtxFunc            func(ctx context.Context, inputUserGuid string) (*Users, error)
+}
+
+var _ UsersRepository = (*UsersRepositoryMock)(nil)
+
+// New records the call and runs NewFunc
+func (mock *UsersRepositoryMock) New() (result0 *Users) {
+	mock.Record("New")
+	if mock.NewFunc != nil {
+		return mock.NewFunc()
+	}
+	return
+}
+
+// CreateFromHttpRequest records the call and runs CreateFromHttpRequestFunc
+func (mock *UsersRepositoryMock) CreateFromHttpRequest(req *http.Request) (result0 *Users, result1 error) {
+	mock.Record("CreateFromHttpRequest", req)
+	if mock.CreateFromHttpRequestFunc != nil {
+		return mock.CreateFromHttpRequestFunc(req)
+	}
+	return
+}
+
+// CreateFromHttpRequestIgnoreErrors records the call and runs CreateFromHttpRequestIgnoreErrorsFunc
+func (mock *UsersRepositoryMock) CreateFromHttpRequestIgnoreErrors(req *http.Request) (result0 *Users, result1 []error) {
+	mock.Record("CreateFromHttpRequestIgnoreErrors", req)
+	if mock.CreateFromHttpRequestIgnoreErrorsFunc != nil {
+		return mock.CreateFromHttpRequestIgnoreErrorsFunc(req)
+	}
+	return
+}
+
+// ToDbFieldName records the call and runs ToDbFieldNameFunc
+func (mock *UsersRepositoryMock) ToDbFieldName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldName", fieldDbOrGoName)
+	if mock.ToDbFieldNameFunc != nil {
+		return mock.ToDbFieldNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// ToDbFieldTypeFromColName records the call and runs ToDbFieldTypeFromColNameFunc
+func (mock *UsersRepositoryMock) ToDbFieldTypeFromColName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldTypeFromColName", fieldDbOrGoName)
+	if mock.ToDbFieldTypeFromColNameFunc != nil {
+		return mock.ToDbFieldTypeFromColNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *UsersRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *UsersRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *UsersRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *UsersRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *UsersRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *UsersRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *UsersRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *UsersRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *UsersRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *UsersRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *UsersRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *UsersRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *UsersRepositoryMock) SelectAll(options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *UsersRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *UsersRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *UsersRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *UsersRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *UsersRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Users, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *UsersRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *UsersRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *UsersRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *UsersRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *UsersRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *Users, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *UsersRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *Users, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectWhereJSONPath records the call and runs SelectWhereJSONPathFunc
+func (mock *UsersRepositoryMock) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Users, result1 error) {
+	mock.Record("SelectWhereJSONPath", column, path, value)
+	if mock.SelectWhereJSONPathFunc != nil {
+		return mock.SelectWhereJSONPathFunc(column, path, value)
+	}
+	return
+}
+
+// SelectWhereJSONPathCtx records the call and runs SelectWhereJSONPathCtxFunc
+func (mock *UsersRepositoryMock) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Users, result1 error) {
+	mock.Record("SelectWhereJSONPathCtx", ctx, column, path, value)
+	if mock.SelectWhereJSONPathCtxFunc != nil {
+		return mock.SelectWhereJSONPathCtxFunc(ctx, column, path, value)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *UsersRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Users, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *UsersRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Users, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// Insert records the call and runs InsertFunc
+func (mock *UsersRepositoryMock) Insert(sourceUsers *Users) (result0 *Users, result1 error) {
+	mock.Record("Insert", sourceUsers)
+	if mock.InsertFunc != nil {
+		return mock.InsertFunc(sourceUsers)
+	}
+	return
+}
+
+// InsertCtx records the call and runs InsertCtxFunc
+func (mock *UsersRepositoryMock) InsertCtx(ctx context.Context, sourceUsers *Users) (result0 *Users, result1 error) {
+	mock.Record("InsertCtx", ctx, sourceUsers)
+	if mock.InsertCtxFunc != nil {
+		return mock.InsertCtxFunc(ctx, sourceUsers)
+	}
+	return
+}
+
+// CopyFromReader records the call and runs CopyFromReaderFunc
+func (mock *UsersRepositoryMock) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReader", r, opt, columns)
+	if mock.CopyFromReaderFunc != nil {
+		return mock.CopyFromReaderFunc(r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromReaderCtx records the call and runs CopyFromReaderCtxFunc
+func (mock *UsersRepositoryMock) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReaderCtx", ctx, r, opt, columns)
+	if mock.CopyFromReaderCtxFunc != nil {
+		return mock.CopyFromReaderCtxFunc(ctx, r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromSlice records the call and runs CopyFromSliceFunc
+func (mock *UsersRepositoryMock) CopyFromSlice(records []Users, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSlice", records, includeSequenceCols)
+	if mock.CopyFromSliceFunc != nil {
+		return mock.CopyFromSliceFunc(records, includeSequenceCols)
+	}
+	return
+}
+
+// CopyFromSliceCtx records the call and runs CopyFromSliceCtxFunc
+func (mock *UsersRepositoryMock) CopyFromSliceCtx(ctx context.Context, records []Users, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSliceCtx", ctx, records, includeSequenceCols)
+	if mock.CopyFromSliceCtxFunc != nil {
+		return mock.CopyFromSliceCtxFunc(ctx, records, includeSequenceCols)
+	}
+	return
+}
+
+// Update records the call and runs UpdateFunc
+func (mock *UsersRepositoryMock) Update(sourceUsers *Users, where Predicate) (result0 int64, result1 error) {
+	mock.Record("Update", sourceUsers, where)
+	if mock.UpdateFunc != nil {
+		return mock.UpdateFunc(sourceUsers, where)
+	}
+	return
+}
+
+// UpdateCtx records the call and runs UpdateCtxFunc
+func (mock *UsersRepositoryMock) UpdateCtx(ctx context.Context, sourceUsers *Users, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateCtx", ctx, sourceUsers, where)
+	if mock.UpdateCtxFunc != nil {
+		return mock.UpdateCtxFunc(ctx, sourceUsers, where)
+	}
+	return
+}
+
+// UpdateWithMask records the call and runs UpdateWithMaskFunc
+func (mock *UsersRepositoryMock) UpdateWithMask(sourceUsers *Users, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMask", sourceUsers, updateMask, where)
+	if mock.UpdateWithMaskFunc != nil {
+		return mock.UpdateWithMaskFunc(sourceUsers, updateMask, where)
+	}
+	return
+}
+
+// UpdateWithMaskCtx records the call and runs UpdateWithMaskCtxFunc
+func (mock *UsersRepositoryMock) UpdateWithMaskCtx(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMaskCtx", ctx, sourceUsers, updateMask, where)
+	if mock.UpdateWithMaskCtxFunc != nil {
+		return mock.UpdateWithMaskCtxFunc(ctx, sourceUsers, updateMask, where)
+	}
+	return
+}
+
+// Delete records the call and runs DeleteFunc
+func (mock *UsersRepositoryMock) Delete(where Predicate) (result0 int64, result1 error) {
+	mock.Record("Delete", where)
+	if mock.DeleteFunc != nil {
+		return mock.DeleteFunc(where)
+	}
+	return
+}
+
+// DeleteCtx records the call and runs DeleteCtxFunc
+func (mock *UsersRepositoryMock) DeleteCtx(ctx context.Context, where Predicate) (result0 int64, result1 error) {
+	mock.Record("DeleteCtx", ctx, where)
+	if mock.DeleteCtxFunc != nil {
+		return mock.DeleteCtxFunc(ctx, where)
+	}
+	return
+}
+
+// DeleteInstance records the call and runs DeleteInstanceFunc
+func (mock *UsersRepositoryMock) DeleteInstance(sourceUsers *Users) (result0 bool, result1 error) {
+	mock.Record("DeleteInstance", sourceUsers)
+	if mock.DeleteInstanceFunc != nil {
+		return mock.DeleteInstanceFunc(sourceUsers)
+	}
+	return
+}
+
+// DeleteInstanceCtx records the call and runs DeleteInstanceCtxFunc
+func (mock *UsersRepositoryMock) DeleteInstanceCtx(ctx context.Context, sourceUsers *Users) (result0 bool, result1 error) {
+	mock.Record("DeleteInstanceCtx", ctx, sourceUsers)
+	if mock.DeleteInstanceCtxFunc != nil {
+		return mock.DeleteInstanceCtxFunc(ctx, sourceUsers)
+	}
+	return
+}
+
+// DeleteAll records the call and runs DeleteAllFunc
+func (mock *UsersRepositoryMock) DeleteAll() (result0 int64, result1 error) {
+	mock.Record("DeleteAll")
+	if mock.DeleteAllFunc != nil {
+		return mock.DeleteAllFunc()
+	}
+	return
+}
+
+// DeleteAllCtx records the call and runs DeleteAllCtxFunc
+func (mock *UsersRepositoryMock) DeleteAllCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("DeleteAllCtx", ctx)
+	if mock.DeleteAllCtxFunc != nil {
+		return mock.DeleteAllCtxFunc(ctx)
+	}
+	return
+}
+
+// GetById records the call and runs GetByIdFunc
+func (mock *UsersRepositoryMock) GetById(inputId int32) (result0 *Users, result1 error) {
+	mock.Record("GetById", inputId)
+	if mock.GetByIdFunc != nil {
+		return mock.GetByIdFunc(inputId)
+	}
+	return
+}
+
+// GetByIdCtx records the call and runs GetByIdCtxFunc
+func (mock *UsersRepositoryMock) GetByIdCtx(ctx context.Context, inputId int32) (result0 *Users, result1 error) {
+	mock.Record("GetByIdCtx", ctx, inputId)
+	if mock.GetByIdCtxFunc != nil {
+		return mock.GetByIdCtxFunc(ctx, inputId)
+	}
+	return
+}
+
+// GetByUniqueEmail records the call and runs GetByUniqueEmailFunc
+func (mock *UsersRepositoryMock) GetByUniqueEmail(inputEmail string) (result0 *Users, result1 error) {
+	mock.Record("GetByUniqueEmail", inputEmail)
+	if mock.GetByUniqueEmailFunc != nil {
+		return mock.GetByUniqueEmailFunc(inputEmail)
+	}
+	return
+}
+
+// GetByUniqueEmailCtx records the call and runs GetByUniqueEmailCtxFunc
+func (mock *UsersRepositoryMock) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (result0 *Users, result1 error) {
+	mock.Record("GetByUniqueEmailCtx", ctx, inputEmail)
+	if mock.GetByUniqueEmailCtxFunc != nil {
+		return mock.GetByUniqueEmailCtxFunc(ctx, inputEmail)
+	}
+	return
+}
+
+// GetByUniqueUserGuid records the call and runs GetByUniqueUserGuidFunc
+func (mock *UsersRepositoryMock) GetByUniqueUserGuid(inputUserGuid string) (result0 *Users, result1 error) {
+	mock.Record("GetByUniqueUserGuid", inputUserGuid)
+	if mock.GetByUniqueUserGuidFunc != nil {
+		return mock.GetByUniqueUserGuidFunc(inputUserGuid)
+	}
+	return
+}
+
+// GetByUniqueUserGuidCtx records the call and runs GetByUniqueUserGuidCtxFunc
+func (mock *UsersRepositoryMock) GetByUniqueUserGuidCtx(ctx context.Context, inputUserGuid string) (result0 *Users, result1 error) {
+	mock.Record("GetByUniqueUserGuidCtx", ctx, inputUserGuid)
+	if mock.GetByUniqueUserGuidCtxFunc != nil {
+		return mock.GetByUniqueUserGuidCtxFunc(ctx, inputUserGuid)
+	}
+	return
+}
+
+// UsersFake is an in-memory UsersRepository for the unit tests, its zero value an empty users table.
+// It assigns the serial columns, and enforces the primary key, the unique constraints and the NOT NULL
+// columns with the errors the database methods return. It evaluates the predicates the way the database
+// does, NULL included, but only the Raw conditions made of column = $n, column IS NULL and column IS NOT NULL
+// comparisons joined by AND. The select options apply, the locking ones having no effect, and the cached
+// selects cache nothing. The methods working on the database only, e.g. CountImprecise, return
+// ErrFakeNotSupported. It is safe for concurrent use.
+type UsersFake struct {
+	mutex sync.RWMutex
+	rows  []Users
+
+	sequenceId int32
+
+	utils tUsersUtils
+}
+
+var _ UsersRepository = (*UsersFake)(nil)
+
+// Rows returns a copy of the rows, in their order of insertion
+func (fake *UsersFake) Rows() []Users {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return append([]Users(nil), fake.rows...)
+}
+
+// column returns the value of the row's column, nil for NULL, and false for an unknown column
+func (fake *UsersFake) column(row *Users, dbName string) (interface{}, bool) {
+
+	switch dbName {
+	case "id":
+		return row.Id, true
+	case "user_guid":
+		return row.UserGuid, true
+	case "email":
+		return row.Email, true
+	case "first_name":
+		if !row.FirstName_IsNotNull {
+			return nil, true
+		}
+		return row.FirstName, true
+	case "created_at":
+		return row.CreatedAt, true
+	case "current_mood":
+		if !row.CurrentMood_IsNotNull {
+			return nil, true
+		}
+		return row.CurrentMood, true
+	case "tags":
+		if !row.Tags_IsNotNull {
+			return nil, true
+		}
+		return row.Tags, true
+	case "balance":
+		if !row.Balance_IsNotNull {
+			return nil, true
+		}
+		return row.Balance, true
+	case "profile":
+		return row.Profile, true
+	}
+	return nil, false
+}
+
+// assign sets the field of the row, named by its Go or database name, to the one of the source
+func (fake *UsersFake) assign(row *Users, sourceUsers *Users, field string) bool {
+
+	switch field {
+	case "Id", "id":
+		row.Id = sourceUsers.Id
+	case "UserGuid", "user_guid":
+		row.UserGuid = sourceUsers.UserGuid
+	case "Email", "email":
+		row.Email = sourceUsers.Email
+	case "FirstName", "first_name":
+		row.FirstName = sourceUsers.FirstName
+		row.FirstName_IsNotNull = sourceUsers.FirstName_IsNotNull
+	case "CreatedAt", "created_at":
+		row.CreatedAt = sourceUsers.CreatedAt
+	case "CurrentMood", "current_mood":
+		row.CurrentMood = sourceUsers.CurrentMood
+		row.CurrentMood_IsNotNull = sourceUsers.CurrentMood_IsNotNull
+	case "Tags", "tags":
+		row.Tags = sourceUsers.Tags
+		row.Tags_IsNotNull = sourceUsers.Tags_IsNotNull
+	case "Balance", "balance":
+		row.Balance = sourceUsers.Balance
+		row.Balance_IsNotNull = sourceUsers.Balance_IsNotNull
+	case "Profile", "profile":
+		row.Profile = sourceUsers.Profile
+	default:
+		return false
+	}
+	return true
+}
+
+// sameKey tells whether the rows have the same values in the columns, NULL equal to nothing
+func (fake *UsersFake) sameKey(a *Users, b *Users, dbNames ...string) bool {
+
+	for _, dbName := range dbNames {
+		aValue, _ := fake.column(a, dbName)
+		bValue, _ := fake.column(b, dbName)
+		if !fakeEqual(aValue, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// deleted tells whether the row is soft-deleted
+func (fake *UsersFake) deleted(row *Users) bool {
+
+	return false
+}
+
+// nextSequenceValues assigns the serial columns of the row
+func (fake *UsersFake) nextSequenceValues(row *Users) {
+
+	fake.sequenceId++
+	row.Id = fake.sequenceId
+}
+
+// validate checks the changed rows against the constraints of the table
+func (fake *UsersFake) validate(rows []Users, changed []int) error {
+
+	for _, i := range changed {
+		for j := range rows {
+			if j == i {
+				continue
+			}
+			if fake.sameKey(&rows[i], &rows[j], "id") {
+				return NewModelsErrorLocalWithCode("Unique constraint violation:", "users primary key", "23505")
+			}
+			if fake.sameKey(&rows[i], &rows[j], "email") {
+				return ErrUsers_UQ_users_email_key
+			}
+			if fake.sameKey(&rows[i], &rows[j], "user_guid") {
+				return ErrUsers_UQ_users_guid_idx
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertRows appends the rows, unless one of them violates a constraint
+func (fake *UsersFake) insertRows(rows ...Users) error {
+
+	all := append(append([]Users(nil), fake.rows...), rows...)
+
+	changed := make([]int, len(rows))
+	for i := range rows {
+		changed[i] = len(fake.rows) + i
+	}
+	if err := fake.validate(all, changed); err != nil {
+		return err
+	}
+
+	fake.rows = all
+	return nil
+}
+
+// updateRows updates the rows at the indexes, unless one of them then violates a constraint
+func (fake *UsersFake) updateRows(indexes []int, update func(row *Users)) (int64, error) {
+
+	rows := append([]Users(nil), fake.rows...)
+	for _, i := range indexes {
+		update(&rows[i])
+	}
+	if err := fake.validate(rows, indexes); err != nil {
+		return 0, err
+	}
+
+	fake.rows = rows
+	return int64(len(indexes)), nil
+}
+
+// matching returns the indexes of the rows matching the predicate, the zero one matching all of them
+func (fake *UsersFake) matching(errorPrefix string, where Predicate, withDeleted bool) ([]int, error) {
+
+	predicate, err := fakePredicate(where, isUsersColumn)
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	var indexes []int
+	for i := range fake.rows {
+		row := &fake.rows[i]
+		if !withDeleted && fake.deleted(row) {
+			continue
+		}
+
+		truth, err := fakeEvaluate(predicate, func(dbName string) interface{} {
+			value, _ := fake.column(row, dbName)
+			return value
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", errorPrefix, err)
+		}
+		if truth == fakeTrue {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// rowsAt returns copies of the rows at the indexes
+func (fake *UsersFake) rowsAt(indexes []int) []Users {
+
+	rows := make([]Users, len(indexes))
+	for i, index := range indexes {
+		rows[i] = fake.rows[index]
+	}
+	return rows
+}
+
+// selectRows returns copies of the rows at the indexes, ordered, offset, limited and projected by the options
+func (fake *UsersFake) selectRows(errorPrefix string, indexes []int, options selectOptions) ([]Users, error) {
+
+	if _, err := options.clause(isUsersColumn); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	rows := fake.rowsAt(indexes)
+
+	var orderErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, term := range options.orderBy {
+			a, _ := fake.column(&rows[i], term.column)
+			b, _ := fake.column(&rows[j], term.column)
+			order, ok := fakeCompare(a, b, term)
+			if !ok {
+				orderErr = fmt.Errorf("%sordering by %s is %w", errorPrefix, term.column, ErrFakeNotSupported)
+			}
+			if order != 0 {
+				return order < 0
+			}
+		}
+		return false
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+
+	if options.offset > len(rows) {
+		options.offset = len(rows)
+	}
+	rows = rows[options.offset:]
+	if options.limit > 0 && options.limit < len(rows) {
+		rows = rows[:options.limit]
+	}
+
+	if options.projection != nil {
+		for i := range rows {
+			rows[i] = fake.project(&rows[i], options.projection)
+		}
+	}
+	return rows, nil
+}
+
+// selectMatching returns copies of the rows matching the predicate, ordered, offset, limited and projected by the options
+func (fake *UsersFake) selectMatching(errorPrefix string, where Predicate, options selectOptions) ([]Users, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return nil, err
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// project returns the row with only the columns of the projection, as WithColumns selects them
+func (fake *UsersFake) project(row *Users, projection *columnProjection) Users {
+
+	projected := Users{pgToGo_projection: projection}
+	if projection.loads("id") {
+		projected.Id = row.Id
+	}
+	if projection.loads("user_guid") {
+		projected.UserGuid = row.UserGuid
+	}
+	if projection.loads("email") {
+		projected.Email = row.Email
+	}
+	if projection.loads("first_name") {
+		projected.FirstName = row.FirstName
+		projected.FirstName_IsNotNull = row.FirstName_IsNotNull
+	}
+	if projection.loads("created_at") {
+		projected.CreatedAt = row.CreatedAt
+	}
+	if projection.loads("current_mood") {
+		projected.CurrentMood = row.CurrentMood
+		projected.CurrentMood_IsNotNull = row.CurrentMood_IsNotNull
+	}
+	if projection.loads("tags") {
+		projected.Tags = row.Tags
+		projected.Tags_IsNotNull = row.Tags_IsNotNull
+	}
+	if projection.loads("balance") {
+		projected.Balance = row.Balance
+		projected.Balance_IsNotNull = row.Balance_IsNotNull
+	}
+	if projection.loads("profile") {
+		projected.Profile = row.Profile
+	}
+
+	return projected
+}
+
+// deleteRows deletes the rows at the indexes
+func (fake *UsersFake) deleteRows(indexes []int) int64 {
+
+	kept := fake.rows[:0]
+	for i, row := range fake.rows {
+		if len(indexes) > 0 && indexes[0] == i {
+			indexes = indexes[1:]
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(fake.rows) - len(kept))
+	fake.rows = kept
+	return deleted
+}
+
+// Insert is InsertCtx with the background context
+func (fake *UsersFake) Insert(sourceUsers *Users) (*Users, error) {
+	return fake.InsertCtx(context.Background(), sourceUsers)
+}
+
+// InsertCtx inserts a copy of the row the way Tables.Users.InsertCtx does, assigning the serial
+// columns when PgToGo_IgnorePKValuesWhenInsertingAndUseSequence is set.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+func (fake *UsersFake) InsertCtx(ctx context.Context, sourceUsers *Users) (*Users, error) {
+
+	var errorPrefix = "UsersFake.Insert() ERROR: "
+
+	if sourceUsers == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+
+	if sourceUsers.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceUsers.CreatedAt = Now()
+
+	}
+
+	if sourceUsers.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+		sourceUsers.UserGuid = NewGuid()
+
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	row := *sourceUsers
+	if sourceUsers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		fake.nextSequenceValues(&row)
+	}
+
+	if err := fake.insertRows(row); err != nil {
+		return nil, err
+	}
+
+	sourceUsers.Id = row.Id
+
+	return sourceUsers, nil
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (fake *UsersFake) CopyFromSlice(records []Users, includeSequenceCols bool) (int64, error) {
+	return fake.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx inserts copies of the records, all of them or none.
+// Unless includeSequenceCols is true, the serial columns are assigned.
+func (fake *UsersFake) CopyFromSliceCtx(ctx context.Context, records []Users, includeSequenceCols bool) (int64, error) {
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	rows := append([]Users(nil), records...)
+	if !includeSequenceCols {
+		for i := range rows {
+			fake.nextSequenceValues(&rows[i])
+		}
+	}
+
+	if err := fake.insertRows(rows...); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// Select is SelectCtx with the background context
+func (fake *UsersFake) Select(where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns copies of the rows matching the predicate, ordered and limited by the options
+func (fake *UsersFake) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersFake.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	return fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+}
+
+// Single is SingleCtx with the background context
+func (fake *UsersFake) Single(where Predicate, options ...SelectOption) (*Users, error) {
+	return fake.SingleCtx(context.Background(), where, options...)
+}
+
+// SingleCtx returns a copy of the row matching the predicate, nil if there is none,
+// and ErrTooManyRows if there are several
+func (fake *UsersFake) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Users, error) {
+
+	var errorPrefix = "UsersFake.Single() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	switch len(rows) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &rows[0], nil
+	}
+	return nil, ErrTooManyRows
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (fake *UsersFake) SelectAll(options ...SelectOption) ([]Users, error) {
+	return fake.SelectAllCtx(context.Background(), options...)
+}
+
+// SelectAllCtx returns copies of all the rows, ordered and limited by the options
+func (fake *UsersFake) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Users, error) {
+	return fake.selectMatching("UsersFake.SelectAll() ERROR: ", Predicate{}, applySelectOptions(options))
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (fake *UsersFake) SelectUnion(where []Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx returns copies of the rows matching any of the predicates, the ones matching several
+// of them once, ordered and limited by the options as a whole
+func (fake *UsersFake) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.selectUnion("UsersFake.SelectUnion() ERROR: ", where, false, applySelectOptions(options))
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (fake *UsersFake) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx returns copies of the rows matching each of the predicates, the ones matching several
+// of them as many times, ordered and limited by the options as a whole
+func (fake *UsersFake) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.selectUnion("UsersFake.SelectUnionAll() ERROR: ", where, true, applySelectOptions(options))
+}
+
+// selectUnion returns copies of the rows matching each of the predicates, only once unless all is set
+func (fake *UsersFake) selectUnion(errorPrefix string, where []Predicate, all bool, options selectOptions) ([]Users, error) {
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+	if err := options.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	var indexes []int
+	selected := make(map[int]bool)
+	for _, predicate := range where {
+		if predicate.IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+		}
+
+		matching, err := fake.matching(errorPrefix, predicate, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range matching {
+			if all || !selected[i] {
+				indexes = append(indexes, i)
+				selected[i] = true
+			}
+		}
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (fake *UsersFake) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns copies of the rows matching the predicate as SelectCtx does, caching nothing
+func (fake *UsersFake) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersFake.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (fake *UsersFake) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns copies of the rows of the page, from 1, matching the predicate
+func (fake *UsersFake) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.selectPage("UsersFake.SelectPage() ERROR: ", pageNumber, pageSize, where, false, options)
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (fake *UsersFake) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns copies of the rows of the page as SelectPageCtx does, caching nothing
+func (fake *UsersFake) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Users, error) {
+	return fake.selectPage("UsersFake.SelectPageCached() ERROR: ", pageNumber, pageSize, where, true, options)
+}
+
+// selectPage returns copies of the rows of the page matching the predicate, refusing the locking options when cached
+func (fake *UsersFake) selectPage(errorPrefix string, pageNumber int, pageSize int, where Predicate, cached bool, options []SelectOption) ([]Users, error) {
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from users")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	if cached {
+		if err := selectOptions.cached(); err != nil {
+			return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+		}
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (fake *UsersFake) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Users, error) {
+	return fake.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// SelectAllOrderByCtx returns copies of all the rows, ordered by the orderBy parameter,
+// columns followed by ASC or DESC and NULLS FIRST or NULLS LAST, or by the OrderBy option
+func (fake *UsersFake) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersFake.SelectAllOrderBy() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (fake *UsersFake) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error) {
+	return fake.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// SelectAllPageCtx returns copies of the rows of the page, from 1, ordered as SelectAllOrderByCtx orders them
+func (fake *UsersFake) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Users, error) {
+
+	var errorPrefix = "UsersFake.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (fake *UsersFake) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error) {
+	return fake.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns copies of the rows of the page following the cursor, and the cursor of the next page,
+// as Tables.Users.SelectAfterCtx does
+func (fake *UsersFake) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Users, string, error) {
+
+	var errorPrefix = "UsersFake.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetUsers.after(cursor, pageSize, &selectOptions, fake.utils.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, And(where, after), selectOptions)
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetUsers.cursor(selectOptions, func(dbName string) interface{} {
+		return fake.utils.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Count is CountCtx with the background context
+func (fake *UsersFake) Count(where ...Predicate) (int64, error) {
+	return fake.CountCtx(context.Background(), where...)
+}
+
+// CountCtx returns the number of rows matching all the predicates
+func (fake *UsersFake) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching("UsersFake.Count() ERROR: ", And(where...), false)
+	if err != nil {
+		return -1, err
+	}
+	return int64(len(indexes)), nil
+}
+
+// Update is UpdateCtx with the background context
+func (fake *UsersFake) Update(sourceUsers *Users, where Predicate) (int64, error) {
+	return fake.UpdateCtx(context.Background(), sourceUsers, where)
+}
+
+// UpdateCtx sets all the fields of the rows matching the predicate to the ones of the source.
+// Returns the number of affected rows.
+func (fake *UsersFake) UpdateCtx(ctx context.Context, sourceUsers *Users, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersFake.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside users")
+	}
+	if sourceUsers == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Users) {
+		fake.assign(row, sourceUsers, "Id")
+		fake.assign(row, sourceUsers, "UserGuid")
+		fake.assign(row, sourceUsers, "Email")
+		fake.assign(row, sourceUsers, "FirstName")
+		fake.assign(row, sourceUsers, "CreatedAt")
+		fake.assign(row, sourceUsers, "CurrentMood")
+		fake.assign(row, sourceUsers, "Tags")
+		fake.assign(row, sourceUsers, "Balance")
+		fake.assign(row, sourceUsers, "Profile")
+
+	})
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (fake *UsersFake) UpdateWithMask(sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+	return fake.UpdateWithMaskCtx(context.Background(), sourceUsers, updateMask, where)
+}
+
+// UpdateWithMaskCtx sets the fields in the mask, Go or database names, of the rows matching the predicate
+// to the ones of the source. Returns the number of affected rows.
+func (fake *UsersFake) UpdateWithMaskCtx(ctx context.Context, sourceUsers *Users, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersFake.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside users")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if sourceUsers == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+	for _, field := range updateMask {
+		if !fake.assign(&Users{}, sourceUsers, field) {
+			return 0, NewModelsErrorLocal(errorPrefix, "the update mask field "+field+" is not a column of users")
+		}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Users) {
+		for _, field := range updateMask {
+			fake.assign(row, sourceUsers, field)
+		}
+	})
+}
+
+// Delete is DeleteCtx with the background context
+func (fake *UsersFake) Delete(where Predicate) (int64, error) {
+	return fake.DeleteCtx(context.Background(), where)
+}
+
+// DeleteCtx deletes the rows matching the predicate.
+// Returns the number of deleted rows.
+func (fake *UsersFake) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "UsersFake.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from users")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return 0, err
+	}
+	return fake.deleteRows(indexes), nil
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (fake *UsersFake) DeleteInstance(sourceUsers *Users) (bool, error) {
+	return fake.DeleteInstanceCtx(context.Background(), sourceUsers)
+}
+
+// DeleteInstanceCtx deletes the row with the primary key of the source.
+// Returns true if the row was found.
+func (fake *UsersFake) DeleteInstanceCtx(ctx context.Context, sourceUsers *Users) (bool, error) {
+
+	var errorPrefix = "UsersFake.DeleteInstance() ERROR: "
+
+	if sourceUsers == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceUsers pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	var indexes []int
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], sourceUsers, "id") {
+			indexes = append(indexes, i)
+		}
+	}
+	return fake.deleteRows(indexes) == 1, nil
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (fake *UsersFake) DeleteAll() (int64, error) {
+	return fake.DeleteAllCtx(context.Background())
+}
+
+// DeleteAllCtx deletes all the rows.
+// Returns the number of deleted rows.
+func (fake *UsersFake) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	// the zero predicate matches all the rows, without an error
+	indexes, _ := fake.matching("", Predicate{}, false)
+	return fake.deleteRows(indexes), nil
+}
+
+// get returns a copy of the row with the values of the key in the columns, nil if there is none
+func (fake *UsersFake) get(key *Users, dbNames ...string) *Users {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], key, dbNames...) {
+			row := fake.rows[i]
+			return &row
+		}
+	}
+	return nil
+}
+
+// GetById is GetByIdCtx with the background context
+func (fake *UsersFake) GetById(inputId int32) (*Users, error) {
+	return fake.GetByIdCtx(context.Background(), inputId)
+}
+
+// GetByIdCtx returns a copy of the row with the primary key, nil if there is none
+func (fake *UsersFake) GetByIdCtx(ctx context.Context, inputId int32) (*Users, error) {
+	return fake.get(&Users{Id: inputId}, "id"), nil
+}
+
+// GetByUniqueEmail is GetByUniqueEmailCtx with the background context
+func (fake *UsersFake) GetByUniqueEmail(inputEmail string) (*Users, error) {
+	return fake.GetByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// GetByUniqueEmailCtx returns a copy of the row with the values of the users_email_key unique constraint, nil if there is none
+func (fake *UsersFake) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Users, error) {
+	return fake.get(&Users{Email: inputEmail}, "email"), nil
+}
+
+// GetByUniqueUserGuid is GetByUniqueUserGuidCtx with the background context
+func (fake *UsersFake) GetByUniqueUserGuid(inputUserGuid string) (*Users, error) {
+	return fake.GetByUniqueUserGuidCtx(context.Background(), inputUserGuid)
+}
+
+// GetByUniqueUserGuidCtx returns a copy of the row with the values of the users_guid_idx unique constraint, nil if there is none
+func (fake *UsersFake) GetByUniqueUserGuidCtx(ctx context.Context, inputUserGuid string) (*Users, error) {
+	return fake.get(&Users{UserGuid: inputUserGuid}, "user_guid"), nil
+}
+
+// New is Tables.Users.New
+func (fake *UsersFake) New() *Users {
+	return fake.utils.New()
+}
+
+// CreateFromHttpRequest is Tables.Users.CreateFromHttpRequest
+func (fake *UsersFake) CreateFromHttpRequest(req *http.Request) (*Users, error) {
+	return fake.utils.CreateFromHttpRequest(req)
+}
+
+// CreateFromHttpRequestIgnoreErrors is Tables.Users.CreateFromHttpRequestIgnoreErrors
+func (fake *UsersFake) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Users, []error) {
+	return fake.utils.CreateFromHttpRequestIgnoreErrors(req)
+}
+
+// ToDbFieldName is Tables.Users.ToDbFieldName
+func (fake *UsersFake) ToDbFieldName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldName(fieldDbOrGoName)
+}
+
+// ToDbFieldTypeFromColName is Tables.Users.ToDbFieldTypeFromColName
+func (fake *UsersFake) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldTypeFromColName(fieldDbOrGoName)
+}
+
+// CountImprecise is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) CountImprecise() (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CountImpreciseCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPath is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Users, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPathCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Users, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReader is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReaderCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *UsersFake) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
@@ -0,0 +1,427 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+	"time"
+
+	pgtype "github.com/jackc/pgx/pgtype"
+)
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tFunctionUtils struct {
+
+	// the querier the functions run on, GetDb() when nil, see NewDB
+	querier Querier
+}
+
+var Functions tFunctionUtils
+
+// AccountByEmail is AccountByEmailCtx with the background context
+func (utilRef *tFunctionUtils) AccountByEmail(paramPEmail string) (returnVal *Accounts, err error) {
+	return utilRef.AccountByEmailCtx(context.Background(), paramPEmail)
+}
+
+// Wrapper over the function named account_by_email
+
+func (utilRef *tFunctionUtils) AccountByEmailCtx(ctx context.Context, paramPEmail string) (returnVal *Accounts, err error) {
+
+	var errorPrefix = "tFunctionUtils.AccountByEmail() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "account_by_email")
+	//queryParts = append(queryParts, "( p_email := $1 )")
+	queryParts = append(queryParts, "( $1 )")
+
+	// we are aiming for a single row so we will use Query Row
+	var nullableAccountId pgtype.Int8
+	var nullableAccountGuid pgtype.Text
+	var nullableEmail pgtype.Text
+	var nullableStatus pgtype.Text
+	var nullablePreviousStatus pgtype.Text
+	var nullableFlags pgtype.Text
+	var nullableSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var nullableBalance Numeric
+	var nullableOpenedOn pgtype.Date
+	var nullableCreatedAt pgtype.Timestamptz
+	returnVal = new(Accounts)
+
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts, ""), paramPEmail).Scan(&nullableAccountId, &nullableAccountGuid, &nullableEmail, &nullableStatus, &nullablePreviousStatus, &nullableFlags, &nullableSettings, &nullableBalance, &nullableOpenedOn, &nullableCreatedAt)
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		err = nil
+		return
+	case err != nil:
+		return
+	default:
+		//BEGIN: non void operations
+
+		// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
+		var isNullRecord bool = true
+
+		returnVal.SetAccountId(nullableAccountId.Int)
+		if boolFromStatus(nullableAccountId.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetAccountGuid(nullableAccountGuid.String)
+		if boolFromStatus(nullableAccountGuid.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetEmail(nullableEmail.String)
+		if boolFromStatus(nullableEmail.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetStatus(nullableStatus.String)
+		if boolFromStatus(nullableStatus.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetPreviousStatus(nullablePreviousStatus.String, boolFromStatus(nullablePreviousStatus.Status))
+
+		if boolFromStatus(nullablePreviousStatus.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetFlags(nullableFlags.String, boolFromStatus(nullableFlags.Status))
+
+		if boolFromStatus(nullableFlags.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetSettings(*nullableSettings.Target.(*map[string]string), nullableSettings.Valid)
+
+		if nullableSettings.Valid {
+			isNullRecord = false
+		}
+
+		returnVal.SetBalance(nullableBalance.NumericVal())
+		if boolFromStatus(nullableBalance.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetOpenedOn(nullableOpenedOn.Time, boolFromStatus(nullableOpenedOn.Status))
+
+		if boolFromStatus(nullableOpenedOn.Status) {
+			isNullRecord = false
+		}
+
+		returnVal.SetCreatedAt(nullableCreatedAt.Time)
+		if boolFromStatus(nullableCreatedAt.Status) {
+			isNullRecord = false
+		}
+
+		if isNullRecord == true {
+			returnVal = nil
+
+		}
+
+		// END: assign any nullable values to the nullable fields inside the struct appropriately
+
+		return
+		//END: non void operations
+	}
+
+}
+
+// AccountEmails is AccountEmailsCtx with the background context
+func (utilRef *tFunctionUtils) AccountEmails() (returnVal []string, err error) {
+	return utilRef.AccountEmailsCtx(context.Background())
+}
+
+// Wrapper over the function named account_emails
+
+func (utilRef *tFunctionUtils) AccountEmailsCtx(ctx context.Context) (returnVal []string, err error) {
+
+	var errorPrefix = "tFunctionUtils.AccountEmails() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "account_emails")
+	//queryParts = append(queryParts, "(  )")
+	queryParts = append(queryParts, "(  )")
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""))
+
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" fatal error running the function statement:", err)
+	}
+	defer rows.Close()
+
+	//BEGIN: non void operations
+
+	for rows.Next() {
+
+		// create a new variable of type string
+		var currentstring string
+
+		// BEGIN: Not-user-defined (internal types) return type collection (slice)
+
+		err = rows.Scan(&currentstring)
+		if err != nil {
+			return returnVal, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+		// END: Not-User-defined (internal types) return type collection (slice)
+
+		// a set is returned (expect one or more records)
+		returnVal = append(returnVal, currentstring)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	//END: non void operations
+
+	return returnVal, nil
+
+}
+
+// CloseAccount is CloseAccountCtx with the background context
+func (utilRef *tFunctionUtils) CloseAccount(paramPAccountId int64) (err error) {
+	return utilRef.CloseAccountCtx(context.Background(), paramPAccountId)
+}
+
+// Wrapper over the function named close_account
+/* Database comments: Closes the account, keeping its transfers */
+// For pure Go return types, a true isDbNull return parameter indicates that
+// the actual value returned from the database was nil, not the default value of the Go type
+func (utilRef *tFunctionUtils) CloseAccountCtx(ctx context.Context, paramPAccountId int64) (err error) {
+
+	var errorPrefix = "tFunctionUtils.CloseAccount() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "close_account")
+	//queryParts = append(queryParts, "( p_account_id := $1 )")
+	queryParts = append(queryParts, "( $1 )")
+
+	// we are aiming for a single row so we will use Query Row
+
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts, ""), paramPAccountId).Scan()
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		err = nil
+		return
+	case err != nil:
+		return
+	default:
+
+		// this function returns void, do not attempt doing anything else
+		return
+
+	}
+
+}
+
+// OpenAccounts is OpenAccountsCtx with the background context
+func (utilRef *tFunctionUtils) OpenAccounts() (returnVal []Accounts, err error) {
+	return utilRef.OpenAccountsCtx(context.Background())
+}
+
+// Wrapper over the function named open_accounts
+
+func (utilRef *tFunctionUtils) OpenAccountsCtx(ctx context.Context) (returnVal []Accounts, err error) {
+
+	var errorPrefix = "tFunctionUtils.OpenAccounts() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "open_accounts")
+	//queryParts = append(queryParts, "(  )")
+	queryParts = append(queryParts, "(  )")
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""))
+
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" fatal error running the function statement:", err)
+	}
+	defer rows.Close()
+
+	//BEGIN: non void operations
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullablePreviousStatus pgtype.Text
+	var nullableFlags pgtype.Text
+	var nullableSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var nullableOpenedOn pgtype.Date
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	for rows.Next() {
+
+		// create a new variable of type Accounts
+		var currentAccounts Accounts
+
+		// BEGIN: User-defined return type collection (slice)
+
+		err := rows.Scan(&currentAccounts.AccountId, &currentAccounts.AccountGuid, &currentAccounts.Email, &currentAccounts.Status, &nullablePreviousStatus, &nullableFlags, &nullableSettings, &currentAccounts.Balance, &nullableOpenedOn, &currentAccounts.CreatedAt)
+		if err != nil {
+			return returnVal, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		// BEGIN: assign any nullable values to the nullable fields inside the struct appropriately
+		currentAccounts.SetPreviousStatus(nullablePreviousStatus.String, boolFromStatus(nullablePreviousStatus.Status))
+		currentAccounts.SetFlags(nullableFlags.String, boolFromStatus(nullableFlags.Status))
+		currentAccounts.SetSettings(*nullableSettings.Target.(*map[string]string), nullableSettings.Valid)
+		currentAccounts.SetOpenedOn(nullableOpenedOn.Time, boolFromStatus(nullableOpenedOn.Status))
+
+		// END: assign any nullable values to the nullable fields inside the struct appropriately
+
+		// END: User-defined (internal types) return type collection (slice)
+
+		// a set is returned (expect one or more records)
+		returnVal = append(returnVal, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return returnVal, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	//END: non void operations
+
+	return returnVal, nil
+
+}
+
+// TransferCount is TransferCountCtx with the background context
+func (utilRef *tFunctionUtils) TransferCount(paramPAccountId int64) (returnVal int64, err error, isDbNull bool) {
+	return utilRef.TransferCountCtx(context.Background(), paramPAccountId)
+}
+
+// Wrapper over the function named transfer_count
+// For pure Go return types, a true isDbNull return parameter indicates that
+// the actual value returned from the database was nil, not the default value of the Go type
+func (utilRef *tFunctionUtils) TransferCountCtx(ctx context.Context, paramPAccountId int64) (returnVal int64, err error, isDbNull bool) {
+
+	var errorPrefix = "tFunctionUtils.TransferCount() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "transfer_count")
+	//queryParts = append(queryParts, "( p_account_id := $1 )")
+	queryParts = append(queryParts, "( $1 )")
+
+	// we are aiming for a single row so we will use Query Row
+
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts, ""), paramPAccountId).Scan(&returnVal)
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		err = nil
+		return
+	case err != nil:
+		return
+	default:
+		//BEGIN: non void operations
+
+		//todo
+
+		return
+		//END: non void operations
+	}
+
+}
+
+// TransferCount_2 is TransferCount_2Ctx with the background context
+func (utilRef *tFunctionUtils) TransferCount_2(paramPAccountId int64, paramPSince time.Time) (returnVal int64, err error, isDbNull bool) {
+	return utilRef.TransferCount_2Ctx(context.Background(), paramPAccountId, paramPSince)
+}
+
+// Wrapper over the function named transfer_count
+// For pure Go return types, a true isDbNull return parameter indicates that
+// the actual value returned from the database was nil, not the default value of the Go type
+func (utilRef *tFunctionUtils) TransferCount_2Ctx(ctx context.Context, paramPAccountId int64, paramPSince time.Time) (returnVal int64, err error, isDbNull bool) {
+
+	var errorPrefix = "tFunctionUtils.TransferCount_2() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		err = NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+		return
+	}
+
+	// define the exec query
+	var queryParts []string
+
+	queryParts = append(queryParts, "SELECT * FROM ")
+	queryParts = append(queryParts, "transfer_count")
+	//queryParts = append(queryParts, "( p_account_id := $1,p_since := $2 )")
+	queryParts = append(queryParts, "( $1,$2 )")
+
+	// we are aiming for a single row so we will use Query Row
+
+	err = currentDbHandle.QueryRow(ctx, JoinStringParts(queryParts, ""), paramPAccountId, paramPSince).Scan(&returnVal)
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		err = nil
+		return
+	case err != nil:
+		return
+	default:
+		//BEGIN: non void operations
+
+		//todo
+
+		return
+		//END: non void operations
+	}
+
+}
@@ -0,0 +1,62 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+// Container struct for table collections
+type stTables struct {
+	Accounts  tAccountsUtils
+	Transfers tTransfersUtils
+
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool // set this to true if you want Inserts to ignore the PK fields
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+}
+
+var Tables stTables
+
+// CacheLookupTables iterates through all tables prefixed with "lookup"
+// (case-insensitive) and enables caching on them, then loads all rows inside
+// each respective cache.
+func (t *stTables) CacheLookupTables() {
+
+}
+
+// Container struct for view collections
+type stViews struct {
+	AccountBalances tAccountBalancesUtils
+	DailyTotals     tDailyTotalsUtils
+}
+
+// Views is a singleton utility container for view operations.
+var Views stViews
+
+// PrepareDbCollections gets called in case of a successful InitDatabase() call.
+// Customize what happens inside as necessary.
+func PrepareDbCollections() {
+
+	// Tables-specific default settings
+
+	// by setting this to true, the inserts will assume PKs are inserted by the database
+	// so whatever PK id is set in the structure will be ignored for insert operations
+	Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = true
+
+	// by setting this to true, whenever a New() or CreateFrom...() method is called
+	// to generate a new table instance struct, the time.Time fields will be automatically
+	// populate to time.Now()
+	Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords = true
+
+	// by setting this to true, whenever a New() or CreateFrom...() method is called
+	// to generate a new table instance struct, the Guid fields will be automatically
+	// populated with a newly generated Guid
+	Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords = true
+
+}
@@ -0,0 +1,16 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+// Database settings variables, with initial, dummy values
+
+var DB_HOST string = "localhost"
+var DB_PORT uint16 = 5432
+var DB_USER string = "testuser"
+var DB_PASS string = "testuser"
+var DB_NAME string = "testdb"
+var DB_POOL_MAX_CONNECTIONS int = 100
+var DB_SSL string = ""
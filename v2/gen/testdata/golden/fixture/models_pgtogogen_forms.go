@@ -0,0 +1,12 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* This is a one-time only generation. Customize when necessary. */
+/* ************************************************************* */
+
+// Validator enables structs that implement it to return the validation
+// state for that particular instance.
+type Validator interface {
+	Validate() (bool, []error)
+}
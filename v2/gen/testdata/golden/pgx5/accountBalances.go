@@ -0,0 +1,1866 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+const AccountBalances_DB_VIEW_NAME string = "account_balances"
+
+/*
+AccountBalances is a structure that corresponds to the account_balances view.
+Database comments: The open accounts
+*/
+type AccountBalances struct {
+	// database field name: account_id
+	AccountId           int64
+	AccountId_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: email
+	Email           string
+	Email_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: status
+	Status           string
+	Status_IsNotNull bool // if true, it means the value is not null
+
+	// database field name: balance
+	Balance           Numeric
+	Balance_IsNotNull bool // if true, it means the value is not null
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+/* Sorting helper containers */
+
+// SortAccountBalancesByAccountId implements sort.Interface for []AccountBalances based on
+// the AccountId field. Usage: sort.Sort(SortAccountBalancesByAccountId(anyGivenAccountBalancesSlice))
+type SortAccountBalancesByAccountId []AccountBalances
+
+func (a SortAccountBalancesByAccountId) Len() int      { return len(a) }
+func (a SortAccountBalancesByAccountId) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountBalancesByAccountId) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].AccountId, a[j].AccountId)
+}
+
+// SortAccountBalancesByEmail implements sort.Interface for []AccountBalances based on
+// the Email field. Usage: sort.Sort(SortAccountBalancesByEmail(anyGivenAccountBalancesSlice))
+type SortAccountBalancesByEmail []AccountBalances
+
+func (a SortAccountBalancesByEmail) Len() int      { return len(a) }
+func (a SortAccountBalancesByEmail) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountBalancesByEmail) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Email, a[j].Email)
+}
+
+// SortAccountBalancesByStatus implements sort.Interface for []AccountBalances based on
+// the Status field. Usage: sort.Sort(SortAccountBalancesByStatus(anyGivenAccountBalancesSlice))
+type SortAccountBalancesByStatus []AccountBalances
+
+func (a SortAccountBalancesByStatus) Len() int      { return len(a) }
+func (a SortAccountBalancesByStatus) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountBalancesByStatus) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Status, a[j].Status)
+}
+
+// SortAccountBalancesByBalance implements sort.Interface for []AccountBalances based on
+// the Balance field. Usage: sort.Sort(SortAccountBalancesByBalance(anyGivenAccountBalancesSlice))
+type SortAccountBalancesByBalance []AccountBalances
+
+func (a SortAccountBalancesByBalance) Len() int      { return len(a) }
+func (a SortAccountBalancesByBalance) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountBalancesByBalance) Less(i, j int) bool {
+	return LessComparatorFor_Numeric(a[i].Balance, a[j].Balance)
+}
+
+func (t *AccountBalances) SetAccountId(val int64, notNull bool) {
+	t.AccountId = val
+	t.AccountId_IsNotNull = notNull
+}
+func (t *AccountBalances) SetEmail(val string, notNull bool) {
+	t.Email = val
+	t.Email_IsNotNull = notNull
+}
+func (t *AccountBalances) SetStatus(val string, notNull bool) {
+	t.Status = val
+	t.Status_IsNotNull = notNull
+}
+func (t *AccountBalances) SetBalance(val Numeric, notNull bool) {
+	t.Balance = val
+	t.Balance_IsNotNull = notNull
+}
+
+// fake, internal type to allow a singleton structure that would hold static-like methods
+type tAccountBalancesUtils struct {
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForAccountBalances structure
+	Cache CacheForAccountBalances
+}
+
+// LoadedColumns returns the columns the AccountBalances was selected with by WithColumns, nil when it holds all of them
+func (t *AccountBalances) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanAccountBalances scans a row of the columns of the projection, all of them when it is nil, into a new AccountBalances
+func scanAccountBalances(scan func(dest ...interface{}) error, projection *columnProjection) (AccountBalances, error) {
+
+	currentAccountBalances := AccountBalances{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableAccountId pgtype.Int8
+	var nullableEmail pgtype.Text
+	var nullableStatus pgtype.Text
+	var nullableBalance Numeric
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&nullableAccountId, &nullableEmail, &nullableStatus, &nullableBalance}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "account_id":
+				dest = append(dest, &nullableAccountId)
+			case "email":
+				dest = append(dest, &nullableEmail)
+			case "status":
+				dest = append(dest, &nullableStatus)
+			case "balance":
+				dest = append(dest, &nullableBalance)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentAccountBalances, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("account_id") {
+		currentAccountBalances.SetAccountId(nullableAccountId.Int64, nullableAccountId.Valid)
+	}
+	if projection.loads("email") {
+		currentAccountBalances.SetEmail(nullableEmail.String, nullableEmail.Valid)
+	}
+	if projection.loads("status") {
+		currentAccountBalances.SetStatus(nullableStatus.String, nullableStatus.Valid)
+	}
+	if projection.loads("balance") {
+		currentAccountBalances.SetBalance(nullableBalance.NumericVal(), nullableBalance.Valid)
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentAccountBalances, nil
+}
+
+// rowToAccountBalances scans a row of all the columns into a new AccountBalances
+func rowToAccountBalances(row pgx.CollectableRow) (AccountBalances, error) {
+	return scanAccountBalances(row.Scan, nil)
+}
+
+// AccountBalancesCols holds the columns of account_balances, building the predicates and the ordering of the queries,
+// e.g. AccountBalancesCols.AccountId.Eq(value)
+var AccountBalancesCols = struct {
+	AccountId QueryColumn
+	Email     QueryColumn
+	Status    QueryColumn
+	Balance   QueryColumn
+}{
+	AccountId: QueryColumn{name: "account_id"},
+	Email:     QueryColumn{name: "email"},
+	Status:    QueryColumn{name: "status"},
+	Balance:   QueryColumn{name: "balance"},
+}
+
+// isAccountBalancesColumn tells whether the database name is one of the columns of account_balances
+func isAccountBalancesColumn(dbName string) bool {
+
+	switch dbName {
+	case "account_id", "email", "status", "balance":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tAccountBalancesUtils) Select(where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from account_balances matching the predicate, e.g.
+// AccountBalancesCols.AccountId.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from account_balances,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from account_balances,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from account_balances matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentAccountBalancesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentAccountBalancesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfAccountBalances)
+		}
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from account_balances matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from account_balances matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from account_balances")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentAccountBalancesRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentAccountBalancesRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfAccountBalances)
+		}
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectAll(options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from account_balances.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountBalancesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allAccountBalancesRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from account_balances ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of AccountBalances instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountBalancesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allAccountBalancesRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from account_balances equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of AccountBalances instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountBalancesUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountBalancesRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allAccountBalancesRowsFromCache) {
+			pageStart = len(allAccountBalancesRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allAccountBalancesRowsFromCache) {
+			pageEnd = len(allAccountBalancesRowsFromCache)
+		}
+
+		return allAccountBalancesRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanAccountBalances scans each row, CollectRows closes the rows once done
+	sliceOfAccountBalances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AccountBalances, error) {
+		return scanAccountBalances(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfAccountBalances, nil
+}
+
+// SelectAccountBalances is SelectAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SelectAccountBalances(where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return txWrapper.SelectAccountBalancesCtx(context.Background(), where, options...)
+}
+
+// SelectAccountBalancesCtx runs SelectCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectAccountBalancesCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedAccountBalances is SelectCachedAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SelectCachedAccountBalances(cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return txWrapper.SelectCachedAccountBalancesCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedAccountBalancesCtx runs SelectCachedCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedAccountBalancesCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageAccountBalances is SelectPageAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SelectPageAccountBalances(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return txWrapper.SelectPageAccountBalancesCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageAccountBalancesCtx runs SelectPageCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageAccountBalancesCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedAccountBalances is SelectPageCachedAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedAccountBalances(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return txWrapper.SelectPageCachedAccountBalancesCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedAccountBalancesCtx runs SelectPageCachedCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedAccountBalancesCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllAccountBalances is SelectAllAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SelectAllAccountBalances(options ...SelectOption) ([]AccountBalances, error) {
+	return txWrapper.SelectAllAccountBalancesCtx(context.Background(), options...)
+}
+
+// SelectAllAccountBalancesCtx runs SelectAllCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllAccountBalancesCtx(ctx context.Context, options ...SelectOption) ([]AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for AccountBalances         */
+/* ************************************************************ */
+
+type CacheForAccountBalances struct {
+	enabled bool // flag to determine if caching is enabled for AccountBalances
+
+	sliceCache      map[string][]AccountBalances
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]AccountBalances
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]AccountBalances
+	singleRowCacheMutex sync.RWMutex
+
+	all      []AccountBalances
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Views.AccountBalances when nil
+	utils *tAccountBalancesUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForAccountBalances) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]AccountBalances)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]AccountBalances)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]AccountBalances)
+	}
+
+}
+
+func (c *CacheForAccountBalances) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForAccountBalances) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForAccountBalances) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForAccountBalances) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForAccountBalances) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for account_balances and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForAccountBalances) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Views.AccountBalances
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForAccountBalances) GetAllRows() ([]AccountBalances, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all AccountBalances records in the database.
+func (c *CacheForAccountBalances) SetAllRows(all []AccountBalances) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all AccountBalances records in the database.
+func (c *CacheForAccountBalances) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForAccountBalances) GetWhere(key string) ([]AccountBalances, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wAccountBalances, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wAccountBalances, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForAccountBalances) SetWhere(key string, sliceAccountBalances []AccountBalances) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceAccountBalances != nil {
+
+			whereSliceCopy := make([]AccountBalances, len(sliceAccountBalances))
+			copy(whereSliceCopy, sliceAccountBalances)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForAccountBalances) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of AccountBalances from the cache store based on
+// the given key.
+func (c *CacheForAccountBalances) GetSlice(key string) ([]AccountBalances, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sAccountBalances, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sAccountBalances, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of AccountBalances inside the cache store based and
+// associates it with the given key.
+func (c *CacheForAccountBalances) SetSlice(key string, sliceAccountBalances []AccountBalances) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceAccountBalances != nil {
+
+			sliceCopy := make([]AccountBalances, len(sliceAccountBalances))
+			copy(sliceCopy, sliceAccountBalances)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of AccountBalances from the cache store entry
+// associated with key.
+func (c *CacheForAccountBalances) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *AccountBalances from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForAccountBalances) Get(key string) (*AccountBalances, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a AccountBalances with key, and saves it in the cache store.
+func (c *CacheForAccountBalances) Set(key string, structAccountBalances AccountBalances) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structAccountBalances
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the AccountBalances instance that is associated with key from the
+// cache store.
+func (c *CacheForAccountBalances) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for AccountBalances           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tAccountBalancesUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from account_balances matching all the predicates, e.g.
+// AccountBalancesCols.AccountId.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tAccountBalancesUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "AccountBalancesUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM account_balances"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tAccountBalancesUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from account_balances
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tAccountBalancesUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "AccountBalancesUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.account_balances'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tAccountBalancesUtils) Single(where Predicate, options ...SelectOption) (*AccountBalances, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from account_balances matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tAccountBalancesUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*AccountBalances, error) {
+
+	var errorPrefix = "AccountBalancesUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountBalancesColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, email, status, balance FROM account_balances ", "account_balances")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfAccountBalances *AccountBalances
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanAccountBalances creates a new instance of AccountBalances
+		currentAccountBalances, err := scanAccountBalances(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfAccountBalances = &currentAccountBalances
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfAccountBalances, nil
+}
+
+// SingleAccountBalances is SingleAccountBalancesCtx with the background context
+func (txWrapper *Transaction) SingleAccountBalances(where Predicate, options ...SelectOption) (*AccountBalances, error) {
+	return txWrapper.SingleAccountBalancesCtx(context.Background(), where, options...)
+}
+
+// SingleAccountBalancesCtx runs SingleCtx of AccountBalances in the transaction,
+// as txWrapper.DB().AccountBalances.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleAccountBalancesCtx(ctx context.Context, where Predicate, options ...SelectOption) (*AccountBalances, error) {
+	return NewDB(txWrapper).AccountBalances.SingleCtx(ctx, where, options...)
+}
+
+// keysetAccountBalances holds the unique keys of account_balances, and the columns its SelectAfter pages can be ordered by
+var keysetAccountBalances = keyset{
+	keys: [][]string{
+		{"account_id"},
+	},
+	columns: []string{"account_id"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tAccountBalancesUtils) keysetValue(row *AccountBalances, dbName string) interface{} {
+
+	switch dbName {
+	case "account_id":
+		return row.AccountId
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tAccountBalancesUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "account_id":
+		var param int64
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tAccountBalancesUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from account_balances matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of account_balances
+// (account_id) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tAccountBalancesUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error) {
+
+	var errorPrefix = "AccountBalancesUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetAccountBalances.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetAccountBalances.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// AccountBalancesRepository holds the methods of Views.AccountBalances, so that the code using them
+// can depend on the interface, and be unit tested with a AccountBalancesRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type AccountBalancesRepository interface {
+	Select(where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectAll(options ...SelectOption) ([]AccountBalances, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*AccountBalances, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*AccountBalances, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error)
+}
+
+var _ AccountBalancesRepository = (*tAccountBalancesUtils)(nil)
+
+// AccountBalancesRepositoryMock is a AccountBalancesRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type AccountBalancesRepositoryMock struct {
+	MockCalls
+
+	SelectFunc              func(where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCtxFunc           func(ctx context.Context, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionFunc         func(where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionCtxFunc      func(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionAllFunc      func(where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectUnionAllCtxFunc   func(ctx context.Context, where []Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCachedFunc        func(cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectCachedCtxFunc     func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageFunc          func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCtxFunc       func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCachedFunc    func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectPageCachedCtxFunc func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllFunc           func(options ...SelectOption) ([]AccountBalances, error)
+	SelectAllCtxFunc        func(ctx context.Context, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllOrderByFunc    func(orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllOrderByCtxFunc func(ctx context.Context, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllPageFunc       func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	SelectAllPageCtxFunc    func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]AccountBalances, error)
+	CountFunc               func(where ...Predicate) (int64, error)
+	CountCtxFunc            func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc      func() (int64, error)
+	CountImpreciseCtxFunc   func(ctx context.Context) (int64, error)
+	SingleFunc              func(where Predicate, options ...SelectOption) (*AccountBalances, error)
+	SingleCtxFunc           func(ctx context.Context, where Predicate, options ...SelectOption) (*AccountBalances, error)
+	SelectAfterFunc         func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error)
+	SelectAfterCtxFunc      func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]AccountBalances, string, error)
+}
+
+var _ AccountBalancesRepository = (*AccountBalancesRepositoryMock)(nil)
+
+// Select records the call and runs SelectFunc
+func (mock *AccountBalancesRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *AccountBalancesRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *AccountBalancesRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *AccountBalancesRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *AccountBalancesRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *AccountBalancesRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *AccountBalancesRepositoryMock) SelectAll(options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *AccountBalancesRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *AccountBalancesRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []AccountBalances, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *AccountBalancesRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *AccountBalancesRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *AccountBalancesRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *AccountBalancesRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *AccountBalancesRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *AccountBalances, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *AccountBalancesRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *AccountBalances, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *AccountBalancesRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []AccountBalances, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *AccountBalancesRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []AccountBalances, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
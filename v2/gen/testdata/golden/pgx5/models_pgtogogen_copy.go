@@ -0,0 +1,256 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+// CopyFromReaderOptions allows customizing the parsing of the bulk copy
+// line input when using CopyFromReader.
+type CopyFromReaderOptions struct {
+	// when not 0, Comment allows skipping lines that start with it (no whitespace allowed before it)
+	Comment rune
+
+	Separator        rune
+	NullPlaceholders []string
+
+	// if true, include the PK columns when explicit columns are not specified
+	IncludePKCols bool
+}
+
+// NewCopyFromReaderOptions instantiates a new *CopyFromReaderOptions with
+// the provided options.
+func NewCopyFromReaderOptions(separator rune, nullPlaceholders []string,
+	comment rune, includePKCols bool) *CopyFromReaderOptions {
+	return &CopyFromReaderOptions{
+		Comment:          comment,
+		Separator:        separator,
+		NullPlaceholders: nullPlaceholders,
+		IncludePKCols:    includePKCols,
+	}
+}
+
+var defCommentRune rune = ZeroRune
+var defNullPlaceholders = [2]string{"[null]", "[nil]"}
+var defSeparator rune = ','
+var defIncludePKCols = false
+
+// CopyFromReader facilitates line-by-line bulk copy functionality from an io.Reader,
+// such as a file or a network connection. It wraps a scanner over the supplied reader
+// and returns an instance that satisfies the pgx.CopyFromSource interface.
+// The lines are expected to be terminated by the '\n'. Any '\r' is automatically dropped.
+//
+// The expected format is a typical csv line format, with a user-defined separator rune.
+// The values should be wrapped with or double quotes, but the parser allows unquoted
+// values, as long as there are no commas inside. Whitespace outside double quotes is trimmed,
+// so, in general, it is a mistake to pass text fields unquoted.
+// The date type must be in the "YYYY-MM-DD" format
+// The timestamp must be in the "YYYY-MM-DD HH:mm:ss MST" format
+// The json fields must be in the official double quote format (e.g. {"myKey":"myVal"})
+// The postgres interval type is (for now) restricted to the Go range and parseable string syntax.
+//
+// If a non-nil nullPlaceholder is specified, the db null value will be used
+// for that column if that value is encountered (including the empty string).
+//
+// Example of a valid, parseable line with six columns (varchar, integer, jsonb, text, bool, date),
+// "Hello, friend", 932, "{"age":34}", "No comments", false, "2010-10-20"
+//
+// Example of usage for a table with the above-mentioned columns
+// imported from a comma-separated reader:
+//
+//	 nullVals := []string {"[!null]"}
+//		copySourceReader, err := CopyFromReader(r, CommaSeparator, nullVals, ZeroRune,
+//									"varchar", "integer", "jsonb","text","bool","date")
+//		if err != nil {
+//			return err
+//		}
+//		copyCount, err := currentDbHandle.CopyFrom(context.Background(), pgx.Identifier{"gaga_test_table"},
+//			[]string{"greeting", "age", "meta_info", "comments", "is_enabled", "date_created"}, copySourceReader)
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println("Records copied:",copyCount)
+func CopyFromReader(rdr io.Reader, separator rune, nullPlaceholders []string,
+	commentRune rune, dbtypes ...string) (pgx.CopyFromSource, error) {
+
+	if len(dbtypes) == 0 {
+		return nil, fmt.Errorf("CopyFromReader: Missing db types definitions")
+	}
+	c := &copyFromReader{
+		lineReader:          csv.NewReader(rdr),
+		separator:           separator,
+		comment:             commentRune,
+		nullPlaceholders:    nullPlaceholders,
+		useNullPlaceholders: (nullPlaceholders != nil && len(nullPlaceholders) > 0),
+		idx:                 -1,
+		dbtypes:             dbtypes,
+		typeMap:             pgtype.NewMap(),
+	}
+	c.lineReader.Comma = separator
+	c.lineReader.Comment = commentRune
+	c.lineReader.LazyQuotes = true
+	c.lineReader.TrimLeadingSpace = true
+	c.lineReader.FieldsPerRecord = len(dbtypes)
+
+	return c, nil
+}
+
+// ZeroRune is the rune constant for the (default) 0 value
+const ZeroRune rune = 0
+
+// CommaSeparator is the rune constant for the comma character. It can be supplied
+// to CopyFromReader as the separator rune for comma-separated values.
+const CommaSeparator rune = ','
+
+// TabSeparator is the rune constant for the tab character. It can be supplied
+// to CopyFromReader as the separator rune for tab-separated values.
+const TabSeparator rune = ','
+
+var bEmptyStringDoubleQuotes = []byte("\"\"")
+var bEmptyStringSingleQuotes = []byte("''")
+var bDotComparatorSlice = []byte(".")
+
+type copyFromReader struct {
+	lineReader          *csv.Reader
+	separator           rune
+	comment             rune
+	useNullPlaceholders bool
+	nullPlaceholders    []string
+	idx                 int
+	dbtypes             []string
+	currRowErr          error
+	currRow             []string
+	typeMap             *pgtype.Map
+}
+
+func (ctr *copyFromReader) Next() bool {
+	ctr.idx++
+	row, err := ctr.lineReader.Read()
+	// end of file, return false
+	if err == io.EOF {
+		return false
+	}
+	if err != nil {
+		ctr.currRowErr = err
+		ctr.currRow = nil
+	}
+	ctr.currRowErr = nil
+	ctr.currRow = row
+	return true
+}
+
+func (ctr *copyFromReader) Values() ([]interface{}, error) {
+
+	// Exit early if a csv parsing / splitting error occured inside Next()
+	if ctr.currRowErr != nil {
+		return nil, fmt.Errorf("copyFromReader.Value(row %d) error: %s", (ctr.idx + 1), ctr.currRowErr.Error())
+	}
+
+	// the nil values are the db nulls
+	outputValues := make([]interface{}, len(ctr.currRow))
+	for i := range ctr.currRow {
+		// Treat zero-length as null value is null placeholder happens to be the empty string
+		if len(ctr.currRow[i]) == 0 && ctr.useNullPlaceholders && ctr.isNullPlaceholder("") {
+			continue
+		}
+
+		// Null placeholder match: treat it as null
+		if ctr.useNullPlaceholders && ctr.isNullPlaceholder(ctr.currRow[i]) {
+			continue
+		}
+
+		// For non-text types, trim any right whitespace left and if the trimmed result
+		// is zero-length, assume null
+		if ctr.dbtypes[i] != "varchar" && ctr.dbtypes[i] != "text" && ctr.dbtypes[i] != "character varying" {
+			ctr.currRow[i] = strings.TrimRight(ctr.currRow[i], " ")
+			if len(ctr.currRow[i]) == 0 {
+				continue
+			}
+		}
+
+		value, err := ctr.decodeValue(ctr.dbtypes[i], ctr.currRow[i])
+		if err != nil {
+			return nil, fmt.Errorf("copyFromReader.Value(row %d, column %d) error: %s", (ctr.idx + 1), (i + 1), err.Error())
+		}
+		outputValues[i] = value
+	}
+	return outputValues, nil
+}
+
+// decodeValue converts the text of a column to a value pgx can copy into a column of the db type.
+// The dates, timestamps and intervals are in the formats documented by CopyFromReader, the other
+// types are decoded from their postgres text format.
+func (ctr *copyFromReader) decodeValue(dbtype string, text string) (interface{}, error) {
+
+	switch dbtype {
+	case "json", "jsonb":
+		return []byte(text), nil
+	case "date":
+		return time.Parse("2006-01-02", text)
+	case "interval":
+		return time.ParseDuration(text)
+	case "timestamptz", "timestamp with time zone":
+		layout := "2006-01-02 15:04:05 MST"
+		if strings.Contains(text, ".") {
+			layout = "2006-01-02 15:04:05.999999999 MST"
+		}
+		return time.Parse(layout, text)
+	case "timestamp", "timestamp without time zone":
+		layout := "2006-01-02 15:04:05"
+		if strings.Contains(text, ".") {
+			layout = "2006-01-02 15:04:05.999999999"
+		}
+		return time.Parse(layout, text)
+	}
+
+	typeName := dbtype
+	if alias, ok := pgTypeNameAliases[dbtype]; ok {
+		typeName = alias
+	}
+	dataType, ok := ctr.typeMap.TypeForName(typeName)
+	if !ok {
+		return nil, fmt.Errorf("unsupported db type %s", dbtype)
+	}
+	return dataType.Codec.DecodeValue(ctr.typeMap, dataType.OID, pgtype.TextFormatCode, []byte(text))
+}
+
+func (ctr *copyFromReader) Err() error {
+	return ctr.currRowErr
+}
+
+func (ctr *copyFromReader) isNullPlaceholder(v string) bool {
+	if len(ctr.nullPlaceholders) == 0 {
+		return false
+	}
+	for i := range ctr.nullPlaceholders {
+		if ctr.nullPlaceholders[i] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// pgTypeNameAliases are the names pgtype knows the types by, for the db types
+// reported under another name
+var pgTypeNameAliases = map[string]string{
+	"bigint":            "int8",
+	"boolean":           "bool",
+	"character":         "bpchar",
+	"character varying": "varchar",
+	"decimal":           "numeric",
+	"double precision":  "float8",
+	"integer":           "int4",
+	"real":              "float4",
+	"smallint":          "int2",
+}
@@ -0,0 +1,56 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"context"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+//
+// DB transaction-related types and functionality
+//
+
+// Transaction isolation levels for the pgx package
+const (
+	IsoLevelSerializable    = pgx.Serializable
+	IsoLevelRepeatableRead  = pgx.RepeatableRead
+	IsoLevelReadCommitted   = pgx.ReadCommitted
+	IsoLevelReadUncommitted = pgx.ReadUncommitted
+)
+
+// Transaction is a wrapper structure over the pgx transaction package, to avoid importing
+// that package in the generated table-to-struct files.
+type Transaction struct {
+	Tx pgx.Tx
+}
+
+// Commit is CommitCtx with the background context
+func (t *Transaction) Commit() error {
+	return t.CommitCtx(context.Background())
+}
+
+// Rollback is RollbackCtx with the background context
+func (t *Transaction) Rollback() error {
+	return t.RollbackCtx(context.Background())
+}
+
+// CommitCtx commits the current transaction
+func (t *Transaction) CommitCtx(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Commit()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Commit(ctx)
+}
+
+// RollbackCtx attempts to rollback the current transaction
+func (t *Transaction) RollbackCtx(ctx context.Context) error {
+	if t.Tx == nil {
+		return NewModelsErrorLocal("Transaction.Rollback()", "The inner Tx transaction is nil")
+	}
+	return t.Tx.Rollback(ctx)
+}
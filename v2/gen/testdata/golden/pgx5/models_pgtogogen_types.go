@@ -0,0 +1,148 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+//
+// DB custom type, aliases and type-related helper functions
+//
+
+// JSON is a nullable json document, kept as it is read,
+// which offers additional string-rendering methods.
+type JSON struct {
+	Bytes []byte
+	Valid bool
+}
+
+func (j *JSON) String() string { return string(j.Bytes) }
+
+// Scan implements the sql.Scanner interface
+func (j *JSON) Scan(src interface{}) error { return scanJSONDocument(src, &j.Bytes, &j.Valid) }
+
+// Value implements the driver.Valuer interface
+func (j JSON) Value() (driver.Value, error) { return jsonDocumentValue(j.Bytes, j.Valid) }
+
+// JSONB is a nullable jsonb document, kept as it is read,
+// which offers additional string-rendering methods.
+type JSONB struct {
+	Bytes []byte
+	Valid bool
+}
+
+func (j *JSONB) String() string { return string(j.Bytes) }
+
+// Scan implements the sql.Scanner interface
+func (j *JSONB) Scan(src interface{}) error { return scanJSONDocument(src, &j.Bytes, &j.Valid) }
+
+// Value implements the driver.Valuer interface
+func (j JSONB) Value() (driver.Value, error) { return jsonDocumentValue(j.Bytes, j.Valid) }
+
+// scanJSONDocument copies the scanned json document, or records a NULL
+func scanJSONDocument(src interface{}, document *[]byte, valid *bool) error {
+
+	switch src := src.(type) {
+	case nil:
+		*document, *valid = nil, false
+	case string:
+		*document, *valid = []byte(src), true
+	case []byte:
+		*document, *valid = append([]byte(nil), src...), true
+	default:
+		return fmt.Errorf("cannot scan a %T into a json document", src)
+	}
+	return nil
+}
+
+// jsonDocumentValue returns the json document to send, or nil for NULL
+func jsonDocumentValue(document []byte, valid bool) (driver.Value, error) {
+	if !valid {
+		return nil, nil
+	}
+	return string(document), nil
+}
+
+// Numeric is a wrapper struct that embeds the pgtype nullable Numeric type,
+// and offers additional assignment and rendering methods
+type Numeric struct {
+	pgtype.Numeric
+}
+
+func (n *Numeric) NumericVal() Numeric { return *n }
+
+func (n *Numeric) EmbeddedVal() *pgtype.Numeric { return &n.Numeric }
+
+// toNumeric returns a new Numeric from an existing numeric but with
+// a pgtogogen notNull bool value taking precedence over the Valid field.
+func toNumeric(existingNumeric Numeric, notNull bool) Numeric {
+	return Numeric{
+		Numeric: pgtype.Numeric{
+			Int:   existingNumeric.Int,
+			Exp:   existingNumeric.Exp,
+			Valid: notNull,
+		},
+	}
+}
+
+// toPgxNumeric returns a new pgtype.Numeric from an existing numeric but with
+// a pgtogogen notNull bool value taking precedence over the Valid field.
+func toPgxNumeric(existingNumeric Numeric, notNull bool) *pgtype.Numeric {
+	return &pgtype.Numeric{
+		Int:   existingNumeric.Int,
+		Exp:   existingNumeric.Exp,
+		Valid: notNull,
+	}
+}
+
+// To_Numeric_FromString converts a string to a Numeric value
+func To_Numeric_FromString(numericStr string) (Numeric, error) {
+
+	var errorPrefix = "To_numericStr_FromString() ERROR: "
+
+	n := Numeric{}
+	if numericStr == "" {
+		return n, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}
+
+	err := n.Scan(numericStr)
+	if err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// LessComparatorFor_Numeric is a sort comparator function for the Numeric type
+func LessComparatorFor_Numeric(first, second Numeric) bool { return cmpNumeric(first, second) }
+
+var big0 *big.Int = big.NewInt(0)
+var big1 *big.Int = big.NewInt(1)
+var big10 *big.Int = big.NewInt(10)
+
+func cmpNumeric(first, second Numeric) bool {
+
+	if !first.Valid {
+		return true
+	}
+
+	if !second.Valid {
+		return false
+	}
+
+	// math.big Cmp compares x and y and returns:
+	//
+	//   -1 if x <  y
+	//    0 if x == y
+	//   +1 if x >  y
+	//
+	cmpInts := first.Int.Cmp(second.Int)
+	return cmpInts == -1
+}
@@ -0,0 +1,4298 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+const Transfers_DB_TABLE_NAME string = "transfers"
+
+// Transfers is a struct that corresponds to the transfers table.
+type Transfers struct {
+	TransferId int32 // database field name: transfer_id, IsPK: true , IsCompositePK: false, IsFK: false
+
+	FromAccount int64 // database field name: from_account, IsPK: false , IsCompositePK: false, IsFK: false
+
+	ToAccount           int64 // database field name: to_account, IsPK: false , IsCompositePK: false, IsFK: false
+	ToAccount_IsNotNull bool  // if true, it means the value is not null
+
+	Amount float64 // database field name: amount, IsPK: false , IsCompositePK: false, IsFK: false
+
+	Memo           string // database field name: memo, IsPK: false , IsCompositePK: false, IsFK: false
+	Memo_IsNotNull bool   // if true, it means the value is not null
+
+	Urgent bool // database field name: urgent, IsPK: false , IsCompositePK: false, IsFK: false
+
+	HappenedAt time.Time // database field name: happened_at, IsPK: false , IsCompositePK: false, IsFK: false
+
+	/* The payment details @gotype:map[string]string */
+	Details map[string]string // database field name: details, IsPK: false , IsCompositePK: false, IsFK: false
+
+	// Set this to true if you want Inserts to ignore the PK fields
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+// SetTransferId sets the TransferId field to val.
+func (t *Transfers) SetTransferId(val int32) {
+	t.TransferId = val
+
+}
+
+// SetFromAccount sets the FromAccount field to val.
+func (t *Transfers) SetFromAccount(val int64) {
+	t.FromAccount = val
+
+}
+
+// SetToAccount sets the ToAccount field to val.
+func (t *Transfers) SetToAccount(val int64, notNull bool) {
+	t.ToAccount = val
+	t.ToAccount_IsNotNull = notNull
+}
+
+// IsNotNull_ToAccount returns true is the field is not null (a value is present)
+func (t *Transfers) IsNotNull_ToAccount() bool {
+	return t.ToAccount_IsNotNull
+}
+
+// IsNull_ToAccount returns true is the field is null (a value is not present)
+func (t *Transfers) IsNull_ToAccount() bool {
+	return !t.ToAccount_IsNotNull
+}
+
+// SetAmount sets the Amount field to val.
+func (t *Transfers) SetAmount(val float64) {
+	t.Amount = val
+
+}
+
+// SetMemo sets the Memo field to val.
+func (t *Transfers) SetMemo(val string, notNull bool) {
+	t.Memo = val
+	t.Memo_IsNotNull = notNull
+}
+
+// IsNotNull_Memo returns true is the field is not null (a value is present)
+func (t *Transfers) IsNotNull_Memo() bool {
+	return t.Memo_IsNotNull
+}
+
+// IsNull_Memo returns true is the field is null (a value is not present)
+func (t *Transfers) IsNull_Memo() bool {
+	return !t.Memo_IsNotNull
+}
+
+// SetUrgent sets the Urgent field to val.
+func (t *Transfers) SetUrgent(val bool) {
+	t.Urgent = val
+
+}
+
+// SetHappenedAt sets the HappenedAt field to val.
+func (t *Transfers) SetHappenedAt(val time.Time) {
+	t.HappenedAt = val
+
+}
+
+// SetDetails sets the Details field to val.
+func (t *Transfers) SetDetails(val map[string]string) {
+	t.Details = val
+
+}
+
+// MarkAllColumnsLoaded lets the instance Update of a Transfers selected WithColumns write back all
+// its fields, the zero values of the columns left out included
+func (t *Transfers) MarkAllColumnsLoaded() {
+	t.pgToGo_projection = nil
+}
+
+/* Transfers slice manipulation helpers */
+
+// TransfersSlice allows manipulation of a slice of Transfers using the
+// attached methods. Simply cast []Transfers to TransfersSlice
+// e.g. TransfersSlice(anyGivenTransfersSlice)
+// and you have various methods available. Please note that these methods do not impact
+// the database in any way.
+type TransfersSlice []Transfers
+
+type TransfersSliceFilterFunc func(c Transfers) bool
+
+// Filter filters the original TransfersSlice elements using the supplied
+// filterFunc closure. The caller must reassign the returned collection:
+//
+//	newSlice := Filter(filterFunc)
+func (cc TransfersSlice) Filter(filterFunc TransfersSliceFilterFunc) TransfersSlice {
+	if cc == nil {
+		return nil
+	}
+	ccNew := make(TransfersSlice, 0, len(cc))
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			ccNew = append(ccNew, cc[i])
+		}
+	}
+	return ccNew
+}
+
+// FilterInto filters the original TransfersSlice collection into the supplied
+// dest TransfersSlice. Passing the preallocated collection can potentially avoid
+// a huge number of allocations in a fast paced application.
+// The caller must reassign the returned collection to dest:
+//
+//	dest = FilterInto(filterFunc, dest)
+func (cc TransfersSlice) FilterInto(filterFunc TransfersSliceFilterFunc,
+	dest TransfersSlice) TransfersSlice {
+	if cc == nil {
+		dest = nil
+		return nil
+	}
+	if dest == nil {
+		return nil
+	}
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			dest = append(dest, cc[i])
+		}
+	}
+	return dest
+}
+
+/* Sorting helper containers */
+
+// SortTransfersByTransferId implements sort.Interface for []Transfers based on
+// the TransferId field. Usage: sort.Sort(SortTransfersByTransferId(anyGivenTransfersSlice))
+type SortTransfersByTransferId []Transfers
+
+func (a SortTransfersByTransferId) Len() int      { return len(a) }
+func (a SortTransfersByTransferId) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByTransferId) Less(i, j int) bool {
+	return LessComparatorFor_int32(a[i].TransferId, a[j].TransferId)
+}
+
+// SortTransfersByFromAccount implements sort.Interface for []Transfers based on
+// the FromAccount field. Usage: sort.Sort(SortTransfersByFromAccount(anyGivenTransfersSlice))
+type SortTransfersByFromAccount []Transfers
+
+func (a SortTransfersByFromAccount) Len() int      { return len(a) }
+func (a SortTransfersByFromAccount) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByFromAccount) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].FromAccount, a[j].FromAccount)
+}
+
+// SortTransfersByToAccount implements sort.Interface for []Transfers based on
+// the ToAccount field. Usage: sort.Sort(SortTransfersByToAccount(anyGivenTransfersSlice))
+type SortTransfersByToAccount []Transfers
+
+func (a SortTransfersByToAccount) Len() int      { return len(a) }
+func (a SortTransfersByToAccount) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByToAccount) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].ToAccount, a[j].ToAccount)
+}
+
+// SortTransfersByAmount implements sort.Interface for []Transfers based on
+// the Amount field. Usage: sort.Sort(SortTransfersByAmount(anyGivenTransfersSlice))
+type SortTransfersByAmount []Transfers
+
+func (a SortTransfersByAmount) Len() int      { return len(a) }
+func (a SortTransfersByAmount) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByAmount) Less(i, j int) bool {
+	return LessComparatorFor_float64(a[i].Amount, a[j].Amount)
+}
+
+// SortTransfersByMemo implements sort.Interface for []Transfers based on
+// the Memo field. Usage: sort.Sort(SortTransfersByMemo(anyGivenTransfersSlice))
+type SortTransfersByMemo []Transfers
+
+func (a SortTransfersByMemo) Len() int      { return len(a) }
+func (a SortTransfersByMemo) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByMemo) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Memo, a[j].Memo)
+}
+
+// SortTransfersByUrgent implements sort.Interface for []Transfers based on
+// the Urgent field. Usage: sort.Sort(SortTransfersByUrgent(anyGivenTransfersSlice))
+type SortTransfersByUrgent []Transfers
+
+func (a SortTransfersByUrgent) Len() int      { return len(a) }
+func (a SortTransfersByUrgent) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByUrgent) Less(i, j int) bool {
+	return LessComparatorFor_bool(a[i].Urgent, a[j].Urgent)
+}
+
+// SortTransfersByHappenedAt implements sort.Interface for []Transfers based on
+// the HappenedAt field. Usage: sort.Sort(SortTransfersByHappenedAt(anyGivenTransfersSlice))
+type SortTransfersByHappenedAt []Transfers
+
+func (a SortTransfersByHappenedAt) Len() int      { return len(a) }
+func (a SortTransfersByHappenedAt) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortTransfersByHappenedAt) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].HappenedAt, a[j].HappenedAt)
+}
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tTransfersUtils struct {
+	utilMutex sync.RWMutex
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForTransfers structure
+	Cache CacheForTransfers
+}
+
+var ErrTransfers_UQ_transfers_from_account_happened_at_key = NewModelsErrorLocalWithCode("Unique constraint violation:", "transfers_from_account_happened_at_key", "23505")
+
+// Creates a new pointer to a blank Transfers structure.
+// Some of the fields, such as the time.Time ones, might be already set to time.Now()
+// based on the Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords setting
+func (utilRef *tTransfersUtils) New() *Transfers {
+
+	newTransfers := &Transfers{}
+
+	newTransfers.CloneGlobalSettings()
+
+	return newTransfers
+}
+
+// Creates a new pointer to a Transfers from an Http Request.
+// The parameters are expected to match the struct field names
+func (utilRef *tTransfersUtils) CreateFromHttpRequest(req *http.Request) (*Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.CreateFromHttpRequest() ERROR: "
+
+	if req == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")
+	}
+
+	var err error = nil
+	newTransfers := &Transfers{}
+
+	newTransfers.CloneGlobalSettings()
+
+	newTransfers.TransferId, err = To_int32_FromString(req.FormValue("TransferId"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newTransfers.FromAccount, err = To_int64_FromString(req.FormValue("FromAccount"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newTransfers.ToAccount, err = To_int64_FromString(req.FormValue("ToAccount"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("ToAccount") != "" {
+		newTransfers.ToAccount_IsNotNull = true
+	}
+
+	newTransfers.Amount, err = To_float64_FromString(req.FormValue("Amount"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newTransfers.Memo = req.FormValue("Memo")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Memo") != "" {
+		newTransfers.Memo_IsNotNull = true
+	}
+
+	newTransfers.Urgent, err = To_bool_FromString(req.FormValue("Urgent"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newTransfers.HappenedAt, err = To_Time_FromString(req.FormValue("HappenedAt"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	// Details has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+
+	return newTransfers, nil
+}
+
+// Creates a new pointer to a Transfers from an Http Request.
+// The parameters are expected to match the struct field names
+// Unlike CreateFromHttpRequest, this method completely does not exit when encountering parsing errors,
+// but accumulates them into an []error slice. Futher Validation is needed.
+func (utilRef *tTransfersUtils) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Transfers, []error) {
+
+	if req == nil {
+
+		var errorPrefix = "TransfersUtils.CreateFromHttpRequestIgnoreErrors() ERROR: "
+		noReqErrors := []error{NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")}
+		return nil, noReqErrors
+	}
+
+	var errors []error
+	var currentError error
+
+	newTransfers := &Transfers{}
+
+	newTransfers.CloneGlobalSettings()
+
+	newTransfers.TransferId, currentError = To_int32_FromString(req.FormValue("TransferId"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.FromAccount, currentError = To_int64_FromString(req.FormValue("FromAccount"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.ToAccount, currentError = To_int64_FromString(req.FormValue("ToAccount"))
+
+	if currentError == nil && req.FormValue("ToAccount") != "" {
+		newTransfers.ToAccount_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.Amount, currentError = To_float64_FromString(req.FormValue("Amount"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.Memo = req.FormValue("Memo")
+
+	if req.FormValue("Memo") != "" {
+		newTransfers.Memo_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.Urgent, currentError = To_bool_FromString(req.FormValue("Urgent"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newTransfers.HappenedAt, currentError = To_Time_FromString(req.FormValue("HappenedAt"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	// Details has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+
+	return newTransfers, errors
+}
+
+// CloneGlobalSettings assigns the global settings for operations to the control fields
+// of this instance. An example would be:
+// instanceTransfers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+func (instance *Transfers) CloneGlobalSettings() {
+
+	instance.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+	instance.PgToGo_SetDateTimeFieldsToNowForNewRecords = Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords
+	instance.PgToGo_SetGuidFieldsToNewGuidsNewRecords = Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords
+
+}
+
+// Returns the database field name, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tTransfersUtils) ToDbFieldName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "TransferId" || fieldDbOrGoName == "transfer_id" {
+		return "transfer_id"
+	}
+	if fieldDbOrGoName == "FromAccount" || fieldDbOrGoName == "from_account" {
+		return "from_account"
+	}
+	if fieldDbOrGoName == "ToAccount" || fieldDbOrGoName == "to_account" {
+		return "to_account"
+	}
+	if fieldDbOrGoName == "Amount" || fieldDbOrGoName == "amount" {
+		return "amount"
+	}
+	if fieldDbOrGoName == "Memo" || fieldDbOrGoName == "memo" {
+		return "memo"
+	}
+	if fieldDbOrGoName == "Urgent" || fieldDbOrGoName == "urgent" {
+		return "urgent"
+	}
+	if fieldDbOrGoName == "HappenedAt" || fieldDbOrGoName == "happened_at" {
+		return "happened_at"
+	}
+	if fieldDbOrGoName == "Details" || fieldDbOrGoName == "details" {
+		return "details"
+	}
+
+	return ""
+}
+
+// Returns the database field type, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tTransfersUtils) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "TransferId" || fieldDbOrGoName == "transfer_id" {
+		return "integer"
+	}
+	if fieldDbOrGoName == "FromAccount" || fieldDbOrGoName == "from_account" {
+		return "bigint"
+	}
+	if fieldDbOrGoName == "ToAccount" || fieldDbOrGoName == "to_account" {
+		return "bigint"
+	}
+	if fieldDbOrGoName == "Amount" || fieldDbOrGoName == "amount" {
+		return "double precision"
+	}
+	if fieldDbOrGoName == "Memo" || fieldDbOrGoName == "memo" {
+		return "text"
+	}
+	if fieldDbOrGoName == "Urgent" || fieldDbOrGoName == "urgent" {
+		return "boolean"
+	}
+	if fieldDbOrGoName == "HappenedAt" || fieldDbOrGoName == "happened_at" {
+		return "timestamp without time zone"
+	}
+	if fieldDbOrGoName == "Details" || fieldDbOrGoName == "details" {
+		return "jsonb"
+	}
+
+	return ""
+}
+
+// LoadedColumns returns the columns the Transfers was selected with by WithColumns, nil when it holds all of them
+func (t *Transfers) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanTransfers scans a row of the columns of the projection, all of them when it is nil, into a new Transfers
+func scanTransfers(scan func(dest ...interface{}) error, projection *columnProjection) (Transfers, error) {
+
+	currentTransfers := Transfers{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullableToAccount pgtype.Int8
+	var nullableMemo pgtype.Text
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&currentTransfers.TransferId, &currentTransfers.FromAccount, &nullableToAccount, &currentTransfers.Amount, &nullableMemo, &currentTransfers.Urgent, &currentTransfers.HappenedAt, &currentTransfers.Details}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "transfer_id":
+				dest = append(dest, &currentTransfers.TransferId)
+			case "from_account":
+				dest = append(dest, &currentTransfers.FromAccount)
+			case "to_account":
+				dest = append(dest, &nullableToAccount)
+			case "amount":
+				dest = append(dest, &currentTransfers.Amount)
+			case "memo":
+				dest = append(dest, &nullableMemo)
+			case "urgent":
+				dest = append(dest, &currentTransfers.Urgent)
+			case "happened_at":
+				dest = append(dest, &currentTransfers.HappenedAt)
+			case "details":
+				dest = append(dest, &currentTransfers.Details)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentTransfers, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("to_account") {
+		currentTransfers.SetToAccount(nullableToAccount.Int64, nullableToAccount.Valid)
+	}
+	if projection.loads("memo") {
+		currentTransfers.SetMemo(nullableMemo.String, nullableMemo.Valid)
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentTransfers, nil
+}
+
+// rowToTransfers scans a row of all the columns into a new Transfers
+func rowToTransfers(row pgx.CollectableRow) (Transfers, error) {
+	return scanTransfers(row.Scan, nil)
+}
+
+// TransfersCols holds the columns of transfers, building the predicates and the ordering of the queries,
+// e.g. TransfersCols.TransferId.Eq(value)
+var TransfersCols = struct {
+	TransferId  QueryColumn
+	FromAccount QueryColumn
+	ToAccount   QueryColumn
+	Amount      QueryColumn
+	Memo        QueryColumn
+	Urgent      QueryColumn
+	HappenedAt  QueryColumn
+	Details     QueryColumn
+}{
+	TransferId:  QueryColumn{name: "transfer_id"},
+	FromAccount: QueryColumn{name: "from_account"},
+	ToAccount:   QueryColumn{name: "to_account"},
+	Amount:      QueryColumn{name: "amount"},
+	Memo:        QueryColumn{name: "memo"},
+	Urgent:      QueryColumn{name: "urgent"},
+	HappenedAt:  QueryColumn{name: "happened_at"},
+	Details:     QueryColumn{name: "details"},
+}
+
+// isTransfersColumn tells whether the database name is one of the columns of transfers
+func isTransfersColumn(dbName string) bool {
+
+	switch dbName {
+	case "transfer_id", "from_account", "to_account", "amount", "memo", "urgent", "happened_at", "details":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tTransfersUtils) Select(where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from transfers matching the predicate, e.g.
+// TransfersCols.TransferId.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tTransfersUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from transfers,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tTransfersUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from transfers,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tTransfersUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from transfers matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentTransfersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentTransfersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfTransfers)
+		}
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tTransfersUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from transfers matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tTransfersUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from transfers matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentTransfersRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentTransfersRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfTransfers)
+		}
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tTransfersUtils) SelectAll(options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from transfers.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	// try to get the rows from cache, if enabled and valid
+	if allTransfersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allTransfersRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tTransfersUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from transfers ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of Transfers instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allTransfersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allTransfersRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tTransfersUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from transfers equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of Transfers instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allTransfersRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allTransfersRowsFromCache) {
+			pageStart = len(allTransfersRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allTransfersRowsFromCache) {
+			pageEnd = len(allTransfersRowsFromCache)
+		}
+
+		return allTransfersRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+
+	// scanTransfers scans each row, CollectRows closes the rows once done
+	sliceOfTransfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Transfers, error) {
+		return scanTransfers(row.Scan, selectOptions.projection)
+	})
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error collecting the rows:", err)
+	}
+
+	return sliceOfTransfers, nil
+}
+
+// SelectTransfers is SelectTransfersCtx with the background context
+func (txWrapper *Transaction) SelectTransfers(where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return txWrapper.SelectTransfersCtx(context.Background(), where, options...)
+}
+
+// SelectTransfersCtx runs SelectCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectTransfersCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return NewDB(txWrapper).Transfers.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedTransfers is SelectCachedTransfersCtx with the background context
+func (txWrapper *Transaction) SelectCachedTransfers(cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return txWrapper.SelectCachedTransfersCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedTransfersCtx runs SelectCachedCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedTransfersCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return NewDB(txWrapper).Transfers.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageTransfers is SelectPageTransfersCtx with the background context
+func (txWrapper *Transaction) SelectPageTransfers(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return txWrapper.SelectPageTransfersCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageTransfersCtx runs SelectPageCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageTransfersCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return NewDB(txWrapper).Transfers.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedTransfers is SelectPageCachedTransfersCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedTransfers(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return txWrapper.SelectPageCachedTransfersCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedTransfersCtx runs SelectPageCachedCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedTransfersCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return NewDB(txWrapper).Transfers.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllTransfers is SelectAllTransfersCtx with the background context
+func (txWrapper *Transaction) SelectAllTransfers(options ...SelectOption) ([]Transfers, error) {
+	return txWrapper.SelectAllTransfersCtx(context.Background(), options...)
+}
+
+// SelectAllTransfersCtx runs SelectAllCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllTransfersCtx(ctx context.Context, options ...SelectOption) ([]Transfers, error) {
+	return NewDB(txWrapper).Transfers.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for Transfers         */
+/* ************************************************************ */
+
+type CacheForTransfers struct {
+	enabled bool // flag to determine if caching is enabled for Transfers
+
+	sliceCache      map[string][]Transfers
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]Transfers
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]Transfers
+	singleRowCacheMutex sync.RWMutex
+
+	all      []Transfers
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Tables.Transfers when nil
+	utils *tTransfersUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForTransfers) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]Transfers)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]Transfers)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]Transfers)
+	}
+
+}
+
+func (c *CacheForTransfers) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForTransfers) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForTransfers) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForTransfers) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForTransfers) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for transfers and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForTransfers) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Tables.Transfers
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForTransfers) GetAllRows() ([]Transfers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all Transfers records in the database.
+func (c *CacheForTransfers) SetAllRows(all []Transfers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all Transfers records in the database.
+func (c *CacheForTransfers) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForTransfers) GetWhere(key string) ([]Transfers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wTransfers, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wTransfers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForTransfers) SetWhere(key string, sliceTransfers []Transfers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceTransfers != nil {
+
+			whereSliceCopy := make([]Transfers, len(sliceTransfers))
+			copy(whereSliceCopy, sliceTransfers)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForTransfers) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of Transfers from the cache store based on
+// the given key.
+func (c *CacheForTransfers) GetSlice(key string) ([]Transfers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sTransfers, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sTransfers, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of Transfers inside the cache store based and
+// associates it with the given key.
+func (c *CacheForTransfers) SetSlice(key string, sliceTransfers []Transfers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceTransfers != nil {
+
+			sliceCopy := make([]Transfers, len(sliceTransfers))
+			copy(sliceCopy, sliceTransfers)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of Transfers from the cache store entry
+// associated with key.
+func (c *CacheForTransfers) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *Transfers from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForTransfers) Get(key string) (*Transfers, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a Transfers with key, and saves it in the cache store.
+func (c *CacheForTransfers) Set(key string, structTransfers Transfers) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structTransfers
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the Transfers instance that is associated with key from the
+// cache store.
+func (c *CacheForTransfers) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for Transfers           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tTransfersUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from transfers matching all the predicates, e.g.
+// TransfersCols.TransferId.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tTransfersUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM transfers"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tTransfersUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from transfers
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tTransfersUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.transfers'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tTransfersUtils) Single(where Predicate, options ...SelectOption) (*Transfers, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from transfers matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tTransfersUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isTransfersColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers ", "transfers")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfTransfers *Transfers
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanTransfers creates a new instance of Transfers
+		currentTransfers, err := scanTransfers(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfTransfers = &currentTransfers
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfTransfers, nil
+}
+
+// SingleTransfers is SingleTransfersCtx with the background context
+func (txWrapper *Transaction) SingleTransfers(where Predicate, options ...SelectOption) (*Transfers, error) {
+	return txWrapper.SingleTransfersCtx(context.Background(), where, options...)
+}
+
+// SingleTransfersCtx runs SingleCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleTransfersCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Transfers, error) {
+	return NewDB(txWrapper).Transfers.SingleCtx(ctx, where, options...)
+}
+
+// SelectWhereJSONPath is SelectWhereJSONPathCtx with the background context
+func (utilRef *tTransfersUtils) SelectWhereJSONPath(column string, path []string, value interface{}) ([]Transfers, error) {
+	return utilRef.SelectWhereJSONPathCtx(context.Background(), column, path, value)
+}
+
+// SelectWhereJSONPathCtx returns the rows from transfers whose json document in the given column
+// holds the value at the given path (e.g. []string{"address", "city"}).
+// A string value is compared with the text found at the path (column #>> path = value),
+// any other value is matched by containment (column @> {"address": {"city": value}}).
+// The column is the database name of one of the json columns: details.
+// This version is not cached and calls the database directly.
+func (utilRef *tTransfersUtils) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.SelectWhereJSONPath() ERROR: "
+
+	switch column {
+	case "details":
+	default:
+		return nil, NewModelsErrorLocal(errorPrefix, "not a json column of transfers: "+column)
+	}
+
+	if len(path) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the path is empty")
+	}
+
+	if text, isString := value.(string); isString {
+		return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+" #>> $1::text[] = $2", path, text))
+	}
+
+	// nest the value inside the path, from the innermost key outwards
+	document := value
+	for i := len(path) - 1; i >= 0; i-- {
+		document = map[string]interface{}{path[i]: document}
+	}
+
+	documentBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" could not marshal the value:", err)
+	}
+
+	return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+"::jsonb @> $1::jsonb", string(documentBytes)))
+}
+
+// keysetTransfers holds the unique keys of transfers, and the columns its SelectAfter pages can be ordered by
+var keysetTransfers = keyset{
+	keys: [][]string{
+		{"transfer_id"},
+		{"from_account", "happened_at"},
+	},
+	columns: []string{"transfer_id", "from_account", "amount", "urgent", "happened_at", "details"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tTransfersUtils) keysetValue(row *Transfers, dbName string) interface{} {
+
+	switch dbName {
+	case "transfer_id":
+		return row.TransferId
+	case "from_account":
+		return row.FromAccount
+	case "amount":
+		return row.Amount
+	case "urgent":
+		return row.Urgent
+	case "happened_at":
+		return row.HappenedAt
+	case "details":
+		return row.Details
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tTransfersUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "transfer_id":
+		var param int32
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "from_account":
+		var param int64
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "amount":
+		var param float64
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "urgent":
+		var param bool
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "happened_at":
+		var param time.Time
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "details":
+		var param map[string]string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tTransfersUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from transfers matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of transfers
+// (transfer_id; from_account, happened_at) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tTransfersUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error) {
+
+	var errorPrefix = "TransfersUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetTransfers.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetTransfers.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Insert is InsertCtx with the background context
+func (utilRef *tTransfersUtils) Insert(sourceTransfers *Transfers) (*Transfers, error) {
+	return utilRef.InsertCtx(context.Background(), sourceTransfers)
+}
+
+// InsertCtx inserts a new row into the transfers table, using the values
+// inside the pointer to a Transfers structure passed to it.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (utilRef *tTransfersUtils) InsertCtx(ctx context.Context, sourceTransfers *Transfers) (*Transfers, error) {
+
+	var errorPrefix = "TransfersUtils.Insert() ERROR: "
+
+	if sourceTransfers == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define returning PK params for the insert query row execution
+	var paramTransferId int32
+
+	// define the insert query
+	var insertQueryAllColumns = "INSERT INTO transfers(transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details) VALUES($1, $2, $3, $4, $5, $6, $7, $8)  RETURNING transfer_id"
+	var insertQueryNoPKColumns = "INSERT INTO transfers(from_account, to_account, amount, memo, urgent, happened_at, details) VALUES($1, $2, $3, $4, $5, $6, $7)  RETURNING transfer_id"
+
+	var query string = insertQueryAllColumns
+
+	if sourceTransfers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		query = insertQueryNoPKColumns
+	}
+
+	// pq does not support the LastInsertId() method of the Result type in database/sql.
+	// To return the identifier of an INSERT (or UPDATE or DELETE), use the Postgres RETURNING clause
+	// with a standard Query or QueryRow call
+
+	var err error
+
+	if sourceTransfers.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceTransfers.HappenedAt = Now()
+
+	}
+
+	if sourceTransfers.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+
+	}
+
+	// define the values to be passed, from the structure
+	var _transfer_id, _from_account, _to_account, _amount, _memo, _urgent, _happened_at, _details = sourceTransfers.TransferId, sourceTransfers.FromAccount, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull}, sourceTransfers.Amount, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull}, sourceTransfers.Urgent, sourceTransfers.HappenedAt, sourceTransfers.Details
+
+	// this will print only if debug mode enabled
+	Debug("Insert Query:", query)
+
+	if sourceTransfers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		err = currentDbHandle.QueryRow(ctx, query, _from_account, _to_account, _amount, _memo, _urgent, _happened_at, _details).Scan(&paramTransferId)
+	} else {
+		err = currentDbHandle.QueryRow(ctx, query, _transfer_id, _from_account, _to_account, _amount, _memo, _urgent, _happened_at, _details).Scan(&paramTransferId)
+	}
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "transfers_from_account_happened_at_key") {
+				return nil, ErrTransfers_UQ_transfers_from_account_happened_at_key
+			}
+
+		}
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// populate the returning ids inside the returnStructure pointer
+		sourceTransfers.TransferId = paramTransferId
+
+		// return the structure
+		return sourceTransfers, nil
+	}
+}
+
+// Insert is InsertCtx with the background context
+func (sourceTransfers *Transfers) Insert() (*Transfers, error) {
+	return sourceTransfers.InsertCtx(context.Background())
+}
+
+// InsertCtx inserts a new row into the transfers table, corresponding to the provided sourceTransfers
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (sourceTransfers *Transfers) InsertCtx(ctx context.Context) (*Transfers, error) {
+
+	return Tables.Transfers.InsertCtx(ctx, sourceTransfers)
+}
+
+// InsertTransfers is InsertTransfersCtx with the background context
+func (txWrapper *Transaction) InsertTransfers(sourceTransfers *Transfers) (*Transfers, error) {
+	return txWrapper.InsertTransfersCtx(context.Background(), sourceTransfers)
+}
+
+// InsertTransfersCtx runs InsertCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.InsertCtx(...) does.
+func (txWrapper *Transaction) InsertTransfersCtx(ctx context.Context, sourceTransfers *Transfers) (*Transfers, error) {
+	return NewDB(txWrapper).Transfers.InsertCtx(ctx, sourceTransfers)
+}
+
+// CopyFromReader is CopyFromReaderCtx with the background context
+func (utilRef *tTransfersUtils) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+	return utilRef.CopyFromReaderCtx(context.Background(), r, opt, columns...)
+}
+
+// CopyFromReaderCtx performs a bulk copy of csv-like content from the specified reader
+// into the transfers table. The opt parameter is a *CopyFromReaderOptions which
+// allows the caller to specify the separator and the null placeholder.
+// In case nil is passed as null placeholder, for empty strings, the actual empty string value
+// will be inserted, instead of a db null value.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tTransfersUtils) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.CopyFromReader() ERROR: "
+
+	if r == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the reader r is nil")
+	}
+
+	var optSeparator rune
+	var optNullPlaceholders []string
+	var optComment rune
+	var optIncludePKCols bool
+	if opt == nil {
+		optSeparator = defSeparator
+		optNullPlaceholders = defNullPlaceholders[:]
+		optComment = defCommentRune
+		optIncludePKCols = defIncludePKCols
+	} else {
+		optSeparator = opt.Separator
+		optNullPlaceholders = opt.NullPlaceholders
+		optComment = opt.Comment
+		optIncludePKCols = opt.IncludePKCols
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var err error
+
+	// Slices that hold the column database names and their db types
+	var colDbNames []string
+	var colDbTypes []string
+
+	// If no custom column mask was provided, assume the all the columns are a target
+	if len(columns) == 0 {
+		if optIncludePKCols {
+			colDbNames = []string{"transfer_id", "from_account", "to_account", "amount", "memo", "urgent", "happened_at", "details"}
+			colDbTypes = []string{"integer", "bigint", "bigint", "double precision", "text", "boolean", "timestamp without time zone", "jsonb"}
+		} else {
+			colDbNames = []string{"from_account", "to_account", "amount", "memo", "urgent", "happened_at", "details"}
+			colDbTypes = []string{"bigint", "bigint", "double precision", "text", "boolean", "timestamp without time zone", "jsonb"}
+		}
+	} else {
+		// Range through the custom columns and obtain the db name and db type
+		for i := range columns {
+			colDbNames = append(colDbNames, Tables.Transfers.ToDbFieldName(columns[i]))
+			colDbTypes = append(colDbTypes, Tables.Transfers.ToDbFieldTypeFromColName(columns[i]))
+		}
+	}
+
+	copySourceReader, err := CopyFromReader(r, optSeparator, optNullPlaceholders, optComment, colDbTypes...)
+	if err != nil {
+		return 0, err
+	}
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"transfers"}, colDbNames, copySourceReader)
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (utilRef *tTransfersUtils) CopyFromSlice(records []Transfers, includeSequenceCols bool) (int64, error) {
+	return utilRef.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx performs a bulk copy of the given Transfers records into the transfers table.
+// Unless includeSequenceCols is true, the sequence-backed (serial) columns are left out,
+// so the database fills them in.
+// The method returns the number of records inserted after a successful copy operation.
+func (utilRef *tTransfersUtils) CopyFromSliceCtx(ctx context.Context, records []Transfers, includeSequenceCols bool) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.CopyFromSlice() ERROR: "
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	var colDbNames []string
+	if includeSequenceCols {
+		colDbNames = []string{"transfer_id", "from_account", "to_account", "amount", "memo", "urgent", "happened_at", "details"}
+	} else {
+		colDbNames = []string{"from_account", "to_account", "amount", "memo", "urgent", "happened_at", "details"}
+	}
+
+	rows := make([][]interface{}, len(records))
+	for i := range records {
+		sourceTransfers := &records[i]
+		if includeSequenceCols {
+			rows[i] = []interface{}{sourceTransfers.TransferId, sourceTransfers.FromAccount, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull}, sourceTransfers.Amount, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull}, sourceTransfers.Urgent, sourceTransfers.HappenedAt, sourceTransfers.Details}
+		} else {
+			rows[i] = []interface{}{sourceTransfers.FromAccount, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull}, sourceTransfers.Amount, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull}, sourceTransfers.Urgent, sourceTransfers.HappenedAt, sourceTransfers.Details}
+		}
+	}
+
+	return currentDbHandle.CopyFrom(ctx, pgx.Identifier{"transfers"}, colDbNames, pgx.CopyFromRows(rows))
+}
+
+// Update is UpdateCtx with the background context
+func (utilRef *tTransfersUtils) Update(sourceTransfers *Transfers, where Predicate) (int64, error) {
+	return utilRef.UpdateCtx(context.Background(), sourceTransfers, where)
+}
+
+// UpdateCtx attempts to update the rows inside the transfers table, based on
+// the supplied predicate, whose placeholders are numbered after the ones of the 8 columns.
+// All the fields in the supplied source Transfers pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// Returns the number of affected rows (zero if no rows found for that condition),
+// and nil error for a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tTransfersUtils) UpdateCtx(ctx context.Context, sourceTransfers *Transfers, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside transfers")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE transfers SET transfer_id = $1,from_account = $2,to_account = $3,amount = $4,memo = $5,urgent = $6,happened_at = $7,details = $8 WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(9)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceTransfers.TransferId, sourceTransfers.FromAccount, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull}, sourceTransfers.Amount, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull}, sourceTransfers.Urgent, sourceTransfers.HappenedAt, sourceTransfers.Details}
+
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "transfers_from_account_happened_at_key") {
+				return 0, ErrTransfers_UQ_transfers_from_account_happened_at_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateTransfers is UpdateTransfersCtx with the background context
+func (txWrapper *Transaction) UpdateTransfers(sourceTransfers *Transfers, where Predicate) (int64, error) {
+	return txWrapper.UpdateTransfersCtx(context.Background(), sourceTransfers, where)
+}
+
+// UpdateTransfersCtx runs UpdateCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.UpdateCtx(...) does.
+func (txWrapper *Transaction) UpdateTransfersCtx(ctx context.Context, sourceTransfers *Transfers, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Transfers.UpdateCtx(ctx, sourceTransfers, where)
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (utilRef *tTransfersUtils) UpdateWithMask(sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+	return utilRef.UpdateWithMaskCtx(context.Background(), sourceTransfers, updateMask, where)
+}
+
+// UpdateWithMaskCtx attempts to update the rows inside the transfers table matching the predicate,
+// whose placeholders are numbered after the ones of the update mask.
+// Only the fields in the supplied mask slice of strings will be updated.
+// If the mask is nil, all fields will be updated.
+// Returns the number of affected rows (zero if no rows found for that condition), and nil error
+// in case of a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tTransfersUtils) UpdateWithMaskCtx(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside transfers")
+	}
+
+	if updateMask == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE transfers SET ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	var instanceValuesSlice []interface{}
+	for i, e := range updateMask {
+
+		_, writeErr = queryBuffer.WriteString(utilRef.ToDbFieldName(e))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString("=$")
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString(Itoa(i + 1))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		// add a comma if not the final set
+		if len(updateMask) != (i + 1) {
+			_, writeErr = queryBuffer.WriteString(",")
+			if writeErr != nil {
+				return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+			}
+		}
+
+		if e == "TransferId" || e == "transfer_id" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.TransferId)
+		}
+		if e == "FromAccount" || e == "from_account" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.FromAccount)
+		}
+		if e == "ToAccount" || e == "to_account" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull})
+		}
+		if e == "Amount" || e == "amount" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.Amount)
+		}
+		if e == "Memo" || e == "memo" {
+			instanceValuesSlice = append(instanceValuesSlice, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull})
+		}
+		if e == "Urgent" || e == "urgent" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.Urgent)
+		}
+		if e == "HappenedAt" || e == "happened_at" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.HappenedAt)
+		}
+		if e == "Details" || e == "details" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceTransfers.Details)
+		}
+
+	}
+
+	_, writeErr = queryBuffer.WriteString(" WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(len(updateMask) + 1)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	// append the condition's params to the ones of the setters
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "transfers_from_account_happened_at_key") {
+				return 0, ErrTransfers_UQ_transfers_from_account_happened_at_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateWithMaskTransfers is UpdateWithMaskTransfersCtx with the background context
+func (txWrapper *Transaction) UpdateWithMaskTransfers(sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+	return txWrapper.UpdateWithMaskTransfersCtx(context.Background(), sourceTransfers, updateMask, where)
+}
+
+// UpdateWithMaskTransfersCtx runs UpdateWithMaskCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.UpdateWithMaskCtx(...) does.
+func (txWrapper *Transaction) UpdateWithMaskTransfersCtx(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Transfers.UpdateWithMaskCtx(ctx, sourceTransfers, updateMask, where)
+}
+
+// Update is UpdateCtx with the background context
+func (sourceTransfers *Transfers) Update() error {
+	return sourceTransfers.UpdateCtx(context.Background())
+}
+
+// UpdateCtx attempts to update the row inside the transfers table, corresponding
+// to the PK of the current Transfers instance.
+// All the fields in the supplied source Transfers pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// An instance selected WithColumns returns ErrColumnsNotLoaded, unless MarkAllColumnsLoaded was called;
+// UpdateWithMask with its LoadedColumns writes back only those.
+// Returns nil error for a successful operation. If operation fails, it returns the error.
+// If more than one row gets updated, it will return an error.
+func (sourceTransfers *Transfers) UpdateCtx(ctx context.Context) error {
+	return sourceTransfers.updateOn(ctx, nil)
+}
+
+// updateOn updates the row of the instance on the querier, GetDb() if nil
+func (sourceTransfers *Transfers) updateOn(ctx context.Context, querier Querier) error {
+
+	var errorPrefix = "instance of Transfers.Update() ERROR: "
+
+	// the fields of the columns left out by WithColumns hold their zero values, not those of the row
+	if sourceTransfers.pgToGo_projection != nil {
+		return ErrColumnsNotLoaded
+	}
+
+	currentDbHandle := dbQuerier(querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE transfers SET transfer_id = $1,from_account = $2,to_account = $3,amount = $4,memo = $5,urgent = $6,happened_at = $7,details = $8 WHERE ")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString("transfer_id=$9")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString (instance condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceTransfers.TransferId, sourceTransfers.FromAccount, &pgtype.Int8{Int64: sourceTransfers.ToAccount, Valid: sourceTransfers.ToAccount_IsNotNull}, sourceTransfers.Amount, &pgtype.Text{String: sourceTransfers.Memo, Valid: sourceTransfers.Memo_IsNotNull}, sourceTransfers.Urgent, sourceTransfers.HappenedAt, sourceTransfers.Details, sourceTransfers.TransferId}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), instanceValuesSlice...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "transfers_from_account_happened_at_key") {
+				return ErrTransfers_UQ_transfers_from_account_happened_at_key
+			}
+
+		}
+
+		return NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	if n > 1 {
+		return NewModelsErrorLocal(errorPrefix, "More than one record was updated: "+Itoa(int(n)))
+	}
+	return nil
+
+}
+
+// UpdateSingleInstanceTransfers is UpdateSingleInstanceTransfersCtx with the background context
+func (txWrapper *Transaction) UpdateSingleInstanceTransfers(sourceTransfers *Transfers) error {
+	return txWrapper.UpdateSingleInstanceTransfersCtx(context.Background(), sourceTransfers)
+}
+
+// UpdateSingleInstanceTransfersCtx runs the UpdateCtx method of the sourceTransfers instance in the transaction.
+func (txWrapper *Transaction) UpdateSingleInstanceTransfersCtx(ctx context.Context, sourceTransfers *Transfers) error {
+	return sourceTransfers.updateOn(ctx, txWrapper)
+}
+
+// Delete is DeleteCtx with the background context
+func (utilRef *tTransfersUtils) Delete(where Predicate) (int64, error) {
+	return utilRef.DeleteCtx(context.Background(), where)
+}
+
+// Deletes the rows from the transfers table matching the predicate, e.g.
+// TransfersCols.TransferId.Eq(value), or Raw(condition, params...).
+// Returns the number of deleted rows (zero if no rows found for that condition), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tTransfersUtils) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from transfers")
+	}
+	condition, params := where.Condition(1)
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the delete query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("DELETE FROM transfers WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), params...)
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteTransfers is DeleteTransfersCtx with the background context
+func (txWrapper *Transaction) DeleteTransfers(where Predicate) (int64, error) {
+	return txWrapper.DeleteTransfersCtx(context.Background(), where)
+}
+
+// DeleteTransfersCtx runs DeleteCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.DeleteCtx(...) does.
+func (txWrapper *Transaction) DeleteTransfersCtx(ctx context.Context, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Transfers.DeleteCtx(ctx, where)
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (utilRef *tTransfersUtils) DeleteInstance(sourceTransfers *Transfers) (bool, error) {
+	return utilRef.DeleteInstanceCtx(context.Background(), sourceTransfers)
+}
+
+// Deletes the row from the transfers table, corresponding to the primary key fields
+// inside the sourceTransfers parameter.
+// Returns true if the row was deleted, or false and nil error if no such PK value was found in the database.
+// If operation fails, it returns nil and the error.
+func (utilRef *tTransfersUtils) DeleteInstanceCtx(ctx context.Context, sourceTransfers *Transfers) (bool, error) {
+
+	var errorPrefix = "TransfersUtils.DeleteInstance() ERROR: "
+
+	if sourceTransfers == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+
+	// define the condition based on the PK columns
+	deleteInstanceCondition := And(
+		TransfersCols.TransferId.Eq(sourceTransfers.TransferId),
+	)
+
+	rowCount, err := utilRef.DeleteCtx(ctx, deleteInstanceCondition)
+	if err != nil {
+		return false, NewModelsError(errorPrefix, err)
+	}
+
+	if rowCount > 1 {
+		return false, NewModelsError(errorPrefix+": FATAL ERROR: Too many rows deleted !! : ", err)
+	}
+
+	return rowCount == 1, nil
+
+}
+
+// DeleteInstanceTransfers is DeleteInstanceTransfersCtx with the background context
+func (txWrapper *Transaction) DeleteInstanceTransfers(sourceTransfers *Transfers) (bool, error) {
+	return txWrapper.DeleteInstanceTransfersCtx(context.Background(), sourceTransfers)
+}
+
+// DeleteInstanceTransfersCtx runs DeleteInstanceCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.DeleteInstanceCtx(...) does.
+func (txWrapper *Transaction) DeleteInstanceTransfersCtx(ctx context.Context, sourceTransfers *Transfers) (bool, error) {
+	return NewDB(txWrapper).Transfers.DeleteInstanceCtx(ctx, sourceTransfers)
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (utilRef *tTransfersUtils) DeleteAll() (int64, error) {
+	return utilRef.DeleteAllCtx(context.Background())
+}
+
+// Deletes all existing rows from the transfers table.
+// Returns the number of deleted rows (zero if no rows found), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tTransfersUtils) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "TransfersUtils.DeleteAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	r, err := currentDbHandle.Exec(ctx, "DELETE FROM transfers")
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteAllTransfers is DeleteAllTransfersCtx with the background context
+func (txWrapper *Transaction) DeleteAllTransfers() (int64, error) {
+	return txWrapper.DeleteAllTransfersCtx(context.Background())
+}
+
+// DeleteAllTransfersCtx runs DeleteAllCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.DeleteAllCtx(...) does.
+func (txWrapper *Transaction) DeleteAllTransfersCtx(ctx context.Context) (int64, error) {
+	return NewDB(txWrapper).Transfers.DeleteAllCtx(ctx)
+}
+
+// GetByTransferId is GetByTransferIdCtx with the background context
+func (utilRef *tTransfersUtils) GetByTransferId(inputTransferId int32) (*Transfers, error) {
+	return utilRef.GetByTransferIdCtx(context.Background(), inputTransferId)
+}
+
+// Queries the database for a single row based on the specified single or multi-column primary key.
+// Returns a pointer to a Transfers structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tTransfersUtils) GetByTransferIdCtx(ctx context.Context, inputTransferId int32) (returnStruct *Transfers, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "TransfersGetByTransferId() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramTransferId int32
+	var paramFromAccount int64
+	var paramToAccount pgtype.Int8
+	var paramAmount float64
+	var paramMemo pgtype.Text
+	var paramUrgent bool
+	var paramHappenedAt time.Time
+	var paramDetails map[string]string
+
+	// define the select query
+	var query = "SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers  WHERE transfer_id = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputTransferId).Scan(&paramTransferId, &paramFromAccount, &paramToAccount, &paramAmount, &paramMemo, &paramUrgent, &paramHappenedAt, &paramDetails)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Transfers{
+			TransferId:  paramTransferId,
+			FromAccount: paramFromAccount,
+			Amount:      paramAmount,
+			Urgent:      paramUrgent,
+			HappenedAt:  paramHappenedAt,
+			Details:     paramDetails,
+		}
+		returnStruct.SetToAccount(paramToAccount.Int64, paramToAccount.Valid)
+		returnStruct.SetMemo(paramMemo.String, paramMemo.Valid)
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetTransfersByTransferId is GetTransfersByTransferIdCtx with the background context
+func (txWrapper *Transaction) GetTransfersByTransferId(inputTransferId int32) (*Transfers, error) {
+	return txWrapper.GetTransfersByTransferIdCtx(context.Background(), inputTransferId)
+}
+
+// GetTransfersByTransferIdCtx runs GetByTransferIdCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.GetByTransferIdCtx(...) does.
+func (txWrapper *Transaction) GetTransfersByTransferIdCtx(ctx context.Context, inputTransferId int32) (*Transfers, error) {
+	return NewDB(txWrapper).Transfers.GetByTransferIdCtx(ctx, inputTransferId)
+}
+
+// GetByUniqueFromAccountAndHappenedAt is GetByUniqueFromAccountAndHappenedAtCtx with the background context
+func (utilRef *tTransfersUtils) GetByUniqueFromAccountAndHappenedAt(inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error) {
+	return utilRef.GetByUniqueFromAccountAndHappenedAtCtx(context.Background(), inputFromAccount, inputHappenedAt)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Transfers structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tTransfersUtils) GetByUniqueFromAccountAndHappenedAtCtx(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (returnStruct *Transfers, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "TransfersGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramTransferId int32
+	var paramFromAccount int64
+	var paramToAccount pgtype.Int8
+	var paramAmount float64
+	var paramMemo pgtype.Text
+	var paramUrgent bool
+	var paramHappenedAt time.Time
+	var paramDetails map[string]string
+
+	// define the select query
+	var query = "SELECT transfer_id, from_account, to_account, amount, memo, urgent, happened_at, details FROM transfers  WHERE from_account = $1 AND happened_at = $2"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputFromAccount, inputHappenedAt).Scan(&paramTransferId, &paramFromAccount, &paramToAccount, &paramAmount, &paramMemo, &paramUrgent, &paramHappenedAt, &paramDetails)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Transfers{
+			TransferId:  paramTransferId,
+			FromAccount: paramFromAccount,
+			Amount:      paramAmount,
+			Urgent:      paramUrgent,
+			HappenedAt:  paramHappenedAt,
+			Details:     paramDetails,
+		}
+		returnStruct.SetToAccount(paramToAccount.Int64, paramToAccount.Valid)
+		returnStruct.SetMemo(paramMemo.String, paramMemo.Valid)
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetTransfersByUniqueFromAccountAndHappenedAt is GetTransfersByUniqueFromAccountAndHappenedAtCtx with the background context
+func (txWrapper *Transaction) GetTransfersByUniqueFromAccountAndHappenedAt(inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error) {
+	return txWrapper.GetTransfersByUniqueFromAccountAndHappenedAtCtx(context.Background(), inputFromAccount, inputHappenedAt)
+}
+
+// GetTransfersByUniqueFromAccountAndHappenedAtCtx runs GetByUniqueFromAccountAndHappenedAtCtx of Transfers in the transaction,
+// as txWrapper.DB().Transfers.GetByUniqueFromAccountAndHappenedAtCtx(...) does.
+func (txWrapper *Transaction) GetTransfersByUniqueFromAccountAndHappenedAtCtx(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error) {
+	return NewDB(txWrapper).Transfers.GetByUniqueFromAccountAndHappenedAtCtx(ctx, inputFromAccount, inputHappenedAt)
+}
+
+// TransfersRepository holds the methods of Tables.Transfers, so that the code using them
+// can depend on the interface, and be unit tested with a TransfersRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type TransfersRepository interface {
+	New() *Transfers
+	CreateFromHttpRequest(req *http.Request) (*Transfers, error)
+	CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Transfers, []error)
+	ToDbFieldName(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColName(fieldDbOrGoName string) string
+	Select(where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectAll(options ...SelectOption) ([]Transfers, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Transfers, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*Transfers, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Transfers, error)
+	SelectWhereJSONPath(column string, path []string, value interface{}) ([]Transfers, error)
+	SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Transfers, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error)
+	Insert(sourceTransfers *Transfers) (*Transfers, error)
+	InsertCtx(ctx context.Context, sourceTransfers *Transfers) (*Transfers, error)
+	CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSlice(records []Transfers, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtx(ctx context.Context, records []Transfers, includeSequenceCols bool) (int64, error)
+	Update(sourceTransfers *Transfers, where Predicate) (int64, error)
+	UpdateCtx(ctx context.Context, sourceTransfers *Transfers, where Predicate) (int64, error)
+	UpdateWithMask(sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtx(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error)
+	Delete(where Predicate) (int64, error)
+	DeleteCtx(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstance(sourceTransfers *Transfers) (bool, error)
+	DeleteInstanceCtx(ctx context.Context, sourceTransfers *Transfers) (bool, error)
+	DeleteAll() (int64, error)
+	DeleteAllCtx(ctx context.Context) (int64, error)
+	GetByTransferId(inputTransferId int32) (*Transfers, error)
+	GetByTransferIdCtx(ctx context.Context, inputTransferId int32) (*Transfers, error)
+	GetByUniqueFromAccountAndHappenedAt(inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error)
+	GetByUniqueFromAccountAndHappenedAtCtx(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error)
+}
+
+var _ TransfersRepository = (*tTransfersUtils)(nil)
+
+// TransfersRepositoryMock is a TransfersRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type TransfersRepositoryMock struct {
+	MockCalls
+
+	NewFunc                                    func() *Transfers
+	CreateFromHttpRequestFunc                  func(req *http.Request) (*Transfers, error)
+	CreateFromHttpRequestIgnoreErrorsFunc      func(req *http.Request) (*Transfers, []error)
+	ToDbFieldNameFunc                          func(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColNameFunc               func(fieldDbOrGoName string) string
+	SelectFunc                                 func(where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCtxFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionFunc                            func(where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionCtxFunc                         func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionAllFunc                         func(where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectUnionAllCtxFunc                      func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCachedFunc                           func(cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectCachedCtxFunc                        func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageFunc                             func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCtxFunc                          func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCachedFunc                       func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectPageCachedCtxFunc                    func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error)
+	SelectAllFunc                              func(options ...SelectOption) ([]Transfers, error)
+	SelectAllCtxFunc                           func(ctx context.Context, options ...SelectOption) ([]Transfers, error)
+	SelectAllOrderByFunc                       func(orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllOrderByCtxFunc                    func(ctx context.Context, orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllPageFunc                          func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error)
+	SelectAllPageCtxFunc                       func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error)
+	CountFunc                                  func(where ...Predicate) (int64, error)
+	CountCtxFunc                               func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                         func() (int64, error)
+	CountImpreciseCtxFunc                      func(ctx context.Context) (int64, error)
+	SingleFunc                                 func(where Predicate, options ...SelectOption) (*Transfers, error)
+	SingleCtxFunc                              func(ctx context.Context, where Predicate, options ...SelectOption) (*Transfers, error)
+	SelectWhereJSONPathFunc                    func(column string, path []string, value interface{}) ([]Transfers, error)
+	SelectWhereJSONPathCtxFunc                 func(ctx context.Context, column string, path []string, value interface{}) ([]Transfers, error)
+	SelectAfterFunc                            func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error)
+	SelectAfterCtxFunc                         func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error)
+	InsertFunc                                 func(sourceTransfers *Transfers) (*Transfers, error)
+	InsertCtxFunc                              func(ctx context.Context, sourceTransfers *Transfers) (*Transfers, error)
+	CopyFromReaderFunc                         func(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromReaderCtxFunc                      func(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (int64, error)
+	CopyFromSliceFunc                          func(records []Transfers, includeSequenceCols bool) (int64, error)
+	CopyFromSliceCtxFunc                       func(ctx context.Context, records []Transfers, includeSequenceCols bool) (int64, error)
+	UpdateFunc                                 func(sourceTransfers *Transfers, where Predicate) (int64, error)
+	UpdateCtxFunc                              func(ctx context.Context, sourceTransfers *Transfers, where Predicate) (int64, error)
+	UpdateWithMaskFunc                         func(sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtxFunc                      func(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error)
+	DeleteFunc                                 func(where Predicate) (int64, error)
+	DeleteCtxFunc                              func(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstanceFunc                         func(sourceTransfers *Transfers) (bool, error)
+	DeleteInstanceCtxFunc                      func(ctx context.Context, sourceTransfers *Transfers) (bool, error)
+	DeleteAllFunc                              func() (int64, error)
+	DeleteAllCtxFunc                           func(ctx context.Context) (int64, error)
+	GetByTransferIdFunc                        func(inputTransferId int32) (*Transfers, error)
+	GetByTransferIdCtxFunc                     func(ctx context.Context, inputTransferId int32) (*Transfers, error)
+	GetByUniqueFromAccountAndHappenedAtFunc    func(inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error)
+	GetByUniqueFromAccountAndHappenedAtCtxFunc func(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error)
+}
+
+var _ TransfersRepository = (*TransfersRepositoryMock)(nil)
+
+// New records the call and runs NewFunc
+func (mock *TransfersRepositoryMock) New() (result0 *Transfers) {
+	mock.Record("New")
+	if mock.NewFunc != nil {
+		return mock.NewFunc()
+	}
+	return
+}
+
+// CreateFromHttpRequest records the call and runs CreateFromHttpRequestFunc
+func (mock *TransfersRepositoryMock) CreateFromHttpRequest(req *http.Request) (result0 *Transfers, result1 error) {
+	mock.Record("CreateFromHttpRequest", req)
+	if mock.CreateFromHttpRequestFunc != nil {
+		return mock.CreateFromHttpRequestFunc(req)
+	}
+	return
+}
+
+// CreateFromHttpRequestIgnoreErrors records the call and runs CreateFromHttpRequestIgnoreErrorsFunc
+func (mock *TransfersRepositoryMock) CreateFromHttpRequestIgnoreErrors(req *http.Request) (result0 *Transfers, result1 []error) {
+	mock.Record("CreateFromHttpRequestIgnoreErrors", req)
+	if mock.CreateFromHttpRequestIgnoreErrorsFunc != nil {
+		return mock.CreateFromHttpRequestIgnoreErrorsFunc(req)
+	}
+	return
+}
+
+// ToDbFieldName records the call and runs ToDbFieldNameFunc
+func (mock *TransfersRepositoryMock) ToDbFieldName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldName", fieldDbOrGoName)
+	if mock.ToDbFieldNameFunc != nil {
+		return mock.ToDbFieldNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// ToDbFieldTypeFromColName records the call and runs ToDbFieldTypeFromColNameFunc
+func (mock *TransfersRepositoryMock) ToDbFieldTypeFromColName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldTypeFromColName", fieldDbOrGoName)
+	if mock.ToDbFieldTypeFromColNameFunc != nil {
+		return mock.ToDbFieldTypeFromColNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *TransfersRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *TransfersRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *TransfersRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *TransfersRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *TransfersRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *TransfersRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *TransfersRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *TransfersRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *TransfersRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *TransfersRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *TransfersRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *TransfersRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *TransfersRepositoryMock) SelectAll(options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *TransfersRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *TransfersRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *TransfersRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *TransfersRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *TransfersRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Transfers, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *TransfersRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *TransfersRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *TransfersRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *TransfersRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *TransfersRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *Transfers, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *TransfersRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *Transfers, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectWhereJSONPath records the call and runs SelectWhereJSONPathFunc
+func (mock *TransfersRepositoryMock) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Transfers, result1 error) {
+	mock.Record("SelectWhereJSONPath", column, path, value)
+	if mock.SelectWhereJSONPathFunc != nil {
+		return mock.SelectWhereJSONPathFunc(column, path, value)
+	}
+	return
+}
+
+// SelectWhereJSONPathCtx records the call and runs SelectWhereJSONPathCtxFunc
+func (mock *TransfersRepositoryMock) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Transfers, result1 error) {
+	mock.Record("SelectWhereJSONPathCtx", ctx, column, path, value)
+	if mock.SelectWhereJSONPathCtxFunc != nil {
+		return mock.SelectWhereJSONPathCtxFunc(ctx, column, path, value)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *TransfersRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Transfers, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *TransfersRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Transfers, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// Insert records the call and runs InsertFunc
+func (mock *TransfersRepositoryMock) Insert(sourceTransfers *Transfers) (result0 *Transfers, result1 error) {
+	mock.Record("Insert", sourceTransfers)
+	if mock.InsertFunc != nil {
+		return mock.InsertFunc(sourceTransfers)
+	}
+	return
+}
+
+// InsertCtx records the call and runs InsertCtxFunc
+func (mock *TransfersRepositoryMock) InsertCtx(ctx context.Context, sourceTransfers *Transfers) (result0 *Transfers, result1 error) {
+	mock.Record("InsertCtx", ctx, sourceTransfers)
+	if mock.InsertCtxFunc != nil {
+		return mock.InsertCtxFunc(ctx, sourceTransfers)
+	}
+	return
+}
+
+// CopyFromReader records the call and runs CopyFromReaderFunc
+func (mock *TransfersRepositoryMock) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReader", r, opt, columns)
+	if mock.CopyFromReaderFunc != nil {
+		return mock.CopyFromReaderFunc(r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromReaderCtx records the call and runs CopyFromReaderCtxFunc
+func (mock *TransfersRepositoryMock) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	mock.Record("CopyFromReaderCtx", ctx, r, opt, columns)
+	if mock.CopyFromReaderCtxFunc != nil {
+		return mock.CopyFromReaderCtxFunc(ctx, r, opt, columns...)
+	}
+	return
+}
+
+// CopyFromSlice records the call and runs CopyFromSliceFunc
+func (mock *TransfersRepositoryMock) CopyFromSlice(records []Transfers, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSlice", records, includeSequenceCols)
+	if mock.CopyFromSliceFunc != nil {
+		return mock.CopyFromSliceFunc(records, includeSequenceCols)
+	}
+	return
+}
+
+// CopyFromSliceCtx records the call and runs CopyFromSliceCtxFunc
+func (mock *TransfersRepositoryMock) CopyFromSliceCtx(ctx context.Context, records []Transfers, includeSequenceCols bool) (result0 int64, result1 error) {
+	mock.Record("CopyFromSliceCtx", ctx, records, includeSequenceCols)
+	if mock.CopyFromSliceCtxFunc != nil {
+		return mock.CopyFromSliceCtxFunc(ctx, records, includeSequenceCols)
+	}
+	return
+}
+
+// Update records the call and runs UpdateFunc
+func (mock *TransfersRepositoryMock) Update(sourceTransfers *Transfers, where Predicate) (result0 int64, result1 error) {
+	mock.Record("Update", sourceTransfers, where)
+	if mock.UpdateFunc != nil {
+		return mock.UpdateFunc(sourceTransfers, where)
+	}
+	return
+}
+
+// UpdateCtx records the call and runs UpdateCtxFunc
+func (mock *TransfersRepositoryMock) UpdateCtx(ctx context.Context, sourceTransfers *Transfers, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateCtx", ctx, sourceTransfers, where)
+	if mock.UpdateCtxFunc != nil {
+		return mock.UpdateCtxFunc(ctx, sourceTransfers, where)
+	}
+	return
+}
+
+// UpdateWithMask records the call and runs UpdateWithMaskFunc
+func (mock *TransfersRepositoryMock) UpdateWithMask(sourceTransfers *Transfers, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMask", sourceTransfers, updateMask, where)
+	if mock.UpdateWithMaskFunc != nil {
+		return mock.UpdateWithMaskFunc(sourceTransfers, updateMask, where)
+	}
+	return
+}
+
+// UpdateWithMaskCtx records the call and runs UpdateWithMaskCtxFunc
+func (mock *TransfersRepositoryMock) UpdateWithMaskCtx(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMaskCtx", ctx, sourceTransfers, updateMask, where)
+	if mock.UpdateWithMaskCtxFunc != nil {
+		return mock.UpdateWithMaskCtxFunc(ctx, sourceTransfers, updateMask, where)
+	}
+	return
+}
+
+// Delete records the call and runs DeleteFunc
+func (mock *TransfersRepositoryMock) Delete(where Predicate) (result0 int64, result1 error) {
+	mock.Record("Delete", where)
+	if mock.DeleteFunc != nil {
+		return mock.DeleteFunc(where)
+	}
+	return
+}
+
+// DeleteCtx records the call and runs DeleteCtxFunc
+func (mock *TransfersRepositoryMock) DeleteCtx(ctx context.Context, where Predicate) (result0 int64, result1 error) {
+	mock.Record("DeleteCtx", ctx, where)
+	if mock.DeleteCtxFunc != nil {
+		return mock.DeleteCtxFunc(ctx, where)
+	}
+	return
+}
+
+// DeleteInstance records the call and runs DeleteInstanceFunc
+func (mock *TransfersRepositoryMock) DeleteInstance(sourceTransfers *Transfers) (result0 bool, result1 error) {
+	mock.Record("DeleteInstance", sourceTransfers)
+	if mock.DeleteInstanceFunc != nil {
+		return mock.DeleteInstanceFunc(sourceTransfers)
+	}
+	return
+}
+
+// DeleteInstanceCtx records the call and runs DeleteInstanceCtxFunc
+func (mock *TransfersRepositoryMock) DeleteInstanceCtx(ctx context.Context, sourceTransfers *Transfers) (result0 bool, result1 error) {
+	mock.Record("DeleteInstanceCtx", ctx, sourceTransfers)
+	if mock.DeleteInstanceCtxFunc != nil {
+		return mock.DeleteInstanceCtxFunc(ctx, sourceTransfers)
+	}
+	return
+}
+
+// DeleteAll records the call and runs DeleteAllFunc
+func (mock *TransfersRepositoryMock) DeleteAll() (result0 int64, result1 error) {
+	mock.Record("DeleteAll")
+	if mock.DeleteAllFunc != nil {
+		return mock.DeleteAllFunc()
+	}
+	return
+}
+
+// DeleteAllCtx records the call and runs DeleteAllCtxFunc
+func (mock *TransfersRepositoryMock) DeleteAllCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("DeleteAllCtx", ctx)
+	if mock.DeleteAllCtxFunc != nil {
+		return mock.DeleteAllCtxFunc(ctx)
+	}
+	return
+}
+
+// GetByTransferId records the call and runs GetByTransferIdFunc
+func (mock *TransfersRepositoryMock) GetByTransferId(inputTransferId int32) (result0 *Transfers, result1 error) {
+	mock.Record("GetByTransferId", inputTransferId)
+	if mock.GetByTransferIdFunc != nil {
+		return mock.GetByTransferIdFunc(inputTransferId)
+	}
+	return
+}
+
+// GetByTransferIdCtx records the call and runs GetByTransferIdCtxFunc
+func (mock *TransfersRepositoryMock) GetByTransferIdCtx(ctx context.Context, inputTransferId int32) (result0 *Transfers, result1 error) {
+	mock.Record("GetByTransferIdCtx", ctx, inputTransferId)
+	if mock.GetByTransferIdCtxFunc != nil {
+		return mock.GetByTransferIdCtxFunc(ctx, inputTransferId)
+	}
+	return
+}
+
+// GetByUniqueFromAccountAndHappenedAt records the call and runs GetByUniqueFromAccountAndHappenedAtFunc
+func (mock *TransfersRepositoryMock) GetByUniqueFromAccountAndHappenedAt(inputFromAccount int64, inputHappenedAt time.Time) (result0 *Transfers, result1 error) {
+	mock.Record("GetByUniqueFromAccountAndHappenedAt", inputFromAccount, inputHappenedAt)
+	if mock.GetByUniqueFromAccountAndHappenedAtFunc != nil {
+		return mock.GetByUniqueFromAccountAndHappenedAtFunc(inputFromAccount, inputHappenedAt)
+	}
+	return
+}
+
+// GetByUniqueFromAccountAndHappenedAtCtx records the call and runs GetByUniqueFromAccountAndHappenedAtCtxFunc
+func (mock *TransfersRepositoryMock) GetByUniqueFromAccountAndHappenedAtCtx(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (result0 *Transfers, result1 error) {
+	mock.Record("GetByUniqueFromAccountAndHappenedAtCtx", ctx, inputFromAccount, inputHappenedAt)
+	if mock.GetByUniqueFromAccountAndHappenedAtCtxFunc != nil {
+		return mock.GetByUniqueFromAccountAndHappenedAtCtxFunc(ctx, inputFromAccount, inputHappenedAt)
+	}
+	return
+}
+
+// TransfersFake is an in-memory TransfersRepository for the unit tests, its zero value an empty transfers table.
+// It assigns the serial columns, and enforces the primary key, the unique constraints and the NOT NULL
+// columns with the errors the database methods return. It evaluates the predicates the way the database
+// does, NULL included, but only the Raw conditions made of column = $n, column IS NULL and column IS NOT NULL
+// comparisons joined by AND. The select options apply, the locking ones having no effect, and the cached
+// selects cache nothing. The methods working on the database only, e.g. CountImprecise, return
+// ErrFakeNotSupported. It is safe for concurrent use.
+type TransfersFake struct {
+	mutex sync.RWMutex
+	rows  []Transfers
+
+	sequenceTransferId int32
+
+	utils tTransfersUtils
+}
+
+var _ TransfersRepository = (*TransfersFake)(nil)
+
+// Rows returns a copy of the rows, in their order of insertion
+func (fake *TransfersFake) Rows() []Transfers {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return append([]Transfers(nil), fake.rows...)
+}
+
+// column returns the value of the row's column, nil for NULL, and false for an unknown column
+func (fake *TransfersFake) column(row *Transfers, dbName string) (interface{}, bool) {
+
+	switch dbName {
+	case "transfer_id":
+		return row.TransferId, true
+	case "from_account":
+		return row.FromAccount, true
+	case "to_account":
+		if !row.ToAccount_IsNotNull {
+			return nil, true
+		}
+		return row.ToAccount, true
+	case "amount":
+		return row.Amount, true
+	case "memo":
+		if !row.Memo_IsNotNull {
+			return nil, true
+		}
+		return row.Memo, true
+	case "urgent":
+		return row.Urgent, true
+	case "happened_at":
+		return row.HappenedAt, true
+	case "details":
+		if row.Details == nil {
+			return nil, true
+		}
+		return row.Details, true
+	}
+	return nil, false
+}
+
+// assign sets the field of the row, named by its Go or database name, to the one of the source
+func (fake *TransfersFake) assign(row *Transfers, sourceTransfers *Transfers, field string) bool {
+
+	switch field {
+	case "TransferId", "transfer_id":
+		row.TransferId = sourceTransfers.TransferId
+	case "FromAccount", "from_account":
+		row.FromAccount = sourceTransfers.FromAccount
+	case "ToAccount", "to_account":
+		row.ToAccount = sourceTransfers.ToAccount
+		row.ToAccount_IsNotNull = sourceTransfers.ToAccount_IsNotNull
+	case "Amount", "amount":
+		row.Amount = sourceTransfers.Amount
+	case "Memo", "memo":
+		row.Memo = sourceTransfers.Memo
+		row.Memo_IsNotNull = sourceTransfers.Memo_IsNotNull
+	case "Urgent", "urgent":
+		row.Urgent = sourceTransfers.Urgent
+	case "HappenedAt", "happened_at":
+		row.HappenedAt = sourceTransfers.HappenedAt
+	case "Details", "details":
+		row.Details = sourceTransfers.Details
+	default:
+		return false
+	}
+	return true
+}
+
+// sameKey tells whether the rows have the same values in the columns, NULL equal to nothing
+func (fake *TransfersFake) sameKey(a *Transfers, b *Transfers, dbNames ...string) bool {
+
+	for _, dbName := range dbNames {
+		aValue, _ := fake.column(a, dbName)
+		bValue, _ := fake.column(b, dbName)
+		if !fakeEqual(aValue, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// deleted tells whether the row is soft-deleted
+func (fake *TransfersFake) deleted(row *Transfers) bool {
+
+	return false
+}
+
+// nextSequenceValues assigns the serial columns of the row
+func (fake *TransfersFake) nextSequenceValues(row *Transfers) {
+
+	fake.sequenceTransferId++
+	row.TransferId = fake.sequenceTransferId
+}
+
+// validate checks the changed rows against the constraints of the table
+func (fake *TransfersFake) validate(rows []Transfers, changed []int) error {
+
+	for _, i := range changed {
+		if rows[i].Details == nil {
+			return NewModelsErrorLocalWithCode("Not null constraint violation:", "details", "23502")
+		}
+		for j := range rows {
+			if j == i {
+				continue
+			}
+			if fake.sameKey(&rows[i], &rows[j], "transfer_id") {
+				return NewModelsErrorLocalWithCode("Unique constraint violation:", "transfers primary key", "23505")
+			}
+			if fake.sameKey(&rows[i], &rows[j], "from_account", "happened_at") {
+				return ErrTransfers_UQ_transfers_from_account_happened_at_key
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertRows appends the rows, unless one of them violates a constraint
+func (fake *TransfersFake) insertRows(rows ...Transfers) error {
+
+	all := append(append([]Transfers(nil), fake.rows...), rows...)
+
+	changed := make([]int, len(rows))
+	for i := range rows {
+		changed[i] = len(fake.rows) + i
+	}
+	if err := fake.validate(all, changed); err != nil {
+		return err
+	}
+
+	fake.rows = all
+	return nil
+}
+
+// updateRows updates the rows at the indexes, unless one of them then violates a constraint
+func (fake *TransfersFake) updateRows(indexes []int, update func(row *Transfers)) (int64, error) {
+
+	rows := append([]Transfers(nil), fake.rows...)
+	for _, i := range indexes {
+		update(&rows[i])
+	}
+	if err := fake.validate(rows, indexes); err != nil {
+		return 0, err
+	}
+
+	fake.rows = rows
+	return int64(len(indexes)), nil
+}
+
+// matching returns the indexes of the rows matching the predicate, the zero one matching all of them
+func (fake *TransfersFake) matching(errorPrefix string, where Predicate, withDeleted bool) ([]int, error) {
+
+	predicate, err := fakePredicate(where, isTransfersColumn)
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	var indexes []int
+	for i := range fake.rows {
+		row := &fake.rows[i]
+		if !withDeleted && fake.deleted(row) {
+			continue
+		}
+
+		truth, err := fakeEvaluate(predicate, func(dbName string) interface{} {
+			value, _ := fake.column(row, dbName)
+			return value
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", errorPrefix, err)
+		}
+		if truth == fakeTrue {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// rowsAt returns copies of the rows at the indexes
+func (fake *TransfersFake) rowsAt(indexes []int) []Transfers {
+
+	rows := make([]Transfers, len(indexes))
+	for i, index := range indexes {
+		rows[i] = fake.rows[index]
+	}
+	return rows
+}
+
+// selectRows returns copies of the rows at the indexes, ordered, offset, limited and projected by the options
+func (fake *TransfersFake) selectRows(errorPrefix string, indexes []int, options selectOptions) ([]Transfers, error) {
+
+	if _, err := options.clause(isTransfersColumn); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	rows := fake.rowsAt(indexes)
+
+	var orderErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, term := range options.orderBy {
+			a, _ := fake.column(&rows[i], term.column)
+			b, _ := fake.column(&rows[j], term.column)
+			order, ok := fakeCompare(a, b, term)
+			if !ok {
+				orderErr = fmt.Errorf("%sordering by %s is %w", errorPrefix, term.column, ErrFakeNotSupported)
+			}
+			if order != 0 {
+				return order < 0
+			}
+		}
+		return false
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+
+	if options.offset > len(rows) {
+		options.offset = len(rows)
+	}
+	rows = rows[options.offset:]
+	if options.limit > 0 && options.limit < len(rows) {
+		rows = rows[:options.limit]
+	}
+
+	if options.projection != nil {
+		for i := range rows {
+			rows[i] = fake.project(&rows[i], options.projection)
+		}
+	}
+	return rows, nil
+}
+
+// selectMatching returns copies of the rows matching the predicate, ordered, offset, limited and projected by the options
+func (fake *TransfersFake) selectMatching(errorPrefix string, where Predicate, options selectOptions) ([]Transfers, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return nil, err
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// project returns the row with only the columns of the projection, as WithColumns selects them
+func (fake *TransfersFake) project(row *Transfers, projection *columnProjection) Transfers {
+
+	projected := Transfers{pgToGo_projection: projection}
+	if projection.loads("transfer_id") {
+		projected.TransferId = row.TransferId
+	}
+	if projection.loads("from_account") {
+		projected.FromAccount = row.FromAccount
+	}
+	if projection.loads("to_account") {
+		projected.ToAccount = row.ToAccount
+		projected.ToAccount_IsNotNull = row.ToAccount_IsNotNull
+	}
+	if projection.loads("amount") {
+		projected.Amount = row.Amount
+	}
+	if projection.loads("memo") {
+		projected.Memo = row.Memo
+		projected.Memo_IsNotNull = row.Memo_IsNotNull
+	}
+	if projection.loads("urgent") {
+		projected.Urgent = row.Urgent
+	}
+	if projection.loads("happened_at") {
+		projected.HappenedAt = row.HappenedAt
+	}
+	if projection.loads("details") {
+		projected.Details = row.Details
+	}
+
+	return projected
+}
+
+// deleteRows deletes the rows at the indexes
+func (fake *TransfersFake) deleteRows(indexes []int) int64 {
+
+	kept := fake.rows[:0]
+	for i, row := range fake.rows {
+		if len(indexes) > 0 && indexes[0] == i {
+			indexes = indexes[1:]
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(fake.rows) - len(kept))
+	fake.rows = kept
+	return deleted
+}
+
+// Insert is InsertCtx with the background context
+func (fake *TransfersFake) Insert(sourceTransfers *Transfers) (*Transfers, error) {
+	return fake.InsertCtx(context.Background(), sourceTransfers)
+}
+
+// InsertCtx inserts a copy of the row the way Tables.Transfers.InsertCtx does, assigning the serial
+// columns when PgToGo_IgnorePKValuesWhenInsertingAndUseSequence is set.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+func (fake *TransfersFake) InsertCtx(ctx context.Context, sourceTransfers *Transfers) (*Transfers, error) {
+
+	var errorPrefix = "TransfersFake.Insert() ERROR: "
+
+	if sourceTransfers == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+
+	if sourceTransfers.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceTransfers.HappenedAt = Now()
+
+	}
+
+	if sourceTransfers.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	row := *sourceTransfers
+	if sourceTransfers.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		fake.nextSequenceValues(&row)
+	}
+
+	if err := fake.insertRows(row); err != nil {
+		return nil, err
+	}
+
+	sourceTransfers.TransferId = row.TransferId
+
+	return sourceTransfers, nil
+}
+
+// CopyFromSlice is CopyFromSliceCtx with the background context
+func (fake *TransfersFake) CopyFromSlice(records []Transfers, includeSequenceCols bool) (int64, error) {
+	return fake.CopyFromSliceCtx(context.Background(), records, includeSequenceCols)
+}
+
+// CopyFromSliceCtx inserts copies of the records, all of them or none.
+// Unless includeSequenceCols is true, the serial columns are assigned.
+func (fake *TransfersFake) CopyFromSliceCtx(ctx context.Context, records []Transfers, includeSequenceCols bool) (int64, error) {
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	rows := append([]Transfers(nil), records...)
+	if !includeSequenceCols {
+		for i := range rows {
+			fake.nextSequenceValues(&rows[i])
+		}
+	}
+
+	if err := fake.insertRows(rows...); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// Select is SelectCtx with the background context
+func (fake *TransfersFake) Select(where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns copies of the rows matching the predicate, ordered and limited by the options
+func (fake *TransfersFake) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersFake.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	return fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+}
+
+// Single is SingleCtx with the background context
+func (fake *TransfersFake) Single(where Predicate, options ...SelectOption) (*Transfers, error) {
+	return fake.SingleCtx(context.Background(), where, options...)
+}
+
+// SingleCtx returns a copy of the row matching the predicate, nil if there is none,
+// and ErrTooManyRows if there are several
+func (fake *TransfersFake) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Transfers, error) {
+
+	var errorPrefix = "TransfersFake.Single() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	switch len(rows) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &rows[0], nil
+	}
+	return nil, ErrTooManyRows
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (fake *TransfersFake) SelectAll(options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectAllCtx(context.Background(), options...)
+}
+
+// SelectAllCtx returns copies of all the rows, ordered and limited by the options
+func (fake *TransfersFake) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Transfers, error) {
+	return fake.selectMatching("TransfersFake.SelectAll() ERROR: ", Predicate{}, applySelectOptions(options))
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (fake *TransfersFake) SelectUnion(where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx returns copies of the rows matching any of the predicates, the ones matching several
+// of them once, ordered and limited by the options as a whole
+func (fake *TransfersFake) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.selectUnion("TransfersFake.SelectUnion() ERROR: ", where, false, applySelectOptions(options))
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (fake *TransfersFake) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx returns copies of the rows matching each of the predicates, the ones matching several
+// of them as many times, ordered and limited by the options as a whole
+func (fake *TransfersFake) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.selectUnion("TransfersFake.SelectUnionAll() ERROR: ", where, true, applySelectOptions(options))
+}
+
+// selectUnion returns copies of the rows matching each of the predicates, only once unless all is set
+func (fake *TransfersFake) selectUnion(errorPrefix string, where []Predicate, all bool, options selectOptions) ([]Transfers, error) {
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+	if err := options.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	var indexes []int
+	selected := make(map[int]bool)
+	for _, predicate := range where {
+		if predicate.IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+		}
+
+		matching, err := fake.matching(errorPrefix, predicate, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range matching {
+			if all || !selected[i] {
+				indexes = append(indexes, i)
+				selected[i] = true
+			}
+		}
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (fake *TransfersFake) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns copies of the rows matching the predicate as SelectCtx does, caching nothing
+func (fake *TransfersFake) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersFake.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (fake *TransfersFake) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns copies of the rows of the page, from 1, matching the predicate
+func (fake *TransfersFake) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.selectPage("TransfersFake.SelectPage() ERROR: ", pageNumber, pageSize, where, false, options)
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (fake *TransfersFake) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns copies of the rows of the page as SelectPageCtx does, caching nothing
+func (fake *TransfersFake) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Transfers, error) {
+	return fake.selectPage("TransfersFake.SelectPageCached() ERROR: ", pageNumber, pageSize, where, true, options)
+}
+
+// selectPage returns copies of the rows of the page matching the predicate, refusing the locking options when cached
+func (fake *TransfersFake) selectPage(errorPrefix string, pageNumber int, pageSize int, where Predicate, cached bool, options []SelectOption) ([]Transfers, error) {
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from transfers")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	if cached {
+		if err := selectOptions.cached(); err != nil {
+			return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+		}
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (fake *TransfersFake) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// SelectAllOrderByCtx returns copies of all the rows, ordered by the orderBy parameter,
+// columns followed by ASC or DESC and NULLS FIRST or NULLS LAST, or by the OrderBy option
+func (fake *TransfersFake) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersFake.SelectAllOrderBy() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (fake *TransfersFake) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error) {
+	return fake.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// SelectAllPageCtx returns copies of the rows of the page, from 1, ordered as SelectAllOrderByCtx orders them
+func (fake *TransfersFake) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Transfers, error) {
+
+	var errorPrefix = "TransfersFake.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (fake *TransfersFake) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error) {
+	return fake.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns copies of the rows of the page following the cursor, and the cursor of the next page,
+// as Tables.Transfers.SelectAfterCtx does
+func (fake *TransfersFake) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Transfers, string, error) {
+
+	var errorPrefix = "TransfersFake.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetTransfers.after(cursor, pageSize, &selectOptions, fake.utils.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, And(where, after), selectOptions)
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetTransfers.cursor(selectOptions, func(dbName string) interface{} {
+		return fake.utils.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Count is CountCtx with the background context
+func (fake *TransfersFake) Count(where ...Predicate) (int64, error) {
+	return fake.CountCtx(context.Background(), where...)
+}
+
+// CountCtx returns the number of rows matching all the predicates
+func (fake *TransfersFake) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching("TransfersFake.Count() ERROR: ", And(where...), false)
+	if err != nil {
+		return -1, err
+	}
+	return int64(len(indexes)), nil
+}
+
+// Update is UpdateCtx with the background context
+func (fake *TransfersFake) Update(sourceTransfers *Transfers, where Predicate) (int64, error) {
+	return fake.UpdateCtx(context.Background(), sourceTransfers, where)
+}
+
+// UpdateCtx sets all the fields of the rows matching the predicate to the ones of the source.
+// Returns the number of affected rows.
+func (fake *TransfersFake) UpdateCtx(ctx context.Context, sourceTransfers *Transfers, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersFake.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside transfers")
+	}
+	if sourceTransfers == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Transfers) {
+		fake.assign(row, sourceTransfers, "TransferId")
+		fake.assign(row, sourceTransfers, "FromAccount")
+		fake.assign(row, sourceTransfers, "ToAccount")
+		fake.assign(row, sourceTransfers, "Amount")
+		fake.assign(row, sourceTransfers, "Memo")
+		fake.assign(row, sourceTransfers, "Urgent")
+		fake.assign(row, sourceTransfers, "HappenedAt")
+		fake.assign(row, sourceTransfers, "Details")
+
+	})
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (fake *TransfersFake) UpdateWithMask(sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+	return fake.UpdateWithMaskCtx(context.Background(), sourceTransfers, updateMask, where)
+}
+
+// UpdateWithMaskCtx sets the fields in the mask, Go or database names, of the rows matching the predicate
+// to the ones of the source. Returns the number of affected rows.
+func (fake *TransfersFake) UpdateWithMaskCtx(ctx context.Context, sourceTransfers *Transfers, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersFake.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside transfers")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if sourceTransfers == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+	for _, field := range updateMask {
+		if !fake.assign(&Transfers{}, sourceTransfers, field) {
+			return 0, NewModelsErrorLocal(errorPrefix, "the update mask field "+field+" is not a column of transfers")
+		}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Transfers) {
+		for _, field := range updateMask {
+			fake.assign(row, sourceTransfers, field)
+		}
+	})
+}
+
+// Delete is DeleteCtx with the background context
+func (fake *TransfersFake) Delete(where Predicate) (int64, error) {
+	return fake.DeleteCtx(context.Background(), where)
+}
+
+// DeleteCtx deletes the rows matching the predicate.
+// Returns the number of deleted rows.
+func (fake *TransfersFake) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "TransfersFake.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from transfers")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return 0, err
+	}
+	return fake.deleteRows(indexes), nil
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (fake *TransfersFake) DeleteInstance(sourceTransfers *Transfers) (bool, error) {
+	return fake.DeleteInstanceCtx(context.Background(), sourceTransfers)
+}
+
+// DeleteInstanceCtx deletes the row with the primary key of the source.
+// Returns true if the row was found.
+func (fake *TransfersFake) DeleteInstanceCtx(ctx context.Context, sourceTransfers *Transfers) (bool, error) {
+
+	var errorPrefix = "TransfersFake.DeleteInstance() ERROR: "
+
+	if sourceTransfers == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceTransfers pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	var indexes []int
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], sourceTransfers, "transfer_id") {
+			indexes = append(indexes, i)
+		}
+	}
+	return fake.deleteRows(indexes) == 1, nil
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (fake *TransfersFake) DeleteAll() (int64, error) {
+	return fake.DeleteAllCtx(context.Background())
+}
+
+// DeleteAllCtx deletes all the rows.
+// Returns the number of deleted rows.
+func (fake *TransfersFake) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	// the zero predicate matches all the rows, without an error
+	indexes, _ := fake.matching("", Predicate{}, false)
+	return fake.deleteRows(indexes), nil
+}
+
+// get returns a copy of the row with the values of the key in the columns, nil if there is none
+func (fake *TransfersFake) get(key *Transfers, dbNames ...string) *Transfers {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], key, dbNames...) {
+			row := fake.rows[i]
+			return &row
+		}
+	}
+	return nil
+}
+
+// GetByTransferId is GetByTransferIdCtx with the background context
+func (fake *TransfersFake) GetByTransferId(inputTransferId int32) (*Transfers, error) {
+	return fake.GetByTransferIdCtx(context.Background(), inputTransferId)
+}
+
+// GetByTransferIdCtx returns a copy of the row with the primary key, nil if there is none
+func (fake *TransfersFake) GetByTransferIdCtx(ctx context.Context, inputTransferId int32) (*Transfers, error) {
+	return fake.get(&Transfers{TransferId: inputTransferId}, "transfer_id"), nil
+}
+
+// GetByUniqueFromAccountAndHappenedAt is GetByUniqueFromAccountAndHappenedAtCtx with the background context
+func (fake *TransfersFake) GetByUniqueFromAccountAndHappenedAt(inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error) {
+	return fake.GetByUniqueFromAccountAndHappenedAtCtx(context.Background(), inputFromAccount, inputHappenedAt)
+}
+
+// GetByUniqueFromAccountAndHappenedAtCtx returns a copy of the row with the values of the transfers_from_account_happened_at_key unique constraint, nil if there is none
+func (fake *TransfersFake) GetByUniqueFromAccountAndHappenedAtCtx(ctx context.Context, inputFromAccount int64, inputHappenedAt time.Time) (*Transfers, error) {
+	return fake.get(&Transfers{FromAccount: inputFromAccount, HappenedAt: inputHappenedAt}, "from_account", "happened_at"), nil
+}
+
+// New is Tables.Transfers.New
+func (fake *TransfersFake) New() *Transfers {
+	return fake.utils.New()
+}
+
+// CreateFromHttpRequest is Tables.Transfers.CreateFromHttpRequest
+func (fake *TransfersFake) CreateFromHttpRequest(req *http.Request) (*Transfers, error) {
+	return fake.utils.CreateFromHttpRequest(req)
+}
+
+// CreateFromHttpRequestIgnoreErrors is Tables.Transfers.CreateFromHttpRequestIgnoreErrors
+func (fake *TransfersFake) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Transfers, []error) {
+	return fake.utils.CreateFromHttpRequestIgnoreErrors(req)
+}
+
+// ToDbFieldName is Tables.Transfers.ToDbFieldName
+func (fake *TransfersFake) ToDbFieldName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldName(fieldDbOrGoName)
+}
+
+// ToDbFieldTypeFromColName is Tables.Transfers.ToDbFieldTypeFromColName
+func (fake *TransfersFake) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldTypeFromColName(fieldDbOrGoName)
+}
+
+// CountImprecise is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) CountImprecise() (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CountImpreciseCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPath is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Transfers, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPathCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Transfers, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReader is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) CopyFromReader(r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CopyFromReaderCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *TransfersFake) CopyFromReaderCtx(ctx context.Context, r io.Reader, opt *CopyFromReaderOptions, columns ...string) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
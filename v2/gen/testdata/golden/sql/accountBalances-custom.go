@@ -0,0 +1,13 @@
+package models
+
+/* *********************************************************** **/
+/* This file is generated by pgtogogen FIRST-TIME ONLY.         */
+/* It will not subsequently overwrite it if it already exists.  */
+/* Use this file to create your custom extension functionality. */
+/* ************************************************************ */
+
+/*
+import (
+
+)
+*/
@@ -0,0 +1,4478 @@
+package models
+
+/* *********************************************************** */
+/* This file was automatically generated by pgtogogen.         */
+/* Do not modify this file unless you know what you are doing. */
+/* *********************************************************** */
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const Accounts_DB_TABLE_NAME string = "accounts"
+
+/*
+Accounts is a struct that corresponds to the accounts table.
+Database comments: The customer accounts
+*/
+type Accounts struct {
+	AccountId int64 // database field name: account_id, IsPK: true , IsCompositePK: false, IsFK: false
+
+	AccountGuid string // database field name: account_guid, IsPK: false , IsCompositePK: false, IsFK: false
+
+	/* Login e-mail */
+	Email string // database field name: email, IsPK: false , IsCompositePK: false, IsFK: false
+
+	Status string // database field name: status, IsPK: false , IsCompositePK: false, IsFK: false
+
+	PreviousStatus           string // database field name: previous_status, IsPK: false , IsCompositePK: false, IsFK: false
+	PreviousStatus_IsNotNull bool   // if true, it means the value is not null
+
+	Flags           string // database field name: flags, IsPK: false , IsCompositePK: false, IsFK: false
+	Flags_IsNotNull bool   // if true, it means the value is not null
+
+	/* Display preferences @gotype:map[string]string */
+	Settings           map[string]string // database field name: settings, IsPK: false , IsCompositePK: false, IsFK: false
+	Settings_IsNotNull bool              // if true, it means the value is not null
+
+	Balance Numeric // database field name: balance, IsPK: false , IsCompositePK: false, IsFK: false
+
+	OpenedOn           time.Time // database field name: opened_on, IsPK: false , IsCompositePK: false, IsFK: false
+	OpenedOn_IsNotNull bool      // if true, it means the value is not null
+
+	CreatedAt time.Time // database field name: created_at, IsPK: false , IsCompositePK: false, IsFK: false
+
+	// Set this to true if you want Inserts to ignore the PK fields
+	PgToGo_IgnorePKValuesWhenInsertingAndUseSequence bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all time.Time (datetime) fields to time.Now()
+	PgToGo_SetDateTimeFieldsToNowForNewRecords bool
+
+	// Set this to true if you want New or Create operations to automatically
+	// set all Guid fields to a new guid
+	PgToGo_SetGuidFieldsToNewGuidsNewRecords bool
+
+	// the columns the row was selected with, nil for all of them, see WithColumns
+	pgToGo_projection *columnProjection
+}
+
+// SetAccountId sets the AccountId field to val.
+func (t *Accounts) SetAccountId(val int64) {
+	t.AccountId = val
+
+}
+
+// SetAccountGuid sets the AccountGuid field to val.
+func (t *Accounts) SetAccountGuid(val string) {
+	t.AccountGuid = val
+
+}
+
+// SetEmail sets the Email field to val.
+func (t *Accounts) SetEmail(val string) {
+	t.Email = val
+
+}
+
+// SetStatus sets the Status field to val.
+func (t *Accounts) SetStatus(val string) {
+	t.Status = val
+
+}
+
+// SetPreviousStatus sets the PreviousStatus field to val.
+func (t *Accounts) SetPreviousStatus(val string, notNull bool) {
+	t.PreviousStatus = val
+	t.PreviousStatus_IsNotNull = notNull
+}
+
+// IsNotNull_PreviousStatus returns true is the field is not null (a value is present)
+func (t *Accounts) IsNotNull_PreviousStatus() bool {
+	return t.PreviousStatus_IsNotNull
+}
+
+// IsNull_PreviousStatus returns true is the field is null (a value is not present)
+func (t *Accounts) IsNull_PreviousStatus() bool {
+	return !t.PreviousStatus_IsNotNull
+}
+
+// SetFlags sets the Flags field to val.
+func (t *Accounts) SetFlags(val string, notNull bool) {
+	t.Flags = val
+	t.Flags_IsNotNull = notNull
+}
+
+// IsNotNull_Flags returns true is the field is not null (a value is present)
+func (t *Accounts) IsNotNull_Flags() bool {
+	return t.Flags_IsNotNull
+}
+
+// IsNull_Flags returns true is the field is null (a value is not present)
+func (t *Accounts) IsNull_Flags() bool {
+	return !t.Flags_IsNotNull
+}
+
+// SetSettings sets the Settings field to val.
+func (t *Accounts) SetSettings(val map[string]string, notNull bool) {
+	t.Settings = val
+	t.Settings_IsNotNull = notNull
+}
+
+// IsNotNull_Settings returns true is the field is not null (a value is present)
+func (t *Accounts) IsNotNull_Settings() bool {
+	return t.Settings_IsNotNull
+}
+
+// IsNull_Settings returns true is the field is null (a value is not present)
+func (t *Accounts) IsNull_Settings() bool {
+	return !t.Settings_IsNotNull
+}
+
+// SetBalance sets the Balance field to val.
+func (t *Accounts) SetBalance(val Numeric) {
+	t.Balance = val
+
+}
+
+// SetOpenedOn sets the OpenedOn field to val.
+func (t *Accounts) SetOpenedOn(val time.Time, notNull bool) {
+	t.OpenedOn = val
+	t.OpenedOn_IsNotNull = notNull
+}
+
+// IsNotNull_OpenedOn returns true is the field is not null (a value is present)
+func (t *Accounts) IsNotNull_OpenedOn() bool {
+	return t.OpenedOn_IsNotNull
+}
+
+// IsNull_OpenedOn returns true is the field is null (a value is not present)
+func (t *Accounts) IsNull_OpenedOn() bool {
+	return !t.OpenedOn_IsNotNull
+}
+
+// SetCreatedAt sets the CreatedAt field to val.
+func (t *Accounts) SetCreatedAt(val time.Time) {
+	t.CreatedAt = val
+
+}
+
+// MarkAllColumnsLoaded lets the instance Update of a Accounts selected WithColumns write back all
+// its fields, the zero values of the columns left out included
+func (t *Accounts) MarkAllColumnsLoaded() {
+	t.pgToGo_projection = nil
+}
+
+/* Accounts slice manipulation helpers */
+
+// AccountsSlice allows manipulation of a slice of Accounts using the
+// attached methods. Simply cast []Accounts to AccountsSlice
+// e.g. AccountsSlice(anyGivenAccountsSlice)
+// and you have various methods available. Please note that these methods do not impact
+// the database in any way.
+type AccountsSlice []Accounts
+
+type AccountsSliceFilterFunc func(c Accounts) bool
+
+// Filter filters the original AccountsSlice elements using the supplied
+// filterFunc closure. The caller must reassign the returned collection:
+//
+//	newSlice := Filter(filterFunc)
+func (cc AccountsSlice) Filter(filterFunc AccountsSliceFilterFunc) AccountsSlice {
+	if cc == nil {
+		return nil
+	}
+	ccNew := make(AccountsSlice, 0, len(cc))
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			ccNew = append(ccNew, cc[i])
+		}
+	}
+	return ccNew
+}
+
+// FilterInto filters the original AccountsSlice collection into the supplied
+// dest AccountsSlice. Passing the preallocated collection can potentially avoid
+// a huge number of allocations in a fast paced application.
+// The caller must reassign the returned collection to dest:
+//
+//	dest = FilterInto(filterFunc, dest)
+func (cc AccountsSlice) FilterInto(filterFunc AccountsSliceFilterFunc,
+	dest AccountsSlice) AccountsSlice {
+	if cc == nil {
+		dest = nil
+		return nil
+	}
+	if dest == nil {
+		return nil
+	}
+	for i := range cc {
+		if filterFunc(cc[i]) == true {
+			dest = append(dest, cc[i])
+		}
+	}
+	return dest
+}
+
+/* Sorting helper containers */
+
+// SortAccountsByAccountId implements sort.Interface for []Accounts based on
+// the AccountId field. Usage: sort.Sort(SortAccountsByAccountId(anyGivenAccountsSlice))
+type SortAccountsByAccountId []Accounts
+
+func (a SortAccountsByAccountId) Len() int      { return len(a) }
+func (a SortAccountsByAccountId) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByAccountId) Less(i, j int) bool {
+	return LessComparatorFor_int64(a[i].AccountId, a[j].AccountId)
+}
+
+// SortAccountsByAccountGuid implements sort.Interface for []Accounts based on
+// the AccountGuid field. Usage: sort.Sort(SortAccountsByAccountGuid(anyGivenAccountsSlice))
+type SortAccountsByAccountGuid []Accounts
+
+func (a SortAccountsByAccountGuid) Len() int      { return len(a) }
+func (a SortAccountsByAccountGuid) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByAccountGuid) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].AccountGuid, a[j].AccountGuid)
+}
+
+// SortAccountsByEmail implements sort.Interface for []Accounts based on
+// the Email field. Usage: sort.Sort(SortAccountsByEmail(anyGivenAccountsSlice))
+type SortAccountsByEmail []Accounts
+
+func (a SortAccountsByEmail) Len() int      { return len(a) }
+func (a SortAccountsByEmail) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByEmail) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Email, a[j].Email)
+}
+
+// SortAccountsByStatus implements sort.Interface for []Accounts based on
+// the Status field. Usage: sort.Sort(SortAccountsByStatus(anyGivenAccountsSlice))
+type SortAccountsByStatus []Accounts
+
+func (a SortAccountsByStatus) Len() int      { return len(a) }
+func (a SortAccountsByStatus) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByStatus) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Status, a[j].Status)
+}
+
+// SortAccountsByPreviousStatus implements sort.Interface for []Accounts based on
+// the PreviousStatus field. Usage: sort.Sort(SortAccountsByPreviousStatus(anyGivenAccountsSlice))
+type SortAccountsByPreviousStatus []Accounts
+
+func (a SortAccountsByPreviousStatus) Len() int      { return len(a) }
+func (a SortAccountsByPreviousStatus) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByPreviousStatus) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].PreviousStatus, a[j].PreviousStatus)
+}
+
+// SortAccountsByFlags implements sort.Interface for []Accounts based on
+// the Flags field. Usage: sort.Sort(SortAccountsByFlags(anyGivenAccountsSlice))
+type SortAccountsByFlags []Accounts
+
+func (a SortAccountsByFlags) Len() int      { return len(a) }
+func (a SortAccountsByFlags) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByFlags) Less(i, j int) bool {
+	return LessComparatorFor_string(a[i].Flags, a[j].Flags)
+}
+
+// SortAccountsByBalance implements sort.Interface for []Accounts based on
+// the Balance field. Usage: sort.Sort(SortAccountsByBalance(anyGivenAccountsSlice))
+type SortAccountsByBalance []Accounts
+
+func (a SortAccountsByBalance) Len() int      { return len(a) }
+func (a SortAccountsByBalance) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByBalance) Less(i, j int) bool {
+	return LessComparatorFor_Numeric(a[i].Balance, a[j].Balance)
+}
+
+// SortAccountsByOpenedOn implements sort.Interface for []Accounts based on
+// the OpenedOn field. Usage: sort.Sort(SortAccountsByOpenedOn(anyGivenAccountsSlice))
+type SortAccountsByOpenedOn []Accounts
+
+func (a SortAccountsByOpenedOn) Len() int      { return len(a) }
+func (a SortAccountsByOpenedOn) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByOpenedOn) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].OpenedOn, a[j].OpenedOn)
+}
+
+// SortAccountsByCreatedAt implements sort.Interface for []Accounts based on
+// the CreatedAt field. Usage: sort.Sort(SortAccountsByCreatedAt(anyGivenAccountsSlice))
+type SortAccountsByCreatedAt []Accounts
+
+func (a SortAccountsByCreatedAt) Len() int      { return len(a) }
+func (a SortAccountsByCreatedAt) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a SortAccountsByCreatedAt) Less(i, j int) bool {
+	return LessComparatorFor_time.Time(a[i].CreatedAt, a[j].CreatedAt)
+}
+
+// Utility-oriented, internal type to allow a singleton structure that would hold static-like methods
+// and global, single-instance settings
+type tAccountsUtils struct {
+	utilMutex sync.RWMutex
+
+	// the querier the methods run on, GetDb() when nil, see NewDB
+	querier Querier
+
+	// instance of a CacheForAccounts structure
+	Cache CacheForAccounts
+}
+
+var ErrAccounts_UQ_accounts_account_guid_key = NewModelsErrorLocalWithCode("Unique constraint violation:", "accounts_account_guid_key", "23505")
+var ErrAccounts_UQ_accounts_email_key = NewModelsErrorLocalWithCode("Unique constraint violation:", "accounts_email_key", "23505")
+
+// Creates a new pointer to a blank Accounts structure.
+// Some of the fields, such as the time.Time ones, might be already set to time.Now()
+// based on the Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords setting
+func (utilRef *tAccountsUtils) New() *Accounts {
+
+	newAccounts := &Accounts{}
+
+	newAccounts.CloneGlobalSettings()
+
+	return newAccounts
+}
+
+// Creates a new pointer to a Accounts from an Http Request.
+// The parameters are expected to match the struct field names
+func (utilRef *tAccountsUtils) CreateFromHttpRequest(req *http.Request) (*Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.CreateFromHttpRequest() ERROR: "
+
+	if req == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")
+	}
+
+	var err error = nil
+	newAccounts := &Accounts{}
+
+	newAccounts.CloneGlobalSettings()
+
+	newAccounts.AccountId, err = To_int64_FromString(req.FormValue("AccountId"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newAccounts.AccountGuid = req.FormValue("AccountGuid")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newAccounts.Email = req.FormValue("Email")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newAccounts.Status = req.FormValue("Status")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newAccounts.PreviousStatus = req.FormValue("PreviousStatus")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("PreviousStatus") != "" {
+		newAccounts.PreviousStatus_IsNotNull = true
+	}
+
+	newAccounts.Flags = req.FormValue("Flags")
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Flags") != "" {
+		newAccounts.Flags_IsNotNull = true
+	}
+
+	// Settings has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("Settings") != "" {
+		newAccounts.Settings_IsNotNull = true
+	}
+
+	newAccounts.Balance, err = To_Numeric_FromString(req.FormValue("Balance"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	newAccounts.OpenedOn, err = To_Time_FromString(req.FormValue("OpenedOn"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+	if err == nil && req.FormValue("OpenedOn") != "" {
+		newAccounts.OpenedOn_IsNotNull = true
+	}
+
+	newAccounts.CreatedAt, err = To_Time_FromString(req.FormValue("CreatedAt"))
+	if err != nil {
+		return nil, NewModelsError(errorPrefix, err)
+	}
+
+	return newAccounts, nil
+}
+
+// Creates a new pointer to a Accounts from an Http Request.
+// The parameters are expected to match the struct field names
+// Unlike CreateFromHttpRequest, this method completely does not exit when encountering parsing errors,
+// but accumulates them into an []error slice. Futher Validation is needed.
+func (utilRef *tAccountsUtils) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Accounts, []error) {
+
+	if req == nil {
+
+		var errorPrefix = "AccountsUtils.CreateFromHttpRequestIgnoreErrors() ERROR: "
+		noReqErrors := []error{NewModelsErrorLocal(errorPrefix, "The *http.Request parameter provided was nil.")}
+		return nil, noReqErrors
+	}
+
+	var errors []error
+	var currentError error
+
+	newAccounts := &Accounts{}
+
+	newAccounts.CloneGlobalSettings()
+
+	newAccounts.AccountId, currentError = To_int64_FromString(req.FormValue("AccountId"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.AccountGuid = req.FormValue("AccountGuid")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.Email = req.FormValue("Email")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.Status = req.FormValue("Status")
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.PreviousStatus = req.FormValue("PreviousStatus")
+
+	if req.FormValue("PreviousStatus") != "" {
+		newAccounts.PreviousStatus_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.Flags = req.FormValue("Flags")
+
+	if req.FormValue("Flags") != "" {
+		newAccounts.Flags_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	// Settings has a custom Go type that cannot be parsed from a string, it has to be set by the caller
+
+	if currentError == nil && req.FormValue("Settings") != "" {
+		newAccounts.Settings_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.Balance, currentError = To_Numeric_FromString(req.FormValue("Balance"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.OpenedOn, currentError = To_Time_FromString(req.FormValue("OpenedOn"))
+
+	if currentError == nil && req.FormValue("OpenedOn") != "" {
+		newAccounts.OpenedOn_IsNotNull = true
+	}
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+	newAccounts.CreatedAt, currentError = To_Time_FromString(req.FormValue("CreatedAt"))
+	if currentError != nil {
+		errors = append(errors, currentError)
+	}
+
+	return newAccounts, errors
+}
+
+// CloneGlobalSettings assigns the global settings for operations to the control fields
+// of this instance. An example would be:
+// instanceAccounts.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+func (instance *Accounts) CloneGlobalSettings() {
+
+	instance.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence = Tables.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence
+	instance.PgToGo_SetDateTimeFieldsToNowForNewRecords = Tables.PgToGo_SetDateTimeFieldsToNowForNewRecords
+	instance.PgToGo_SetGuidFieldsToNewGuidsNewRecords = Tables.PgToGo_SetGuidFieldsToNewGuidsNewRecords
+
+}
+
+// Returns the database field name, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tAccountsUtils) ToDbFieldName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "AccountId" || fieldDbOrGoName == "account_id" {
+		return "account_id"
+	}
+	if fieldDbOrGoName == "AccountGuid" || fieldDbOrGoName == "account_guid" {
+		return "account_guid"
+	}
+	if fieldDbOrGoName == "Email" || fieldDbOrGoName == "email" {
+		return "email"
+	}
+	if fieldDbOrGoName == "Status" || fieldDbOrGoName == "status" {
+		return "status"
+	}
+	if fieldDbOrGoName == "PreviousStatus" || fieldDbOrGoName == "previous_status" {
+		return "previous_status"
+	}
+	if fieldDbOrGoName == "Flags" || fieldDbOrGoName == "flags" {
+		return "flags"
+	}
+	if fieldDbOrGoName == "Settings" || fieldDbOrGoName == "settings" {
+		return "settings"
+	}
+	if fieldDbOrGoName == "Balance" || fieldDbOrGoName == "balance" {
+		return "balance"
+	}
+	if fieldDbOrGoName == "OpenedOn" || fieldDbOrGoName == "opened_on" {
+		return "opened_on"
+	}
+	if fieldDbOrGoName == "CreatedAt" || fieldDbOrGoName == "created_at" {
+		return "created_at"
+	}
+
+	return ""
+}
+
+// Returns the database field type, regardless whether the Go name or the db name was provided.
+// If no field was found, return empty string.
+func (utilRef *tAccountsUtils) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+
+	if fieldDbOrGoName == "AccountId" || fieldDbOrGoName == "account_id" {
+		return "bigint"
+	}
+	if fieldDbOrGoName == "AccountGuid" || fieldDbOrGoName == "account_guid" {
+		return "uuid"
+	}
+	if fieldDbOrGoName == "Email" || fieldDbOrGoName == "email" {
+		return "character varying"
+	}
+	if fieldDbOrGoName == "Status" || fieldDbOrGoName == "status" {
+		return "text"
+	}
+	if fieldDbOrGoName == "PreviousStatus" || fieldDbOrGoName == "previous_status" {
+		return "text"
+	}
+	if fieldDbOrGoName == "Flags" || fieldDbOrGoName == "flags" {
+		return "ARRAY"
+	}
+	if fieldDbOrGoName == "Settings" || fieldDbOrGoName == "settings" {
+		return "jsonb"
+	}
+	if fieldDbOrGoName == "Balance" || fieldDbOrGoName == "balance" {
+		return "numeric"
+	}
+	if fieldDbOrGoName == "OpenedOn" || fieldDbOrGoName == "opened_on" {
+		return "date"
+	}
+	if fieldDbOrGoName == "CreatedAt" || fieldDbOrGoName == "created_at" {
+		return "timestamp with time zone"
+	}
+
+	return ""
+}
+
+// LoadedColumns returns the columns the Accounts was selected with by WithColumns, nil when it holds all of them
+func (t *Accounts) LoadedColumns() []string {
+	return t.pgToGo_projection.loadedColumns()
+}
+
+// scanAccounts scans a row of the columns of the projection, all of them when it is nil, into a new Accounts
+func scanAccounts(scan func(dest ...interface{}) error, projection *columnProjection) (Accounts, error) {
+
+	currentAccounts := Accounts{pgToGo_projection: projection}
+
+	// BEGIN: if any nullable fields, create temporary nullable variables to receive null values
+	var nullablePreviousStatus sql.NullString
+	var nullableFlags sql.NullString
+	var nullableSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var nullableOpenedOn sql.NullTime
+
+	// END: if any nullable fields, create temporary nullable variables to receive null values
+
+	var dest []interface{}
+	if projection == nil {
+		dest = []interface{}{&currentAccounts.AccountId, &currentAccounts.AccountGuid, &currentAccounts.Email, &currentAccounts.Status, &nullablePreviousStatus, &nullableFlags, &nullableSettings, &currentAccounts.Balance, &nullableOpenedOn, &currentAccounts.CreatedAt}
+	} else {
+		for _, column := range projection.columns {
+			switch column {
+			case "account_id":
+				dest = append(dest, &currentAccounts.AccountId)
+			case "account_guid":
+				dest = append(dest, &currentAccounts.AccountGuid)
+			case "email":
+				dest = append(dest, &currentAccounts.Email)
+			case "status":
+				dest = append(dest, &currentAccounts.Status)
+			case "previous_status":
+				dest = append(dest, &nullablePreviousStatus)
+			case "flags":
+				dest = append(dest, &nullableFlags)
+			case "settings":
+				dest = append(dest, &nullableSettings)
+			case "balance":
+				dest = append(dest, &currentAccounts.Balance)
+			case "opened_on":
+				dest = append(dest, &nullableOpenedOn)
+			case "created_at":
+				dest = append(dest, &currentAccounts.CreatedAt)
+			}
+		}
+	}
+
+	err := scan(dest...)
+	if err != nil {
+		return currentAccounts, err
+	}
+
+	// BEGIN: assign any nullable values selected to the nullable fields inside the struct appropriately
+	if projection.loads("previous_status") {
+		currentAccounts.SetPreviousStatus(nullablePreviousStatus.String, nullablePreviousStatus.Valid)
+	}
+	if projection.loads("flags") {
+		currentAccounts.SetFlags(nullableFlags.String, nullableFlags.Valid)
+	}
+	if projection.loads("settings") {
+		currentAccounts.SetSettings(*nullableSettings.Target.(*map[string]string), nullableSettings.Valid)
+	}
+	if projection.loads("opened_on") {
+		currentAccounts.SetOpenedOn(nullableOpenedOn.Time, nullableOpenedOn.Valid)
+	}
+
+	// END: assign any nullable values selected to the nullable fields inside the struct appropriately
+
+	return currentAccounts, nil
+}
+
+// AccountsCols holds the columns of accounts, building the predicates and the ordering of the queries,
+// e.g. AccountsCols.AccountId.Eq(value)
+var AccountsCols = struct {
+	AccountId      QueryColumn
+	AccountGuid    QueryColumn
+	Email          QueryColumn
+	Status         QueryColumn
+	PreviousStatus QueryColumn
+	Flags          QueryColumn
+	Settings       QueryColumn
+	Balance        QueryColumn
+	OpenedOn       QueryColumn
+	CreatedAt      QueryColumn
+}{
+	AccountId:      QueryColumn{name: "account_id"},
+	AccountGuid:    QueryColumn{name: "account_guid"},
+	Email:          QueryColumn{name: "email"},
+	Status:         QueryColumn{name: "status"},
+	PreviousStatus: QueryColumn{name: "previous_status"},
+	Flags:          QueryColumn{name: "flags"},
+	Settings:       QueryColumn{name: "settings"},
+	Balance:        QueryColumn{name: "balance"},
+	OpenedOn:       QueryColumn{name: "opened_on"},
+	CreatedAt:      QueryColumn{name: "created_at"},
+}
+
+// isAccountsColumn tells whether the database name is one of the columns of accounts
+func isAccountsColumn(dbName string) bool {
+
+	switch dbName {
+	case "account_id", "account_guid", "email", "status", "previous_status", "flags", "settings", "balance", "opened_on", "created_at":
+		return true
+	}
+
+	return false
+}
+
+// Select is SelectCtx with the background context
+func (utilRef *tAccountsUtils) Select(where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns the rows from accounts matching the predicate, e.g.
+// AccountsCols.AccountId.Eq(value), or Raw(condition, params...).
+// The options, e.g. OrderBy or Limit, apply to the query.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (utilRef *tAccountsUtils) SelectUnion(where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx performs a union between select queries from accounts,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectUnion() ERROR: "
+
+	var isUnionAll = false
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (utilRef *tAccountsUtils) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx performs a union between select queries from accounts,
+// each matching one of the predicates, whose placeholders are numbered one after the other.
+//
+// The options, e.g. OrderBy or Limit, apply to the whole union, which cannot lock the rows:
+// ForUpdate and ForShare return an error.
+//
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectUnionAll() ERROR: "
+
+	var isUnionAll = true
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query, the placeholders of each predicate numbered after the ones of the previous
+	var queryParts []string
+	var params []interface{}
+
+	lcMinusOne := len(where) - 1
+	for cIdx := range where {
+		if where[cIdx].IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+		}
+		condition, conditionParams := where[cIdx].Condition(len(params) + 1)
+		params = append(params, conditionParams...)
+
+		queryParts = append(queryParts, selectQuery+"WHERE ")
+		queryParts = append(queryParts, condition)
+		if cIdx != lcMinusOne {
+			if isUnionAll {
+				queryParts = append(queryParts, " UNION ALL ")
+			} else {
+				queryParts = append(queryParts, " UNION ")
+			}
+		}
+	}
+
+	// the ORDER BY, LIMIT and OFFSET of the options apply to the whole union
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (utilRef *tAccountsUtils) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns the rows from accounts matching the predicate.
+// The options, e.g. OrderBy or Limit, apply to the query, and to its cache key; ForUpdate and ForShare return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentAccountsRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentAccountsRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfAccounts)
+		}
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (utilRef *tAccountsUtils) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns the paginated rows from accounts matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, except Limit and Offset, which the page sets.
+// This version is not cached and calls the database directly.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectPage() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (utilRef *tAccountsUtils) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns the page of the rows from accounts matching the predicate.
+// The pageNumber parameter (must be greater than or equal to 1) indicates the page offset from the beginning of the resultset.
+// The pageSize parameter (must be greater than or equal to 1) indicates how many maximum records are to be returned.
+// If pageNumber is 1, there is no offset.
+// The options apply to the query, and to its cache key, except Limit and Offset, which the page sets,
+// and ForUpdate and ForShare, which return an error.
+// The cacheOption parameter is one of the PgToGoFlagCache[behaviour] global integer constants.
+// PgToGoFlagCacheDisable has a value of 0, and caching is completely bypassed.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectPageCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	var whereClauseHash string = ""
+	var hashErr error = nil
+
+	if cacheOption > PgToGoFlagCacheDisable {
+
+		if cacheOption == PgToGoFlagCacheUse || cacheOption == PgToGoFlagCacheReload {
+
+			whereClauseHash, hashErr = GetHashFromConditionAndParams(selectQuery+condition+selectClause, params...)
+			if hashErr != nil {
+				return nil, NewModelsError(errorPrefix+"GetHashFromConditionAndParams() error:", hashErr)
+			}
+
+			// because this is a pagination-based method, we need to append the pageSize and pageNum to the cache key
+			var whereClauseHashPaginated []string = []string{whereClauseHash}
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageSize:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageSize))
+			whereClauseHashPaginated = append(whereClauseHashPaginated, "-pageNumber:")
+			whereClauseHashPaginated = append(whereClauseHashPaginated, Itoa(pageNumber))
+
+			whereClauseHash = JoinStringParts(whereClauseHashPaginated, "")
+
+		}
+
+		// check the caching options - in case it's PgToGoFlagCacheUse and there is cache available no need to go further
+		if cacheOption == PgToGoFlagCacheUse {
+
+			// try to get the rows from cache, if enabled and valid
+			if currentAccountsRowsFromCache, cacheValid := utilRef.Cache.GetWhere(whereClauseHash); cacheValid == true {
+
+				return currentAccountsRowsFromCache, nil
+			}
+		}
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	// before returning the result, make sure to insert it into cache if instructed
+	if cacheOption > PgToGoFlagCacheDisable && whereClauseHash != "" {
+
+		if cacheOption != PgToGoFlagCacheDelete {
+			utilRef.Cache.SetWhere(whereClauseHash, sliceOfAccounts)
+		}
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (utilRef *tAccountsUtils) SelectAll(options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectAllCtx(context.Background(), options...)
+}
+
+// Returns all the rows from accounts.
+// The options, e.g. OrderBy or Limit, apply to the query, which then bypasses the cache.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectClause == "" && selectOptions.projection == nil {
+		return allAccountsRowsFromCache, nil
+	}
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (utilRef *tAccountsUtils) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// Returns all the rows from accounts ordered by the field names specified in the orderBy parameter.
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query as they do to SelectAll, the OrderBy option replacing the orderBy parameter,
+// which must then be empty. The rows are read from the cache only when they are neither ordered nor projected.
+// The rows are converted to a slice of Accounts instances
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectAllOrderBy() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+		return allAccountsRowsFromCache, nil
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (utilRef *tAccountsUtils) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error) {
+	return utilRef.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// Returns a page of rows from accounts equal to pageSize,
+// with the appropriate offset determined by pageNumber (starting from 1, not 0).
+// The orderBy parameter must not contain the 'ORDER BY' keywords, and it can be empty,
+// in which case the results are unpredictable.
+// The options apply to the query, the OrderBy option replacing the orderBy parameter, which must then be empty,
+// except Limit and Offset, which the page sets. The page is read from the cache only when the rows
+// are neither ordered nor projected.
+// The rows are converted to a slice of Accounts instances
+// If operation succeeds, it returns the page-restricted rows, and nil as error.
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.orderedBy(orderBy); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	// try to get the rows from cache, if enabled and valid
+	if allAccountsRowsFromCache, cacheValid := utilRef.Cache.GetAllRows(); cacheValid == true && selectOptions.plain() {
+
+		// the last page may be shorter, and the pages after it empty
+		pageStart := (pageNumber - 1) * pageSize
+		if pageStart > len(allAccountsRowsFromCache) {
+			pageStart = len(allAccountsRowsFromCache)
+		}
+		pageEnd := pageStart + pageSize
+		if pageEnd > len(allAccountsRowsFromCache) {
+			pageEnd = len(allAccountsRowsFromCache)
+		}
+
+		return allAccountsRowsFromCache[pageStart:pageEnd], nil
+	}
+
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	rows, err := currentDbHandle.Query(ctx, selectQuery+selectClause)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var sliceOfAccounts []Accounts
+
+	for rows.Next() {
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		sliceOfAccounts = append(sliceOfAccounts, currentAccounts)
+
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during rows.Next() iterations:", err)
+	}
+
+	return sliceOfAccounts, nil
+}
+
+// SelectAccounts is SelectAccountsCtx with the background context
+func (txWrapper *Transaction) SelectAccounts(where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return txWrapper.SelectAccountsCtx(context.Background(), where, options...)
+}
+
+// SelectAccountsCtx runs SelectCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SelectCtx(...) does.
+func (txWrapper *Transaction) SelectAccountsCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return NewDB(txWrapper).Accounts.SelectCtx(ctx, where, options...)
+}
+
+// SelectCachedAccounts is SelectCachedAccountsCtx with the background context
+func (txWrapper *Transaction) SelectCachedAccounts(cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return txWrapper.SelectCachedAccountsCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedAccountsCtx runs SelectCachedCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SelectCachedCtx(...) does.
+func (txWrapper *Transaction) SelectCachedAccountsCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return NewDB(txWrapper).Accounts.SelectCachedCtx(ctx, cacheOption, where, options...)
+}
+
+// SelectPageAccounts is SelectPageAccountsCtx with the background context
+func (txWrapper *Transaction) SelectPageAccounts(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return txWrapper.SelectPageAccountsCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageAccountsCtx runs SelectPageCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SelectPageCtx(...) does.
+func (txWrapper *Transaction) SelectPageAccountsCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return NewDB(txWrapper).Accounts.SelectPageCtx(ctx, pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCachedAccounts is SelectPageCachedAccountsCtx with the background context
+func (txWrapper *Transaction) SelectPageCachedAccounts(pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return txWrapper.SelectPageCachedAccountsCtx(context.Background(), pageSize, pageNumber, cacheOption, where, options...)
+}
+
+// SelectPageCachedAccountsCtx runs SelectPageCachedCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SelectPageCachedCtx(...) does.
+func (txWrapper *Transaction) SelectPageCachedAccountsCtx(ctx context.Context, pageSize int, pageNumber int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return NewDB(txWrapper).Accounts.SelectPageCachedCtx(ctx, pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectAllAccounts is SelectAllAccountsCtx with the background context
+func (txWrapper *Transaction) SelectAllAccounts(options ...SelectOption) ([]Accounts, error) {
+	return txWrapper.SelectAllAccountsCtx(context.Background(), options...)
+}
+
+// SelectAllAccountsCtx runs SelectAllCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SelectAllCtx(...) does.
+func (txWrapper *Transaction) SelectAllAccountsCtx(ctx context.Context, options ...SelectOption) ([]Accounts, error) {
+	return NewDB(txWrapper).Accounts.SelectAllCtx(ctx, options...)
+}
+
+/* ************************************************************ */
+/* BEGIN: Caching Functionality for Accounts         */
+/* ************************************************************ */
+
+type CacheForAccounts struct {
+	enabled bool // flag to determine if caching is enabled for Accounts
+
+	sliceCache      map[string][]Accounts
+	sliceCacheMutex sync.RWMutex
+
+	whereCache      map[string][]Accounts
+	whereCacheMutex sync.RWMutex
+
+	singleRowCache      map[string]Accounts
+	singleRowCacheMutex sync.RWMutex
+
+	all      []Accounts
+	allMutex sync.RWMutex
+
+	// the utilities EnableAndLoadAllRows selects the rows with, Tables.Accounts when nil
+	utils *tAccountsUtils
+
+	CacheProvider ICacheProvider
+}
+
+func (c *CacheForAccounts) Init() {
+
+	if c.sliceCache == nil {
+		c.sliceCache = make(map[string][]Accounts)
+	}
+	if c.whereCache == nil {
+		c.whereCache = make(map[string][]Accounts)
+	}
+	if c.singleRowCache == nil {
+		c.singleRowCache = make(map[string]Accounts)
+	}
+
+}
+
+func (c *CacheForAccounts) Dealloc() {
+
+	if c.sliceCache != nil {
+		c.sliceCache = nil
+	}
+	if c.whereCache != nil {
+		c.whereCache = nil
+	}
+	if c.singleRowCache != nil {
+		c.singleRowCache = nil
+	}
+
+	if c.all != nil {
+		c.all = nil
+	}
+
+}
+
+func (c *CacheForAccounts) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *CacheForAccounts) Enable() {
+
+	c.enabled = true
+	c.Init()
+}
+
+func (c *CacheForAccounts) Disable() {
+
+	c.enabled = false
+	c.Dealloc()
+
+}
+
+// EnableAndLoadAllRows is EnableAndLoadAllRowsCtx with the background context
+func (c *CacheForAccounts) EnableAndLoadAllRows() {
+	c.EnableAndLoadAllRowsCtx(context.Background())
+}
+
+// Enables caching for accounts and loads all rows inside the cache.
+// This should only be used for small-sized lookup tables, not for tables that can
+// grow to huge numbers of records. Since the result set is unordered, please use
+// the SortBy functionality to sort the result set when needed
+func (c *CacheForAccounts) EnableAndLoadAllRowsCtx(ctx context.Context) {
+
+	c.Enable()
+
+	utils := c.utils
+	if utils == nil {
+		utils = &Tables.Accounts
+	}
+	allRows, err := utils.SelectAllCtx(ctx)
+	if err == nil && len(allRows) > 0 {
+		c.SetAllRows(allRows)
+	}
+
+}
+
+func (c *CacheForAccounts) GetAllRows() ([]Accounts, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.RLock()
+		allRecords := c.all
+		c.allMutex.RUnlock()
+
+		return allRecords, (allRecords != nil)
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetAllRows sets or refreshes the cache for all Accounts records in the database.
+func (c *CacheForAccounts) SetAllRows(all []Accounts) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if all != nil {
+
+			c.allMutex.Lock()
+
+			// empty the slice and release its memory to GC
+			if c.all != nil {
+				c.all = nil
+			}
+
+			c.all = append(c.all, all...)
+			c.allMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteAllRows deletes the dedicated cache store for all Accounts records in the database.
+func (c *CacheForAccounts) DeleteAllRows() {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.allMutex.Lock()
+
+		// empty the slice and release its memory to GC
+		if c.all != nil {
+			c.all = nil
+		}
+
+		c.allMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// GetWhere, enables caching of the Where methods (together with SetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForAccounts) GetWhere(key string) ([]Accounts, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.RLock()
+		wAccounts, keyExists := c.whereCache[key]
+		c.whereCacheMutex.RUnlock()
+
+		return wAccounts, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetWhere, enables caching of the Where methods (together with GetWhere).
+// The condition that gets cached acts as the cache store key.
+func (c *CacheForAccounts) SetWhere(key string, sliceAccounts []Accounts) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceAccounts != nil {
+
+			whereSliceCopy := make([]Accounts, len(sliceAccounts))
+			copy(whereSliceCopy, sliceAccounts)
+
+			c.whereCacheMutex.Lock()
+			c.whereCache[key] = whereSliceCopy
+			c.whereCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+}
+
+// DeleteWhere removes the cache item corresponding to key.
+func (c *CacheForAccounts) DeleteWhere(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.whereCacheMutex.Lock()
+		delete(c.whereCache, key)
+		c.whereCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// GetSlice returns a slice of Accounts from the cache store based on
+// the given key.
+func (c *CacheForAccounts) GetSlice(key string) ([]Accounts, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.RLock()
+		sAccounts, keyExists := c.sliceCache[key]
+		c.sliceCacheMutex.RUnlock()
+
+		return sAccounts, keyExists
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+
+}
+
+// SetSlice caches a slice of Accounts inside the cache store based and
+// associates it with the given key.
+func (c *CacheForAccounts) SetSlice(key string, sliceAccounts []Accounts) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		if sliceAccounts != nil {
+
+			sliceCopy := make([]Accounts, len(sliceAccounts))
+			copy(sliceCopy, sliceAccounts)
+
+			c.sliceCacheMutex.Lock()
+			c.sliceCache[key] = sliceCopy
+			c.sliceCacheMutex.Unlock()
+		}
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// DeleteSlice removes the slice of Accounts from the cache store entry
+// associated with key.
+func (c *CacheForAccounts) DeleteSlice(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.sliceCacheMutex.Lock()
+		delete(c.sliceCache, key)
+		c.sliceCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+// Get retrives a *Accounts from the cache store if it exists.
+// The second, boolean return value indicates whether the value was actually found.
+func (c *CacheForAccounts) Get(key string) (*Accounts, bool) {
+
+	if c.enabled == false {
+		return nil, false
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.RLock()
+		singleCachedObject, exists := c.singleRowCache[key]
+		c.singleRowCacheMutex.RUnlock()
+
+		if exists {
+			return &singleCachedObject, true
+		}
+
+		return nil, false
+	}
+
+	// todo: implement CacheProvider functionality
+	return nil, false
+}
+
+// Set associates a Accounts with key, and saves it in the cache store.
+func (c *CacheForAccounts) Set(key string, structAccounts Accounts) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		c.singleRowCache[key] = structAccounts
+		c.singleRowCacheMutex.Unlock()
+
+	}
+
+	// todo: implement CacheProvider functionality
+
+}
+
+// Delete removes the Accounts instance that is associated with key from the
+// cache store.
+func (c *CacheForAccounts) Delete(key string) {
+
+	if c.enabled == false {
+		return
+	}
+
+	// if cache provider is nil use memory cache via the built-in
+	// map and mutex combo
+	if c.CacheProvider == nil {
+
+		c.singleRowCacheMutex.Lock()
+		delete(c.singleRowCache, key)
+		c.singleRowCacheMutex.Unlock()
+
+		return
+	}
+
+	// todo: implement CacheProvider functionality
+	return
+
+}
+
+/* ************************************************************ */
+/* END: Caching Functionality for Accounts           */
+/* ************************************************************ */
+
+// Count is CountCtx with the background context
+func (utilRef *tAccountsUtils) Count(where ...Predicate) (int64, error) {
+	return utilRef.CountCtx(context.Background(), where...)
+}
+
+// Returns the number of rows from accounts matching all the predicates, e.g.
+// AccountsCols.AccountId.Eq(value), or of all the rows without any.
+// This version is accurate, but can be slow. For a faster version, user CountImprecise.
+// If an error occures, it returns -1 and the error.
+func (utilRef *tAccountsUtils) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.Count() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT COUNT(*) FROM accounts"
+	var totalRows int64
+
+	condition, params := And(where...).Condition(1)
+	if condition != "" {
+		query = query + " WHERE " + condition
+	}
+
+	err := currentDbHandle.QueryRow(ctx, query, params...).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return totalRows, nil
+}
+
+// CountImprecise is CountImpreciseCtx with the background context
+func (utilRef *tAccountsUtils) CountImprecise() (int64, error) {
+	return utilRef.CountImpreciseCtx(context.Background())
+}
+
+// Returns the number of rows from accounts
+// This version is less accurate, but much faster. It depends on the table being
+// vacuum-analyzed regularly. With autovacuum results are quite accurate
+func (utilRef *tAccountsUtils) CountImpreciseCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.CountImprecise() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return -1, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the select query
+	var query string = "SELECT reltuples FROM pg_class WHERE oid = 'public.accounts'::regclass;"
+
+	// the reltuples is real (oid 700) so we need to retrieve it using a float32 value
+	var totalRows float32
+
+	err := currentDbHandle.QueryRow(ctx, query).Scan(&totalRows)
+	if err != nil {
+		return -1, NewModelsError(errorPrefix+" error during QueryRow() or Scan():", err)
+	}
+
+	return int64(totalRows), nil
+}
+
+// Single is SingleCtx with the background context
+func (utilRef *tAccountsUtils) Single(where Predicate, options ...SelectOption) (*Accounts, error) {
+	return utilRef.SingleCtx(context.Background(), where, options...)
+}
+
+// Returns the a single record from accounts matching the predicate.
+// If no record is found, nil is returned. If an error occures, the function returns nil and the error.
+// The options, e.g. OrderBy with Limit(1) to get the first row, apply to the query.
+// In case more than one record is found, an ErrTooManyRows error is thrown.
+func (utilRef *tAccountsUtils) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.Single() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	condition, params := where.Condition(1)
+	selectOptions := applySelectOptions(options)
+
+	selectClause, err := selectOptions.clause(isAccountsColumn)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	selectQuery := selectOptions.query("SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts ", "accounts")
+
+	// define the select query
+	var queryParts []string
+
+	queryParts = append(queryParts, selectQuery+"WHERE ")
+	queryParts = append(queryParts, condition)
+	queryParts = append(queryParts, selectClause)
+
+	rows, err := currentDbHandle.Query(ctx, JoinStringParts(queryParts, ""), params...)
+
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" fatal error running the query:", err)
+	}
+	defer rows.Close()
+
+	var instanceOfAccounts *Accounts
+
+	var iteration int = 0
+
+	for rows.Next() {
+
+		if iteration > 0 {
+			return nil, ErrTooManyRows
+		}
+
+		// scanAccounts creates a new instance of Accounts
+		currentAccounts, err := scanAccounts(rows.Scan, selectOptions.projection)
+		if err != nil {
+			return nil, NewModelsError(errorPrefix+" error during rows.Scan():", err)
+		}
+
+		instanceOfAccounts = &currentAccounts
+		iteration = iteration + 1
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" error during single row fetching:", err)
+	}
+
+	return instanceOfAccounts, nil
+}
+
+// SingleAccounts is SingleAccountsCtx with the background context
+func (txWrapper *Transaction) SingleAccounts(where Predicate, options ...SelectOption) (*Accounts, error) {
+	return txWrapper.SingleAccountsCtx(context.Background(), where, options...)
+}
+
+// SingleAccountsCtx runs SingleCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.SingleCtx(...) does.
+func (txWrapper *Transaction) SingleAccountsCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Accounts, error) {
+	return NewDB(txWrapper).Accounts.SingleCtx(ctx, where, options...)
+}
+
+// SelectWhereJSONPath is SelectWhereJSONPathCtx with the background context
+func (utilRef *tAccountsUtils) SelectWhereJSONPath(column string, path []string, value interface{}) ([]Accounts, error) {
+	return utilRef.SelectWhereJSONPathCtx(context.Background(), column, path, value)
+}
+
+// SelectWhereJSONPathCtx returns the rows from accounts whose json document in the given column
+// holds the value at the given path (e.g. []string{"address", "city"}).
+// A string value is compared with the text found at the path (column #>> path = value),
+// any other value is matched by containment (column @> {"address": {"city": value}}).
+// The column is the database name of one of the json columns: settings.
+// This version is not cached and calls the database directly.
+func (utilRef *tAccountsUtils) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.SelectWhereJSONPath() ERROR: "
+
+	switch column {
+	case "settings":
+	default:
+		return nil, NewModelsErrorLocal(errorPrefix, "not a json column of accounts: "+column)
+	}
+
+	if len(path) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the path is empty")
+	}
+
+	if text, isString := value.(string); isString {
+		return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+" #>> $1::text[] = $2", textArray(path), text))
+	}
+
+	// nest the value inside the path, from the innermost key outwards
+	document := value
+	for i := len(path) - 1; i >= 0; i-- {
+		document = map[string]interface{}{path[i]: document}
+	}
+
+	documentBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, NewModelsError(errorPrefix+" could not marshal the value:", err)
+	}
+
+	return utilRef.SelectCtx(ctx, Raw(quoteIdentifier(column)+"::jsonb @> $1::jsonb", string(documentBytes)))
+}
+
+// keysetAccounts holds the unique keys of accounts, and the columns its SelectAfter pages can be ordered by
+var keysetAccounts = keyset{
+	keys: [][]string{
+		{"account_id"},
+		{"account_guid"},
+		{"email"},
+	},
+	columns: []string{"account_id", "account_guid", "email", "status", "balance", "created_at"},
+}
+
+// keysetValue returns the value of the row's column, which the cursors hold
+func (utilRef *tAccountsUtils) keysetValue(row *Accounts, dbName string) interface{} {
+
+	switch dbName {
+	case "account_id":
+		return row.AccountId
+	case "account_guid":
+		return row.AccountGuid
+	case "email":
+		return row.Email
+	case "status":
+		return row.Status
+	case "balance":
+		return row.Balance
+	case "created_at":
+		return row.CreatedAt
+	}
+	return nil
+}
+
+// keysetParam returns the value of the column decoded from a cursor
+func (utilRef *tAccountsUtils) keysetParam(dbName string, value json.RawMessage) (interface{}, error) {
+
+	switch dbName {
+	case "account_id":
+		var param int64
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "account_guid":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "email":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "status":
+		var param string
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "balance":
+		var param Numeric
+		err := json.Unmarshal(value, &param)
+		return param, err
+	case "created_at":
+		var param time.Time
+		err := json.Unmarshal(value, &param)
+		return param, err
+	}
+	return nil, fmt.Errorf("%s is not one of the columns of the keyset", dbName)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (utilRef *tAccountsUtils) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error) {
+	return utilRef.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns the page of at most pageSize rows from accounts matching the predicate,
+// the zero one for all the rows, following the cursor, the empty one for the first page, and the cursor
+// of the next page, empty after the last page. The predicate must be the same for all the pages.
+// The pages are ordered by the OrderBy option, which must include one of the unique keys of accounts
+// (account_id; account_guid; email) and no nullable column, by default by the first one, ascending.
+// A cursor is only valid with the ordering it was returned for, ErrInvalidCursor otherwise.
+// Unlike SelectPage, the pages do not slow down as they go, nor skip or repeat rows when rows
+// are inserted or deleted meanwhile. The ForUpdate and ForShare options apply, Limit and Offset do not.
+func (utilRef *tAccountsUtils) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error) {
+
+	var errorPrefix = "AccountsUtils.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetAccounts.after(cursor, pageSize, &selectOptions, utilRef.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	page := And(where, after)
+	if page.IsZero() {
+		page = Raw("TRUE")
+	}
+
+	rows, err := utilRef.SelectCtx(ctx, page, selectOptions.option())
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetAccounts.cursor(selectOptions, func(dbName string) interface{} {
+		return utilRef.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Insert is InsertCtx with the background context
+func (utilRef *tAccountsUtils) Insert(sourceAccounts *Accounts) (*Accounts, error) {
+	return utilRef.InsertCtx(context.Background(), sourceAccounts)
+}
+
+// InsertCtx inserts a new row into the accounts table, using the values
+// inside the pointer to a Accounts structure passed to it.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (utilRef *tAccountsUtils) InsertCtx(ctx context.Context, sourceAccounts *Accounts) (*Accounts, error) {
+
+	var errorPrefix = "AccountsUtils.Insert() ERROR: "
+
+	if sourceAccounts == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define returning PK params for the insert query row execution
+	var paramAccountId int64
+
+	// define the insert query
+	var insertQueryAllColumns = "INSERT INTO accounts(account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)  RETURNING account_id"
+	var insertQueryNoPKColumns = "INSERT INTO accounts(account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)  RETURNING account_id"
+
+	var query string = insertQueryAllColumns
+
+	if sourceAccounts.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		query = insertQueryNoPKColumns
+	}
+
+	// pq does not support the LastInsertId() method of the Result type in database/sql.
+	// To return the identifier of an INSERT (or UPDATE or DELETE), use the Postgres RETURNING clause
+	// with a standard Query or QueryRow call
+
+	var err error
+
+	if sourceAccounts.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceAccounts.OpenedOn = Now()
+		sourceAccounts.CreatedAt = Now()
+
+	}
+
+	if sourceAccounts.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+		sourceAccounts.AccountGuid = NewGuid()
+
+	}
+
+	// define the values to be passed, from the structure
+	var _account_id, _account_guid, _email, _status, _previous_status, _flags, _settings, _balance, _opened_on, _created_at = sourceAccounts.AccountId, sourceAccounts.AccountGuid, sourceAccounts.Email, sourceAccounts.Status, sql.NullString{String: sourceAccounts.PreviousStatus, Valid: sourceAccounts.PreviousStatus_IsNotNull}, sql.NullString{String: sourceAccounts.Flags, Valid: sourceAccounts.Flags_IsNotNull}, JSONColumn{Target: sourceAccounts.Settings, Valid: sourceAccounts.Settings_IsNotNull}, sourceAccounts.Balance.EmbeddedVal(), sql.NullTime{Time: sourceAccounts.OpenedOn, Valid: sourceAccounts.OpenedOn_IsNotNull}, sourceAccounts.CreatedAt
+
+	// this will print only if debug mode enabled
+	Debug("Insert Query:", query)
+
+	if sourceAccounts.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		err = currentDbHandle.QueryRow(ctx, query, _account_guid, _email, _status, _previous_status, _flags, _settings, _balance, _opened_on, _created_at).Scan(&paramAccountId)
+	} else {
+		err = currentDbHandle.QueryRow(ctx, query, _account_id, _account_guid, _email, _status, _previous_status, _flags, _settings, _balance, _opened_on, _created_at).Scan(&paramAccountId)
+	}
+
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "accounts_account_guid_key") {
+				return nil, ErrAccounts_UQ_accounts_account_guid_key
+			}
+			if Contains(err.Error(), "accounts_email_key") {
+				return nil, ErrAccounts_UQ_accounts_email_key
+			}
+
+		}
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// populate the returning ids inside the returnStructure pointer
+		sourceAccounts.AccountId = paramAccountId
+
+		// return the structure
+		return sourceAccounts, nil
+	}
+}
+
+// Insert is InsertCtx with the background context
+func (sourceAccounts *Accounts) Insert() (*Accounts, error) {
+	return sourceAccounts.InsertCtx(context.Background())
+}
+
+// InsertCtx inserts a new row into the accounts table, corresponding to the provided sourceAccounts
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+// If operation fails, it returns nil and the error
+func (sourceAccounts *Accounts) InsertCtx(ctx context.Context) (*Accounts, error) {
+
+	return Tables.Accounts.InsertCtx(ctx, sourceAccounts)
+}
+
+// InsertAccounts is InsertAccountsCtx with the background context
+func (txWrapper *Transaction) InsertAccounts(sourceAccounts *Accounts) (*Accounts, error) {
+	return txWrapper.InsertAccountsCtx(context.Background(), sourceAccounts)
+}
+
+// InsertAccountsCtx runs InsertCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.InsertCtx(...) does.
+func (txWrapper *Transaction) InsertAccountsCtx(ctx context.Context, sourceAccounts *Accounts) (*Accounts, error) {
+	return NewDB(txWrapper).Accounts.InsertCtx(ctx, sourceAccounts)
+}
+
+// Update is UpdateCtx with the background context
+func (utilRef *tAccountsUtils) Update(sourceAccounts *Accounts, where Predicate) (int64, error) {
+	return utilRef.UpdateCtx(context.Background(), sourceAccounts, where)
+}
+
+// UpdateCtx attempts to update the rows inside the accounts table, based on
+// the supplied predicate, whose placeholders are numbered after the ones of the 10 columns.
+// All the fields in the supplied source Accounts pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// Returns the number of affected rows (zero if no rows found for that condition),
+// and nil error for a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tAccountsUtils) UpdateCtx(ctx context.Context, sourceAccounts *Accounts, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside accounts")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE accounts SET account_id = $1,account_guid = $2,email = $3,status = $4,previous_status = $5,flags = $6,settings = $7,balance = $8,opened_on = $9,created_at = $10 WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(11)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceAccounts.AccountId, sourceAccounts.AccountGuid, sourceAccounts.Email, sourceAccounts.Status, sql.NullString{String: sourceAccounts.PreviousStatus, Valid: sourceAccounts.PreviousStatus_IsNotNull}, sql.NullString{String: sourceAccounts.Flags, Valid: sourceAccounts.Flags_IsNotNull}, JSONColumn{Target: sourceAccounts.Settings, Valid: sourceAccounts.Settings_IsNotNull}, sourceAccounts.Balance, sql.NullTime{Time: sourceAccounts.OpenedOn, Valid: sourceAccounts.OpenedOn_IsNotNull}, sourceAccounts.CreatedAt}
+
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "accounts_account_guid_key") {
+				return 0, ErrAccounts_UQ_accounts_account_guid_key
+			}
+			if Contains(err.Error(), "accounts_email_key") {
+				return 0, ErrAccounts_UQ_accounts_email_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateAccounts is UpdateAccountsCtx with the background context
+func (txWrapper *Transaction) UpdateAccounts(sourceAccounts *Accounts, where Predicate) (int64, error) {
+	return txWrapper.UpdateAccountsCtx(context.Background(), sourceAccounts, where)
+}
+
+// UpdateAccountsCtx runs UpdateCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.UpdateCtx(...) does.
+func (txWrapper *Transaction) UpdateAccountsCtx(ctx context.Context, sourceAccounts *Accounts, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Accounts.UpdateCtx(ctx, sourceAccounts, where)
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (utilRef *tAccountsUtils) UpdateWithMask(sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+	return utilRef.UpdateWithMaskCtx(context.Background(), sourceAccounts, updateMask, where)
+}
+
+// UpdateWithMaskCtx attempts to update the rows inside the accounts table matching the predicate,
+// whose placeholders are numbered after the ones of the update mask.
+// Only the fields in the supplied mask slice of strings will be updated.
+// If the mask is nil, all fields will be updated.
+// Returns the number of affected rows (zero if no rows found for that condition), and nil error
+// in case of a successful operation. If the operation fails, it returns 0 and the error.
+func (utilRef *tAccountsUtils) UpdateWithMaskCtx(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside accounts")
+	}
+
+	if updateMask == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE accounts SET ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	var instanceValuesSlice []interface{}
+	for i, e := range updateMask {
+
+		_, writeErr = queryBuffer.WriteString(utilRef.ToDbFieldName(e))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString("=$")
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		_, writeErr = queryBuffer.WriteString(Itoa(i + 1))
+		if writeErr != nil {
+			return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+		}
+
+		// add a comma if not the final set
+		if len(updateMask) != (i + 1) {
+			_, writeErr = queryBuffer.WriteString(",")
+			if writeErr != nil {
+				return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error (inside range updateMask):", writeErr)
+			}
+		}
+
+		if e == "AccountId" || e == "account_id" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.AccountId)
+		}
+		if e == "AccountGuid" || e == "account_guid" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.AccountGuid)
+		}
+		if e == "Email" || e == "email" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.Email)
+		}
+		if e == "Status" || e == "status" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.Status)
+		}
+		if e == "PreviousStatus" || e == "previous_status" {
+			instanceValuesSlice = append(instanceValuesSlice, sql.NullString{String: sourceAccounts.PreviousStatus, Valid: sourceAccounts.PreviousStatus_IsNotNull})
+		}
+		if e == "Flags" || e == "flags" {
+			instanceValuesSlice = append(instanceValuesSlice, sql.NullString{String: sourceAccounts.Flags, Valid: sourceAccounts.Flags_IsNotNull})
+		}
+		if e == "Settings" || e == "settings" {
+			instanceValuesSlice = append(instanceValuesSlice, JSONColumn{Target: sourceAccounts.Settings, Valid: sourceAccounts.Settings_IsNotNull})
+		}
+		if e == "Balance" || e == "balance" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.Balance)
+		}
+		if e == "OpenedOn" || e == "opened_on" {
+			instanceValuesSlice = append(instanceValuesSlice, sql.NullTime{Time: sourceAccounts.OpenedOn, Valid: sourceAccounts.OpenedOn_IsNotNull})
+		}
+		if e == "CreatedAt" || e == "created_at" {
+			instanceValuesSlice = append(instanceValuesSlice, sourceAccounts.CreatedAt)
+		}
+
+	}
+
+	_, writeErr = queryBuffer.WriteString(" WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	condition, params := where.Condition(len(updateMask) + 1)
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	// append the condition's params to the ones of the setters
+	allParams := append(instanceValuesSlice, params...)
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), allParams...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "accounts_account_guid_key") {
+				return 0, ErrAccounts_UQ_accounts_account_guid_key
+			}
+			if Contains(err.Error(), "accounts_email_key") {
+				return 0, ErrAccounts_UQ_accounts_email_key
+			}
+
+		}
+
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// UpdateWithMaskAccounts is UpdateWithMaskAccountsCtx with the background context
+func (txWrapper *Transaction) UpdateWithMaskAccounts(sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+	return txWrapper.UpdateWithMaskAccountsCtx(context.Background(), sourceAccounts, updateMask, where)
+}
+
+// UpdateWithMaskAccountsCtx runs UpdateWithMaskCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.UpdateWithMaskCtx(...) does.
+func (txWrapper *Transaction) UpdateWithMaskAccountsCtx(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Accounts.UpdateWithMaskCtx(ctx, sourceAccounts, updateMask, where)
+}
+
+// Update is UpdateCtx with the background context
+func (sourceAccounts *Accounts) Update() error {
+	return sourceAccounts.UpdateCtx(context.Background())
+}
+
+// UpdateCtx attempts to update the row inside the accounts table, corresponding
+// to the PK of the current Accounts instance.
+// All the fields in the supplied source Accounts pointer will be updated.
+// If you need only certain fields to be updated, you will have to create a custom method,
+// or use UpdateWithMask().
+// An instance selected WithColumns returns ErrColumnsNotLoaded, unless MarkAllColumnsLoaded was called;
+// UpdateWithMask with its LoadedColumns writes back only those.
+// Returns nil error for a successful operation. If operation fails, it returns the error.
+// If more than one row gets updated, it will return an error.
+func (sourceAccounts *Accounts) UpdateCtx(ctx context.Context) error {
+	return sourceAccounts.updateOn(ctx, nil)
+}
+
+// updateOn updates the row of the instance on the querier, GetDb() if nil
+func (sourceAccounts *Accounts) updateOn(ctx context.Context, querier Querier) error {
+
+	var errorPrefix = "instance of Accounts.Update() ERROR: "
+
+	// the fields of the columns left out by WithColumns hold their zero values, not those of the row
+	if sourceAccounts.pgToGo_projection != nil {
+		return ErrColumnsNotLoaded
+	}
+
+	currentDbHandle := dbQuerier(querier)
+	if currentDbHandle == nil {
+		return NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the update query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("UPDATE accounts SET account_id = $1,account_guid = $2,email = $3,status = $4,previous_status = $5,flags = $6,settings = $7,balance = $8,opened_on = $9,created_at = $10 WHERE ")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString("account_id=$11")
+	if writeErr != nil {
+		return NewModelsError(errorPrefix+"queryBuffer.WriteString (instance condition param) error:", writeErr)
+	}
+
+	instanceValuesSlice := []interface{}{sourceAccounts.AccountId, sourceAccounts.AccountGuid, sourceAccounts.Email, sourceAccounts.Status, sql.NullString{String: sourceAccounts.PreviousStatus, Valid: sourceAccounts.PreviousStatus_IsNotNull}, sql.NullString{String: sourceAccounts.Flags, Valid: sourceAccounts.Flags_IsNotNull}, JSONColumn{Target: sourceAccounts.Settings, Valid: sourceAccounts.Settings_IsNotNull}, sourceAccounts.Balance, sql.NullTime{Time: sourceAccounts.OpenedOn, Valid: sourceAccounts.OpenedOn_IsNotNull}, sourceAccounts.CreatedAt, sourceAccounts.AccountId}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), instanceValuesSlice...)
+	if err != nil {
+
+		if Contains(err.Error(), "SQLSTATE 23505") {
+			if Contains(err.Error(), "accounts_account_guid_key") {
+				return ErrAccounts_UQ_accounts_account_guid_key
+			}
+			if Contains(err.Error(), "accounts_email_key") {
+				return ErrAccounts_UQ_accounts_email_key
+			}
+
+		}
+
+		return NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	if n > 1 {
+		return NewModelsErrorLocal(errorPrefix, "More than one record was updated: "+Itoa(int(n)))
+	}
+	return nil
+
+}
+
+// UpdateSingleInstanceAccounts is UpdateSingleInstanceAccountsCtx with the background context
+func (txWrapper *Transaction) UpdateSingleInstanceAccounts(sourceAccounts *Accounts) error {
+	return txWrapper.UpdateSingleInstanceAccountsCtx(context.Background(), sourceAccounts)
+}
+
+// UpdateSingleInstanceAccountsCtx runs the UpdateCtx method of the sourceAccounts instance in the transaction.
+func (txWrapper *Transaction) UpdateSingleInstanceAccountsCtx(ctx context.Context, sourceAccounts *Accounts) error {
+	return sourceAccounts.updateOn(ctx, txWrapper)
+}
+
+// Delete is DeleteCtx with the background context
+func (utilRef *tAccountsUtils) Delete(where Predicate) (int64, error) {
+	return utilRef.DeleteCtx(context.Background(), where)
+}
+
+// Deletes the rows from the accounts table matching the predicate, e.g.
+// AccountsCols.AccountId.Eq(value), or Raw(condition, params...).
+// Returns the number of deleted rows (zero if no rows found for that condition), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tAccountsUtils) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from accounts")
+	}
+	condition, params := where.Condition(1)
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define the delete query
+	queryBuffer := bytes.Buffer{}
+	_, writeErr := queryBuffer.WriteString("DELETE FROM accounts WHERE ")
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString error:", writeErr)
+	}
+
+	_, writeErr = queryBuffer.WriteString(condition)
+	if writeErr != nil {
+		return 0, NewModelsError(errorPrefix+"queryBuffer.WriteString (condition param) error:", writeErr)
+	}
+
+	r, err := currentDbHandle.Exec(ctx, queryBuffer.String(), params...)
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"db.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteAccounts is DeleteAccountsCtx with the background context
+func (txWrapper *Transaction) DeleteAccounts(where Predicate) (int64, error) {
+	return txWrapper.DeleteAccountsCtx(context.Background(), where)
+}
+
+// DeleteAccountsCtx runs DeleteCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.DeleteCtx(...) does.
+func (txWrapper *Transaction) DeleteAccountsCtx(ctx context.Context, where Predicate) (int64, error) {
+	return NewDB(txWrapper).Accounts.DeleteCtx(ctx, where)
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (utilRef *tAccountsUtils) DeleteInstance(sourceAccounts *Accounts) (bool, error) {
+	return utilRef.DeleteInstanceCtx(context.Background(), sourceAccounts)
+}
+
+// Deletes the row from the accounts table, corresponding to the primary key fields
+// inside the sourceAccounts parameter.
+// Returns true if the row was deleted, or false and nil error if no such PK value was found in the database.
+// If operation fails, it returns nil and the error.
+func (utilRef *tAccountsUtils) DeleteInstanceCtx(ctx context.Context, sourceAccounts *Accounts) (bool, error) {
+
+	var errorPrefix = "AccountsUtils.DeleteInstance() ERROR: "
+
+	if sourceAccounts == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+
+	// define the condition based on the PK columns
+	deleteInstanceCondition := And(
+		AccountsCols.AccountId.Eq(sourceAccounts.AccountId),
+	)
+
+	rowCount, err := utilRef.DeleteCtx(ctx, deleteInstanceCondition)
+	if err != nil {
+		return false, NewModelsError(errorPrefix, err)
+	}
+
+	if rowCount > 1 {
+		return false, NewModelsError(errorPrefix+": FATAL ERROR: Too many rows deleted !! : ", err)
+	}
+
+	return rowCount == 1, nil
+
+}
+
+// DeleteInstanceAccounts is DeleteInstanceAccountsCtx with the background context
+func (txWrapper *Transaction) DeleteInstanceAccounts(sourceAccounts *Accounts) (bool, error) {
+	return txWrapper.DeleteInstanceAccountsCtx(context.Background(), sourceAccounts)
+}
+
+// DeleteInstanceAccountsCtx runs DeleteInstanceCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.DeleteInstanceCtx(...) does.
+func (txWrapper *Transaction) DeleteInstanceAccountsCtx(ctx context.Context, sourceAccounts *Accounts) (bool, error) {
+	return NewDB(txWrapper).Accounts.DeleteInstanceCtx(ctx, sourceAccounts)
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (utilRef *tAccountsUtils) DeleteAll() (int64, error) {
+	return utilRef.DeleteAllCtx(context.Background())
+}
+
+// Deletes all existing rows from the accounts table.
+// Returns the number of deleted rows (zero if no rows found), and nil error for a successful operation.
+// If operation fails, it returns zero and the error.
+func (utilRef *tAccountsUtils) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	var errorPrefix = "AccountsUtils.DeleteAll() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	r, err := currentDbHandle.Exec(ctx, "DELETE FROM accounts")
+	if err != nil {
+		return 0, NewModelsError(errorPrefix+"currentDbHandle.Exec error:", err)
+	}
+
+	n := r.RowsAffected()
+	return n, nil
+
+}
+
+// DeleteAllAccounts is DeleteAllAccountsCtx with the background context
+func (txWrapper *Transaction) DeleteAllAccounts() (int64, error) {
+	return txWrapper.DeleteAllAccountsCtx(context.Background())
+}
+
+// DeleteAllAccountsCtx runs DeleteAllCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.DeleteAllCtx(...) does.
+func (txWrapper *Transaction) DeleteAllAccountsCtx(ctx context.Context) (int64, error) {
+	return NewDB(txWrapper).Accounts.DeleteAllCtx(ctx)
+}
+
+// GetByAccountId is GetByAccountIdCtx with the background context
+func (utilRef *tAccountsUtils) GetByAccountId(inputAccountId int64) (*Accounts, error) {
+	return utilRef.GetByAccountIdCtx(context.Background(), inputAccountId)
+}
+
+// Queries the database for a single row based on the specified single or multi-column primary key.
+// Returns a pointer to a Accounts structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tAccountsUtils) GetByAccountIdCtx(ctx context.Context, inputAccountId int64) (returnStruct *Accounts, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "AccountsGetByAccountId() ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramAccountId int64
+	var paramAccountGuid string
+	var paramEmail string
+	var paramStatus string
+	var paramPreviousStatus sql.NullString
+	var paramFlags sql.NullString
+	var paramSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var paramBalance Numeric
+	var paramOpenedOn sql.NullTime
+	var paramCreatedAt time.Time
+
+	// define the select query
+	var query = "SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts  WHERE account_id = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputAccountId).Scan(&paramAccountId, &paramAccountGuid, &paramEmail, &paramStatus, &paramPreviousStatus, &paramFlags, &paramSettings, &paramBalance, &paramOpenedOn, &paramCreatedAt)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Accounts{
+			AccountId:   paramAccountId,
+			AccountGuid: paramAccountGuid,
+			Email:       paramEmail,
+			Status:      paramStatus,
+			Balance:     paramBalance,
+			CreatedAt:   paramCreatedAt,
+		}
+		returnStruct.SetPreviousStatus(paramPreviousStatus.String, paramPreviousStatus.Valid)
+		returnStruct.SetFlags(paramFlags.String, paramFlags.Valid)
+		returnStruct.SetSettings(*paramSettings.Target.(*map[string]string), paramSettings.Valid)
+		returnStruct.SetOpenedOn(paramOpenedOn.Time, paramOpenedOn.Valid)
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetAccountsByAccountId is GetAccountsByAccountIdCtx with the background context
+func (txWrapper *Transaction) GetAccountsByAccountId(inputAccountId int64) (*Accounts, error) {
+	return txWrapper.GetAccountsByAccountIdCtx(context.Background(), inputAccountId)
+}
+
+// GetAccountsByAccountIdCtx runs GetByAccountIdCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.GetByAccountIdCtx(...) does.
+func (txWrapper *Transaction) GetAccountsByAccountIdCtx(ctx context.Context, inputAccountId int64) (*Accounts, error) {
+	return NewDB(txWrapper).Accounts.GetByAccountIdCtx(ctx, inputAccountId)
+}
+
+// GetByUniqueAccountGuid is GetByUniqueAccountGuidCtx with the background context
+func (utilRef *tAccountsUtils) GetByUniqueAccountGuid(inputAccountGuid string) (*Accounts, error) {
+	return utilRef.GetByUniqueAccountGuidCtx(context.Background(), inputAccountGuid)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Accounts structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tAccountsUtils) GetByUniqueAccountGuidCtx(ctx context.Context, inputAccountGuid string) (returnStruct *Accounts, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "AccountsGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramAccountId int64
+	var paramAccountGuid string
+	var paramEmail string
+	var paramStatus string
+	var paramPreviousStatus sql.NullString
+	var paramFlags sql.NullString
+	var paramSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var paramBalance Numeric
+	var paramOpenedOn sql.NullTime
+	var paramCreatedAt time.Time
+
+	// define the select query
+	var query = "SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts  WHERE account_guid = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputAccountGuid).Scan(&paramAccountId, &paramAccountGuid, &paramEmail, &paramStatus, &paramPreviousStatus, &paramFlags, &paramSettings, &paramBalance, &paramOpenedOn, &paramCreatedAt)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Accounts{
+			AccountId:   paramAccountId,
+			AccountGuid: paramAccountGuid,
+			Email:       paramEmail,
+			Status:      paramStatus,
+			Balance:     paramBalance,
+			CreatedAt:   paramCreatedAt,
+		}
+		returnStruct.SetPreviousStatus(paramPreviousStatus.String, paramPreviousStatus.Valid)
+		returnStruct.SetFlags(paramFlags.String, paramFlags.Valid)
+		returnStruct.SetSettings(*paramSettings.Target.(*map[string]string), paramSettings.Valid)
+		returnStruct.SetOpenedOn(paramOpenedOn.Time, paramOpenedOn.Valid)
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetAccountsByUniqueAccountGuid is GetAccountsByUniqueAccountGuidCtx with the background context
+func (txWrapper *Transaction) GetAccountsByUniqueAccountGuid(inputAccountGuid string) (*Accounts, error) {
+	return txWrapper.GetAccountsByUniqueAccountGuidCtx(context.Background(), inputAccountGuid)
+}
+
+// GetAccountsByUniqueAccountGuidCtx runs GetByUniqueAccountGuidCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.GetByUniqueAccountGuidCtx(...) does.
+func (txWrapper *Transaction) GetAccountsByUniqueAccountGuidCtx(ctx context.Context, inputAccountGuid string) (*Accounts, error) {
+	return NewDB(txWrapper).Accounts.GetByUniqueAccountGuidCtx(ctx, inputAccountGuid)
+}
+
+// GetByUniqueEmail is GetByUniqueEmailCtx with the background context
+func (utilRef *tAccountsUtils) GetByUniqueEmail(inputEmail string) (*Accounts, error) {
+	return utilRef.GetByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// Queries the database for a single row based on the specified single or multi-column unique constraint.
+// Returns a pointer to a Accounts structure if a record was found,
+// otherwise it returns nil.
+func (utilRef *tAccountsUtils) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (returnStruct *Accounts, err error) {
+
+	returnStruct = nil
+	err = nil
+
+	var errorPrefix = "AccountsGetByUnique ERROR: "
+
+	currentDbHandle := dbQuerier(utilRef.querier)
+	if currentDbHandle == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the database handle is nil")
+	}
+
+	// define receiving params for the row iteration
+	var paramAccountId int64
+	var paramAccountGuid string
+	var paramEmail string
+	var paramStatus string
+	var paramPreviousStatus sql.NullString
+	var paramFlags sql.NullString
+	var paramSettings JSONColumn = JSONColumn{Target: new(map[string]string)}
+	var paramBalance Numeric
+	var paramOpenedOn sql.NullTime
+	var paramCreatedAt time.Time
+
+	// define the select query
+	var query = "SELECT account_id, account_guid, email, status, previous_status, flags, settings, balance, opened_on, created_at FROM accounts  WHERE email = $1"
+
+	// we are aiming for a single row so we will use Query Row
+	err = currentDbHandle.QueryRow(ctx, query, inputEmail).Scan(&paramAccountId, &paramAccountGuid, &paramEmail, &paramStatus, &paramPreviousStatus, &paramFlags, &paramSettings, &paramBalance, &paramOpenedOn, &paramCreatedAt)
+	switch {
+	case err == ErrNoRows:
+		// no such row found, return nil and nil
+		return nil, nil
+	case err != nil:
+		return nil, NewModelsError(errorPrefix+"fatal error running the query:", err)
+	default:
+		// create the return structure as a pointer of the type
+		returnStruct = &Accounts{
+			AccountId:   paramAccountId,
+			AccountGuid: paramAccountGuid,
+			Email:       paramEmail,
+			Status:      paramStatus,
+			Balance:     paramBalance,
+			CreatedAt:   paramCreatedAt,
+		}
+		returnStruct.SetPreviousStatus(paramPreviousStatus.String, paramPreviousStatus.Valid)
+		returnStruct.SetFlags(paramFlags.String, paramFlags.Valid)
+		returnStruct.SetSettings(*paramSettings.Target.(*map[string]string), paramSettings.Valid)
+		returnStruct.SetOpenedOn(paramOpenedOn.Time, paramOpenedOn.Valid)
+
+		// return the structure
+		return returnStruct, nil
+	}
+}
+
+// GetAccountsByUniqueEmail is GetAccountsByUniqueEmailCtx with the background context
+func (txWrapper *Transaction) GetAccountsByUniqueEmail(inputEmail string) (*Accounts, error) {
+	return txWrapper.GetAccountsByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// GetAccountsByUniqueEmailCtx runs GetByUniqueEmailCtx of Accounts in the transaction,
+// as txWrapper.DB().Accounts.GetByUniqueEmailCtx(...) does.
+func (txWrapper *Transaction) GetAccountsByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Accounts, error) {
+	return NewDB(txWrapper).Accounts.GetByUniqueEmailCtx(ctx, inputEmail)
+}
+
+// AccountsRepository holds the methods of Tables.Accounts, so that the code using them
+// can depend on the interface, and be unit tested with a AccountsRepositoryMock.
+// The DB utilities of NewDB implement it as well.
+type AccountsRepository interface {
+	New() *Accounts
+	CreateFromHttpRequest(req *http.Request) (*Accounts, error)
+	CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Accounts, []error)
+	ToDbFieldName(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColName(fieldDbOrGoName string) string
+	Select(where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnion(where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionAll(where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectAll(options ...SelectOption) ([]Accounts, error)
+	SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Accounts, error)
+	SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error)
+	Count(where ...Predicate) (int64, error)
+	CountCtx(ctx context.Context, where ...Predicate) (int64, error)
+	CountImprecise() (int64, error)
+	CountImpreciseCtx(ctx context.Context) (int64, error)
+	Single(where Predicate, options ...SelectOption) (*Accounts, error)
+	SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Accounts, error)
+	SelectWhereJSONPath(column string, path []string, value interface{}) ([]Accounts, error)
+	SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) ([]Accounts, error)
+	SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error)
+	SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error)
+	Insert(sourceAccounts *Accounts) (*Accounts, error)
+	InsertCtx(ctx context.Context, sourceAccounts *Accounts) (*Accounts, error)
+	Update(sourceAccounts *Accounts, where Predicate) (int64, error)
+	UpdateCtx(ctx context.Context, sourceAccounts *Accounts, where Predicate) (int64, error)
+	UpdateWithMask(sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtx(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error)
+	Delete(where Predicate) (int64, error)
+	DeleteCtx(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstance(sourceAccounts *Accounts) (bool, error)
+	DeleteInstanceCtx(ctx context.Context, sourceAccounts *Accounts) (bool, error)
+	DeleteAll() (int64, error)
+	DeleteAllCtx(ctx context.Context) (int64, error)
+	GetByAccountId(inputAccountId int64) (*Accounts, error)
+	GetByAccountIdCtx(ctx context.Context, inputAccountId int64) (*Accounts, error)
+	GetByUniqueAccountGuid(inputAccountGuid string) (*Accounts, error)
+	GetByUniqueAccountGuidCtx(ctx context.Context, inputAccountGuid string) (*Accounts, error)
+	GetByUniqueEmail(inputEmail string) (*Accounts, error)
+	GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Accounts, error)
+}
+
+var _ AccountsRepository = (*tAccountsUtils)(nil)
+
+// AccountsRepositoryMock is a AccountsRepository for the unit tests. Each method records
+// the call, then returns what the function of the same name, with a Func suffix, returns.
+// It returns the zero values when that function is not set.
+type AccountsRepositoryMock struct {
+	MockCalls
+
+	NewFunc                               func() *Accounts
+	CreateFromHttpRequestFunc             func(req *http.Request) (*Accounts, error)
+	CreateFromHttpRequestIgnoreErrorsFunc func(req *http.Request) (*Accounts, []error)
+	ToDbFieldNameFunc                     func(fieldDbOrGoName string) string
+	ToDbFieldTypeFromColNameFunc          func(fieldDbOrGoName string) string
+	SelectFunc                            func(where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionFunc                       func(where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionCtxFunc                    func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionAllFunc                    func(where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectUnionAllCtxFunc                 func(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCachedFunc                      func(cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectCachedCtxFunc                   func(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageFunc                        func(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCtxFunc                     func(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCachedFunc                  func(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectPageCachedCtxFunc               func(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error)
+	SelectAllFunc                         func(options ...SelectOption) ([]Accounts, error)
+	SelectAllCtxFunc                      func(ctx context.Context, options ...SelectOption) ([]Accounts, error)
+	SelectAllOrderByFunc                  func(orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllOrderByCtxFunc               func(ctx context.Context, orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllPageFunc                     func(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error)
+	SelectAllPageCtxFunc                  func(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error)
+	CountFunc                             func(where ...Predicate) (int64, error)
+	CountCtxFunc                          func(ctx context.Context, where ...Predicate) (int64, error)
+	CountImpreciseFunc                    func() (int64, error)
+	CountImpreciseCtxFunc                 func(ctx context.Context) (int64, error)
+	SingleFunc                            func(where Predicate, options ...SelectOption) (*Accounts, error)
+	SingleCtxFunc                         func(ctx context.Context, where Predicate, options ...SelectOption) (*Accounts, error)
+	SelectWhereJSONPathFunc               func(column string, path []string, value interface{}) ([]Accounts, error)
+	SelectWhereJSONPathCtxFunc            func(ctx context.Context, column string, path []string, value interface{}) ([]Accounts, error)
+	SelectAfterFunc                       func(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error)
+	SelectAfterCtxFunc                    func(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error)
+	InsertFunc                            func(sourceAccounts *Accounts) (*Accounts, error)
+	InsertCtxFunc                         func(ctx context.Context, sourceAccounts *Accounts) (*Accounts, error)
+	UpdateFunc                            func(sourceAccounts *Accounts, where Predicate) (int64, error)
+	UpdateCtxFunc                         func(ctx context.Context, sourceAccounts *Accounts, where Predicate) (int64, error)
+	UpdateWithMaskFunc                    func(sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error)
+	UpdateWithMaskCtxFunc                 func(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error)
+	DeleteFunc                            func(where Predicate) (int64, error)
+	DeleteCtxFunc                         func(ctx context.Context, where Predicate) (int64, error)
+	DeleteInstanceFunc                    func(sourceAccounts *Accounts) (bool, error)
+	DeleteInstanceCtxFunc                 func(ctx context.Context, sourceAccounts *Accounts) (bool, error)
+	DeleteAllFunc                         func() (int64, error)
+	DeleteAllCtxFunc                      func(ctx context.Context) (int64, error)
+	GetByAccountIdFunc                    func(inputAccountId int64) (*Accounts, error)
+	GetByAccountIdCtxFunc                 func(ctx context.Context, inputAccountId int64) (*Accounts, error)
+	GetByUniqueAccountGuidFunc            func(inputAccountGuid string) (*Accounts, error)
+	GetByUniqueAccountGuidCtxFunc         func(ctx context.Context, inputAccountGuid string) (*Accounts, error)
+	GetByUniqueEmailFunc                  func(inputEmail string) (*Accounts, error)
+	GetByUniqueEmailCtxFunc               func(ctx context.Context, inputEmail string) (*Accounts, error)
+}
+
+var _ AccountsRepository = (*AccountsRepositoryMock)(nil)
+
+// New records the call and runs NewFunc
+func (mock *AccountsRepositoryMock) New() (result0 *Accounts) {
+	mock.Record("New")
+	if mock.NewFunc != nil {
+		return mock.NewFunc()
+	}
+	return
+}
+
+// CreateFromHttpRequest records the call and runs CreateFromHttpRequestFunc
+func (mock *AccountsRepositoryMock) CreateFromHttpRequest(req *http.Request) (result0 *Accounts, result1 error) {
+	mock.Record("CreateFromHttpRequest", req)
+	if mock.CreateFromHttpRequestFunc != nil {
+		return mock.CreateFromHttpRequestFunc(req)
+	}
+	return
+}
+
+// CreateFromHttpRequestIgnoreErrors records the call and runs CreateFromHttpRequestIgnoreErrorsFunc
+func (mock *AccountsRepositoryMock) CreateFromHttpRequestIgnoreErrors(req *http.Request) (result0 *Accounts, result1 []error) {
+	mock.Record("CreateFromHttpRequestIgnoreErrors", req)
+	if mock.CreateFromHttpRequestIgnoreErrorsFunc != nil {
+		return mock.CreateFromHttpRequestIgnoreErrorsFunc(req)
+	}
+	return
+}
+
+// ToDbFieldName records the call and runs ToDbFieldNameFunc
+func (mock *AccountsRepositoryMock) ToDbFieldName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldName", fieldDbOrGoName)
+	if mock.ToDbFieldNameFunc != nil {
+		return mock.ToDbFieldNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// ToDbFieldTypeFromColName records the call and runs ToDbFieldTypeFromColNameFunc
+func (mock *AccountsRepositoryMock) ToDbFieldTypeFromColName(fieldDbOrGoName string) (result0 string) {
+	mock.Record("ToDbFieldTypeFromColName", fieldDbOrGoName)
+	if mock.ToDbFieldTypeFromColNameFunc != nil {
+		return mock.ToDbFieldTypeFromColNameFunc(fieldDbOrGoName)
+	}
+	return
+}
+
+// Select records the call and runs SelectFunc
+func (mock *AccountsRepositoryMock) Select(where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("Select", where, options)
+	if mock.SelectFunc != nil {
+		return mock.SelectFunc(where, options...)
+	}
+	return
+}
+
+// SelectCtx records the call and runs SelectCtxFunc
+func (mock *AccountsRepositoryMock) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectCtx", ctx, where, options)
+	if mock.SelectCtxFunc != nil {
+		return mock.SelectCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnion records the call and runs SelectUnionFunc
+func (mock *AccountsRepositoryMock) SelectUnion(where []Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectUnion", where, options)
+	if mock.SelectUnionFunc != nil {
+		return mock.SelectUnionFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionCtx records the call and runs SelectUnionCtxFunc
+func (mock *AccountsRepositoryMock) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectUnionCtx", ctx, where, options)
+	if mock.SelectUnionCtxFunc != nil {
+		return mock.SelectUnionCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectUnionAll records the call and runs SelectUnionAllFunc
+func (mock *AccountsRepositoryMock) SelectUnionAll(where []Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectUnionAll", where, options)
+	if mock.SelectUnionAllFunc != nil {
+		return mock.SelectUnionAllFunc(where, options...)
+	}
+	return
+}
+
+// SelectUnionAllCtx records the call and runs SelectUnionAllCtxFunc
+func (mock *AccountsRepositoryMock) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectUnionAllCtx", ctx, where, options)
+	if mock.SelectUnionAllCtxFunc != nil {
+		return mock.SelectUnionAllCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectCached records the call and runs SelectCachedFunc
+func (mock *AccountsRepositoryMock) SelectCached(cacheOption int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectCached", cacheOption, where, options)
+	if mock.SelectCachedFunc != nil {
+		return mock.SelectCachedFunc(cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectCachedCtx records the call and runs SelectCachedCtxFunc
+func (mock *AccountsRepositoryMock) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectCachedCtx", ctx, cacheOption, where, options)
+	if mock.SelectCachedCtxFunc != nil {
+		return mock.SelectCachedCtxFunc(ctx, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPage records the call and runs SelectPageFunc
+func (mock *AccountsRepositoryMock) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectPage", pageNumber, pageSize, where, options)
+	if mock.SelectPageFunc != nil {
+		return mock.SelectPageFunc(pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCtx records the call and runs SelectPageCtxFunc
+func (mock *AccountsRepositoryMock) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectPageCtx", ctx, pageNumber, pageSize, where, options)
+	if mock.SelectPageCtxFunc != nil {
+		return mock.SelectPageCtxFunc(ctx, pageNumber, pageSize, where, options...)
+	}
+	return
+}
+
+// SelectPageCached records the call and runs SelectPageCachedFunc
+func (mock *AccountsRepositoryMock) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectPageCached", pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedFunc != nil {
+		return mock.SelectPageCachedFunc(pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectPageCachedCtx records the call and runs SelectPageCachedCtxFunc
+func (mock *AccountsRepositoryMock) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectPageCachedCtx", ctx, pageNumber, pageSize, cacheOption, where, options)
+	if mock.SelectPageCachedCtxFunc != nil {
+		return mock.SelectPageCachedCtxFunc(ctx, pageNumber, pageSize, cacheOption, where, options...)
+	}
+	return
+}
+
+// SelectAll records the call and runs SelectAllFunc
+func (mock *AccountsRepositoryMock) SelectAll(options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAll", options)
+	if mock.SelectAllFunc != nil {
+		return mock.SelectAllFunc(options...)
+	}
+	return
+}
+
+// SelectAllCtx records the call and runs SelectAllCtxFunc
+func (mock *AccountsRepositoryMock) SelectAllCtx(ctx context.Context, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAllCtx", ctx, options)
+	if mock.SelectAllCtxFunc != nil {
+		return mock.SelectAllCtxFunc(ctx, options...)
+	}
+	return
+}
+
+// SelectAllOrderBy records the call and runs SelectAllOrderByFunc
+func (mock *AccountsRepositoryMock) SelectAllOrderBy(orderBy string, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAllOrderBy", orderBy, options)
+	if mock.SelectAllOrderByFunc != nil {
+		return mock.SelectAllOrderByFunc(orderBy, options...)
+	}
+	return
+}
+
+// SelectAllOrderByCtx records the call and runs SelectAllOrderByCtxFunc
+func (mock *AccountsRepositoryMock) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAllOrderByCtx", ctx, orderBy, options)
+	if mock.SelectAllOrderByCtxFunc != nil {
+		return mock.SelectAllOrderByCtxFunc(ctx, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPage records the call and runs SelectAllPageFunc
+func (mock *AccountsRepositoryMock) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAllPage", pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageFunc != nil {
+		return mock.SelectAllPageFunc(pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// SelectAllPageCtx records the call and runs SelectAllPageCtxFunc
+func (mock *AccountsRepositoryMock) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) (result0 []Accounts, result1 error) {
+	mock.Record("SelectAllPageCtx", ctx, pageNumber, pageSize, orderBy, options)
+	if mock.SelectAllPageCtxFunc != nil {
+		return mock.SelectAllPageCtxFunc(ctx, pageNumber, pageSize, orderBy, options...)
+	}
+	return
+}
+
+// Count records the call and runs CountFunc
+func (mock *AccountsRepositoryMock) Count(where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("Count", where)
+	if mock.CountFunc != nil {
+		return mock.CountFunc(where...)
+	}
+	return
+}
+
+// CountCtx records the call and runs CountCtxFunc
+func (mock *AccountsRepositoryMock) CountCtx(ctx context.Context, where ...Predicate) (result0 int64, result1 error) {
+	mock.Record("CountCtx", ctx, where)
+	if mock.CountCtxFunc != nil {
+		return mock.CountCtxFunc(ctx, where...)
+	}
+	return
+}
+
+// CountImprecise records the call and runs CountImpreciseFunc
+func (mock *AccountsRepositoryMock) CountImprecise() (result0 int64, result1 error) {
+	mock.Record("CountImprecise")
+	if mock.CountImpreciseFunc != nil {
+		return mock.CountImpreciseFunc()
+	}
+	return
+}
+
+// CountImpreciseCtx records the call and runs CountImpreciseCtxFunc
+func (mock *AccountsRepositoryMock) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("CountImpreciseCtx", ctx)
+	if mock.CountImpreciseCtxFunc != nil {
+		return mock.CountImpreciseCtxFunc(ctx)
+	}
+	return
+}
+
+// Single records the call and runs SingleFunc
+func (mock *AccountsRepositoryMock) Single(where Predicate, options ...SelectOption) (result0 *Accounts, result1 error) {
+	mock.Record("Single", where, options)
+	if mock.SingleFunc != nil {
+		return mock.SingleFunc(where, options...)
+	}
+	return
+}
+
+// SingleCtx records the call and runs SingleCtxFunc
+func (mock *AccountsRepositoryMock) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (result0 *Accounts, result1 error) {
+	mock.Record("SingleCtx", ctx, where, options)
+	if mock.SingleCtxFunc != nil {
+		return mock.SingleCtxFunc(ctx, where, options...)
+	}
+	return
+}
+
+// SelectWhereJSONPath records the call and runs SelectWhereJSONPathFunc
+func (mock *AccountsRepositoryMock) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Accounts, result1 error) {
+	mock.Record("SelectWhereJSONPath", column, path, value)
+	if mock.SelectWhereJSONPathFunc != nil {
+		return mock.SelectWhereJSONPathFunc(column, path, value)
+	}
+	return
+}
+
+// SelectWhereJSONPathCtx records the call and runs SelectWhereJSONPathCtxFunc
+func (mock *AccountsRepositoryMock) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Accounts, result1 error) {
+	mock.Record("SelectWhereJSONPathCtx", ctx, column, path, value)
+	if mock.SelectWhereJSONPathCtxFunc != nil {
+		return mock.SelectWhereJSONPathCtxFunc(ctx, column, path, value)
+	}
+	return
+}
+
+// SelectAfter records the call and runs SelectAfterFunc
+func (mock *AccountsRepositoryMock) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Accounts, result1 string, result2 error) {
+	mock.Record("SelectAfter", where, cursor, pageSize, options)
+	if mock.SelectAfterFunc != nil {
+		return mock.SelectAfterFunc(where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// SelectAfterCtx records the call and runs SelectAfterCtxFunc
+func (mock *AccountsRepositoryMock) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) (result0 []Accounts, result1 string, result2 error) {
+	mock.Record("SelectAfterCtx", ctx, where, cursor, pageSize, options)
+	if mock.SelectAfterCtxFunc != nil {
+		return mock.SelectAfterCtxFunc(ctx, where, cursor, pageSize, options...)
+	}
+	return
+}
+
+// Insert records the call and runs InsertFunc
+func (mock *AccountsRepositoryMock) Insert(sourceAccounts *Accounts) (result0 *Accounts, result1 error) {
+	mock.Record("Insert", sourceAccounts)
+	if mock.InsertFunc != nil {
+		return mock.InsertFunc(sourceAccounts)
+	}
+	return
+}
+
+// InsertCtx records the call and runs InsertCtxFunc
+func (mock *AccountsRepositoryMock) InsertCtx(ctx context.Context, sourceAccounts *Accounts) (result0 *Accounts, result1 error) {
+	mock.Record("InsertCtx", ctx, sourceAccounts)
+	if mock.InsertCtxFunc != nil {
+		return mock.InsertCtxFunc(ctx, sourceAccounts)
+	}
+	return
+}
+
+// Update records the call and runs UpdateFunc
+func (mock *AccountsRepositoryMock) Update(sourceAccounts *Accounts, where Predicate) (result0 int64, result1 error) {
+	mock.Record("Update", sourceAccounts, where)
+	if mock.UpdateFunc != nil {
+		return mock.UpdateFunc(sourceAccounts, where)
+	}
+	return
+}
+
+// UpdateCtx records the call and runs UpdateCtxFunc
+func (mock *AccountsRepositoryMock) UpdateCtx(ctx context.Context, sourceAccounts *Accounts, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateCtx", ctx, sourceAccounts, where)
+	if mock.UpdateCtxFunc != nil {
+		return mock.UpdateCtxFunc(ctx, sourceAccounts, where)
+	}
+	return
+}
+
+// UpdateWithMask records the call and runs UpdateWithMaskFunc
+func (mock *AccountsRepositoryMock) UpdateWithMask(sourceAccounts *Accounts, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMask", sourceAccounts, updateMask, where)
+	if mock.UpdateWithMaskFunc != nil {
+		return mock.UpdateWithMaskFunc(sourceAccounts, updateMask, where)
+	}
+	return
+}
+
+// UpdateWithMaskCtx records the call and runs UpdateWithMaskCtxFunc
+func (mock *AccountsRepositoryMock) UpdateWithMaskCtx(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (result0 int64, result1 error) {
+	mock.Record("UpdateWithMaskCtx", ctx, sourceAccounts, updateMask, where)
+	if mock.UpdateWithMaskCtxFunc != nil {
+		return mock.UpdateWithMaskCtxFunc(ctx, sourceAccounts, updateMask, where)
+	}
+	return
+}
+
+// Delete records the call and runs DeleteFunc
+func (mock *AccountsRepositoryMock) Delete(where Predicate) (result0 int64, result1 error) {
+	mock.Record("Delete", where)
+	if mock.DeleteFunc != nil {
+		return mock.DeleteFunc(where)
+	}
+	return
+}
+
+// DeleteCtx records the call and runs DeleteCtxFunc
+func (mock *AccountsRepositoryMock) DeleteCtx(ctx context.Context, where Predicate) (result0 int64, result1 error) {
+	mock.Record("DeleteCtx", ctx, where)
+	if mock.DeleteCtxFunc != nil {
+		return mock.DeleteCtxFunc(ctx, where)
+	}
+	return
+}
+
+// DeleteInstance records the call and runs DeleteInstanceFunc
+func (mock *AccountsRepositoryMock) DeleteInstance(sourceAccounts *Accounts) (result0 bool, result1 error) {
+	mock.Record("DeleteInstance", sourceAccounts)
+	if mock.DeleteInstanceFunc != nil {
+		return mock.DeleteInstanceFunc(sourceAccounts)
+	}
+	return
+}
+
+// DeleteInstanceCtx records the call and runs DeleteInstanceCtxFunc
+func (mock *AccountsRepositoryMock) DeleteInstanceCtx(ctx context.Context, sourceAccounts *Accounts) (result0 bool, result1 error) {
+	mock.Record("DeleteInstanceCtx", ctx, sourceAccounts)
+	if mock.DeleteInstanceCtxFunc != nil {
+		return mock.DeleteInstanceCtxFunc(ctx, sourceAccounts)
+	}
+	return
+}
+
+// DeleteAll records the call and runs DeleteAllFunc
+func (mock *AccountsRepositoryMock) DeleteAll() (result0 int64, result1 error) {
+	mock.Record("DeleteAll")
+	if mock.DeleteAllFunc != nil {
+		return mock.DeleteAllFunc()
+	}
+	return
+}
+
+// DeleteAllCtx records the call and runs DeleteAllCtxFunc
+func (mock *AccountsRepositoryMock) DeleteAllCtx(ctx context.Context) (result0 int64, result1 error) {
+	mock.Record("DeleteAllCtx", ctx)
+	if mock.DeleteAllCtxFunc != nil {
+		return mock.DeleteAllCtxFunc(ctx)
+	}
+	return
+}
+
+// GetByAccountId records the call and runs GetByAccountIdFunc
+func (mock *AccountsRepositoryMock) GetByAccountId(inputAccountId int64) (result0 *Accounts, result1 error) {
+	mock.Record("GetByAccountId", inputAccountId)
+	if mock.GetByAccountIdFunc != nil {
+		return mock.GetByAccountIdFunc(inputAccountId)
+	}
+	return
+}
+
+// GetByAccountIdCtx records the call and runs GetByAccountIdCtxFunc
+func (mock *AccountsRepositoryMock) GetByAccountIdCtx(ctx context.Context, inputAccountId int64) (result0 *Accounts, result1 error) {
+	mock.Record("GetByAccountIdCtx", ctx, inputAccountId)
+	if mock.GetByAccountIdCtxFunc != nil {
+		return mock.GetByAccountIdCtxFunc(ctx, inputAccountId)
+	}
+	return
+}
+
+// GetByUniqueAccountGuid records the call and runs GetByUniqueAccountGuidFunc
+func (mock *AccountsRepositoryMock) GetByUniqueAccountGuid(inputAccountGuid string) (result0 *Accounts, result1 error) {
+	mock.Record("GetByUniqueAccountGuid", inputAccountGuid)
+	if mock.GetByUniqueAccountGuidFunc != nil {
+		return mock.GetByUniqueAccountGuidFunc(inputAccountGuid)
+	}
+	return
+}
+
+// GetByUniqueAccountGuidCtx records the call and runs GetByUniqueAccountGuidCtxFunc
+func (mock *AccountsRepositoryMock) GetByUniqueAccountGuidCtx(ctx context.Context, inputAccountGuid string) (result0 *Accounts, result1 error) {
+	mock.Record("GetByUniqueAccountGuidCtx", ctx, inputAccountGuid)
+	if mock.GetByUniqueAccountGuidCtxFunc != nil {
+		return mock.GetByUniqueAccountGuidCtxFunc(ctx, inputAccountGuid)
+	}
+	return
+}
+
+// GetByUniqueEmail records the call and runs GetByUniqueEmailFunc
+func (mock *AccountsRepositoryMock) GetByUniqueEmail(inputEmail string) (result0 *Accounts, result1 error) {
+	mock.Record("GetByUniqueEmail", inputEmail)
+	if mock.GetByUniqueEmailFunc != nil {
+		return mock.GetByUniqueEmailFunc(inputEmail)
+	}
+	return
+}
+
+// GetByUniqueEmailCtx records the call and runs GetByUniqueEmailCtxFunc
+func (mock *AccountsRepositoryMock) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (result0 *Accounts, result1 error) {
+	mock.Record("GetByUniqueEmailCtx", ctx, inputEmail)
+	if mock.GetByUniqueEmailCtxFunc != nil {
+		return mock.GetByUniqueEmailCtxFunc(ctx, inputEmail)
+	}
+	return
+}
+
+// AccountsFake is an in-memory AccountsRepository for the unit tests, its zero value an empty accounts table.
+// It assigns the serial columns, and enforces the primary key, the unique constraints and the NOT NULL
+// columns with the errors the database methods return. It evaluates the predicates the way the database
+// does, NULL included, but only the Raw conditions made of column = $n, column IS NULL and column IS NOT NULL
+// comparisons joined by AND. The select options apply, the locking ones having no effect, and the cached
+// selects cache nothing. The methods working on the database only, e.g. CountImprecise, return
+// ErrFakeNotSupported. It is safe for concurrent use.
+type AccountsFake struct {
+	mutex sync.RWMutex
+	rows  []Accounts
+
+	sequenceAccountId int64
+
+	utils tAccountsUtils
+}
+
+var _ AccountsRepository = (*AccountsFake)(nil)
+
+// Rows returns a copy of the rows, in their order of insertion
+func (fake *AccountsFake) Rows() []Accounts {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return append([]Accounts(nil), fake.rows...)
+}
+
+// column returns the value of the row's column, nil for NULL, and false for an unknown column
+func (fake *AccountsFake) column(row *Accounts, dbName string) (interface{}, bool) {
+
+	switch dbName {
+	case "account_id":
+		return row.AccountId, true
+	case "account_guid":
+		return row.AccountGuid, true
+	case "email":
+		return row.Email, true
+	case "status":
+		return row.Status, true
+	case "previous_status":
+		if !row.PreviousStatus_IsNotNull {
+			return nil, true
+		}
+		return row.PreviousStatus, true
+	case "flags":
+		if !row.Flags_IsNotNull {
+			return nil, true
+		}
+		return row.Flags, true
+	case "settings":
+		if !row.Settings_IsNotNull {
+			return nil, true
+		}
+		if row.Settings == nil {
+			return nil, true
+		}
+		return row.Settings, true
+	case "balance":
+		return row.Balance, true
+	case "opened_on":
+		if !row.OpenedOn_IsNotNull {
+			return nil, true
+		}
+		return row.OpenedOn, true
+	case "created_at":
+		return row.CreatedAt, true
+	}
+	return nil, false
+}
+
+// assign sets the field of the row, named by its Go or database name, to the one of the source
+func (fake *AccountsFake) assign(row *Accounts, sourceAccounts *Accounts, field string) bool {
+
+	switch field {
+	case "AccountId", "account_id":
+		row.AccountId = sourceAccounts.AccountId
+	case "AccountGuid", "account_guid":
+		row.AccountGuid = sourceAccounts.AccountGuid
+	case "Email", "email":
+		row.Email = sourceAccounts.Email
+	case "Status", "status":
+		row.Status = sourceAccounts.Status
+	case "PreviousStatus", "previous_status":
+		row.PreviousStatus = sourceAccounts.PreviousStatus
+		row.PreviousStatus_IsNotNull = sourceAccounts.PreviousStatus_IsNotNull
+	case "Flags", "flags":
+		row.Flags = sourceAccounts.Flags
+		row.Flags_IsNotNull = sourceAccounts.Flags_IsNotNull
+	case "Settings", "settings":
+		row.Settings = sourceAccounts.Settings
+		row.Settings_IsNotNull = sourceAccounts.Settings_IsNotNull
+	case "Balance", "balance":
+		row.Balance = sourceAccounts.Balance
+	case "OpenedOn", "opened_on":
+		row.OpenedOn = sourceAccounts.OpenedOn
+		row.OpenedOn_IsNotNull = sourceAccounts.OpenedOn_IsNotNull
+	case "CreatedAt", "created_at":
+		row.CreatedAt = sourceAccounts.CreatedAt
+	default:
+		return false
+	}
+	return true
+}
+
+// sameKey tells whether the rows have the same values in the columns, NULL equal to nothing
+func (fake *AccountsFake) sameKey(a *Accounts, b *Accounts, dbNames ...string) bool {
+
+	for _, dbName := range dbNames {
+		aValue, _ := fake.column(a, dbName)
+		bValue, _ := fake.column(b, dbName)
+		if !fakeEqual(aValue, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// deleted tells whether the row is soft-deleted
+func (fake *AccountsFake) deleted(row *Accounts) bool {
+
+	return false
+}
+
+// nextSequenceValues assigns the serial columns of the row
+func (fake *AccountsFake) nextSequenceValues(row *Accounts) {
+
+	fake.sequenceAccountId++
+	row.AccountId = fake.sequenceAccountId
+}
+
+// validate checks the changed rows against the constraints of the table
+func (fake *AccountsFake) validate(rows []Accounts, changed []int) error {
+
+	for _, i := range changed {
+		for j := range rows {
+			if j == i {
+				continue
+			}
+			if fake.sameKey(&rows[i], &rows[j], "account_id") {
+				return NewModelsErrorLocalWithCode("Unique constraint violation:", "accounts primary key", "23505")
+			}
+			if fake.sameKey(&rows[i], &rows[j], "account_guid") {
+				return ErrAccounts_UQ_accounts_account_guid_key
+			}
+			if fake.sameKey(&rows[i], &rows[j], "email") {
+				return ErrAccounts_UQ_accounts_email_key
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertRows appends the rows, unless one of them violates a constraint
+func (fake *AccountsFake) insertRows(rows ...Accounts) error {
+
+	all := append(append([]Accounts(nil), fake.rows...), rows...)
+
+	changed := make([]int, len(rows))
+	for i := range rows {
+		changed[i] = len(fake.rows) + i
+	}
+	if err := fake.validate(all, changed); err != nil {
+		return err
+	}
+
+	fake.rows = all
+	return nil
+}
+
+// updateRows updates the rows at the indexes, unless one of them then violates a constraint
+func (fake *AccountsFake) updateRows(indexes []int, update func(row *Accounts)) (int64, error) {
+
+	rows := append([]Accounts(nil), fake.rows...)
+	for _, i := range indexes {
+		update(&rows[i])
+	}
+	if err := fake.validate(rows, indexes); err != nil {
+		return 0, err
+	}
+
+	fake.rows = rows
+	return int64(len(indexes)), nil
+}
+
+// matching returns the indexes of the rows matching the predicate, the zero one matching all of them
+func (fake *AccountsFake) matching(errorPrefix string, where Predicate, withDeleted bool) ([]int, error) {
+
+	predicate, err := fakePredicate(where, isAccountsColumn)
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	var indexes []int
+	for i := range fake.rows {
+		row := &fake.rows[i]
+		if !withDeleted && fake.deleted(row) {
+			continue
+		}
+
+		truth, err := fakeEvaluate(predicate, func(dbName string) interface{} {
+			value, _ := fake.column(row, dbName)
+			return value
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s%w", errorPrefix, err)
+		}
+		if truth == fakeTrue {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+// rowsAt returns copies of the rows at the indexes
+func (fake *AccountsFake) rowsAt(indexes []int) []Accounts {
+
+	rows := make([]Accounts, len(indexes))
+	for i, index := range indexes {
+		rows[i] = fake.rows[index]
+	}
+	return rows
+}
+
+// selectRows returns copies of the rows at the indexes, ordered, offset, limited and projected by the options
+func (fake *AccountsFake) selectRows(errorPrefix string, indexes []int, options selectOptions) ([]Accounts, error) {
+
+	if _, err := options.clause(isAccountsColumn); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	rows := fake.rowsAt(indexes)
+
+	var orderErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, term := range options.orderBy {
+			a, _ := fake.column(&rows[i], term.column)
+			b, _ := fake.column(&rows[j], term.column)
+			order, ok := fakeCompare(a, b, term)
+			if !ok {
+				orderErr = fmt.Errorf("%sordering by %s is %w", errorPrefix, term.column, ErrFakeNotSupported)
+			}
+			if order != 0 {
+				return order < 0
+			}
+		}
+		return false
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+
+	if options.offset > len(rows) {
+		options.offset = len(rows)
+	}
+	rows = rows[options.offset:]
+	if options.limit > 0 && options.limit < len(rows) {
+		rows = rows[:options.limit]
+	}
+
+	if options.projection != nil {
+		for i := range rows {
+			rows[i] = fake.project(&rows[i], options.projection)
+		}
+	}
+	return rows, nil
+}
+
+// selectMatching returns copies of the rows matching the predicate, ordered, offset, limited and projected by the options
+func (fake *AccountsFake) selectMatching(errorPrefix string, where Predicate, options selectOptions) ([]Accounts, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return nil, err
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// project returns the row with only the columns of the projection, as WithColumns selects them
+func (fake *AccountsFake) project(row *Accounts, projection *columnProjection) Accounts {
+
+	projected := Accounts{pgToGo_projection: projection}
+	if projection.loads("account_id") {
+		projected.AccountId = row.AccountId
+	}
+	if projection.loads("account_guid") {
+		projected.AccountGuid = row.AccountGuid
+	}
+	if projection.loads("email") {
+		projected.Email = row.Email
+	}
+	if projection.loads("status") {
+		projected.Status = row.Status
+	}
+	if projection.loads("previous_status") {
+		projected.PreviousStatus = row.PreviousStatus
+		projected.PreviousStatus_IsNotNull = row.PreviousStatus_IsNotNull
+	}
+	if projection.loads("flags") {
+		projected.Flags = row.Flags
+		projected.Flags_IsNotNull = row.Flags_IsNotNull
+	}
+	if projection.loads("settings") {
+		projected.Settings = row.Settings
+		projected.Settings_IsNotNull = row.Settings_IsNotNull
+	}
+	if projection.loads("balance") {
+		projected.Balance = row.Balance
+	}
+	if projection.loads("opened_on") {
+		projected.OpenedOn = row.OpenedOn
+		projected.OpenedOn_IsNotNull = row.OpenedOn_IsNotNull
+	}
+	if projection.loads("created_at") {
+		projected.CreatedAt = row.CreatedAt
+	}
+
+	return projected
+}
+
+// deleteRows deletes the rows at the indexes
+func (fake *AccountsFake) deleteRows(indexes []int) int64 {
+
+	kept := fake.rows[:0]
+	for i, row := range fake.rows {
+		if len(indexes) > 0 && indexes[0] == i {
+			indexes = indexes[1:]
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(fake.rows) - len(kept))
+	fake.rows = kept
+	return deleted
+}
+
+// Insert is InsertCtx with the background context
+func (fake *AccountsFake) Insert(sourceAccounts *Accounts) (*Accounts, error) {
+	return fake.InsertCtx(context.Background(), sourceAccounts)
+}
+
+// InsertCtx inserts a copy of the row the way Tables.Accounts.InsertCtx does, assigning the serial
+// columns when PgToGo_IgnorePKValuesWhenInsertingAndUseSequence is set.
+// Returns back the pointer to the structure with all the fields, including the PK fields.
+func (fake *AccountsFake) InsertCtx(ctx context.Context, sourceAccounts *Accounts) (*Accounts, error) {
+
+	var errorPrefix = "AccountsFake.Insert() ERROR: "
+
+	if sourceAccounts == nil {
+		return nil, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+
+	if sourceAccounts.PgToGo_SetDateTimeFieldsToNowForNewRecords {
+		sourceAccounts.OpenedOn = Now()
+		sourceAccounts.CreatedAt = Now()
+
+	}
+
+	if sourceAccounts.PgToGo_SetGuidFieldsToNewGuidsNewRecords {
+		sourceAccounts.AccountGuid = NewGuid()
+
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	row := *sourceAccounts
+	if sourceAccounts.PgToGo_IgnorePKValuesWhenInsertingAndUseSequence {
+		fake.nextSequenceValues(&row)
+	}
+
+	if err := fake.insertRows(row); err != nil {
+		return nil, err
+	}
+
+	sourceAccounts.AccountId = row.AccountId
+
+	return sourceAccounts, nil
+}
+
+// Select is SelectCtx with the background context
+func (fake *AccountsFake) Select(where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectCtx(context.Background(), where, options...)
+}
+
+// SelectCtx returns copies of the rows matching the predicate, ordered and limited by the options
+func (fake *AccountsFake) SelectCtx(ctx context.Context, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsFake.Select() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	return fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+}
+
+// Single is SingleCtx with the background context
+func (fake *AccountsFake) Single(where Predicate, options ...SelectOption) (*Accounts, error) {
+	return fake.SingleCtx(context.Background(), where, options...)
+}
+
+// SingleCtx returns a copy of the row matching the predicate, nil if there is none,
+// and ErrTooManyRows if there are several
+func (fake *AccountsFake) SingleCtx(ctx context.Context, where Predicate, options ...SelectOption) (*Accounts, error) {
+
+	var errorPrefix = "AccountsFake.Single() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified")
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, where, applySelectOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	switch len(rows) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &rows[0], nil
+	}
+	return nil, ErrTooManyRows
+}
+
+// SelectAll is SelectAllCtx with the background context
+func (fake *AccountsFake) SelectAll(options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectAllCtx(context.Background(), options...)
+}
+
+// SelectAllCtx returns copies of all the rows, ordered and limited by the options
+func (fake *AccountsFake) SelectAllCtx(ctx context.Context, options ...SelectOption) ([]Accounts, error) {
+	return fake.selectMatching("AccountsFake.SelectAll() ERROR: ", Predicate{}, applySelectOptions(options))
+}
+
+// SelectUnion is SelectUnionCtx with the background context
+func (fake *AccountsFake) SelectUnion(where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectUnionCtx(context.Background(), where, options...)
+}
+
+// SelectUnionCtx returns copies of the rows matching any of the predicates, the ones matching several
+// of them once, ordered and limited by the options as a whole
+func (fake *AccountsFake) SelectUnionCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.selectUnion("AccountsFake.SelectUnion() ERROR: ", where, false, applySelectOptions(options))
+}
+
+// SelectUnionAll is SelectUnionAllCtx with the background context
+func (fake *AccountsFake) SelectUnionAll(where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectUnionAllCtx(context.Background(), where, options...)
+}
+
+// SelectUnionAllCtx returns copies of the rows matching each of the predicates, the ones matching several
+// of them as many times, ordered and limited by the options as a whole
+func (fake *AccountsFake) SelectUnionAllCtx(ctx context.Context, where []Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.selectUnion("AccountsFake.SelectUnionAll() ERROR: ", where, true, applySelectOptions(options))
+}
+
+// selectUnion returns copies of the rows matching each of the predicates, only once unless all is set
+func (fake *AccountsFake) selectUnion(errorPrefix string, where []Predicate, all bool, options selectOptions) ([]Accounts, error) {
+
+	if len(where) == 0 {
+		return nil, NewModelsErrorLocal(errorPrefix, "the number of predicates cannot be zero")
+	}
+	if err := options.union(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	var indexes []int
+	selected := make(map[int]bool)
+	for _, predicate := range where {
+		if predicate.IsZero() {
+			return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+		}
+
+		matching, err := fake.matching(errorPrefix, predicate, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range matching {
+			if all || !selected[i] {
+				indexes = append(indexes, i)
+				selected[i] = true
+			}
+		}
+	}
+	return fake.selectRows(errorPrefix, indexes, options)
+}
+
+// SelectCached is SelectCachedCtx with the background context
+func (fake *AccountsFake) SelectCached(cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectCachedCtx(context.Background(), cacheOption, where, options...)
+}
+
+// SelectCachedCtx returns copies of the rows matching the predicate as SelectCtx does, caching nothing
+func (fake *AccountsFake) SelectCachedCtx(ctx context.Context, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsFake.SelectCached() ERROR: "
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.cached(); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectPage is SelectPageCtx with the background context
+func (fake *AccountsFake) SelectPage(pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectPageCtx(context.Background(), pageNumber, pageSize, where, options...)
+}
+
+// SelectPageCtx returns copies of the rows of the page, from 1, matching the predicate
+func (fake *AccountsFake) SelectPageCtx(ctx context.Context, pageNumber int, pageSize int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.selectPage("AccountsFake.SelectPage() ERROR: ", pageNumber, pageSize, where, false, options)
+}
+
+// SelectPageCached is SelectPageCachedCtx with the background context
+func (fake *AccountsFake) SelectPageCached(pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectPageCachedCtx(context.Background(), pageNumber, pageSize, cacheOption, where, options...)
+}
+
+// SelectPageCachedCtx returns copies of the rows of the page as SelectPageCtx does, caching nothing
+func (fake *AccountsFake) SelectPageCachedCtx(ctx context.Context, pageNumber int, pageSize int, cacheOption int, where Predicate, options ...SelectOption) ([]Accounts, error) {
+	return fake.selectPage("AccountsFake.SelectPageCached() ERROR: ", pageNumber, pageSize, where, true, options)
+}
+
+// selectPage returns copies of the rows of the page matching the predicate, refusing the locking options when cached
+func (fake *AccountsFake) selectPage(errorPrefix string, pageNumber int, pageSize int, where Predicate, cached bool, options []SelectOption) ([]Accounts, error) {
+
+	if where.IsZero() {
+		return nil, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use SelectAll method to select all rows from accounts")
+	}
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	if cached {
+		if err := selectOptions.cached(); err != nil {
+			return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+		}
+	}
+	return fake.selectMatching(errorPrefix, where, selectOptions)
+}
+
+// SelectAllOrderBy is SelectAllOrderByCtx with the background context
+func (fake *AccountsFake) SelectAllOrderBy(orderBy string, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectAllOrderByCtx(context.Background(), orderBy, options...)
+}
+
+// SelectAllOrderByCtx returns copies of all the rows, ordered by the orderBy parameter,
+// columns followed by ASC or DESC and NULLS FIRST or NULLS LAST, or by the OrderBy option
+func (fake *AccountsFake) SelectAllOrderByCtx(ctx context.Context, orderBy string, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsFake.SelectAllOrderBy() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAllPage is SelectAllPageCtx with the background context
+func (fake *AccountsFake) SelectAllPage(pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error) {
+	return fake.SelectAllPageCtx(context.Background(), pageNumber, pageSize, orderBy, options...)
+}
+
+// SelectAllPageCtx returns copies of the rows of the page, from 1, ordered as SelectAllOrderByCtx orders them
+func (fake *AccountsFake) SelectAllPageCtx(ctx context.Context, pageNumber int, pageSize int, orderBy string, options ...SelectOption) ([]Accounts, error) {
+
+	var errorPrefix = "AccountsFake.SelectAllPage() ERROR: "
+
+	if pageSize < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageSize parameter must be greater than or equal to 1")
+	}
+	if pageNumber < 1 {
+		return nil, NewModelsErrorLocal(errorPrefix, "The pageNumber parameter must be greater than or equal to 1")
+	}
+
+	selectOptions := applySelectOptions(options)
+	if err := selectOptions.fakeOrderedBy(orderBy); err != nil {
+		return nil, fmt.Errorf("%s%w", errorPrefix, err)
+	}
+	if err := selectOptions.page(pageNumber, pageSize); err != nil {
+		return nil, NewModelsError(errorPrefix+" invalid select options:", err)
+	}
+	return fake.selectMatching(errorPrefix, Predicate{}, selectOptions)
+}
+
+// SelectAfter is SelectAfterCtx with the background context
+func (fake *AccountsFake) SelectAfter(where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error) {
+	return fake.SelectAfterCtx(context.Background(), where, cursor, pageSize, options...)
+}
+
+// SelectAfterCtx returns copies of the rows of the page following the cursor, and the cursor of the next page,
+// as Tables.Accounts.SelectAfterCtx does
+func (fake *AccountsFake) SelectAfterCtx(ctx context.Context, where Predicate, cursor string, pageSize int, options ...SelectOption) ([]Accounts, string, error) {
+
+	var errorPrefix = "AccountsFake.SelectAfter() ERROR: "
+
+	selectOptions := applySelectOptions(options)
+	after, err := keysetAccounts.after(cursor, pageSize, &selectOptions, fake.utils.keysetParam)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s%w", errorPrefix, err)
+	}
+
+	rows, err := fake.selectMatching(errorPrefix, And(where, after), selectOptions)
+	if err != nil || len(rows) <= pageSize {
+		return rows, "", err
+	}
+
+	rows = rows[:pageSize]
+	nextCursor, err := keysetAccounts.cursor(selectOptions, func(dbName string) interface{} {
+		return fake.utils.keysetValue(&rows[pageSize-1], dbName)
+	})
+	if err != nil {
+		return nil, "", NewModelsError(errorPrefix+" error encoding the cursor:", err)
+	}
+
+	return rows, nextCursor, nil
+}
+
+// Count is CountCtx with the background context
+func (fake *AccountsFake) Count(where ...Predicate) (int64, error) {
+	return fake.CountCtx(context.Background(), where...)
+}
+
+// CountCtx returns the number of rows matching all the predicates
+func (fake *AccountsFake) CountCtx(ctx context.Context, where ...Predicate) (int64, error) {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	indexes, err := fake.matching("AccountsFake.Count() ERROR: ", And(where...), false)
+	if err != nil {
+		return -1, err
+	}
+	return int64(len(indexes)), nil
+}
+
+// Update is UpdateCtx with the background context
+func (fake *AccountsFake) Update(sourceAccounts *Accounts, where Predicate) (int64, error) {
+	return fake.UpdateCtx(context.Background(), sourceAccounts, where)
+}
+
+// UpdateCtx sets all the fields of the rows matching the predicate to the ones of the source.
+// Returns the number of affected rows.
+func (fake *AccountsFake) UpdateCtx(ctx context.Context, sourceAccounts *Accounts, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsFake.Update() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside accounts")
+	}
+	if sourceAccounts == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Accounts) {
+		fake.assign(row, sourceAccounts, "AccountId")
+		fake.assign(row, sourceAccounts, "AccountGuid")
+		fake.assign(row, sourceAccounts, "Email")
+		fake.assign(row, sourceAccounts, "Status")
+		fake.assign(row, sourceAccounts, "PreviousStatus")
+		fake.assign(row, sourceAccounts, "Flags")
+		fake.assign(row, sourceAccounts, "Settings")
+		fake.assign(row, sourceAccounts, "Balance")
+		fake.assign(row, sourceAccounts, "OpenedOn")
+		fake.assign(row, sourceAccounts, "CreatedAt")
+
+	})
+}
+
+// UpdateWithMask is UpdateWithMaskCtx with the background context
+func (fake *AccountsFake) UpdateWithMask(sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+	return fake.UpdateWithMaskCtx(context.Background(), sourceAccounts, updateMask, where)
+}
+
+// UpdateWithMaskCtx sets the fields in the mask, Go or database names, of the rows matching the predicate
+// to the ones of the source. Returns the number of affected rows.
+func (fake *AccountsFake) UpdateWithMaskCtx(ctx context.Context, sourceAccounts *Accounts, updateMask []string, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsFake.UpdateWithMask() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use UpdateAll method to update all rows inside accounts")
+	}
+	if len(updateMask) == 0 {
+		return 0, NewModelsErrorLocal(errorPrefix, "No update mask specified. Please use Update or UpdateAll method to update all fields.")
+	}
+	if sourceAccounts == nil {
+		return 0, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+	for _, field := range updateMask {
+		if !fake.assign(&Accounts{}, sourceAccounts, field) {
+			return 0, NewModelsErrorLocal(errorPrefix, "the update mask field "+field+" is not a column of accounts")
+		}
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return fake.updateRows(indexes, func(row *Accounts) {
+		for _, field := range updateMask {
+			fake.assign(row, sourceAccounts, field)
+		}
+	})
+}
+
+// Delete is DeleteCtx with the background context
+func (fake *AccountsFake) Delete(where Predicate) (int64, error) {
+	return fake.DeleteCtx(context.Background(), where)
+}
+
+// DeleteCtx deletes the rows matching the predicate.
+// Returns the number of deleted rows.
+func (fake *AccountsFake) DeleteCtx(ctx context.Context, where Predicate) (int64, error) {
+
+	var errorPrefix = "AccountsFake.Delete() ERROR: "
+
+	if where.IsZero() {
+		return 0, NewModelsErrorLocal(errorPrefix, "No condition specified. Please use DeleteAll method to delete all rows from accounts")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	indexes, err := fake.matching(errorPrefix, where, false)
+	if err != nil {
+		return 0, err
+	}
+	return fake.deleteRows(indexes), nil
+}
+
+// DeleteInstance is DeleteInstanceCtx with the background context
+func (fake *AccountsFake) DeleteInstance(sourceAccounts *Accounts) (bool, error) {
+	return fake.DeleteInstanceCtx(context.Background(), sourceAccounts)
+}
+
+// DeleteInstanceCtx deletes the row with the primary key of the source.
+// Returns true if the row was found.
+func (fake *AccountsFake) DeleteInstanceCtx(ctx context.Context, sourceAccounts *Accounts) (bool, error) {
+
+	var errorPrefix = "AccountsFake.DeleteInstance() ERROR: "
+
+	if sourceAccounts == nil {
+		return false, NewModelsErrorLocal(errorPrefix, "the sourceAccounts pointer is nil")
+	}
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	var indexes []int
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], sourceAccounts, "account_id") {
+			indexes = append(indexes, i)
+		}
+	}
+	return fake.deleteRows(indexes) == 1, nil
+}
+
+// DeleteAll is DeleteAllCtx with the background context
+func (fake *AccountsFake) DeleteAll() (int64, error) {
+	return fake.DeleteAllCtx(context.Background())
+}
+
+// DeleteAllCtx deletes all the rows.
+// Returns the number of deleted rows.
+func (fake *AccountsFake) DeleteAllCtx(ctx context.Context) (int64, error) {
+
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	// the zero predicate matches all the rows, without an error
+	indexes, _ := fake.matching("", Predicate{}, false)
+	return fake.deleteRows(indexes), nil
+}
+
+// get returns a copy of the row with the values of the key in the columns, nil if there is none
+func (fake *AccountsFake) get(key *Accounts, dbNames ...string) *Accounts {
+
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+
+	for i := range fake.rows {
+		if !fake.deleted(&fake.rows[i]) && fake.sameKey(&fake.rows[i], key, dbNames...) {
+			row := fake.rows[i]
+			return &row
+		}
+	}
+	return nil
+}
+
+// GetByAccountId is GetByAccountIdCtx with the background context
+func (fake *AccountsFake) GetByAccountId(inputAccountId int64) (*Accounts, error) {
+	return fake.GetByAccountIdCtx(context.Background(), inputAccountId)
+}
+
+// GetByAccountIdCtx returns a copy of the row with the primary key, nil if there is none
+func (fake *AccountsFake) GetByAccountIdCtx(ctx context.Context, inputAccountId int64) (*Accounts, error) {
+	return fake.get(&Accounts{AccountId: inputAccountId}, "account_id"), nil
+}
+
+// GetByUniqueAccountGuid is GetByUniqueAccountGuidCtx with the background context
+func (fake *AccountsFake) GetByUniqueAccountGuid(inputAccountGuid string) (*Accounts, error) {
+	return fake.GetByUniqueAccountGuidCtx(context.Background(), inputAccountGuid)
+}
+
+// GetByUniqueAccountGuidCtx returns a copy of the row with the values of the accounts_account_guid_key unique constraint, nil if there is none
+func (fake *AccountsFake) GetByUniqueAccountGuidCtx(ctx context.Context, inputAccountGuid string) (*Accounts, error) {
+	return fake.get(&Accounts{AccountGuid: inputAccountGuid}, "account_guid"), nil
+}
+
+// GetByUniqueEmail is GetByUniqueEmailCtx with the background context
+func (fake *AccountsFake) GetByUniqueEmail(inputEmail string) (*Accounts, error) {
+	return fake.GetByUniqueEmailCtx(context.Background(), inputEmail)
+}
+
+// GetByUniqueEmailCtx returns a copy of the row with the values of the accounts_email_key unique constraint, nil if there is none
+func (fake *AccountsFake) GetByUniqueEmailCtx(ctx context.Context, inputEmail string) (*Accounts, error) {
+	return fake.get(&Accounts{Email: inputEmail}, "email"), nil
+}
+
+// New is Tables.Accounts.New
+func (fake *AccountsFake) New() *Accounts {
+	return fake.utils.New()
+}
+
+// CreateFromHttpRequest is Tables.Accounts.CreateFromHttpRequest
+func (fake *AccountsFake) CreateFromHttpRequest(req *http.Request) (*Accounts, error) {
+	return fake.utils.CreateFromHttpRequest(req)
+}
+
+// CreateFromHttpRequestIgnoreErrors is Tables.Accounts.CreateFromHttpRequestIgnoreErrors
+func (fake *AccountsFake) CreateFromHttpRequestIgnoreErrors(req *http.Request) (*Accounts, []error) {
+	return fake.utils.CreateFromHttpRequestIgnoreErrors(req)
+}
+
+// ToDbFieldName is Tables.Accounts.ToDbFieldName
+func (fake *AccountsFake) ToDbFieldName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldName(fieldDbOrGoName)
+}
+
+// ToDbFieldTypeFromColName is Tables.Accounts.ToDbFieldTypeFromColName
+func (fake *AccountsFake) ToDbFieldTypeFromColName(fieldDbOrGoName string) string {
+	return fake.utils.ToDbFieldTypeFromColName(fieldDbOrGoName)
+}
+
+// CountImprecise is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *AccountsFake) CountImprecise() (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// CountImpreciseCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *AccountsFake) CountImpreciseCtx(ctx context.Context) (result0 int64, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPath is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *AccountsFake) SelectWhereJSONPath(column string, path []string, value interface{}) (result0 []Accounts, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
+
+// SelectWhereJSONPathCtx is not supported by the in-memory fake, it returns ErrFakeNotSupported
+func (fake *AccountsFake) SelectWhereJSONPathCtx(ctx context.Context, column string, path []string, value interface{}) (result0 []Accounts, result1 error) {
+	result1 = ErrFakeNotSupported
+	return
+}
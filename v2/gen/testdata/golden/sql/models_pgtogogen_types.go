@@ -0,0 +1,340 @@
+package models
+
+/* ************************************************************* */
+/* This file was automatically generated by pgtogogen.           */
+/* Do not modify this file unless you know what you are doing.   */
+/* ************************************************************* */
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+//
+// DB custom type, aliases and type-related helper functions
+//
+
+// JSON is a nullable json document, kept as it is read,
+// which offers additional string-rendering methods.
+type JSON struct {
+	Bytes []byte
+	Valid bool
+}
+
+func (j *JSON) String() string { return string(j.Bytes) }
+
+// Scan implements the sql.Scanner interface
+func (j *JSON) Scan(src interface{}) error { return scanJSONDocument(src, &j.Bytes, &j.Valid) }
+
+// Value implements the driver.Valuer interface
+func (j JSON) Value() (driver.Value, error) { return jsonDocumentValue(j.Bytes, j.Valid) }
+
+// JSONB is a nullable jsonb document, kept as it is read,
+// which offers additional string-rendering methods.
+type JSONB struct {
+	Bytes []byte
+	Valid bool
+}
+
+func (j *JSONB) String() string { return string(j.Bytes) }
+
+// Scan implements the sql.Scanner interface
+func (j *JSONB) Scan(src interface{}) error { return scanJSONDocument(src, &j.Bytes, &j.Valid) }
+
+// Value implements the driver.Valuer interface
+func (j JSONB) Value() (driver.Value, error) { return jsonDocumentValue(j.Bytes, j.Valid) }
+
+// scanJSONDocument copies the scanned json document, or records a NULL
+func scanJSONDocument(src interface{}, document *[]byte, valid *bool) error {
+
+	switch src := src.(type) {
+	case nil:
+		*document, *valid = nil, false
+	case string:
+		*document, *valid = []byte(src), true
+	case []byte:
+		*document, *valid = append([]byte(nil), src...), true
+	default:
+		return fmt.Errorf("cannot scan a %T into a json document", src)
+	}
+	return nil
+}
+
+// jsonDocumentValue returns the json document to send, or nil for NULL
+func jsonDocumentValue(document []byte, valid bool) (driver.Value, error) {
+	if !valid {
+		return nil, nil
+	}
+	return string(document), nil
+}
+
+// NullFloat32 is the float32 counterpart of sql.NullFloat64
+type NullFloat32 struct {
+	Float32 float32
+	Valid   bool
+}
+
+// Scan implements the sql.Scanner interface
+func (n *NullFloat32) Scan(src interface{}) error {
+
+	var f sql.NullFloat64
+	if err := f.Scan(src); err != nil {
+		return err
+	}
+	n.Float32, n.Valid = float32(f.Float64), f.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (n NullFloat32) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return float64(n.Float32), nil
+}
+
+// Interval is a nullable interval, split in months, days and microseconds the way Postgres keeps it
+type Interval struct {
+	Microseconds int64
+	Days         int32
+	Months       int32
+	Valid        bool
+}
+
+// Scan implements the sql.Scanner interface. It reads the intervals in the default
+// IntervalStyle (postgres), e.g. "1 year 2 mons -3 days 04:05:06.5"
+func (i *Interval) Scan(src interface{}) error {
+
+	var text string
+	switch src := src.(type) {
+	case nil:
+		*i = Interval{}
+		return nil
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	default:
+		return fmt.Errorf("Interval.Scan: cannot scan a %T", src)
+	}
+
+	invalid := fmt.Errorf("Interval.Scan: invalid interval %q", text)
+
+	scanned := Interval{Valid: true}
+	fields := strings.Fields(text)
+	for k := 0; k < len(fields); k++ {
+
+		if strings.Contains(fields[k], ":") {
+			microseconds, ok := parseIntervalTime(fields[k])
+			if !ok {
+				return invalid
+			}
+			scanned.Microseconds += microseconds
+			continue
+		}
+
+		if k+1 == len(fields) {
+			return invalid
+		}
+		value, err := strconv.ParseInt(fields[k], 10, 32)
+		if err != nil {
+			return invalid
+		}
+		k++
+
+		switch strings.TrimSuffix(fields[k], "s") {
+		case "year":
+			scanned.Months += int32(value) * 12
+		case "mon":
+			scanned.Months += int32(value)
+		case "day":
+			scanned.Days += int32(value)
+		default:
+			return invalid
+		}
+	}
+
+	*i = scanned
+	return nil
+}
+
+// parseIntervalTime reads the [-]HH:MM:SS[.ffffff] part of an interval into microseconds
+func parseIntervalTime(text string) (int64, bool) {
+
+	parts := strings.Split(strings.TrimLeft(text, "+-"), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	seconds := strings.SplitN(parts[2], ".", 2)
+	if len(seconds) == 2 {
+		// the fraction, in microseconds
+		seconds[1] = (seconds[1] + "000000")[:6]
+	} else {
+		seconds = append(seconds, "0")
+	}
+
+	var microseconds int64
+	for k, part := range []string{parts[0], parts[1], seconds[0], seconds[1]} {
+		value, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		microseconds += value * []int64{3600000000, 60000000, 1000000, 1}[k]
+	}
+
+	if strings.HasPrefix(text, "-") {
+		microseconds = -microseconds
+	}
+	return microseconds, true
+}
+
+// Value implements the driver.Valuer interface
+func (i Interval) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return fmt.Sprintf("%d months %d days %d microseconds", i.Months, i.Days, i.Microseconds), nil
+}
+
+// Numeric is a nullable numeric, the Int value scaled by 10 to the power of Exp,
+// which offers additional assignment and rendering methods
+type Numeric struct {
+	Int   *big.Int
+	Exp   int32
+	Valid bool
+}
+
+func (n *Numeric) NumericVal() Numeric { return *n }
+
+// EmbeddedVal returns the value to send to the database, the Numeric itself for database/sql
+func (n *Numeric) EmbeddedVal() *Numeric { return n }
+
+// Scan implements the sql.Scanner interface
+func (n *Numeric) Scan(src interface{}) error {
+
+	switch src := src.(type) {
+	case nil:
+		*n = Numeric{}
+		return nil
+	case string:
+		return n.parse(src)
+	case []byte:
+		return n.parse(string(src))
+	case int64:
+		*n = Numeric{Int: big.NewInt(src), Valid: true}
+		return nil
+	case float64:
+		return n.parse(strconv.FormatFloat(src, 'f', -1, 64))
+	}
+	return fmt.Errorf("Numeric.Scan: cannot scan a %T", src)
+}
+
+// parse reads a number such as "-12.345" or "1.5e-3"
+func (n *Numeric) parse(text string) error {
+
+	mantissa, exp := text, int64(0)
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(text[i+1:], 10, 32); err != nil {
+			return fmt.Errorf("Numeric.Scan: invalid number %q", text)
+		}
+		mantissa = text[:i]
+	}
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		exp -= int64(len(mantissa) - i - 1)
+		mantissa = mantissa[:i] + mantissa[i+1:]
+	}
+
+	value, ok := new(big.Int).SetString(mantissa, 10)
+	if !ok {
+		return fmt.Errorf("Numeric.Scan: invalid number %q", text)
+	}
+
+	*n = Numeric{Int: value, Exp: int32(exp), Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (n Numeric) Value() (driver.Value, error) {
+
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Int == nil {
+		return "0", nil
+	}
+
+	digits := new(big.Int).Abs(n.Int).String()
+	sign := ""
+	if n.Int.Sign() < 0 {
+		sign = "-"
+	}
+
+	if n.Exp >= 0 {
+		return sign + digits + strings.Repeat("0", int(n.Exp)), nil
+	}
+
+	scale := int(-n.Exp)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:], nil
+}
+
+// toNumeric returns a new Numeric from an existing numeric but with
+// a pgtogogen notNull bool value taking precedence over the Valid field.
+func toNumeric(existingNumeric Numeric, notNull bool) Numeric {
+	return Numeric{
+		Int:   existingNumeric.Int,
+		Exp:   existingNumeric.Exp,
+		Valid: notNull,
+	}
+}
+
+// To_Numeric_FromString converts a string to a Numeric value
+func To_Numeric_FromString(numericStr string) (Numeric, error) {
+
+	var errorPrefix = "To_numericStr_FromString() ERROR: "
+
+	n := Numeric{}
+	if numericStr == "" {
+		return n, NewModelsErrorLocal(errorPrefix, "The input parameter is an empty string.")
+	}
+
+	err := n.Scan(numericStr)
+	if err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// LessComparatorFor_Numeric is a sort comparator function for the Numeric type
+func LessComparatorFor_Numeric(first, second Numeric) bool { return cmpNumeric(first, second) }
+
+var big0 *big.Int = big.NewInt(0)
+var big1 *big.Int = big.NewInt(1)
+var big10 *big.Int = big.NewInt(10)
+
+func cmpNumeric(first, second Numeric) bool {
+
+	if !first.Valid {
+		return true
+	}
+
+	if !second.Valid {
+		return false
+	}
+
+	// math.big Cmp compares x and y and returns:
+	//
+	//   -1 if x <  y
+	//    0 if x == y
+	//   +1 if x >  y
+	//
+	cmpInts := first.Int.Cmp(second.Int)
+	return cmpInts == -1
+}
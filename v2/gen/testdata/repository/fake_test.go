@@ -0,0 +1,325 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFakeInsert(t *testing.T) {
+
+	var fake AccountsFake
+	var repository AccountsRepository = &fake
+
+	for _, email := range []string{"ann@example.com", "bob@example.com"} {
+		account, err := repository.Insert(&Accounts{AccountGuid: "guid-" + email, Email: email, Status: "open",
+			PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64(len(fake.Rows())); account.AccountId != want {
+			t.Fatalf("the serial account_id of %s is %d instead of %d", email, account.AccountId, want)
+		}
+	}
+
+	// the unique constraints, with the errors of the database methods
+	_, err := repository.Insert(&Accounts{AccountGuid: "guid-other", Email: "ann@example.com",
+		PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if err != ErrAccounts_UQ_accounts_email_key {
+		t.Fatalf("inserting a duplicate email returned %v instead of ErrAccounts_UQ_accounts_email_key", err)
+	}
+	_, err = repository.Insert(&Accounts{AccountGuid: "guid-ann@example.com", Email: "carol@example.com",
+		PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if err != ErrAccounts_UQ_accounts_account_guid_key {
+		t.Fatalf("inserting a duplicate guid returned %v instead of ErrAccounts_UQ_accounts_account_guid_key", err)
+	}
+
+	// the primary key, without the sequence
+	_, err = repository.Insert(&Accounts{AccountId: 1, AccountGuid: "guid-carol", Email: "carol@example.com"})
+	if code := GetPostgresErrorCode(err); code != "23505" {
+		t.Fatalf("inserting a duplicate account_id returned %v, with the code %q instead of 23505", err, code)
+	}
+
+	if rows := fake.Rows(); len(rows) != 2 {
+		t.Fatalf("the failed inserts left %d rows instead of 2", len(rows))
+	}
+
+	// the failed inserts used serials too
+	account, err := repository.Insert(&Accounts{AccountGuid: "guid-carol", Email: "carol@example.com",
+		PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.AccountId != 5 {
+		t.Fatalf("the serial account_id is %d instead of 5", account.AccountId)
+	}
+
+	found, err := repository.GetByUniqueEmail("bob@example.com")
+	if err != nil || found == nil || found.AccountId != 2 {
+		t.Fatalf("GetByUniqueEmail returned %v, %v instead of the second account", found, err)
+	}
+	found.Email = "changed@example.com"
+	if found, _ := repository.GetByAccountId(2); found.Email != "bob@example.com" {
+		t.Fatalf("changing the row returned by GetByUniqueEmail changed the stored row to %s", found.Email)
+	}
+	if found, err := repository.GetByAccountId(42); found != nil || err != nil {
+		t.Fatalf("GetByAccountId of a missing row returned %v, %v instead of nil", found, err)
+	}
+}
+
+func TestFakeNotNull(t *testing.T) {
+
+	var fake TransfersFake
+	happenedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	_, err := fake.Insert(&Transfers{FromAccount: 1, Amount: 10, HappenedAt: happenedAt,
+		PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if code := GetPostgresErrorCode(err); code != "23502" {
+		t.Fatalf("inserting a nil details returned %v, with the code %q instead of 23502", err, code)
+	}
+
+	transfer, err := fake.Insert(&Transfers{FromAccount: 1, Amount: 10, HappenedAt: happenedAt, Details: map[string]string{},
+		PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the failed insert used 1, the way nextval does in the database
+	if transfer.TransferId != 2 {
+		t.Fatalf("the serial transfer_id is %d instead of 2", transfer.TransferId)
+	}
+
+	// the unique constraint on two columns, the time compared as an instant
+	_, err = fake.Insert(&Transfers{FromAccount: 1, Amount: 20, HappenedAt: happenedAt.In(time.FixedZone("EET", 7200)),
+		Details: map[string]string{}, PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true})
+	if err != ErrTransfers_UQ_transfers_from_account_happened_at_key {
+		t.Fatalf("inserting a duplicate from_account and happened_at returned %v", err)
+	}
+
+	// the NOT NULL columns are checked by the updates too
+	_, err = fake.UpdateWithMask(&Transfers{}, []string{"Details"}, TransfersCols.TransferId.Eq(transfer.TransferId))
+	if code := GetPostgresErrorCode(err); code != "23502" {
+		t.Fatalf("updating the details to nil returned %v, with the code %q instead of 23502", err, code)
+	}
+}
+
+func TestFakeUpdateAndDelete(t *testing.T) {
+
+	fake := fakeAccounts(t, "ann@example.com", "bob@example.com", "carol@example.com")
+
+	updated, err := fake.UpdateWithMask(&Accounts{Status: "closed"}, []string{"status"},
+		And(AccountsCols.Email.Eq("bob@example.com"), AccountsCols.PreviousStatus.IsNull()))
+	if err != nil || updated != 1 {
+		t.Fatalf("UpdateWithMask returned %d, %v instead of 1 row", updated, err)
+	}
+	if count, err := fake.Count(AccountsCols.Status.Eq("closed")); err != nil || count != 1 {
+		t.Fatalf("Count returned %d, %v instead of the 1 closed account", count, err)
+	}
+
+	// a unique constraint violated by the update leaves all the rows as they were
+	_, err = fake.UpdateWithMask(&Accounts{Email: "ann@example.com"}, []string{"Email"}, AccountsCols.Status.Eq("open"))
+	if err != ErrAccounts_UQ_accounts_email_key {
+		t.Fatalf("updating the emails to the same one returned %v instead of ErrAccounts_UQ_accounts_email_key", err)
+	}
+	if found, _ := fake.GetByAccountId(3); found.Email != "carol@example.com" {
+		t.Fatalf("the failed update changed the email of carol to %s", found.Email)
+	}
+
+	carol, _ := fake.GetByAccountId(3)
+	carol.Status = "frozen"
+	if updated, err := fake.Update(carol, AccountsCols.AccountId.Eq(int64(3))); err != nil || updated != 1 {
+		t.Fatalf("Update returned %d, %v instead of 1 row", updated, err)
+	}
+
+	rows, err := fake.Select(AccountsCols.Status.NotEq("open"), OrderBy(AccountsCols.Email.Desc()))
+	if err != nil || len(rows) != 2 || rows[0].Email != "carol@example.com" || rows[1].Email != "bob@example.com" {
+		t.Fatalf("Select with a <> condition returned %v, %v instead of carol then bob", rows, err)
+	}
+	rows, err = fake.SelectAll(OrderBy(AccountsCols.Status.Asc(), AccountsCols.Email.Desc()), Limit(2))
+	if err != nil || len(rows) != 2 || rows[0].Email != "bob@example.com" || rows[1].Email != "carol@example.com" {
+		t.Fatalf("SelectAll returned %v, %v instead of bob then carol", rows, err)
+	}
+
+	deleted, err := fake.Delete(AccountsCols.Status.Eq("closed"))
+	if err != nil || deleted != 1 {
+		t.Fatalf("Delete returned %d, %v instead of 1 row", deleted, err)
+	}
+	if found, err := fake.DeleteInstance(&Accounts{AccountId: 3}); err != nil || !found {
+		t.Fatalf("DeleteInstance returned %v, %v instead of the deleted row", found, err)
+	}
+	if found, err := fake.DeleteInstance(&Accounts{AccountId: 3}); err != nil || found {
+		t.Fatalf("DeleteInstance of a deleted row returned %v, %v", found, err)
+	}
+	if rows := fake.Rows(); len(rows) != 1 || rows[0].Email != "ann@example.com" {
+		t.Fatalf("the rows left are %v instead of ann", rows)
+	}
+
+	if deleted, err := fake.DeleteAll(); err != nil || deleted != 1 {
+		t.Fatalf("DeleteAll returned %d, %v instead of 1 row", deleted, err)
+	}
+}
+
+func TestFakeConditions(t *testing.T) {
+
+	ann, bob, carol := "ann@example.com", "bob@example.com", "carol@example.com"
+	fake := fakeAccounts(t, ann, bob, carol)
+	if _, err := fake.UpdateWithMask(&Accounts{PreviousStatus: "new", PreviousStatus_IsNotNull: true}, []string{"PreviousStatus"},
+		AccountsCols.Email.Eq(bob)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		where  Predicate
+		emails []string
+	}{
+		{AccountsCols.Email.Eq(ann), []string{ann}},
+		{AccountsCols.Email.NotEq(ann), []string{bob, carol}},
+		{Or(AccountsCols.Email.Eq(ann), AccountsCols.Email.Eq(carol)), []string{ann, carol}},
+		{AccountsCols.Email.In(ann, carol), []string{ann, carol}},
+		{AccountsCols.Email.In(), nil},
+		{AccountsCols.AccountId.Gt(1), []string{bob, carol}},
+		{AccountsCols.AccountId.Lte(int32(2)), []string{ann, bob}},
+		{AccountsCols.AccountId.Between(2, 3), []string{bob, carol}},
+		{AccountsCols.Email.Like("%o%@example.com"), []string{bob, carol}},
+		{AccountsCols.Email.Like("_nn@%"), []string{ann}},
+		{AccountsCols.Email.Like("ANN@%"), nil},
+		{AccountsCols.Email.ILike("ANN@%"), []string{ann}},
+		{And(AccountsCols.Status.Eq("open"), AccountsCols.PreviousStatus.IsNull()), []string{ann, carol}},
+		{Not(AccountsCols.OpenedOn.IsNull()), nil},
+
+		// NULL is neither equal to a value nor different from it
+		{AccountsCols.PreviousStatus.NotEq("new"), nil},
+		{Not(AccountsCols.PreviousStatus.Eq("new")), nil},
+		{AccountsCols.PreviousStatus.In("new", nil), []string{bob}},
+		{Or(AccountsCols.PreviousStatus.Eq("new"), AccountsCols.AccountId.Eq(1)), []string{ann, bob}},
+
+		{Raw("EMAIL = $1", bob), []string{bob}},
+		{Raw("accounts.\"email\" = $1 AND status IS NOT NULL", bob), []string{bob}},
+		{Or(Raw("email = $1", ann), AccountsCols.AccountId.Eq(3)), []string{ann, carol}},
+	} {
+		condition, _ := test.where.Condition(1)
+		rows, err := fake.Select(test.where, OrderBy(AccountsCols.AccountId.Asc()))
+		if emails := accountEmails(rows); err != nil || !reflect.DeepEqual(emails, test.emails) {
+			t.Errorf("%s matched %v, %v, instead of %v", condition, emails, err, test.emails)
+		}
+	}
+
+	for _, where := range []Predicate{
+		Raw("lower(email) = $1", ann),
+		AccountsCols.Email.Gt(1),
+	} {
+		condition, _ := where.Condition(1)
+		if _, err := fake.Select(where); !errors.Is(err, ErrFakeNotSupported) {
+			t.Errorf("%s returned %v instead of ErrFakeNotSupported", condition, err)
+		}
+	}
+
+	if _, err := fake.Select(Raw("missing = $1", 1)); err == nil || errors.Is(err, ErrFakeNotSupported) {
+		t.Errorf("a condition on an unknown column returned %v instead of an error", err)
+	}
+	if _, err := fake.Select(Raw("email = $2", ann)); err == nil {
+		t.Errorf("a placeholder without a parameter returned no error")
+	}
+
+	// the methods working on the database only
+	if _, err := fake.CountImprecise(); !errors.Is(err, ErrFakeNotSupported) {
+		t.Errorf("CountImprecise returned %v instead of ErrFakeNotSupported", err)
+	}
+}
+
+func TestFakeSelects(t *testing.T) {
+
+	ann, bob, carol, dave := "ann@example.com", "bob@example.com", "carol@example.com", "dave@example.com"
+	fake := fakeAccounts(t, ann, bob, carol, dave)
+	open, byEmail := AccountsCols.Status.Eq("open"), OrderBy(AccountsCols.Email.Desc())
+
+	union := []Predicate{AccountsCols.AccountId.Lte(2), AccountsCols.AccountId.Gte(2)}
+	for _, test := range []struct {
+		method  string
+		selects func() ([]Accounts, error)
+		emails  []string
+	}{
+		{"SelectPage", func() ([]Accounts, error) { return fake.SelectPage(2, 3, open, byEmail) }, []string{ann}},
+		{"SelectPageCached", func() ([]Accounts, error) {
+			return fake.SelectPageCached(1, 2, PgToGoFlagCacheUse, open, byEmail)
+		}, []string{dave, carol}},
+		{"SelectCached", func() ([]Accounts, error) {
+			return fake.SelectCached(PgToGoFlagCacheUse, AccountsCols.AccountId.In(1, 4))
+		}, []string{ann, dave}},
+		{"SelectAllOrderBy", func() ([]Accounts, error) {
+			return fake.SelectAllOrderBy("status, Email DESC NULLS LAST")
+		}, []string{dave, carol, bob, ann}},
+		{"SelectAllPage", func() ([]Accounts, error) { return fake.SelectAllPage(2, 2, "account_id") }, []string{carol, dave}},
+		{"SelectUnion", func() ([]Accounts, error) {
+			return fake.SelectUnion(union, OrderBy(AccountsCols.AccountId.Asc()))
+		}, []string{ann, bob, carol, dave}},
+		{"SelectUnionAll", func() ([]Accounts, error) {
+			return fake.SelectUnionAll(union, OrderBy(AccountsCols.AccountId.Asc()))
+		}, []string{ann, bob, bob, carol, dave}},
+	} {
+		rows, err := test.selects()
+		if emails := accountEmails(rows); err != nil || !reflect.DeepEqual(emails, test.emails) {
+			t.Errorf("%s returned %v, %v, instead of %v", test.method, emails, err, test.emails)
+		}
+	}
+
+	if _, err := fake.SelectCached(PgToGoFlagCacheUse, open, ForUpdate()); err == nil {
+		t.Errorf("SelectCached with ForUpdate returned no error")
+	}
+	if _, err := fake.SelectPage(1, 2, open, Limit(1)); err == nil {
+		t.Errorf("SelectPage with Limit returned no error")
+	}
+	if _, err := fake.SelectAllOrderBy("lower(email)"); !errors.Is(err, ErrFakeNotSupported) {
+		t.Errorf("SelectAllOrderBy of an expression returned %v instead of ErrFakeNotSupported", err)
+	}
+
+	// the keyset pages, by one column, by a row comparison and by columns in both directions
+	for _, test := range []struct {
+		order  SelectOption
+		emails []string
+	}{
+		{byEmail, []string{dave, carol, bob, ann}},
+		{OrderBy(AccountsCols.Status.Asc(), AccountsCols.AccountId.Asc()), []string{ann, bob, carol, dave}},
+		{OrderBy(AccountsCols.Status.Asc(), AccountsCols.Email.Desc()), []string{dave, carol, bob, ann}},
+	} {
+		var emails []string
+		cursor, pages := "", 0
+		for pages = 1; ; pages++ {
+			rows, next, err := fake.SelectAfter(open, cursor, 3, test.order)
+			if err != nil {
+				t.Fatalf("SelectAfter returned %v", err)
+			}
+			emails = append(emails, accountEmails(rows)...)
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		if pages != 2 || !reflect.DeepEqual(emails, test.emails) {
+			t.Errorf("SelectAfter returned %v in %d pages instead of %v in 2", emails, pages, test.emails)
+		}
+	}
+}
+
+// accountEmails returns the emails of the rows, nil for none
+func accountEmails(rows []Accounts) []string {
+
+	var emails []string
+	for _, row := range rows {
+		emails = append(emails, row.Email)
+	}
+	return emails
+}
+
+// fakeAccounts returns a fake holding the open accounts with the emails, their account_id from 1
+func fakeAccounts(t *testing.T, emails ...string) *AccountsFake {
+
+	fake := &AccountsFake{}
+	for _, email := range emails {
+		if _, err := fake.Insert(&Accounts{AccountGuid: "guid-" + email, Email: email, Status: "open",
+			PgToGo_IgnorePKValuesWhenInsertingAndUseSequence: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fake
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+// recordingDriver is a database/sql driver returning no rows, which records the parameters
+// of the last query after the database/sql conversion
+type recordingDriver struct {
+	query string
+	args  []driver.NamedValue
+}
+
+func (d *recordingDriver) Open(name string) (driver.Conn, error) {
+	return &recordingConn{driver: d}, nil
+}
+
+type recordingConn struct {
+	driver *recordingDriver
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("recordingConn: Prepare is not supported")
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("recordingConn: Begin is not supported")
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.driver.query, c.driver.args = query, args
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string {
+	return []string{"account_id", "account_guid", "email", "status", "previous_status", "flags", "settings",
+		"balance", "opened_on", "created_at"}
+}
+
+func (emptyRows) Close() error {
+	return nil
+}
+
+func (emptyRows) Next(dest []driver.Value) error {
+	return io.EOF
+}
+
+var recorder = &recordingDriver{}
+
+func init() {
+	sql.Register("pgtogogen-recorder", recorder)
+}
+
+func TestSelectWhereJSONPathParameters(t *testing.T) {
+
+	db, err := sql.Open("pgtogogen-recorder", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	accounts := NewDB(&SqlDB{DB: db}).Accounts
+
+	if _, err := accounts.SelectWhereJSONPath("settings", []string{"address", `the "city"`, `a\b`}, "Paris"); err != nil {
+		t.Fatalf("SelectWhereJSONPath returned %v", err)
+	}
+	if len(recorder.args) != 2 {
+		t.Fatalf("the query %s got the parameters %v instead of the path and the value", recorder.query, recorder.args)
+	}
+	if want := `{"address","the \"city\"","a\\b"}`; recorder.args[0].Value != want {
+		t.Fatalf("the path was bound as %#v instead of %s", recorder.args[0].Value, want)
+	}
+	if recorder.args[1].Value != "Paris" {
+		t.Fatalf("the value was bound as %#v instead of Paris", recorder.args[1].Value)
+	}
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// run by TestGeneratedRepositories in the models package of the sql golden files
+
+func TestRepositoryMock(t *testing.T) {
+
+	errNotFound := errors.New("not found")
+
+	mock := &AccountsRepositoryMock{
+		GetByUniqueEmailFunc: func(inputEmail string) (*Accounts, error) {
+			if inputEmail != "ann@example.com" {
+				return nil, errNotFound
+			}
+			return &Accounts{AccountId: 7, Email: inputEmail}, nil
+		},
+	}
+	var repository AccountsRepository = mock
+
+	account, err := repository.GetByUniqueEmail("ann@example.com")
+	if err != nil || account == nil || account.AccountId != 7 {
+		t.Fatalf("GetByUniqueEmail returned %v, %v instead of the account of GetByUniqueEmailFunc", account, err)
+	}
+	if _, err := repository.GetByUniqueEmail("bob@example.com"); err != errNotFound {
+		t.Fatalf("GetByUniqueEmail returned %v instead of the error of GetByUniqueEmailFunc", err)
+	}
+
+	// without a function, the zero values
+	where := AccountsCols.Status.Eq("open")
+	if count, err := repository.Count(where); count != 0 || err != nil {
+		t.Fatalf("Count returned %d, %v instead of the zero values", count, err)
+	}
+	if rows, err := repository.Select(where, Limit(10)); rows != nil || err != nil {
+		t.Fatalf("Select returned %v, %v instead of the zero values", rows, err)
+	}
+
+	var methods []string
+	for _, call := range mock.Calls() {
+		methods = append(methods, call.Method)
+	}
+	if want := []string{"GetByUniqueEmail", "GetByUniqueEmail", "Count", "Select"}; !reflect.DeepEqual(methods, want) {
+		t.Fatalf("the recorded calls are %v instead of %v", methods, want)
+	}
+
+	calls := mock.CallsTo("GetByUniqueEmail")
+	if len(calls) != 2 || calls[0].Args[0] != "ann@example.com" || calls[1].Args[0] != "bob@example.com" {
+		t.Fatalf("the recorded GetByUniqueEmail calls are %v", calls)
+	}
+
+	// the variadic arguments recorded as a slice
+	calls = mock.CallsTo("Count")
+	if predicates, ok := calls[0].Args[0].([]Predicate); !ok || len(predicates) != 1 {
+		t.Fatalf("the recorded Count call is %v instead of one with the predicate slice", calls[0])
+	}
+	calls = mock.CallsTo("Select")
+	if options, ok := calls[0].Args[1].([]SelectOption); len(calls[0].Args) != 2 || !ok || len(options) != 1 {
+		t.Fatalf("the recorded Select call is %v instead of one with the predicate and the option slice", calls[0])
+	}
+
+	mock.ResetCalls()
+	if calls := mock.Calls(); len(calls) != 0 {
+		t.Fatalf("the calls are %v after ResetCalls", calls)
+	}
+}
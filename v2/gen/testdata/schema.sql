@@ -0,0 +1,51 @@
+--
+-- The schema of the golden tests, in the pg_dump --schema-only format
+--
+
+CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy');
+CREATE DOMAIN public.email AS character varying(255) CHECK (VALUE ~ '@');
+
+CREATE FUNCTION public.add_em(integer, b integer DEFAULT 2) RETURNS integer
+    LANGUAGE sql
+    AS $$ SELECT $1 + b; $$;
+
+CREATE FUNCTION public.active_users() RETURNS SETOF public.users
+    LANGUAGE sql AS $_$ select * from users where x = 'a;b' $_$;
+
+CREATE TABLE public.users (
+    id integer NOT NULL,
+    user_guid uuid NOT NULL,
+    email public.email NOT NULL,
+    first_name text,
+    created_at timestamp(3) with time zone DEFAULT now() NOT NULL,
+    current_mood public.mood,
+    tags character(2)[],
+    balance numeric(10,2) DEFAULT 0.0,
+    profile jsonb NOT NULL DEFAULT '{}'
+);
+COMMENT ON TABLE public.users IS 'The users; all of them';
+COMMENT ON COLUMN public.users.email IS 'Login e-mail';
+COMMENT ON COLUMN public.users.profile IS '@gotype:encoding/json.RawMessage';
+
+CREATE SEQUENCE public.users_id_seq AS integer START WITH 1;
+ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass);
+ALTER TABLE ONLY public.users ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.users ADD CONSTRAINT users_email_key UNIQUE (email);
+CREATE UNIQUE INDEX users_guid_idx ON public.users USING btree (user_guid);
+CREATE UNIQUE INDEX users_lower_email ON public.users USING btree (lower((email)::text));
+
+CREATE TABLE public.roles (
+    role_id serial PRIMARY KEY,
+    name varchar(50) NOT NULL UNIQUE,
+    user_id int REFERENCES users(id) ON DELETE SET NULL,
+    archived boolean NOT NULL DEFAULT false,
+    priority double precision,
+    CONSTRAINT roles_x CHECK (role_id > 0)
+);
+
+CREATE VIEW public.user_roles AS
+ SELECT u.id, u.email AS user_email, r.name, count(*) over () AS total, r.priority::numeric as prio, 'x' as lit, lower(u.email) lmail, u.created_at::date
+   FROM (public.users u
+     JOIN public.roles r ON ((r.user_id = u.id)));
+CREATE MATERIALIZED VIEW public.mv_users AS SELECT id FROM users WITH NO DATA;
+COMMENT ON FUNCTION public.add_em(integer, integer) IS 'adds';
@@ -0,0 +1,23 @@
+// Package pgconn is a stub of github.com/jackc/pgconn holding what the generated code uses,
+// for the golden tests to type check it without downloading the module.
+package pgconn
+
+type CommandTag []byte
+
+func (ct CommandTag) RowsAffected() int64 { return 0 }
+
+type PgError struct {
+	Severity       string
+	Code           string
+	Message        string
+	Detail         string
+	Hint           string
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	ConstraintName string
+}
+
+func (pe *PgError) Error() string {
+	return pe.Severity + ": " + pe.Message + " (SQLSTATE " + pe.Code + ")"
+}
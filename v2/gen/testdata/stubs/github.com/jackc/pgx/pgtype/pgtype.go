@@ -0,0 +1,632 @@
+// Package pgtype is a stub of github.com/jackc/pgx/pgtype holding what the generated code uses,
+// for the golden tests to type check it without downloading the module.
+package pgtype
+
+import (
+	"math/big"
+	"net"
+	"time"
+)
+
+type Status byte
+
+const (
+	Undefined Status = iota
+	Null
+	Present
+)
+
+type InfinityModifier int8
+
+type BoundType byte
+
+type ArrayDimension struct {
+	Length     int32
+	LowerBound int32
+}
+
+type Vec2 struct {
+	X float64
+	Y float64
+}
+
+type Value interface {
+	Set(src interface{}) error
+	Get() interface{}
+	AssignTo(dst interface{}) error
+}
+
+type ACLItem struct {
+	String string
+	Status Status
+}
+
+func (dst *ACLItem) Set(src interface{}) error      { return nil }
+func (dst *ACLItem) Get() interface{}               { return dst }
+func (src *ACLItem) AssignTo(dst interface{}) error { return nil }
+
+type ACLItemArray struct {
+	Elements   []ACLItem
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *ACLItemArray) Set(src interface{}) error      { return nil }
+func (dst *ACLItemArray) Get() interface{}               { return dst }
+func (src *ACLItemArray) AssignTo(dst interface{}) error { return nil }
+
+type Bool struct {
+	Bool   bool
+	Status Status
+}
+
+func (dst *Bool) Set(src interface{}) error      { return nil }
+func (dst *Bool) Get() interface{}               { return dst }
+func (src *Bool) AssignTo(dst interface{}) error { return nil }
+
+type BoolArray struct {
+	Elements   []Bool
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *BoolArray) Set(src interface{}) error      { return nil }
+func (dst *BoolArray) Get() interface{}               { return dst }
+func (src *BoolArray) AssignTo(dst interface{}) error { return nil }
+
+type Box struct {
+	P      [2]Vec2
+	Status Status
+}
+
+func (dst *Box) Set(src interface{}) error      { return nil }
+func (dst *Box) Get() interface{}               { return dst }
+func (src *Box) AssignTo(dst interface{}) error { return nil }
+
+type Bytea struct {
+	Bytes  []byte
+	Status Status
+}
+
+func (dst *Bytea) Set(src interface{}) error      { return nil }
+func (dst *Bytea) Get() interface{}               { return dst }
+func (src *Bytea) AssignTo(dst interface{}) error { return nil }
+
+type ByteaArray struct {
+	Elements   []Bytea
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *ByteaArray) Set(src interface{}) error      { return nil }
+func (dst *ByteaArray) Get() interface{}               { return dst }
+func (src *ByteaArray) AssignTo(dst interface{}) error { return nil }
+
+type CID struct {
+	Uint   uint32
+	Status Status
+}
+
+func (dst *CID) Set(src interface{}) error      { return nil }
+func (dst *CID) Get() interface{}               { return dst }
+func (src *CID) AssignTo(dst interface{}) error { return nil }
+
+type CIDR struct {
+	IPNet  *net.IPNet
+	Status Status
+}
+
+func (dst *CIDR) Set(src interface{}) error      { return nil }
+func (dst *CIDR) Get() interface{}               { return dst }
+func (src *CIDR) AssignTo(dst interface{}) error { return nil }
+
+type CIDRArray struct {
+	Elements   []CIDR
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *CIDRArray) Set(src interface{}) error      { return nil }
+func (dst *CIDRArray) Get() interface{}               { return dst }
+func (src *CIDRArray) AssignTo(dst interface{}) error { return nil }
+
+type Circle struct {
+	P      Vec2
+	R      float64
+	Status Status
+}
+
+func (dst *Circle) Set(src interface{}) error      { return nil }
+func (dst *Circle) Get() interface{}               { return dst }
+func (src *Circle) AssignTo(dst interface{}) error { return nil }
+
+type Date struct {
+	Time             time.Time
+	Status           Status
+	InfinityModifier InfinityModifier
+}
+
+func (dst *Date) Set(src interface{}) error      { return nil }
+func (dst *Date) Get() interface{}               { return dst }
+func (src *Date) AssignTo(dst interface{}) error { return nil }
+
+type DateArray struct {
+	Elements   []Date
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *DateArray) Set(src interface{}) error      { return nil }
+func (dst *DateArray) Get() interface{}               { return dst }
+func (src *DateArray) AssignTo(dst interface{}) error { return nil }
+
+type Daterange struct {
+	Lower     Date
+	Upper     Date
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Daterange) Set(src interface{}) error      { return nil }
+func (dst *Daterange) Get() interface{}               { return dst }
+func (src *Daterange) AssignTo(dst interface{}) error { return nil }
+
+type Decimal struct {
+	Int    *big.Int
+	Exp    int32
+	Status Status
+}
+
+func (dst *Decimal) Set(src interface{}) error      { return nil }
+func (dst *Decimal) Get() interface{}               { return dst }
+func (src *Decimal) AssignTo(dst interface{}) error { return nil }
+
+type Float4 struct {
+	Float  float32
+	Status Status
+}
+
+func (dst *Float4) Set(src interface{}) error      { return nil }
+func (dst *Float4) Get() interface{}               { return dst }
+func (src *Float4) AssignTo(dst interface{}) error { return nil }
+
+type Float4Array struct {
+	Elements   []Float4
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *Float4Array) Set(src interface{}) error      { return nil }
+func (dst *Float4Array) Get() interface{}               { return dst }
+func (src *Float4Array) AssignTo(dst interface{}) error { return nil }
+
+type Float8 struct {
+	Float  float64
+	Status Status
+}
+
+func (dst *Float8) Set(src interface{}) error      { return nil }
+func (dst *Float8) Get() interface{}               { return dst }
+func (src *Float8) AssignTo(dst interface{}) error { return nil }
+
+type Float8Array struct {
+	Elements   []Float8
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *Float8Array) Set(src interface{}) error      { return nil }
+func (dst *Float8Array) Get() interface{}               { return dst }
+func (src *Float8Array) AssignTo(dst interface{}) error { return nil }
+
+type Hstore struct {
+	Map    map[string]Text
+	Status Status
+}
+
+func (dst *Hstore) Set(src interface{}) error      { return nil }
+func (dst *Hstore) Get() interface{}               { return dst }
+func (src *Hstore) AssignTo(dst interface{}) error { return nil }
+
+type Inet struct {
+	IPNet  *net.IPNet
+	Status Status
+}
+
+func (dst *Inet) Set(src interface{}) error      { return nil }
+func (dst *Inet) Get() interface{}               { return dst }
+func (src *Inet) AssignTo(dst interface{}) error { return nil }
+
+type InetArray struct {
+	Elements   []Inet
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *InetArray) Set(src interface{}) error      { return nil }
+func (dst *InetArray) Get() interface{}               { return dst }
+func (src *InetArray) AssignTo(dst interface{}) error { return nil }
+
+type Int2 struct {
+	Int    int16
+	Status Status
+}
+
+func (dst *Int2) Set(src interface{}) error      { return nil }
+func (dst *Int2) Get() interface{}               { return dst }
+func (src *Int2) AssignTo(dst interface{}) error { return nil }
+
+type Int2Array struct {
+	Elements   []Int2
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *Int2Array) Set(src interface{}) error      { return nil }
+func (dst *Int2Array) Get() interface{}               { return dst }
+func (src *Int2Array) AssignTo(dst interface{}) error { return nil }
+
+type Int4 struct {
+	Int    int32
+	Status Status
+}
+
+func (dst *Int4) Set(src interface{}) error      { return nil }
+func (dst *Int4) Get() interface{}               { return dst }
+func (src *Int4) AssignTo(dst interface{}) error { return nil }
+
+type Int4Array struct {
+	Elements   []Int4
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *Int4Array) Set(src interface{}) error      { return nil }
+func (dst *Int4Array) Get() interface{}               { return dst }
+func (src *Int4Array) AssignTo(dst interface{}) error { return nil }
+
+type Int4range struct {
+	Lower     Int4
+	Upper     Int4
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Int4range) Set(src interface{}) error      { return nil }
+func (dst *Int4range) Get() interface{}               { return dst }
+func (src *Int4range) AssignTo(dst interface{}) error { return nil }
+
+type Int8 struct {
+	Int    int64
+	Status Status
+}
+
+func (dst *Int8) Set(src interface{}) error      { return nil }
+func (dst *Int8) Get() interface{}               { return dst }
+func (src *Int8) AssignTo(dst interface{}) error { return nil }
+
+type Int8Array struct {
+	Elements   []Int8
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *Int8Array) Set(src interface{}) error      { return nil }
+func (dst *Int8Array) Get() interface{}               { return dst }
+func (src *Int8Array) AssignTo(dst interface{}) error { return nil }
+
+type Int8range struct {
+	Lower     Int8
+	Upper     Int8
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Int8range) Set(src interface{}) error      { return nil }
+func (dst *Int8range) Get() interface{}               { return dst }
+func (src *Int8range) AssignTo(dst interface{}) error { return nil }
+
+type JSON struct {
+	Bytes  []byte
+	Status Status
+}
+
+func (dst *JSON) Set(src interface{}) error      { return nil }
+func (dst *JSON) Get() interface{}               { return dst }
+func (src *JSON) AssignTo(dst interface{}) error { return nil }
+
+type JSONB struct {
+	Bytes  []byte
+	Status Status
+}
+
+func (dst *JSONB) Set(src interface{}) error      { return nil }
+func (dst *JSONB) Get() interface{}               { return dst }
+func (src *JSONB) AssignTo(dst interface{}) error { return nil }
+
+type Line struct {
+	A      float64
+	B      float64
+	C      float64
+	Status Status
+}
+
+func (dst *Line) Set(src interface{}) error      { return nil }
+func (dst *Line) Get() interface{}               { return dst }
+func (src *Line) AssignTo(dst interface{}) error { return nil }
+
+type Lseg struct {
+	P      [2]Vec2
+	Status Status
+}
+
+func (dst *Lseg) Set(src interface{}) error      { return nil }
+func (dst *Lseg) Get() interface{}               { return dst }
+func (src *Lseg) AssignTo(dst interface{}) error { return nil }
+
+type Macaddr struct {
+	Addr   net.HardwareAddr
+	Status Status
+}
+
+func (dst *Macaddr) Set(src interface{}) error      { return nil }
+func (dst *Macaddr) Get() interface{}               { return dst }
+func (src *Macaddr) AssignTo(dst interface{}) error { return nil }
+
+type Name struct {
+	String string
+	Status Status
+}
+
+func (dst *Name) Set(src interface{}) error      { return nil }
+func (dst *Name) Get() interface{}               { return dst }
+func (src *Name) AssignTo(dst interface{}) error { return nil }
+
+type Numeric struct {
+	Int    *big.Int
+	Exp    int32
+	Status Status
+}
+
+func (dst *Numeric) Set(src interface{}) error      { return nil }
+func (dst *Numeric) Get() interface{}               { return dst }
+func (src *Numeric) AssignTo(dst interface{}) error { return nil }
+
+type NumericArray struct {
+	Elements   []Numeric
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *NumericArray) Set(src interface{}) error      { return nil }
+func (dst *NumericArray) Get() interface{}               { return dst }
+func (src *NumericArray) AssignTo(dst interface{}) error { return nil }
+
+type Numrange struct {
+	Lower     Numeric
+	Upper     Numeric
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Numrange) Set(src interface{}) error      { return nil }
+func (dst *Numrange) Get() interface{}               { return dst }
+func (src *Numrange) AssignTo(dst interface{}) error { return nil }
+
+type OIDValue struct {
+	Uint   uint32
+	Status Status
+}
+
+func (dst *OIDValue) Set(src interface{}) error      { return nil }
+func (dst *OIDValue) Get() interface{}               { return dst }
+func (src *OIDValue) AssignTo(dst interface{}) error { return nil }
+
+type Path struct {
+	P      []Vec2
+	Closed bool
+	Status Status
+}
+
+func (dst *Path) Set(src interface{}) error      { return nil }
+func (dst *Path) Get() interface{}               { return dst }
+func (src *Path) AssignTo(dst interface{}) error { return nil }
+
+type Point struct {
+	P      Vec2
+	Status Status
+}
+
+func (dst *Point) Set(src interface{}) error      { return nil }
+func (dst *Point) Get() interface{}               { return dst }
+func (src *Point) AssignTo(dst interface{}) error { return nil }
+
+type Polygon struct {
+	P      []Vec2
+	Status Status
+}
+
+func (dst *Polygon) Set(src interface{}) error      { return nil }
+func (dst *Polygon) Get() interface{}               { return dst }
+func (src *Polygon) AssignTo(dst interface{}) error { return nil }
+
+type QChar struct {
+	Int    int8
+	Status Status
+}
+
+func (dst *QChar) Set(src interface{}) error      { return nil }
+func (dst *QChar) Get() interface{}               { return dst }
+func (src *QChar) AssignTo(dst interface{}) error { return nil }
+
+type Record struct {
+	Fields []Value
+	Status Status
+}
+
+func (dst *Record) Set(src interface{}) error      { return nil }
+func (dst *Record) Get() interface{}               { return dst }
+func (src *Record) AssignTo(dst interface{}) error { return nil }
+
+type TID struct {
+	BlockNumber  uint32
+	OffsetNumber uint16
+	Status       Status
+}
+
+func (dst *TID) Set(src interface{}) error      { return nil }
+func (dst *TID) Get() interface{}               { return dst }
+func (src *TID) AssignTo(dst interface{}) error { return nil }
+
+type Text struct {
+	String string
+	Status Status
+}
+
+func (dst *Text) Set(src interface{}) error      { return nil }
+func (dst *Text) Get() interface{}               { return dst }
+func (src *Text) AssignTo(dst interface{}) error { return nil }
+
+type TextArray struct {
+	Elements   []Text
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *TextArray) Set(src interface{}) error      { return nil }
+func (dst *TextArray) Get() interface{}               { return dst }
+func (src *TextArray) AssignTo(dst interface{}) error { return nil }
+
+type Timestamp struct {
+	Time             time.Time
+	Status           Status
+	InfinityModifier InfinityModifier
+}
+
+func (dst *Timestamp) Set(src interface{}) error      { return nil }
+func (dst *Timestamp) Get() interface{}               { return dst }
+func (src *Timestamp) AssignTo(dst interface{}) error { return nil }
+
+type TimestampArray struct {
+	Elements   []Timestamp
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *TimestampArray) Set(src interface{}) error      { return nil }
+func (dst *TimestampArray) Get() interface{}               { return dst }
+func (src *TimestampArray) AssignTo(dst interface{}) error { return nil }
+
+type Timestamptz struct {
+	Time             time.Time
+	Status           Status
+	InfinityModifier InfinityModifier
+}
+
+func (dst *Timestamptz) Set(src interface{}) error      { return nil }
+func (dst *Timestamptz) Get() interface{}               { return dst }
+func (src *Timestamptz) AssignTo(dst interface{}) error { return nil }
+
+type TimestamptzArray struct {
+	Elements   []Timestamptz
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *TimestamptzArray) Set(src interface{}) error      { return nil }
+func (dst *TimestamptzArray) Get() interface{}               { return dst }
+func (src *TimestamptzArray) AssignTo(dst interface{}) error { return nil }
+
+type Tsrange struct {
+	Lower     Timestamp
+	Upper     Timestamp
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Tsrange) Set(src interface{}) error      { return nil }
+func (dst *Tsrange) Get() interface{}               { return dst }
+func (src *Tsrange) AssignTo(dst interface{}) error { return nil }
+
+type Tstzrange struct {
+	Lower     Timestamptz
+	Upper     Timestamptz
+	LowerType BoundType
+	UpperType BoundType
+	Status    Status
+}
+
+func (dst *Tstzrange) Set(src interface{}) error      { return nil }
+func (dst *Tstzrange) Get() interface{}               { return dst }
+func (src *Tstzrange) AssignTo(dst interface{}) error { return nil }
+
+type UUID struct {
+	Bytes  [16]byte
+	Status Status
+}
+
+func (dst *UUID) Set(src interface{}) error      { return nil }
+func (dst *UUID) Get() interface{}               { return dst }
+func (src *UUID) AssignTo(dst interface{}) error { return nil }
+
+type UUIDArray struct {
+	Elements   []UUID
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *UUIDArray) Set(src interface{}) error      { return nil }
+func (dst *UUIDArray) Get() interface{}               { return dst }
+func (src *UUIDArray) AssignTo(dst interface{}) error { return nil }
+
+type Unknown struct {
+	String string
+	Status Status
+}
+
+func (dst *Unknown) Set(src interface{}) error      { return nil }
+func (dst *Unknown) Get() interface{}               { return dst }
+func (src *Unknown) AssignTo(dst interface{}) error { return nil }
+
+type Varbit struct {
+	Bytes  []byte
+	Len    int32
+	Status Status
+}
+
+func (dst *Varbit) Set(src interface{}) error      { return nil }
+func (dst *Varbit) Get() interface{}               { return dst }
+func (src *Varbit) AssignTo(dst interface{}) error { return nil }
+
+type Varchar struct {
+	String string
+	Status Status
+}
+
+func (dst *Varchar) Set(src interface{}) error      { return nil }
+func (dst *Varchar) Get() interface{}               { return dst }
+func (src *Varchar) AssignTo(dst interface{}) error { return nil }
+
+type VarcharArray struct {
+	Elements   []Varchar
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *VarcharArray) Set(src interface{}) error      { return nil }
+func (dst *VarcharArray) Get() interface{}               { return dst }
+func (src *VarcharArray) AssignTo(dst interface{}) error { return nil }
+
+type XID struct {
+	Uint   uint32
+	Status Status
+}
+
+func (dst *XID) Set(src interface{}) error      { return nil }
+func (dst *XID) Get() interface{}               { return dst }
+func (src *XID) AssignTo(dst interface{}) error { return nil }
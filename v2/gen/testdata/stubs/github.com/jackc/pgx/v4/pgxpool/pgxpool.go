@@ -0,0 +1,44 @@
+// Package pgxpool is a stub of github.com/jackc/pgx/v4/pgxpool holding what the generated code uses,
+// for the golden tests to type check it without downloading the module.
+package pgxpool
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type Config struct {
+	ConnConfig *pgx.ConnConfig
+	MaxConns   int32
+	MinConns   int32
+}
+
+func ParseConfig(connString string) (*Config, error) {
+	return &Config{ConnConfig: &pgx.ConnConfig{}}, nil
+}
+
+type Pool struct{}
+
+func ConnectConfig(ctx context.Context, config *Config) (*Pool, error) { return &Pool{}, nil }
+
+func (p *Pool) Close() {}
+
+func (p *Pool) Begin(ctx context.Context) (pgx.Tx, error) { return nil, nil }
+
+func (p *Pool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) { return nil, nil }
+
+func (p *Pool) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+
+func (p *Pool) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return nil, nil
+}
+
+func (p *Pool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, nil
+}
+
+func (p *Pool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row { return nil }
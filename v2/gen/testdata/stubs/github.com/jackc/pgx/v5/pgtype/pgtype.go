@@ -0,0 +1,130 @@
+// Package pgtype is a stub of github.com/jackc/pgx/v5/pgtype holding what the generated code uses,
+// for the golden tests to type check it without downloading the module.
+package pgtype
+
+import (
+	"math/big"
+	"time"
+)
+
+const (
+	TextFormatCode   = 0
+	BinaryFormatCode = 1
+)
+
+type InfinityModifier int8
+
+type Codec interface {
+	DecodeValue(m *Map, oid uint32, format int16, src []byte) (any, error)
+}
+
+type Type struct {
+	Codec Codec
+	Name  string
+	OID   uint32
+}
+
+type Map struct{}
+
+func NewMap() *Map { return &Map{} }
+
+func (m *Map) TypeForName(name string) (*Type, bool) { return nil, false }
+
+type Bool struct {
+	Bool  bool
+	Valid bool
+}
+
+func (b *Bool) Scan(src any) error { return nil }
+
+type Date struct {
+	Time             time.Time
+	InfinityModifier InfinityModifier
+	Valid            bool
+}
+
+func (d *Date) Scan(src any) error { return nil }
+
+type Float4 struct {
+	Float32 float32
+	Valid   bool
+}
+
+func (f *Float4) Scan(src any) error { return nil }
+
+type Float8 struct {
+	Float64 float64
+	Valid   bool
+}
+
+func (f *Float8) Scan(src any) error { return nil }
+
+type Int2 struct {
+	Int16 int16
+	Valid bool
+}
+
+func (dst *Int2) Scan(src any) error { return nil }
+
+type Int4 struct {
+	Int32 int32
+	Valid bool
+}
+
+func (dst *Int4) Scan(src any) error { return nil }
+
+type Int8 struct {
+	Int64 int64
+	Valid bool
+}
+
+func (dst *Int8) Scan(src any) error { return nil }
+
+type Interval struct {
+	Microseconds int64
+	Days         int32
+	Months       int32
+	Valid        bool
+}
+
+func (interval *Interval) Scan(src any) error { return nil }
+
+type Numeric struct {
+	Int              *big.Int
+	Exp              int32
+	NaN              bool
+	InfinityModifier InfinityModifier
+	Valid            bool
+}
+
+func (n *Numeric) Scan(src any) error { return nil }
+
+type Text struct {
+	String string
+	Valid  bool
+}
+
+func (dst *Text) Scan(src any) error { return nil }
+
+type Timestamp struct {
+	Time             time.Time
+	InfinityModifier InfinityModifier
+	Valid            bool
+}
+
+func (ts *Timestamp) Scan(src any) error { return nil }
+
+type Timestamptz struct {
+	Time             time.Time
+	InfinityModifier InfinityModifier
+	Valid            bool
+}
+
+func (tstz *Timestamptz) Scan(src any) error { return nil }
+
+type UUID struct {
+	Bytes [16]byte
+	Valid bool
+}
+
+func (dst *UUID) Scan(src any) error { return nil }
@@ -0,0 +1,91 @@
+// Package pgx is a stub of github.com/jackc/pgx/v5 holding what the generated code uses,
+// for the golden tests to type check it without downloading the module.
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var ErrNoRows = errors.New("no rows in result set")
+
+var ErrTxClosed = errors.New("tx is closed")
+
+type Row interface {
+	Scan(dest ...any) error
+}
+
+type Rows interface {
+	Close()
+	Err() error
+	Next() bool
+	Scan(dest ...any) error
+	Values() ([]any, error)
+	RawValues() [][]byte
+}
+
+type CollectableRow interface {
+	Scan(dest ...any) error
+	Values() ([]any, error)
+	RawValues() [][]byte
+}
+
+type RowToFunc[T any] func(row CollectableRow) (T, error)
+
+func CollectRows[T any](rows Rows, fn RowToFunc[T]) ([]T, error) { return nil, nil }
+
+func RowTo[T any](row CollectableRow) (T, error) {
+	var value T
+	return value, nil
+}
+
+type Identifier []string
+
+type CopyFromSource interface {
+	Next() bool
+	Values() ([]any, error)
+	Err() error
+}
+
+func CopyFromRows(rows [][]any) CopyFromSource { return nil }
+
+type TxIsoLevel string
+
+const (
+	Serializable    = TxIsoLevel("serializable")
+	RepeatableRead  = TxIsoLevel("repeatable read")
+	ReadCommitted   = TxIsoLevel("read committed")
+	ReadUncommitted = TxIsoLevel("read uncommitted")
+)
+
+type TxAccessMode string
+
+type TxDeferrableMode string
+
+type TxOptions struct {
+	IsoLevel       TxIsoLevel
+	AccessMode     TxAccessMode
+	DeferrableMode TxDeferrableMode
+}
+
+type Tx interface {
+	Begin(ctx context.Context) (Tx, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+
+	CopyFrom(ctx context.Context, tableName Identifier, columnNames []string, rowSrc CopyFromSource) (int64, error)
+
+	Exec(ctx context.Context, sql string, arguments ...any) (commandTag pgconn.CommandTag, err error)
+	Query(ctx context.Context, sql string, args ...any) (Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) Row
+}
+
+type ConnConfig struct {
+	Host     string
+	Port     uint16
+	Database string
+	User     string
+	Password string
+}
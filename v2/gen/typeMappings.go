@@ -0,0 +1,268 @@
+package gen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// TypeMapping describes a custom Go type for a database type, a domain or a single
+// table.column, along with the Go expressions used to scan and encode it.
+// Inside the expressions, $v stands for the value, $notNull for the not-null flag,
+// $s for a string and $a, $b for the two values being compared.
+type TypeMapping struct {
+	GoType string `json:"goType"` // e.g. "decimal.Decimal"
+	Import string `json:"import"` // e.g. "github.com/shopspring/decimal"
+
+	// The type the nullable columns are scanned into (e.g. "decimal.NullDecimal").
+	// If empty, the Go type is expected to handle NULL on its own, and the
+	// generated structure has no _IsNotNull field for the column.
+	NullableType   string `json:"nullableType"`
+	NullableImport string `json:"nullableImport"`
+	NullableInit   string `json:"nullableInit"` // the initial value of the nullable variable, if the zero value does not do
+
+	ScanValue      string `json:"scanValue"`      // the value held by the scanned nullable variable $v, e.g. "$v.Decimal"
+	ScanNotNull    string `json:"scanNotNull"`    // true if the scanned nullable variable $v is not null, e.g. "$v.Valid"
+	Encode         string `json:"encode"`         // the query argument for a not null value $v, defaults to "$v"
+	EncodeNullable string `json:"encodeNullable"` // the query argument for a nullable value, e.g. "decimal.NullDecimal{Decimal: $v, Valid: $notNull}"
+
+	FromString string `json:"fromString"` // parses the string $s into (value, error), used by the http methods
+	Less       string `json:"less"`       // true if $a sorts before $b, used by the sorting helpers
+	NewValue   string `json:"newValue"`   // a new random value, used for the uuid columns
+}
+
+// The well-known types only need the Go type and the import, the rest comes from here
+var typeMappingPresets = map[string]TypeMapping{
+
+	"github.com/shopspring/decimal.Decimal": {
+		NullableType:   "decimal.NullDecimal",
+		ScanValue:      "$v.Decimal",
+		ScanNotNull:    "$v.Valid",
+		EncodeNullable: "decimal.NullDecimal{Decimal: $v, Valid: $notNull}",
+		FromString:     "decimal.NewFromString($s)",
+		Less:           "$a.LessThan($b)",
+	},
+
+	"github.com/google/uuid.UUID": {
+		NullableType:   "uuid.NullUUID",
+		ScanValue:      "$v.UUID",
+		ScanNotNull:    "$v.Valid",
+		EncodeNullable: "uuid.NullUUID{UUID: $v, Valid: $notNull}",
+		FromString:     "uuid.Parse($s)",
+		NewValue:       "uuid.New()",
+	},
+
+	"github.com/gofrs/uuid.UUID": {
+		NullableType:   "uuid.NullUUID",
+		ScanValue:      "$v.UUID",
+		ScanNotNull:    "$v.Valid",
+		EncodeNullable: "uuid.NullUUID{UUID: $v, Valid: $notNull}",
+		FromString:     "uuid.FromString($s)",
+		NewValue:       "uuid.Must(uuid.NewV4())",
+	},
+
+	"github.com/satori/go.uuid.UUID": {
+		NullableType:   "uuid.NullUUID",
+		ScanValue:      "$v.UUID",
+		ScanNotNull:    "$v.Valid",
+		EncodeNullable: "uuid.NullUUID{UUID: $v, Valid: $notNull}",
+		FromString:     "uuid.FromString($s)",
+		NewValue:       "uuid.NewV4()",
+	},
+}
+
+// TypeMappings is the registry of the custom Go types, keyed by database type, domain or table.column
+type TypeMappings map[string]*TypeMapping
+
+// Add validates the mapping, fills in the well-known types and adds
+// it to the registry under the given key.
+func (m TypeMappings) Add(key string, mapping *TypeMapping) error {
+
+	if key == "" {
+		return fmt.Errorf("type mapping for %s: the key is required", mapping.GoType)
+	}
+	if err := mapping.complete(); err != nil {
+		return fmt.Errorf("type mapping %s: %s", key, err)
+	}
+
+	m[key] = mapping
+	return nil
+}
+
+// TypeMappingFor returns the custom type of a column, or nil. The table.column mapping
+// wins over a @gotype annotation in the column comment, which wins over the domain
+// mapping, which wins over the database type one.
+// The json and jsonb columns bound to a Go type are marshalled to and from it.
+func (g *Generator) TypeMappingFor(tableName, columnName, dataType, udtName, domainName, comment string) *TypeMapping {
+
+	mapping := g.typeMappingFor(tableName, columnName, dataType, udtName, domainName, comment)
+
+	if mapping != nil && IsJSONType(dataType, udtName) {
+		return mapping.forJSON()
+	}
+	return mapping
+}
+
+func (g *Generator) typeMappingFor(tableName, columnName, dataType, udtName, domainName, comment string) *TypeMapping {
+
+	var mappings TypeMappings
+	if g != nil {
+		mappings = g.TypeMappings
+	}
+
+	if mapping, found := mappings[tableName+"."+columnName]; found {
+		return mapping
+	}
+
+	if goTypeSpec := ParseAnnotations(comment).GoType; goTypeSpec != "" {
+		goType, goImport := ParseGoTypeSpec(goTypeSpec)
+		mapping := &TypeMapping{GoType: goType, Import: goImport}
+		if err := mapping.complete(); err == nil {
+			return mapping
+		}
+	}
+
+	for _, key := range []string{domainName, udtName, dataType} {
+		if key == "" {
+			continue
+		}
+		if mapping, found := mappings[key]; found {
+			return mapping
+		}
+	}
+
+	return nil
+}
+
+// IsJSONType returns true for the json and jsonb database types
+func IsJSONType(dataType, udtName string) bool {
+	return dataType == "json" || dataType == "jsonb" || udtName == "json" || udtName == "jsonb"
+}
+
+// applyTo switches a resolved column to the custom type. It returns the imports
+// the custom type needs.
+func (m *TypeMapping) applyTo(col *Column) []string {
+
+	var imports []string
+	if m.Import != "" {
+		imports = append(imports, m.Import)
+	}
+
+	col.TypeMapping = m
+	col.GoType = m.GoType
+	col.GoNameForInsert = col.GoName
+	col.IsGuid = col.IsGuid && m.NewValue != ""
+
+	if col.Nullable && m.NullableType != "" {
+		col.GoNullableType = m.NullableType
+		if m.NullableImport != "" {
+			imports = append(imports, m.NullableImport)
+		}
+	} else {
+		// the custom type deals with NULL itself
+		col.GoNullableType, col.Nullable = "", false
+	}
+
+	return imports
+}
+
+// complete fills in the well-known types and validates the mapping
+func (m *TypeMapping) complete() error {
+
+	if m.GoType == "" {
+		return fmt.Errorf("the Go type is required")
+	}
+
+	if m.Import != "" {
+		if preset, found := typeMappingPresets[m.Import+"."+goTypeName(m.GoType)]; found {
+			m.fillFrom(&preset)
+		}
+	}
+
+	if m.NullableType != "" && (m.ScanValue == "" || m.ScanNotNull == "" || m.EncodeNullable == "") {
+		return fmt.Errorf("a nullableType also needs scanValue, scanNotNull and encodeNullable")
+	}
+
+	return nil
+}
+
+// forJSON returns the mapping for a json or jsonb column. Unless the mapping says otherwise,
+// pgx marshals the not null values on its own, and the nullable ones go through the
+// JSONColumn type of the models package.
+func (m *TypeMapping) forJSON() *TypeMapping {
+
+	if m.NullableType != "" {
+		return m
+	}
+
+	jsonMapping := *m
+	jsonMapping.NullableType = "JSONColumn"
+	jsonMapping.NullableInit = "JSONColumn{Target: new(" + m.GoType + ")}"
+	jsonMapping.ScanValue = "*$v.Target.(*" + m.GoType + ")"
+	jsonMapping.ScanNotNull = "$v.Valid"
+	jsonMapping.EncodeNullable = "JSONColumn{Target: $v, Valid: $notNull}"
+
+	return &jsonMapping
+}
+
+func (m *TypeMapping) fillFrom(preset *TypeMapping) {
+
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+
+	fill(&m.NullableType, preset.NullableType)
+	fill(&m.NullableImport, preset.NullableImport)
+	fill(&m.NullableInit, preset.NullableInit)
+	fill(&m.ScanValue, preset.ScanValue)
+	fill(&m.ScanNotNull, preset.ScanNotNull)
+	fill(&m.Encode, preset.Encode)
+	fill(&m.EncodeNullable, preset.EncodeNullable)
+	fill(&m.FromString, preset.FromString)
+	fill(&m.Less, preset.Less)
+	fill(&m.NewValue, preset.NewValue)
+}
+
+// expand replaces the $placeholders of a mapping expression, given as name, value pairs
+func (m *TypeMapping) expand(expression string, placeholderValues ...string) string {
+	return strings.NewReplacer(placeholderValues...).Replace(expression)
+}
+
+// ParseGoTypeSpec splits a "github.com/shopspring/decimal.Decimal" type into
+// the Go type ("decimal.Decimal") and the import path. Types without a path
+// (e.g. "string", or a type declared in the models package) have no import.
+func ParseGoTypeSpec(spec string) (goType, goImport string) {
+
+	dotPos := strings.LastIndex(spec, ".")
+	if dotPos < 0 || dotPos < strings.LastIndex(spec, "/") {
+		return spec, ""
+	}
+
+	goImport, typeName := spec[:dotPos], spec[dotPos+1:]
+	return goPackageName(goImport) + "." + typeName, goImport
+}
+
+// goPackageName guesses the package name of an import path, the way goimports does
+func goPackageName(importPath string) string {
+
+	name := path.Base(importPath)
+
+	// major version suffixes, e.g. github.com/jackc/pgx/v4
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+
+	name = strings.TrimPrefix(name, "go-")
+	name = strings.TrimPrefix(name, "go.")
+	name = strings.TrimSuffix(name, ".go")
+	name = strings.TrimSuffix(name, "-go")
+
+	return strings.Replace(name, "-", "", -1)
+}
+
+// goTypeName returns the type name without the package, e.g. "Decimal" for "decimal.Decimal"
+func goTypeName(goType string) string {
+	return goType[strings.LastIndex(goType, ".")+1:]
+}
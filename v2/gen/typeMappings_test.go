@@ -0,0 +1,136 @@
+package gen_test
+
+import (
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestTypeMappingForPrecedence(t *testing.T) {
+
+	generator := &gen.Generator{Options: gen.Options{TypeMappings: gen.TypeMappings{}}}
+	for key, goType := range map[string]string{
+		"orders.total": "ColumnType",
+		"amount":       "DomainType",
+		"numeric":      "UdtType",
+		"USER-DEFINED": "DataType",
+	} {
+		if err := generator.TypeMappings.Add(key, &gen.TypeMapping{GoType: goType}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		table      string
+		column     string
+		dataType   string
+		udtName    string
+		domainName string
+		comment    string
+		want       string
+	}{
+		{"table.column wins over everything", "orders", "total", "numeric", "numeric", "amount", "@gotype:Annotated", "ColumnType"},
+		{"annotation wins over the domain", "orders", "subtotal", "numeric", "numeric", "amount", "net @gotype:Annotated", "Annotated"},
+		{"malformed annotation falls through", "orders", "subtotal", "numeric", "numeric", "amount", "@gotype:", "DomainType"},
+		{"domain wins over the type", "orders", "subtotal", "numeric", "numeric", "amount", "", "DomainType"},
+		{"udt name wins over the data type", "orders", "subtotal", "USER-DEFINED", "numeric", "", "", "UdtType"},
+		{"data type", "orders", "subtotal", "USER-DEFINED", "ltree", "", "", "DataType"},
+		{"no mapping", "orders", "subtotal", "integer", "int4", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			mapping := generator.TypeMappingFor(test.table, test.column, test.dataType, test.udtName, test.domainName, test.comment)
+			got := ""
+			if mapping != nil {
+				got = mapping.GoType
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	var noGenerator *gen.Generator
+	if mapping := noGenerator.TypeMappingFor("orders", "meta", "jsonb", "jsonb", "", "@gotype:example.com/meta.Meta"); mapping == nil || mapping.Import != "example.com/meta" {
+		t.Errorf("a nil generator should still honour the annotation, got %+v", mapping)
+	}
+}
+
+func TestTypeMappingForJSON(t *testing.T) {
+
+	generator := &gen.Generator{Options: gen.Options{TypeMappings: gen.TypeMappings{}}}
+	if err := generator.TypeMappings.Add("jsonb", &gen.TypeMapping{GoType: "Settings"}); err != nil {
+		t.Fatal(err)
+	}
+
+	mapping := generator.TypeMappingFor("users", "settings", "jsonb", "jsonb", "", "")
+	if mapping.NullableType != "JSONColumn" || mapping.ScanValue != "*$v.Target.(*Settings)" {
+		t.Errorf("a json column bound to a Go type should go through JSONColumn, got %+v", mapping)
+	}
+	if generator.TypeMappings["jsonb"].NullableType != "" {
+		t.Errorf("the registered mapping should be left untouched")
+	}
+
+	explicit := &gen.TypeMapping{GoType: "Settings", NullableType: "NullSettings", ScanValue: "$v.Settings", ScanNotNull: "$v.Valid", EncodeNullable: "NullSettings{$v, $notNull}"}
+	if err := generator.TypeMappings.Add("users.settings", explicit); err != nil {
+		t.Fatal(err)
+	}
+	if mapping := generator.TypeMappingFor("users", "settings", "jsonb", "jsonb", "", ""); mapping != explicit {
+		t.Errorf("a json mapping with its own nullable type should be kept, got %+v", mapping)
+	}
+}
+
+func TestTypeMappingsAdd(t *testing.T) {
+
+	mappings := gen.TypeMappings{}
+
+	mapping := &gen.TypeMapping{GoType: "uuid.UUID", Import: "github.com/google/uuid", FromString: "parseUUID($s)"}
+	if err := mappings.Add("uuid", mapping); err != nil {
+		t.Fatal(err)
+	}
+	if mapping.NullableType != "uuid.NullUUID" || mapping.NewValue != "uuid.New()" || mapping.FromString != "parseUUID($s)" {
+		t.Errorf("the preset should fill in the missing settings only, got %+v", mapping)
+	}
+
+	if err := mappings.Add("", &gen.TypeMapping{GoType: "int64"}); err == nil {
+		t.Errorf("a mapping without a key should be rejected")
+	}
+	if err := mappings.Add("money", &gen.TypeMapping{}); err == nil {
+		t.Errorf("a mapping without a Go type should be rejected")
+	}
+	if _, found := mappings["money"]; found {
+		t.Errorf("a rejected mapping should not be registered")
+	}
+}
+
+func TestParseGoTypeSpec(t *testing.T) {
+
+	tests := []struct {
+		spec     string
+		goType   string
+		goImport string
+	}{
+		{"string", "string", ""},
+		{"Currency", "Currency", ""},
+		{"github.com/shopspring/decimal.Decimal", "decimal.Decimal", "github.com/shopspring/decimal"},
+		{"github.com/jackc/pgx/v4.Identifier", "pgx.Identifier", "github.com/jackc/pgx/v4"},
+		{"github.com/satori/go.uuid.UUID", "uuid.UUID", "github.com/satori/go.uuid"},
+		{"github.com/go-playground/validator.Validate", "validator.Validate", "github.com/go-playground/validator"},
+		{"example.com/geo-types.Point", "geotypes.Point", "example.com/geo-types"},
+		{"encoding/json.RawMessage", "json.RawMessage", "encoding/json"},
+		{"example.com/pkg", "example.com/pkg", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+
+			goType, goImport := gen.ParseGoTypeSpec(test.spec)
+			if goType != test.goType || goImport != test.goImport {
+				t.Errorf("got %s from %q, want %s from %q", goType, goImport, test.goType, test.goImport)
+			}
+		})
+	}
+}
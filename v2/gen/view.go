@@ -0,0 +1,385 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* View Section */
+
+type View struct {
+	Options *Generator
+
+	Columns       []Column
+	ColumnsString string
+
+	DbName         string
+	GoFriendlyName string
+	DbComments     string
+
+	GoTypesToImport map[string]string
+
+	GeneratedTemplate GeneratedSource
+
+	// the methods of the t<Name>Utils type, for the REPOSITORY_TEMPLATE
+	RepositoryMethods []RepositoryMethod
+
+	// holds a typical SELECT FROM with all the db columns without any WHERE condition
+	GenericSelectQuery string
+
+	// holds a typical INSERT query, postgres style
+	GenericInsertQuery     string
+	GenericInsertQueryNoPK string
+
+	// holds the parameters comma-separated
+	ParamString     string
+	ParamStringNoPK string
+
+	// true if view is a materialized view, false otherwise
+	IsMaterialized bool
+
+	// this value is true for tables, false for views
+	IsTable bool
+
+	// the overrides from the configuration file, nil if there are none
+	Config *TableConfig
+
+	// set when the generation failed and the view is skipped, with -keep-going
+	failed bool
+
+	// the progress messages, printed in order once all the objects are generated
+	progress progressLog
+
+	// the fingerprint of the generated file, and whether it needs to be rendered again
+	fingerprint fingerprintCheck
+}
+
+// AddColumn resolves the Go type of a view column and appends the column to the view.
+// The domainName is empty for the columns not based on a domain, the comment is the
+// column comment, which may hold a @gotype annotation.
+func (v *View) AddColumn(columnName, dataType, udtName, domainName, comment string, nullable bool, columnDefault *string, maxLength int) {
+
+	columnConfig := v.Config.ColumnConfigFor(columnName).WithAnnotations(comment)
+	if columnConfig.IsSkipped() {
+		return
+	}
+
+	typeMapping := v.Options.TypeMappingFor(v.DbName, columnName, dataType, udtName, domainName, comment)
+
+	resolvedGoType, nullableType, goTypeToImport := v.Options.GoTypeForColumn(dataType, nullable, udtName)
+
+	if goTypeToImport != "" && typeMapping == nil {
+		v.AddGoTypeToImport(goTypeToImport)
+	}
+
+	// instantiate a column struct
+	currentColumn := &Column{
+		DbName:       columnName,
+		DbComments:   comment,
+		Type:         dataType,
+		DefaultValue: columnDefault,
+		Nullable:     nullable,
+		MaxLength:    maxLength,
+		IsSequence:   DecodeIsColumnSequence(columnDefault),
+
+		IsCompositePK: false, IsPK: false, IsFK: false,
+
+		GoName:         GetGoFriendlyNameForColumn(columnName),
+		GoType:         resolvedGoType,
+		GoNullableType: nullableType,
+
+		Options: v.Options,
+	}
+
+	// switch to the custom Go type, if any
+	if typeMapping != nil {
+		for _, goTypeToImport := range typeMapping.applyTo(currentColumn) {
+			v.AddGoTypeToImport(goTypeToImport)
+		}
+	}
+
+	columnConfig.applyTo(currentColumn)
+
+	v.Columns = append(v.Columns, *currentColumn)
+}
+
+// SelectSource returns what the select queries read from, the view itself
+func (v *View) SelectSource() string {
+	return v.DbName
+}
+
+// SensitiveColumns returns the columns left out of String()
+func (v *View) SensitiveColumns() []Column {
+
+	var sensitiveColumns []Column
+	for i := range v.Columns {
+		if v.Columns[i].IsSensitive {
+			sensitiveColumns = append(sensitiveColumns, v.Columns[i])
+		}
+	}
+	return sensitiveColumns
+}
+
+func (v *View) AddGoTypeToImport(goTypeToImport string) {
+
+	if v.GoTypesToImport == nil {
+		v.GoTypesToImport = make(map[string]string)
+	}
+
+	v.GoTypesToImport[goTypeToImport] = goTypeToImport
+}
+
+func (v *View) CreateGenericQueries() {
+
+	// BEGIN Create the generic SELECT query
+	if v.Columns != nil {
+		genericSelectQueryBuffer := bytes.Buffer{}
+
+		// The SELECT prefix
+		genericSelectQueryBuffer.WriteString("SELECT ")
+
+		// the column names, comma-separated
+		genericSelectQueryBuffer.WriteString(v.getSqlFriendlyColumnList())
+
+		// The FROM section
+		genericSelectQueryBuffer.WriteString(" FROM " + v.DbName + " ")
+		v.GenericSelectQuery = genericSelectQueryBuffer.String()
+	}
+	// END Create the generic SELECT query
+
+}
+
+// returns a string of comma separated database column names, as they are used in SELECT
+// or INSERT sql statements (e.g. "username, first_name, last_name")
+// if ignoreSequenceColumns is true, it checks which columns are auto-generated via
+// sequences and does not include those.
+func (v *View) getSqlFriendlyColumnList() string {
+
+	genericQueryFriendlyColumnsBuffer := bytes.Buffer{}
+
+	var totalNumberOfColumns int = len(v.Columns) - 1
+	var colNameToWriteToBuffer string = ""
+
+	for colRange := range v.Columns {
+
+		if totalNumberOfColumns == colRange {
+			colNameToWriteToBuffer = v.Columns[colRange].DbName
+		} else {
+			colNameToWriteToBuffer = v.Columns[colRange].DbName + ", "
+		}
+
+		genericQueryFriendlyColumnsBuffer.WriteString(colNameToWriteToBuffer)
+	}
+
+	finalString := genericQueryFriendlyColumnsBuffer.String()
+
+	// just in case ignoring sequence columns happened to produce a situation where there is a
+	// comma followed by space at the end of the string, let's strip it
+	if strings.HasSuffix(finalString, ", ") {
+		finalString = strings.TrimSuffix(finalString, ", ")
+	}
+
+	return finalString
+}
+
+// Returns a string of comma separated parameters, incremented by 1, Postgres style,
+// but taking into account if some columns are have default sequence autogeneration,
+// hence should not be inserted
+func (v *View) getSqlFriendlyParameters() string {
+
+	genericQueryFriendlyParamsBuffer := bytes.Buffer{}
+
+	var totalNumberOfColumns int = len(v.Columns) - 1
+	var paramToWriteToBuffer string = ""
+
+	var realParamCount int = 1
+
+	for colRange := range v.Columns {
+
+		// we cannot rely on the colRange iterator because we may skip columns
+		// which are sequence based, so we would have a situation such as
+		// "$1, $3, $4, etc" with $2 missing due to the continue statement above
+		var currentParamCount string = "$" + strconv.Itoa(realParamCount)
+		realParamCount = realParamCount + 1
+
+		if totalNumberOfColumns == colRange {
+			paramToWriteToBuffer = currentParamCount
+		} else {
+			paramToWriteToBuffer = currentParamCount + ", "
+		}
+
+		genericQueryFriendlyParamsBuffer.WriteString(paramToWriteToBuffer)
+	}
+
+	finalString := genericQueryFriendlyParamsBuffer.String()
+
+	// just in case ignoring sequence columns happened to produce a situation where there is a
+	// comma followed by space at the end of the string, let's strip it
+	if strings.HasSuffix(finalString, ", ") {
+		finalString = strings.TrimSuffix(finalString, ", ")
+	}
+
+	return finalString
+}
+
+func (v *View) GenerateViewStruct() error {
+
+	return v.generateAndAppendTemplate("VIEW_TEMPLATE", VIEW_TEMPLATE, "View structure generated.")
+}
+
+// ShouldGenerate returns true unless the configuration file turns off the given template group
+func (v *View) ShouldGenerate(group string) bool {
+	return v.Config.ShouldGenerate(group)
+}
+
+// IsCacheDisabled returns true if the configuration file turns off caching for the view
+func (v *View) IsCacheDisabled() bool {
+	return v.Config != nil && v.Config.NoCache
+}
+
+func (v *View) GenerateSelectFunctions() error {
+
+	// the cache is part of the view structure, so it always gets generated
+	if !v.ShouldGenerate(TEMPLATE_GROUP_SELECT) {
+		return v.generateAndAppendTemplate("TABLE_TEMPLATE_CACHE", TABLE_TEMPLATE_CACHE, "")
+	}
+
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_WHERE", SELECT_TEMPLATE_WHERE, ""); err != nil {
+		return err
+	}
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_ALL", SELECT_TEMPLATE_ALL, ""); err != nil {
+		return err
+	}
+
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_WHERE_TX", SELECT_TEMPLATE_WHERE_TX, ""); err != nil {
+		return err
+	}
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_ALL_TX", SELECT_TEMPLATE_ALL_TX, ""); err != nil {
+		return err
+	}
+
+	// generate the caching functionality
+	if err := v.generateAndAppendTemplate("TABLE_TEMPLATE_CACHE", TABLE_TEMPLATE_CACHE, ""); err != nil {
+		return err
+	}
+
+	// generate the extra functionality (count, first, last, single)
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_COUNT", SELECT_TEMPLATE_COUNT, ""); err != nil {
+		return err
+	}
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_SINGLE_ATOMIC", SELECT_TEMPLATE_SINGLE_ATOMIC, ""); err != nil {
+		return err
+	}
+	if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_SINGLE_TX", SELECT_TEMPLATE_SINGLE_TX, ""); err != nil {
+		return err
+	}
+
+	// generate the keyset pagination, when there is a key to page by
+	keys, err := v.KeysetKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		if err := v.generateAndAppendTemplate("SELECT_TEMPLATE_AFTER", SELECT_TEMPLATE_AFTER, ""); err != nil {
+			return err
+		}
+	}
+
+	v.progress.println("View select functions generated.")
+
+	return nil
+}
+
+// RenderFile returns the formatted generated code of the view, once all the templates ran
+func (v *View) RenderFile() (File, error) {
+
+	file := File{Name: v.fileName(), Kind: OBJECT_VIEW, Object: v.DbName, GoName: v.GoFriendlyName, Fingerprint: &v.fingerprint.Fingerprint}
+
+	// the file of the previous run is up to date
+	if v.fingerprint.unchanged {
+		file.Unchanged = true
+		return file, nil
+	}
+
+	source, err := v.GeneratedTemplate.Format(file.Name)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderFile(): error formatting the generated code for view %s: %s", v.DbName, err)
+	}
+	file.Content = source
+
+	return file, nil
+}
+
+func (v *View) fileName() string {
+	return CamelCase(v.GoFriendlyName) + ".go"
+}
+
+// RenderCustomFile returns the custom file of the view, which is only written if it is not already in the folder.
+// Custom files are ideal to place your own methods in addition to the auto-generated ones.
+// If the generated files is named user.go, the custom file would be named: user-custom.go
+func (v *View) RenderCustomFile() (File, error) {
+
+	tmpl, err := v.Options.parsedTemplate("VIEW_TEMPLATE_CUSTOM", VIEW_TEMPLATE_CUSTOM)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error parsing the custom file template for view %s: %s", v.DbName, err)
+	}
+
+	var generatedCustomFileTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedCustomFileTemplate, v)
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error running the custom file template for view %s: %s", v.DbName, err)
+	}
+
+	var customFileName string = CamelCase(v.GoFriendlyName) + "-custom.go"
+
+	source, err := FormatGoSource(customFileName, generatedCustomFileTemplate.Bytes())
+	if err != nil {
+		return File{}, fmt.Errorf("RenderCustomFile(): error formatting the custom file for view %s: %s", v.DbName, err)
+	}
+
+	return File{Name: customFileName, Content: source, Kind: OBJECT_VIEW, Object: v.DbName, GoName: v.GoFriendlyName, WriteOnce: true}, nil
+}
+
+// GenerateUserTemplates renders the additional view templates from the -templates folder,
+// in the order of their file names, and appends them to the generated code of the view
+func (v *View) GenerateUserTemplates() error {
+
+	if v.Options.Templates == nil {
+		return nil
+	}
+
+	for _, userTemplate := range v.Options.Templates.ViewTemplates {
+		if err := v.generateAndAppendTemplate(userTemplate.Name, userTemplate.Content, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *View) generateAndAppendTemplate(templateName string, templateContent string, taskCompletionMessage string) error {
+
+	// parsed once per run, a template with the same name in the -templates folder replaces the built-in one
+	tmpl, err := v.Options.parsedTemplate(templateName, templateContent)
+	if err != nil {
+		return fmt.Errorf("error parsing the %s template for view %s: %s", templateName, v.DbName, err)
+	}
+
+	var generatedTemplate bytes.Buffer
+	err = tmpl.Execute(&generatedTemplate, v)
+	if err != nil {
+		return fmt.Errorf("error running the %s template for view %s: %s", templateName, v.DbName, err)
+	}
+
+	if _, err = v.GeneratedTemplate.WriteTemplate(templateName, generatedTemplate.Bytes()); err != nil {
+		return fmt.Errorf("error writing the output of the %s template for view %s: %s", templateName, v.DbName, err)
+	}
+
+	if taskCompletionMessage != "" {
+		v.progress.println(taskCompletionMessage)
+	}
+
+	return nil
+}
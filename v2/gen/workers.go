@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+// forEach calls fn for each index from 0 to n-1, on up to jobs goroutines at a time, and
+// returns the errors by index. With jobs <= 1, the calls are made in order, on the calling goroutine.
+// The callers go through the results in index order, so the outcome does not depend on the scheduling.
+func forEach(jobs, n int, fn func(i int) error) []error {
+
+	errs := make([]error, n)
+
+	if jobs <= 1 || n <= 1 {
+		for i := 0; i < n; i++ {
+			errs[i] = fn(i)
+		}
+		return errs
+	}
+
+	if jobs > n {
+		jobs = n
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errs
+}
+
+// progressLog holds the progress messages of a table, view or function. The objects are
+// generated concurrently, so their messages are kept aside and printed in order once all are done.
+type progressLog struct {
+	bytes.Buffer
+}
+
+func (p *progressLog) println(a ...interface{}) {
+	fmt.Fprintln(&p.Buffer, a...)
+}
+
+// flushProgress prints the progress messages of an object, if there is somewhere to print them
+func (g *Generator) flushProgress(p *progressLog) {
+	if g.Log != nil {
+		g.Log.Write(p.Bytes())
+	}
+	p.Reset()
+}
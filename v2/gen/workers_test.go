@@ -0,0 +1,73 @@
+package gen
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestForEach(t *testing.T) {
+
+	for _, jobs := range []int{0, 1, 3, 50} {
+
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		var order []int
+
+		errs := forEach(jobs, 20, func(i int) error {
+
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			order = append(order, i)
+			mutex.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+
+			if i%7 == 0 {
+				return errors.New(strconv.Itoa(i))
+			}
+			return nil
+		})
+
+		// the errors are returned by index, whatever the order the calls finished in
+		if len(errs) != 20 {
+			t.Fatalf("jobs %d: got %d results", jobs, len(errs))
+		}
+		for i, err := range errs {
+			if (err != nil) != (i%7 == 0) || (err != nil && err.Error() != strconv.Itoa(i)) {
+				t.Errorf("jobs %d: got the error %v at %d", jobs, err, i)
+			}
+		}
+		if len(order) != 20 {
+			t.Errorf("jobs %d: got %d calls", jobs, len(order))
+		}
+
+		switch {
+		case jobs <= 1:
+			if maxRunning != 1 {
+				t.Errorf("jobs %d: got %d concurrent calls", jobs, maxRunning)
+			}
+			for i, index := range order {
+				if index != i {
+					t.Errorf("jobs %d: the calls should be made in order, got %v", jobs, order)
+					break
+				}
+			}
+		case maxRunning > jobs:
+			t.Errorf("jobs %d: got %d concurrent calls", jobs, maxRunning)
+		}
+	}
+
+	if errs := forEach(4, 0, func(i int) error { t.Errorf("no call expected"); return nil }); len(errs) != 0 {
+		t.Errorf("got %v", errs)
+	}
+}
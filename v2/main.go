@@ -5,18 +5,41 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
+	"runtime"
 	"strconv"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+	"github.com/silviucm/pgtogogen/v2/schema"
 )
 
 const ARGS_ERROR_HEADER string = "\n-------------------------\nARGUMENTS ERROR:\n-------------------------\n"
 
-var dbHost, dbPort, dbName, dbUser, dbPass, dbSchema, dbSSLMode, outputFolder, packageName *string
-var createFolderIfNotExists, debug *bool
-var generateFunctions, generatePKGetters, generateUQGetters, generateGuidGetters *bool
+// the exit codes of the tool
+const (
+	EXIT_OK      = 0 // everything got generated
+	EXIT_ERROR   = 1 // the generation stopped, no files or only some of them were written
+	EXIT_USAGE   = 2 // invalid flags or configuration, nothing was done
+	EXIT_PARTIAL = 3 // with -keep-going: the generation completed, but some objects failed and were skipped
+	EXIT_DRIFT   = 4 // with -check: the generated files in the output folder are out of date
+)
+
+var dbHost, dbPort, dbName, dbUser, dbPass, dbSchema, dbSSLMode, outputFolder, packageName, target, ddlFile, configFile *string
+var templatesFolder, dumpTemplatesFolder, dumpSchemaFile *string
+var createFolderIfNotExists, debug, keepGoing, check, force, ctxOnly *bool
+var jobs *int
+var generateFunctions, generatePKGetters, generateUQGetters, generateGuidGetters, generateRepositories *bool
+
+var typeMappingFlags TypeMappingFlags
 
 var dbPortUInt16 uint16 = 5432
 
 func main() {
+	os.Exit(run())
+}
+
+// run generates the models and returns the exit code of the tool
+func run() int {
 
 	// collect command-line flags
 
@@ -29,6 +52,15 @@ func main() {
 	dbSchema = flag.String("schema", "public", "database schema, defaults to 'public' if left empty")
 	dbSSLMode = flag.String("ssl", "", "SSL mode (defaults to 'prefer'), one of the standard sslmode connection string values ")
 
+	// offline mode: read the schema from a DDL file instead of connecting to the database
+	ddlFile = flag.String("ddl", "", "path to a SQL DDL file (e.g. pg_dump --schema-only output) to generate from, instead of connecting to the database")
+
+	// save the schema read, to generate from it later with -ddl=<file>.json, e.g. in tests, without the database
+	dumpSchemaFile = flag.String("dump-schema", "", "write the schema read from the database or the DDL file to this JSON file and exit")
+
+	// project configuration file, holding the values for any of these flags plus the per-table overrides
+	configFile = flag.String("config", "", "path to the "+CONFIG_FILE_NAME+" project file, by default looked up in the current folder and its parents")
+
 	// location settings
 	outputFolder = flag.String("o", "./models", "the output folder to generate the db structures, defaults to models")
 
@@ -38,20 +70,60 @@ func main() {
 	// debug mode to help identify crashes (print out the query before crashing)
 	debug = flag.Bool("debug", false, "when true, increase debug verbosity to help identify the nature of the crash")
 
+	// error handling: skip the failing objects instead of stopping at the first error
+	keepGoing = flag.Bool("keep-going", false, "skip the tables, views and functions that fail, generate the rest and report the failures at the end (exit code 3)")
+
+	// incremental generation: render the objects whose fingerprint did not change as well
+	force = flag.Bool("force", false, "regenerate every file, even the ones whose table, view or function and templates did not change since the previous run")
+
+	// concurrency: the tables, views and functions are read and generated this many at a time
+	jobs = flag.Int("j", runtime.NumCPU(), "the number of tables, views and functions read and generated at the same time, defaults to the number of CPUs")
+
+	// drift detection: compare the generated code with the files in the output folder, write nothing
+	check = flag.Bool("check", false, "do not write anything, report the generated files that differ from the schema and exit with code 4 if any")
+
 	// package settings
 	packageName = flag.String("pkg", "models", "the package name for the generated files")
 
+	// the driver the generated code is written for
+	target = flag.String("target", gen.TARGET_PGX4, "the driver the generated code is written for, "+gen.TARGET_PGX4+", "+gen.TARGET_PGX5+" or "+gen.TARGET_SQL+" (database/sql)")
+	ctxOnly = flag.Bool("ctxOnly", false, "the generated methods take a context.Context first, without the variants using context.Background()")
+
 	// output settings
 	generateFunctions = flag.Bool("fn", false, "generate functions, defaults to false")
 	generatePKGetters = flag.Bool("pk", true, "generate pk get methods, defaults to true")
 	generateUQGetters = flag.Bool("uq", true, "generate unique constraints get methods, defaults to true")
 	generateGuidGetters = flag.Bool("guid", true, "generate guid columns select methods, defaults to true")
+	generateRepositories = flag.Bool("repo", false, "generate a repository interface and its mock for each table and view, and an in-memory fake for each table, defaults to false")
+
+	// templates: replace the built-in ones, add new ones, or write the built-in ones out as a starting point
+	templatesFolder = flag.String("templates", "", "folder with .tmpl files replacing the built-in templates of the same name, or rendered for each table (table.*.tmpl), view (view.*.tmpl) or function (function.*.tmpl)")
+	dumpTemplatesFolder = flag.String("dump-templates", "", "write the built-in templates to this folder and exit")
+
+	// custom Go types, can be repeated
+	flag.Var(&typeMappingFlags, "type", "custom Go type for a database type, domain or table.column, e.g. -type=numeric=github.com/shopspring/decimal.Decimal (can be repeated)")
 
 	flag.Parse()
 
+	// load the project configuration file, the flags set on the command line take precedence
+	projectConfig, err := loadProjectConfig()
+	if err != nil {
+		fmt.Println("Configuration file error: " + err.Error() + ". Exiting here.")
+		return EXIT_USAGE
+	}
+
+	// write the built-in templates and exit, no database needed
+	if *dumpTemplatesFolder != "" {
+		if err := gen.DumpTemplates(*dumpTemplatesFolder, os.Stdout); err != nil {
+			fmt.Println("DumpTemplates error: " + err.Error() + ". Exiting here.")
+			return EXIT_ERROR
+		}
+		return EXIT_OK
+	}
+
 	// validate and exit if not true
 	if validateFlags() == false {
-		return
+		return EXIT_USAGE
 	}
 
 	// assign the options to a ToolOptions struct
@@ -63,78 +135,175 @@ func main() {
 		DbPass:    *dbPass,
 		DbSchema:  *dbSchema,
 		DbSSLMode: *dbSSLMode,
-
-		PgxImport:     "github.com/jackc/pgx/v4",
-		PgxPoolImport: "github.com/jackc/pgx/v4/pgxpool",
-		PgTypeImport:  "github.com/jackc/pgx/pgtype",
-		PgConnImport:  "github.com/jackc/pgconn",
+		Debug:     *debug,
 
 		OutputFolder:            *outputFolder,
 		CreateFolderIfNotExists: *createFolderIfNotExists,
-		PackageName:             *packageName,
 
-		GenerateFunctions:   *generateFunctions,
-		GeneratePKGetters:   *generatePKGetters,
-		GenerateUQGetters:   *generateUQGetters,
-		GenerateGuidGetters: *generateGuidGetters}
+		GenOptions: gen.Options{
+			PackageName: *packageName,
 
-	// initialize the database and acquire the database handle
-	db, err := options.InitDatabase()
-	if err != nil {
-		if db != nil {
-			db.Close()
-		}
-		// exit here
-		fmt.Println("InitDatabase error: " + err.Error() + ".Exiting here.")
-		return
+			GenerateFunctions:   *generateFunctions,
+			GeneratePKGetters:   *generatePKGetters,
+			GenerateUQGetters:   *generateUQGetters,
+			GenerateGuidGetters: *generateGuidGetters,
+
+			GenerateRepositories: *generateRepositories,
 
+			CtxOnly: *ctxOnly,
+
+			KeepGoing: *keepGoing,
+
+			Jobs:  *jobs,
+			Force: *force,
+
+			Log: os.Stdout,
+		},
+
+		CheckOnly: *check,
+
+		Config: projectConfig}
+
+	// the target sets the import paths of the pgx packages as well, if any
+	if err := options.GenOptions.SetTarget(*target); err != nil {
+		fmt.Println("Target error: " + err.Error() + ". Exiting here.")
+		return EXIT_USAGE
 	}
 
-	// make sure the db gets closed eventually
-	defer func() {
+	if projectConfig != nil {
+		options.GenOptions.TableConfigs = projectConfig.Tables
+		options.GenOptions.FunctionConfigs = projectConfig.FunctionOverrides
+	}
 
-		if db != nil {
-			db.Close()
+	// collect the custom Go types from the configuration file and the -type flags
+	if err := options.CollectTypeMappings(typeMappingFlags); err != nil {
+		fmt.Println("Type mapping error: " + err.Error() + ". Exiting here.")
+		return EXIT_USAGE
+	}
+	options.GenOptions.TypeMappings = options.TypeMappings
+
+	// load the templates replacing or adding to the built-in ones
+	if *templatesFolder != "" {
+		templateSet, err := gen.LoadTemplates(*templatesFolder)
+		if err != nil {
+			fmt.Println("Templates error: " + err.Error() + ". Exiting here.")
+			return EXIT_USAGE
 		}
-	}()
+		options.GenOptions.Templates = templateSet
+	}
+
+	// the fingerprints of the previous run tell which objects need to be rendered again
+	if err := options.LoadPreviousManifest(); err != nil {
+		fmt.Println("Manifest error: " + err.Error() + ". Exiting here.")
+		return EXIT_ERROR
+	}
 
-	// start collecting db info
-	options.Collect()
+	// read the schema, from the DDL file or from the database
+	db, err := options.ReadSchema(*ddlFile)
+	if err != nil {
+		if *ddlFile != "" {
+			fmt.Println("CollectFromDDL error: " + err.Error() + ".Exiting here.")
+		} else {
+			fmt.Println("Collect error: " + err.Error() + ".Exiting here.")
+		}
+		return EXIT_ERROR
+	}
+
+	if *dumpSchemaFile != "" {
+		if err := schema.WriteFixture(db, *dumpSchemaFile); err != nil {
+			fmt.Println("Dump schema error: " + err.Error() + ". Exiting here.")
+			return EXIT_ERROR
+		}
+		fmt.Println("The schema was written to " + *dumpSchemaFile + ".")
+		return EXIT_OK
+	}
 
 	// start generating
-	options.Generate()
+	result, err := gen.RenderFiles(db, options.GenOptions)
+	if err != nil {
+		fmt.Println("Generate error: " + err.Error() + ".Exiting here.")
+		return EXIT_ERROR
+	}
+	options.Report = result.Report
 
 	// if the option to create the folder is set to true, create if not there
-	if options.CreateFolderIfNotExists {
+	if options.CreateFolderIfNotExists && !options.CheckOnly {
 		if err := options.MkDir(); err != nil {
 			// exit here
 			fmt.Println("MkDir error: " + err.Error() + ".Exiting here.")
-			return
+			return EXIT_ERROR
 		}
 	}
 
 	// start writing to files
-	options.WriteFiles()
+	if err := options.WriteFiles(result.Files); err != nil {
+		fmt.Println("WriteFiles error: " + err.Error() + ".Exiting here.")
+		options.Report.Print(os.Stdout)
+		return EXIT_ERROR
+	}
+
+	// remove the files of the tables and views that no longer exist, and list the generated ones
+	if err := options.UpdateManifest(); err != nil {
+		fmt.Println("UpdateManifest error: " + err.Error() + ".Exiting here.")
+		options.Report.Print(os.Stdout)
+		return EXIT_ERROR
+	}
+
+	options.Report.Print(os.Stdout)
 
-	// write the base file
-	options.WriteBaseFiles()
+	// in -check mode, report the files that are out of date
+	if options.CheckOnly {
+		options.PrintDrift(os.Stdout)
+		if len(options.Drift) > 0 {
+			return EXIT_DRIFT
+		}
+	}
 
+	if options.Report.HasFailures() {
+		return EXIT_PARTIAL
+	}
+	return EXIT_OK
+}
+
+// loadProjectConfig loads the -config file, or the pgtogogen.json file found from the current
+// folder upwards, and applies its values to the flags not set on the command line.
+// It returns nil if there is no configuration file.
+func loadProjectConfig() (*ProjectConfig, error) {
+
+	configFilePath := *configFile
+	if configFilePath == "" {
+		if configFilePath = FindConfigFile(); configFilePath == "" {
+			return nil, nil
+		}
+	}
+
+	config, err := LoadProjectConfig(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println("Using the configuration file: " + configFilePath)
+
+	return config, config.ApplyToFlags()
 }
 
 func validateFlags() bool {
 	// BEGIN: Perform flags validation
 	var flagParsingErrors string = ""
 
-	if *dbName == "" {
-		flagParsingErrors = flagParsingErrors + "Missing database name flag -n\n"
-	}
+	// the connection flags are not needed when generating from a DDL file
+	if *ddlFile == "" {
+		if *dbName == "" {
+			flagParsingErrors = flagParsingErrors + "Missing database name flag -n\n"
+		}
 
-	if *dbUser == "" {
-		flagParsingErrors = flagParsingErrors + "Missing database user flag -u\n"
-	}
+		if *dbUser == "" {
+			flagParsingErrors = flagParsingErrors + "Missing database user flag -u\n"
+		}
 
-	if *dbPass == "" {
-		flagParsingErrors = flagParsingErrors + "Missing database password flag -pass\n"
+		if *dbPass == "" {
+			flagParsingErrors = flagParsingErrors + "Missing database password flag -pass\n"
+		}
 	}
 
 	// make sure the port is uint
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+// the manifest listing the files the generator owns, kept in the output folder
+const MANIFEST_FILENAME = ".pgtogogen-manifest.json"
+
+// Manifest lists every file written by the generator, so that the next run can find
+// the files left behind by the tables and views that no longer exist
+type Manifest struct {
+	Generator string         `json:"generator"`
+	Target    string         `json:"target,omitempty"` // the -target the files were generated for
+	Files     []ManifestFile `json:"files"`
+}
+
+// ManifestFile is a generated file, with the object it was generated for and the hash of its content
+type ManifestFile struct {
+	Path   string `json:"path"`             // relative to the output folder
+	Kind   string `json:"kind"`             // table, view, function or base
+	Object string `json:"object,omitempty"` // the database name of the table or view
+	GoName string `json:"goName,omitempty"` // the name of the generated struct
+	Hash   string `json:"sha256"`
+
+	// what the file was rendered from, to tell whether the next run needs to render it again
+	Fingerprint *gen.Fingerprint `json:"fingerprint,omitempty"`
+}
+
+// LoadManifest reads the manifest from the output folder. A missing manifest is not an error,
+// the files written before the manifest existed are simply not known.
+func LoadManifest(outputFolder string) (*Manifest, error) {
+
+	content, err := ioutil.ReadFile(filepath.Join(outputFolder, MANIFEST_FILENAME))
+	if os.IsNotExist(err) {
+		return &Manifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %s", MANIFEST_FILENAME, err)
+	}
+
+	return manifest, nil
+}
+
+// Save writes the manifest to the output folder, with the files sorted by path
+func (m *Manifest) Save(outputFolder string) error {
+
+	sort.Slice(m.Files, func(i, j int) bool { return m.Files[i].Path < m.Files[j].Path })
+
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(outputFolder, MANIFEST_FILENAME), append(content, '\n'), 0644)
+}
+
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadPreviousManifest reads the manifest of the previous run and hands the fingerprints of its files
+// to the generation, so that the objects whose file is up to date are not rendered again. The files
+// missing from the output folder or modified since they were generated are left out, to be rendered.
+// -check compares everything, it does not use the fingerprints.
+func (t *ToolOptions) LoadPreviousManifest() error {
+
+	previous, err := LoadManifest(t.OutputFolder)
+	if err != nil {
+		return fmt.Errorf("LoadPreviousManifest(): error loading the manifest: %s", err)
+	}
+	t.PreviousManifest = previous
+
+	if err := t.checkTarget(); err != nil {
+		return err
+	}
+
+	if t.CheckOnly {
+		return nil
+	}
+
+	t.GenOptions.Fingerprints = make(map[string]gen.Fingerprint)
+	for _, file := range previous.Files {
+
+		if file.Fingerprint == nil {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(filepath.Join(t.OutputFolder, file.Path))
+		if err != nil || hashContent(content) != file.Hash {
+			continue
+		}
+
+		t.GenOptions.Fingerprints[file.Path] = *file.Fingerprint
+	}
+
+	return nil
+}
+
+// checkTarget refuses to generate for another target than the previous run's, as long as the base
+// files written once for it are there: they are yours to edit, so they are neither replaced nor
+// removed, and they do not build with the new target
+func (t *ToolOptions) checkTarget() error {
+
+	previousTarget := t.PreviousManifest.Target
+	if previousTarget == "" || previousTarget == t.GenOptions.Target {
+		return nil
+	}
+
+	var leftovers []string
+	for _, name := range t.GenOptions.TargetBaseFiles() {
+		if gen.FileExists(filepath.Join(t.OutputFolder, name)) {
+			leftovers = append(leftovers, name)
+		}
+	}
+	if len(leftovers) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the output folder was generated for -target=%s, not %s: delete %s, written once for %s, "+
+		"and run again to have them written for %s, then carry your changes over",
+		previousTarget, t.GenOptions.Target, strings.Join(leftovers, ", "), previousTarget, t.GenOptions.Target)
+}
+
+// keepUnchangedFile records a file left as the previous run wrote it, with its manifest entry
+func (t *ToolOptions) keepUnchangedFile(file gen.File) {
+	for _, previous := range t.PreviousManifest.Files {
+		if previous.Path == file.Name {
+			t.Manifest.Files = append(t.Manifest.Files, previous)
+			return
+		}
+	}
+}
+
+// addToManifest records a file written (or, in -check mode, rendered) during this run
+func (t *ToolOptions) addToManifest(owner ManifestFile, filePath string, source []byte) {
+
+	owner.Path = filepath.Base(filePath)
+	owner.Hash = hashContent(source)
+
+	t.Manifest.Files = append(t.Manifest.Files, owner)
+}
+
+// UpdateManifest compares the files written during this run with the ones listed by the previous run.
+// The generated files whose table or view no longer exists are deleted, unless they were modified
+// since they were generated, in which case they are only reported. The -custom.go files are never
+// touched, but a warning is given when they reference the struct of a removed table or view.
+// In -check mode nothing is deleted or written, the stale files are reported as drift.
+func (t *ToolOptions) UpdateManifest() error {
+
+	previous := t.PreviousManifest
+
+	t.Manifest.Generator = "pgtogogen"
+	t.Manifest.Target = t.GenOptions.Target
+
+	current := map[string]bool{}
+	for _, file := range t.Manifest.Files {
+		current[file.Path] = true
+	}
+
+	failed := map[string]bool{}
+	for _, skipped := range t.Report.Skipped {
+		if skipped.Failed {
+			failed[skipped.Kind+" "+skipped.Name] = true
+		}
+	}
+
+	var removed []ManifestFile
+	for _, file := range previous.Files {
+
+		if current[file.Path] {
+			continue
+		}
+
+		// with -keep-going, the files of the objects that failed this time stay as they are
+		if file.Object != "" && failed[file.Kind+" "+file.Object] {
+			t.Manifest.Files = append(t.Manifest.Files, file)
+			continue
+		}
+
+		filePath := filepath.Join(t.OutputFolder, file.Path)
+		content, err := ioutil.ReadFile(filePath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("UpdateManifest(): error reading the stale file %s: %s", filePath, err)
+		}
+
+		removed = append(removed, file)
+
+		if t.CheckOnly {
+			t.Drift = append(t.Drift, FileDrift{FilePath: filePath, Stale: true, Reason: describeManifestFile(file)})
+			continue
+		}
+
+		if hashContent(content) != file.Hash {
+			// keep it listed, so that it is reported again until it gets deleted by hand
+			t.Manifest.Files = append(t.Manifest.Files, file)
+			t.Report.AddWarning("the stale file " + filePath + " (" + describeManifestFile(file) + ") was modified since it was generated, it was left in place")
+			continue
+		}
+
+		if err := os.Remove(filePath); err != nil {
+			return fmt.Errorf("UpdateManifest(): error removing the stale file %s: %s", filePath, err)
+		}
+		t.Report.AddRemoved(filePath)
+	}
+
+	if err := t.warnAboutCustomFiles(removed); err != nil {
+		return err
+	}
+
+	if t.CheckOnly {
+		return nil
+	}
+
+	if err := t.Manifest.Save(t.OutputFolder); err != nil {
+		return fmt.Errorf("UpdateManifest(): error writing the manifest: %s", err)
+	}
+
+	return nil
+}
+
+// warnAboutCustomFiles looks for the -custom.go files that still use the structs of the removed tables and views.
+// They are never modified, but the package will not compile until they are fixed.
+func (t *ToolOptions) warnAboutCustomFiles(removed []ManifestFile) error {
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	customFiles, err := filepath.Glob(filepath.Join(t.OutputFolder, "*-custom.go"))
+	if err != nil {
+		return fmt.Errorf("UpdateManifest(): error listing the custom files: %s", err)
+	}
+	sort.Strings(customFiles)
+
+	for _, customFile := range customFiles {
+
+		content, err := ioutil.ReadFile(customFile)
+		if err != nil {
+			return fmt.Errorf("UpdateManifest(): error reading the custom file %s: %s", customFile, err)
+		}
+
+		for _, file := range removed {
+			if file.GoName == "" {
+				continue
+			}
+			if regexp.MustCompile(`\b` + regexp.QuoteMeta(file.GoName) + `\b`).Match(content) {
+				t.Report.AddWarning(customFile + " references " + file.GoName + ", generated for the removed " + describeManifestFile(file) + "; it was left in place and needs to be updated or deleted")
+			} else if filepath.Base(customFile) == strings.TrimSuffix(file.Path, ".go")+"-custom.go" {
+				t.Report.AddWarning(customFile + " was created for the removed " + describeManifestFile(file) + "; it was left in place and can be deleted")
+			}
+		}
+	}
+
+	return nil
+}
+
+func describeManifestFile(file ManifestFile) string {
+	if file.Object == "" {
+		return file.Kind + "s file"
+	}
+	return file.Kind + " " + file.Object
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+// manifestFolder writes the files to a temporary output folder and returns the manifest
+// of the previous run listing them, with the hash of their generated content
+func manifestFolder(t *testing.T, files map[string]string, listed []ManifestFile) (string, *Manifest) {
+
+	outputFolder := tempDir(t)
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(outputFolder, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	previous := &Manifest{Generator: "pgtogogen"}
+	for _, file := range listed {
+		file.Hash = hashContent([]byte(generatedContent(file.Path)))
+		previous.Files = append(previous.Files, file)
+	}
+	if err := previous.Save(outputFolder); err != nil {
+		t.Fatal(err)
+	}
+
+	return outputFolder, previous
+}
+
+// generatedContent is the content of a generated file, as the previous run wrote it
+func generatedContent(name string) string {
+	return "package models // " + name + "\n"
+}
+
+func TestUpdateManifest(t *testing.T) {
+
+	outputFolder, previous := manifestFolder(t, map[string]string{
+		"users.go":           generatedContent("users.go"),
+		"orders.go":          generatedContent("orders.go"),
+		"invoices.go":        generatedContent("invoices.go") + "// edited by hand\n",
+		"payments.go":        generatedContent("payments.go"),
+		"orders-custom.go":   "package models\n\nfunc (o *Orders) Total() int { return 0 }\n",
+		"invoices-custom.go": "package models\n",
+		"users-custom.go":    "package models\n",
+	}, []ManifestFile{
+		{Path: "users.go", Kind: gen.OBJECT_TABLE, Object: "users", GoName: "Users"},
+		{Path: "orders.go", Kind: gen.OBJECT_TABLE, Object: "orders", GoName: "Orders"},
+		{Path: "invoices.go", Kind: gen.OBJECT_TABLE, Object: "invoices", GoName: "Invoices"},
+		{Path: "payments.go", Kind: gen.OBJECT_TABLE, Object: "payments", GoName: "Payments"},
+		{Path: "refunds.go", Kind: gen.OBJECT_VIEW, Object: "refunds", GoName: "Refunds"},
+	})
+
+	options := &ToolOptions{OutputFolder: outputFolder, PreviousManifest: previous}
+	options.addToManifest(ManifestFile{Kind: gen.OBJECT_TABLE, Object: "users", GoName: "Users"}, filepath.Join(outputFolder, "users.go"), []byte(generatedContent("users.go")))
+
+	// payments failed this time, with -keep-going
+	options.Report.AddFailed(gen.OBJECT_TABLE, "payments", errors.New("unsupported type"))
+
+	if err := options.UpdateManifest(); err != nil {
+		t.Fatal(err)
+	}
+
+	// orders was not modified, it is removed; invoices was, it stays
+	for name, exists := range map[string]bool{"users.go": true, "orders.go": false, "invoices.go": true, "payments.go": true, "orders-custom.go": true, "invoices-custom.go": true} {
+		if _, err := os.Stat(filepath.Join(outputFolder, name)); (err == nil) != exists {
+			t.Errorf("%s: got exists %v, want %v", name, err == nil, exists)
+		}
+	}
+	if len(options.Report.Removed) != 1 || filepath.Base(options.Report.Removed[0]) != "orders.go" {
+		t.Errorf("got the removed files %v", options.Report.Removed)
+	}
+
+	warnings := strings.Join(options.Report.Warnings, "\n")
+	for _, want := range []string{
+		"invoices.go (table invoices) was modified since it was generated",
+		"orders-custom.go references Orders, generated for the removed table orders",
+		"invoices-custom.go was created for the removed table invoices",
+	} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("no warning about %q in\n%s", want, warnings)
+		}
+	}
+	if len(options.Report.Warnings) != 3 {
+		t.Errorf("got the warnings\n%s", warnings)
+	}
+
+	saved, err := LoadManifest(outputFolder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, file := range saved.Files {
+		paths = append(paths, file.Path)
+	}
+	// the missing refunds.go is dropped, the modified and the failed files stay listed
+	if strings.Join(paths, ",") != "invoices.go,payments.go,users.go" {
+		t.Errorf("got the manifest files %v", paths)
+	}
+}
+
+func TestUpdateManifestCheckOnly(t *testing.T) {
+
+	outputFolder, previous := manifestFolder(t, map[string]string{
+		"orders.go": generatedContent("orders.go"),
+	}, []ManifestFile{
+		{Path: "orders.go", Kind: gen.OBJECT_TABLE, Object: "orders", GoName: "Orders"},
+		{Path: "modelsDbFunctions.go", Kind: gen.OBJECT_FUNCTION},
+	})
+	manifestBefore, err := ioutil.ReadFile(filepath.Join(outputFolder, MANIFEST_FILENAME))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := &ToolOptions{OutputFolder: outputFolder, PreviousManifest: previous, CheckOnly: true}
+	if err := options.UpdateManifest(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(options.Drift) != 1 || !options.Drift[0].Stale || options.Drift[0].Reason != "table orders" {
+		t.Errorf("got the drift %+v", options.Drift)
+	}
+	if _, err := os.Stat(filepath.Join(outputFolder, "orders.go")); err != nil {
+		t.Errorf("-check should not remove anything: %s", err)
+	}
+	if manifestAfter, _ := ioutil.ReadFile(filepath.Join(outputFolder, MANIFEST_FILENAME)); string(manifestAfter) != string(manifestBefore) {
+		t.Errorf("-check should not write the manifest")
+	}
+}
+
+func TestLoadPreviousManifest(t *testing.T) {
+
+	fingerprint := &gen.Fingerprint{Generator: "g", Definition: "d"}
+	outputFolder, _ := manifestFolder(t, map[string]string{
+		"users.go":  generatedContent("users.go"),
+		"orders.go": generatedContent("orders.go") + "// edited by hand\n",
+	}, []ManifestFile{
+		{Path: "users.go", Kind: gen.OBJECT_TABLE, Object: "users", Fingerprint: fingerprint},
+		{Path: "orders.go", Kind: gen.OBJECT_TABLE, Object: "orders", Fingerprint: fingerprint},
+		{Path: "refunds.go", Kind: gen.OBJECT_VIEW, Object: "refunds", Fingerprint: fingerprint},
+		{Path: "modelsDbFunctions.go", Kind: gen.OBJECT_FUNCTION},
+	})
+
+	options := &ToolOptions{OutputFolder: outputFolder}
+	if err := options.LoadPreviousManifest(); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the files on disk as they were generated are trusted
+	if len(options.GenOptions.Fingerprints) != 1 || options.GenOptions.Fingerprints["users.go"] != *fingerprint {
+		t.Errorf("got the fingerprints %+v", options.GenOptions.Fingerprints)
+	}
+	if len(options.PreviousManifest.Files) != 4 {
+		t.Errorf("got the previous manifest %+v", options.PreviousManifest)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outputFolder, MANIFEST_FILENAME), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.LoadPreviousManifest(); err == nil || !strings.Contains(err.Error(), "invalid manifest") {
+		t.Errorf("got error %v, want one about the invalid manifest", err)
+	}
+
+	if manifest, err := LoadManifest(tempDir(t)); err != nil || len(manifest.Files) != 0 {
+		t.Errorf("a missing manifest should be empty, got %+v, %v", manifest, err)
+	}
+}
+
+func TestLoadPreviousManifestTarget(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		previousTarget string
+		target         string
+		files          []string
+		wantErr        string
+	}{
+		{"same target", gen.TARGET_PGX4, gen.TARGET_PGX4, []string{"models_pgtogogen_tx.go", "models_pgtogogen_copy.go"}, ""},
+		{"no target recorded", "", gen.TARGET_SQL, []string{"models_pgtogogen_tx.go"}, ""},
+		{"switched, base files deleted", gen.TARGET_PGX4, gen.TARGET_PGX5, []string{"models_pgtogogen_db.go"}, ""},
+		{"switched to sql", gen.TARGET_PGX4, gen.TARGET_SQL, []string{"models_pgtogogen_db.go", "models_pgtogogen_types.go", "models_pgtogogen_copy.go"},
+			"generated for -target=pgx4, not sql: delete models_pgtogogen_types.go, models_pgtogogen_copy.go, written once for pgx4"},
+		{"switched to pgx5", gen.TARGET_SQL, gen.TARGET_PGX5, []string{"models_pgtogogen_tx.go"},
+			"generated for -target=sql, not pgx5: delete models_pgtogogen_tx.go, written once for sql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			files := map[string]string{}
+			for _, name := range tt.files {
+				files[name] = "package models\n"
+			}
+			outputFolder, previous := manifestFolder(t, files, nil)
+			previous.Target = tt.previousTarget
+			if err := previous.Save(outputFolder); err != nil {
+				t.Fatal(err)
+			}
+
+			options := &ToolOptions{OutputFolder: outputFolder}
+			options.GenOptions.PackageName = "models"
+			if err := options.GenOptions.SetTarget(tt.target); err != nil {
+				t.Fatal(err)
+			}
+
+			err := options.LoadPreviousManifest()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("got error %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("got error %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the manifest records the target of the run
+	outputFolder, previous := manifestFolder(t, nil, nil)
+	options := &ToolOptions{OutputFolder: outputFolder, PreviousManifest: previous}
+	options.GenOptions.SetTarget(gen.TARGET_PGX5)
+	if err := options.UpdateManifest(); err != nil {
+		t.Fatal(err)
+	}
+	if saved, err := LoadManifest(outputFolder); err != nil || saved.Target != gen.TARGET_PGX5 {
+		t.Errorf("got the manifest %+v, %v", saved, err)
+	}
+}
@@ -1,3 +1,10 @@
+//go:build ignore
+// +build ignore
+
+// operations_test.go is a manual test program of the generated code, run against a database with a models
+// package generated into github.com/silviucm/pgtogogen/v2/models. It declares its own main, so it is left
+// out of the builds and of go test by the ignore tag.
+
 package main
 
 import (
@@ -0,0 +1,2709 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/* DDL Section */
+
+// The DDL parser is the offline counterpart of Introspect: it reads a schema file
+// (the output of pg_dump --schema-only, or a set of concatenated migrations) and builds
+// the very same Database, so the generator does not care where the schema came from.
+
+type ddlTokenKind int
+
+const (
+	ddlTokenWord        ddlTokenKind = iota // keywords and unquoted identifiers (lower-cased)
+	ddlTokenQuotedIdent                     // "Quoted" identifiers (case preserved)
+	ddlTokenString                          // 'string' literals and $$dollar quoted$$ strings
+	ddlTokenNumber
+	ddlTokenSymbol
+)
+
+type ddlToken struct {
+	Kind ddlTokenKind
+	Text string
+	Line int
+}
+
+func (tok ddlToken) is(word string) bool {
+	return tok.Kind == ddlTokenWord && tok.Text == word
+}
+
+func (tok ddlToken) isSymbol(symbol string) bool {
+	return tok.Kind == ddlTokenSymbol && tok.Text == symbol
+}
+
+func (tok ddlToken) isIdent() bool {
+	return tok.Kind == ddlTokenWord || tok.Kind == ddlTokenQuotedIdent
+}
+
+// ddlType holds a column type the way information_schema.columns reports it, so it
+// can be fed to GetGoTypeForColumn unchanged.
+type ddlType struct {
+	DataType  string // e.g. "character varying", "ARRAY"
+	UdtName   string // e.g. "varchar", "_int4"
+	MaxLength int    // character_maximum_length, -1 if not applicable
+	IsSerial  bool
+
+	DomainName string // the domain the type comes from, if any
+
+	ElementDataType string // for arrays, the data type of the elements
+}
+
+type ddlColumn struct {
+	Name       string
+	Type       ddlType
+	NotNull    bool
+	HasDefault bool
+	Default    string
+	Comment    string
+}
+
+type ddlConstraint struct {
+	Name    string
+	Columns []string
+}
+
+type ddlTable struct {
+	Name    string
+	Columns []*ddlColumn
+	Comment string
+
+	PrimaryKeyName string
+	PrimaryKey     []string
+
+	UniqueConstraints []ddlConstraint
+	UniqueIndexes     []ddlConstraint
+}
+
+type ddlView struct {
+	Name           string
+	IsMaterialized bool
+	ColumnNames    []string // the optional explicit column list, e.g. CREATE VIEW v (a, b) AS ...
+	Query          []ddlToken
+	Comment        string
+
+	// the COMMENT ON COLUMN comments, keyed by the column name, since the columns are inferred later
+	ColumnComments map[string]string
+
+	// filled in when the columns are inferred from the query
+	Columns []*ddlColumn
+}
+
+type ddlFunctionParam struct {
+	Name    string
+	Mode    string
+	Type    ddlType
+	Default string
+}
+
+type ddlFunction struct {
+	Name          string
+	Params        []ddlFunctionParam
+	ReturnType    ddlType
+	ReturnTypeSet bool
+	ReturnsSet    bool
+	Comment       string
+}
+
+// ddlSchema accumulates the state of the schema while the statements are applied in order
+type ddlSchema struct {
+	SchemaName string
+
+	Tables    []*ddlTable
+	Views     []*ddlView
+	Functions []*ddlFunction
+
+	Enums   map[string]bool
+	Domains map[string]ddlType
+
+	// what could not be parsed and was left out
+	Warnings []string
+}
+
+// the built-in types, keyed by every name (or alias) they can be declared with
+var ddlBuiltinTypes = map[string]ddlType{
+	"smallint":    {DataType: "smallint", UdtName: "int2"},
+	"int2":        {DataType: "smallint", UdtName: "int2"},
+	"smallserial": {DataType: "smallint", UdtName: "int2", IsSerial: true},
+	"serial2":     {DataType: "smallint", UdtName: "int2", IsSerial: true},
+
+	"integer": {DataType: "integer", UdtName: "int4"},
+	"int":     {DataType: "integer", UdtName: "int4"},
+	"int4":    {DataType: "integer", UdtName: "int4"},
+	"serial":  {DataType: "integer", UdtName: "int4", IsSerial: true},
+	"serial4": {DataType: "integer", UdtName: "int4", IsSerial: true},
+
+	"bigint":    {DataType: "bigint", UdtName: "int8"},
+	"int8":      {DataType: "bigint", UdtName: "int8"},
+	"bigserial": {DataType: "bigint", UdtName: "int8", IsSerial: true},
+	"serial8":   {DataType: "bigint", UdtName: "int8", IsSerial: true},
+
+	"real":             {DataType: "real", UdtName: "float4"},
+	"float4":           {DataType: "real", UdtName: "float4"},
+	"double precision": {DataType: "double precision", UdtName: "float8"},
+	"float8":           {DataType: "double precision", UdtName: "float8"},
+	"float":            {DataType: "double precision", UdtName: "float8"},
+	"numeric":          {DataType: "numeric", UdtName: "numeric"},
+	"decimal":          {DataType: "numeric", UdtName: "numeric"},
+	"money":            {DataType: "money", UdtName: "money"},
+
+	"text":              {DataType: "text", UdtName: "text"},
+	"character varying": {DataType: "character varying", UdtName: "varchar"},
+	"varchar":           {DataType: "character varying", UdtName: "varchar"},
+	"character":         {DataType: "character", UdtName: "bpchar"},
+	"char":              {DataType: "character", UdtName: "bpchar"},
+	"bpchar":            {DataType: "character", UdtName: "bpchar"},
+	"name":              {DataType: "name", UdtName: "name"},
+	"\"char\"":          {DataType: "\"char\"", UdtName: "char"},
+	"citext":            {DataType: "USER-DEFINED", UdtName: "citext"},
+
+	"boolean": {DataType: "boolean", UdtName: "bool"},
+	"bool":    {DataType: "boolean", UdtName: "bool"},
+	"bytea":   {DataType: "bytea", UdtName: "bytea"},
+	"uuid":    {DataType: "uuid", UdtName: "uuid"},
+	"json":    {DataType: "json", UdtName: "json"},
+	"jsonb":   {DataType: "jsonb", UdtName: "jsonb"},
+	"xml":     {DataType: "xml", UdtName: "xml"},
+	"inet":    {DataType: "inet", UdtName: "inet"},
+	"cidr":    {DataType: "cidr", UdtName: "cidr"},
+	"macaddr": {DataType: "macaddr", UdtName: "macaddr"},
+	"oid":     {DataType: "oid", UdtName: "oid"},
+
+	"bit":         {DataType: "bit", UdtName: "bit"},
+	"bit varying": {DataType: "bit varying", UdtName: "varbit"},
+	"varbit":      {DataType: "bit varying", UdtName: "varbit"},
+
+	"date":                        {DataType: "date", UdtName: "date"},
+	"interval":                    {DataType: "interval", UdtName: "interval"},
+	"timestamp":                   {DataType: "timestamp without time zone", UdtName: "timestamp"},
+	"timestamp without time zone": {DataType: "timestamp without time zone", UdtName: "timestamp"},
+	"timestamptz":                 {DataType: "timestamp with time zone", UdtName: "timestamptz"},
+	"timestamp with time zone":    {DataType: "timestamp with time zone", UdtName: "timestamptz"},
+	"time":                        {DataType: "time without time zone", UdtName: "time"},
+	"time without time zone":      {DataType: "time without time zone", UdtName: "time"},
+	"timetz":                      {DataType: "time with time zone", UdtName: "timetz"},
+	"time with time zone":         {DataType: "time with time zone", UdtName: "timetz"},
+
+	"void":    {DataType: "void", UdtName: "void"},
+	"record":  {DataType: "record", UdtName: "record"},
+	"trigger": {DataType: "trigger", UdtName: "trigger"},
+}
+
+// the words which end a column type or a DEFAULT expression inside a column definition
+var ddlColumnConstraintWords = map[string]bool{
+	"constraint": true, "not": true, "null": true, "default": true, "primary": true, "unique": true,
+	"references": true, "check": true, "collate": true, "generated": true, "deferrable": true, "initially": true,
+}
+
+var ddlJoinWords = map[string]bool{
+	"join": true, "inner": true, "left": true, "right": true, "full": true, "outer": true,
+	"cross": true, "natural": true, "lateral": true, "on": true, "using": true,
+}
+
+// ParseDDL parses the statements in the source, in order, and returns the resulting
+// schema. Only the objects belonging to schemaName (or unqualified ones) are kept.
+// Statements which do not affect the generated code (grants, sequences, triggers etc.)
+// are ignored.
+func ParseDDL(source string, schemaName string) (*Database, error) {
+
+	schema, err := parseDDL(source, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	db := schema.toDatabase()
+	db.Sort()
+
+	return db, nil
+}
+
+func parseDDL(source string, schemaName string) (*ddlSchema, error) {
+
+	tokens, err := tokenizeDDL(source)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &ddlSchema{
+		SchemaName: schemaName,
+		Enums:      make(map[string]bool),
+		Domains:    make(map[string]ddlType),
+	}
+
+	var statement []ddlToken
+	for _, tok := range tokens {
+		if tok.isSymbol(";") {
+			if err := schema.parseStatement(statement); err != nil {
+				return nil, err
+			}
+			statement = nil
+			continue
+		}
+		statement = append(statement, tok)
+	}
+
+	if err := schema.parseStatement(statement); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+/* Tokenizer */
+
+func tokenizeDDL(source string) ([]ddlToken, error) {
+
+	var tokens []ddlToken
+	line := 1
+
+	for i := 0; i < len(source); {
+
+		c := source[i]
+		startLine := line
+
+		switch {
+
+		case c == '\n':
+			line++
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+
+		case strings.HasPrefix(source[i:], "--"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+
+		case strings.HasPrefix(source[i:], "/*"):
+			// block comments nest in Postgres
+			depth := 0
+			for i < len(source) {
+				if strings.HasPrefix(source[i:], "/*") {
+					depth++
+					i += 2
+					continue
+				}
+				if strings.HasPrefix(source[i:], "*/") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+					continue
+				}
+				if source[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			if depth > 0 {
+				return nil, fmt.Errorf("line %d: unterminated block comment", startLine)
+			}
+
+		case c == '\'' || ((c == 'e' || c == 'E') && i+1 < len(source) && source[i+1] == '\''):
+			escapes := c != '\''
+			if escapes {
+				i++
+			}
+			text, end, err := scanDDLQuoted(source, i, '\'', escapes)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", startLine, err)
+			}
+			line += strings.Count(source[i:end], "\n")
+			tokens = append(tokens, ddlToken{Kind: ddlTokenString, Text: text, Line: startLine})
+			i = end
+
+		case c == '"':
+			text, end, err := scanDDLQuoted(source, i, '"', false)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", startLine, err)
+			}
+			line += strings.Count(source[i:end], "\n")
+			tokens = append(tokens, ddlToken{Kind: ddlTokenQuotedIdent, Text: text, Line: startLine})
+			i = end
+
+		case c == '$' && ddlDollarTag(source[i:]) != "":
+			tag := ddlDollarTag(source[i:])
+			closing := strings.Index(source[i+len(tag):], tag)
+			if closing < 0 {
+				return nil, fmt.Errorf("line %d: unterminated dollar-quoted string %s", startLine, tag)
+			}
+			text := source[i+len(tag) : i+len(tag)+closing]
+			line += strings.Count(text, "\n")
+			tokens = append(tokens, ddlToken{Kind: ddlTokenString, Text: text, Line: startLine})
+			i = i + len(tag) + closing + len(tag)
+
+		case isDDLDigit(c) || (c == '.' && i+1 < len(source) && isDDLDigit(source[i+1])):
+			start := i
+			for i < len(source) && (isDDLDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				i++
+				if i < len(source) && (source[i] == '+' || source[i] == '-') {
+					i++
+				}
+				for i < len(source) && isDDLDigit(source[i]) {
+					i++
+				}
+			}
+			tokens = append(tokens, ddlToken{Kind: ddlTokenNumber, Text: source[start:i], Line: startLine})
+
+		case isDDLIdentStart(c):
+			start := i
+			for i < len(source) && (isDDLIdentStart(source[i]) || isDDLDigit(source[i]) || source[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, ddlToken{Kind: ddlTokenWord, Text: strings.ToLower(source[start:i]), Line: startLine})
+
+		case strings.HasPrefix(source[i:], "::"):
+			tokens = append(tokens, ddlToken{Kind: ddlTokenSymbol, Text: "::", Line: startLine})
+			i += 2
+
+		case strings.IndexByte(ddlOperatorChars, c) >= 0:
+			operator := ddlOperator(source[i:])
+			tokens = append(tokens, ddlToken{Kind: ddlTokenSymbol, Text: operator, Line: startLine})
+			i += len(operator)
+
+		default:
+			tokens = append(tokens, ddlToken{Kind: ddlTokenSymbol, Text: string(c), Line: startLine})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// the characters of the operators, e.g. ->> or <=
+const ddlOperatorChars = "+-*/<>=~!@#%^&|`?"
+
+// ddlOperator returns the operator at the start of s, the way Postgres reads it: the longest run of operator
+// characters not starting a comment, without its trailing + and - unless it holds one of ~!@#%^&|`?
+func ddlOperator(s string) string {
+
+	end := 0
+	for end < len(s) && strings.IndexByte(ddlOperatorChars, s[end]) >= 0 {
+		if end > 0 && (strings.HasPrefix(s[end:], "--") || strings.HasPrefix(s[end:], "/*")) {
+			break
+		}
+		end++
+	}
+
+	if !strings.ContainsAny(s[:end], "~!@#%^&|`?") {
+		for end > 1 && (s[end-1] == '+' || s[end-1] == '-') {
+			end--
+		}
+	}
+
+	return s[:end]
+}
+
+// scanDDLQuoted reads the quoted sequence starting at source[start] (the opening quote),
+// where a doubled quote stands for the quote itself. It returns the unquoted text and the
+// position right after the closing quote.
+func scanDDLQuoted(source string, start int, quote byte, backslashEscapes bool) (string, int, error) {
+
+	var text bytes.Buffer
+
+	for i := start + 1; i < len(source); i++ {
+		switch {
+		case backslashEscapes && source[i] == '\\' && i+1 < len(source):
+			i = writeDDLBackslashEscape(&text, source, i+1)
+		case source[i] == quote && i+1 < len(source) && source[i+1] == quote:
+			text.WriteByte(quote)
+			i++
+		case source[i] == quote:
+			return text.String(), i + 1, nil
+		default:
+			text.WriteByte(source[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted sequence starting with %c", quote)
+}
+
+// writeDDLBackslashEscape writes the character of the escape sequence of an E'...' string starting at
+// source[start], right after the backslash, and returns the position of its last byte
+func writeDDLBackslashEscape(text *bytes.Buffer, source string, start int) int {
+
+	// readDigits reads at most maxDigits digits of the base, returning the value and the last position
+	readDigits := func(from int, maxDigits int, base int) (rune, int) {
+		value, end := 0, from
+		for end < len(source) && end-from < maxDigits {
+			digit, err := strconv.ParseInt(source[end:end+1], base, 8)
+			if err != nil {
+				break
+			}
+			value = value*base + int(digit)
+			end++
+		}
+		return rune(value), end - 1
+	}
+
+	switch c := source[start]; c {
+	case 'b':
+		text.WriteByte('\b')
+	case 'f':
+		text.WriteByte('\f')
+	case 'n':
+		text.WriteByte('\n')
+	case 'r':
+		text.WriteByte('\r')
+	case 't':
+		text.WriteByte('\t')
+	case 'x':
+		value, end := readDigits(start+1, 2, 16)
+		if end == start {
+			text.WriteByte(c)
+			return start
+		}
+		text.WriteByte(byte(value))
+		return end
+	case 'u', 'U':
+		maxDigits := 4
+		if c == 'U' {
+			maxDigits = 8
+		}
+		value, end := readDigits(start+1, maxDigits, 16)
+		if end-start != maxDigits {
+			text.WriteByte(c)
+			return start
+		}
+		text.WriteRune(value)
+		return end
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		value, end := readDigits(start, 3, 8)
+		text.WriteByte(byte(value))
+		return end
+	default:
+		// \\, \' and any other character stand for the character itself
+		text.WriteByte(c)
+	}
+	return start
+}
+
+// ddlDollarTag returns the opening tag (e.g. "$$" or "$body$") if s starts with one
+func ddlDollarTag(s string) string {
+
+	for i := 1; i < len(s); i++ {
+		if s[i] == '$' {
+			return s[:i+1]
+		}
+		if !isDDLIdentStart(s[i]) && !(i > 1 && isDDLDigit(s[i])) {
+			return ""
+		}
+	}
+	return ""
+}
+
+// quoteDDLIdentifier quotes the name as Postgres renders it, unless it is a lower case identifier
+func quoteDDLIdentifier(name string) string {
+
+	plain := name != "" && !isDDLDigit(name[0])
+	for i := 0; i < len(name); i++ {
+		if !(name[i] == '_' || (name[i] >= 'a' && name[i] <= 'z') || isDDLDigit(name[i]) || name[i] == '$') {
+			plain = false
+		}
+	}
+	if plain {
+		return name
+	}
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func isDDLDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isDDLIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// renderDDLTokens turns the tokens back into (normalized) SQL, e.g. for default values
+func renderDDLTokens(tokens []ddlToken) string {
+
+	var rendered bytes.Buffer
+
+	for i, tok := range tokens {
+		text := tok.Text
+		switch tok.Kind {
+		case ddlTokenString:
+			text = "'" + strings.Replace(text, "'", "''", -1) + "'"
+		case ddlTokenQuotedIdent:
+			text = `"` + strings.Replace(text, `"`, `""`, -1) + `"`
+		}
+
+		if i > 0 {
+			previous := tokens[i-1]
+			glued := previous.isSymbol("(") || previous.isSymbol(".") || previous.isSymbol("::") || previous.isSymbol("[") ||
+				tok.isSymbol("(") || tok.isSymbol(")") || tok.isSymbol(",") || tok.isSymbol(".") || tok.isSymbol("::") ||
+				tok.isSymbol("[") || tok.isSymbol("]")
+			if !glued {
+				rendered.WriteByte(' ')
+			}
+		}
+		rendered.WriteString(text)
+	}
+
+	return rendered.String()
+}
+
+// splitDDLTopLevel splits the tokens on the separator symbol, ignoring the separators
+// nested inside parentheses or brackets
+func splitDDLTopLevel(tokens []ddlToken, separator string) [][]ddlToken {
+
+	var parts [][]ddlToken
+	var current []ddlToken
+	depth := 0
+
+	for _, tok := range tokens {
+		switch {
+		case tok.isSymbol("(") || tok.isSymbol("["):
+			depth++
+		case tok.isSymbol(")") || tok.isSymbol("]"):
+			depth--
+		case depth == 0 && tok.isSymbol(separator):
+			parts = append(parts, current)
+			current = nil
+			continue
+		}
+		current = append(current, tok)
+	}
+
+	if len(current) > 0 || len(parts) > 0 {
+		parts = append(parts, current)
+	}
+
+	return parts
+}
+
+/* Statement cursor */
+
+type ddlCursor struct {
+	tokens []ddlToken
+	pos    int
+}
+
+func (c *ddlCursor) atEnd() bool {
+	return c.pos >= len(c.tokens)
+}
+
+func (c *ddlCursor) peekAt(offset int) ddlToken {
+	if c.pos+offset >= len(c.tokens) {
+		return ddlToken{Kind: ddlTokenSymbol, Line: c.line()}
+	}
+	return c.tokens[c.pos+offset]
+}
+
+func (c *ddlCursor) peek() ddlToken {
+	return c.peekAt(0)
+}
+
+func (c *ddlCursor) next() ddlToken {
+	tok := c.peek()
+	if !c.atEnd() {
+		c.pos++
+	}
+	return tok
+}
+
+func (c *ddlCursor) line() int {
+	if len(c.tokens) == 0 {
+		return 0
+	}
+	if c.pos < len(c.tokens) {
+		return c.tokens[c.pos].Line
+	}
+	return c.tokens[len(c.tokens)-1].Line
+}
+
+func (c *ddlCursor) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: "+format, append([]interface{}{c.line()}, args...)...)
+}
+
+// acceptWord consumes the sequence of words only if all of them are next in line
+func (c *ddlCursor) acceptWord(words ...string) bool {
+	for i, word := range words {
+		if !c.peekAt(i).is(word) {
+			return false
+		}
+	}
+	c.pos += len(words)
+	return true
+}
+
+func (c *ddlCursor) acceptSymbol(symbol string) bool {
+	if c.peek().isSymbol(symbol) {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+func (c *ddlCursor) expectWord(words ...string) error {
+	if !c.acceptWord(words...) {
+		return c.errorf("expected %q, found %q", strings.Join(words, " "), c.peek().Text)
+	}
+	return nil
+}
+
+func (c *ddlCursor) identifier() (string, error) {
+	tok := c.peek()
+	if !tok.isIdent() {
+		return "", c.errorf("expected an identifier, found %q", tok.Text)
+	}
+	c.pos++
+	return tok.Text, nil
+}
+
+// qualifiedNameParts reads a dotted name such as public.users.id
+func (c *ddlCursor) qualifiedNameParts() ([]string, error) {
+
+	first, err := c.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := []string{first}
+	for c.peek().isSymbol(".") && c.peekAt(1).isIdent() {
+		c.pos++
+		parts = append(parts, c.next().Text)
+	}
+
+	return parts, nil
+}
+
+// qualifiedName reads an optionally schema-qualified object name
+func (c *ddlCursor) qualifiedName() (schemaName string, objectName string, err error) {
+
+	parts, err := c.qualifiedNameParts()
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(parts) == 1 {
+		return "", parts[0], nil
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// parenthesized consumes a parenthesized group and returns the tokens inside it
+func (c *ddlCursor) parenthesized() ([]ddlToken, error) {
+
+	if !c.acceptSymbol("(") {
+		return nil, c.errorf("expected \"(\", found %q", c.peek().Text)
+	}
+
+	start := c.pos
+	depth := 1
+	for !c.atEnd() {
+		tok := c.next()
+		switch {
+		case tok.isSymbol("("):
+			depth++
+		case tok.isSymbol(")"):
+			depth--
+			if depth == 0 {
+				return c.tokens[start : c.pos-1], nil
+			}
+		}
+	}
+
+	return nil, c.errorf("unbalanced parentheses")
+}
+
+// identifierList consumes a parenthesized list of column names
+func (c *ddlCursor) identifierList() ([]string, error) {
+
+	inner, err := c.parenthesized()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, element := range splitDDLTopLevel(inner, ",") {
+		if len(element) == 0 || !element[0].isIdent() {
+			return nil, c.errorf("expected a list of column names")
+		}
+		names = append(names, element[0].Text)
+	}
+
+	return names, nil
+}
+
+// takeUntil consumes and returns the tokens up to the first top-level word in stopWords
+// (or a top-level comma, if stopAtComma). The first token is always taken.
+func (c *ddlCursor) takeUntil(stopWords map[string]bool, stopAtComma bool) []ddlToken {
+
+	start := c.pos
+	depth := 0
+	for !c.atEnd() {
+		tok := c.peek()
+		if depth == 0 && c.pos > start {
+			if tok.Kind == ddlTokenWord && stopWords[tok.Text] {
+				break
+			}
+			if stopAtComma && tok.isSymbol(",") {
+				break
+			}
+		}
+		switch {
+		case tok.isSymbol("(") || tok.isSymbol("["):
+			depth++
+		case tok.isSymbol(")") || tok.isSymbol("]"):
+			depth--
+		}
+		c.pos++
+	}
+
+	return c.tokens[start:c.pos]
+}
+
+func (c *ddlCursor) rest() []ddlToken {
+	rest := c.tokens[c.pos:]
+	c.pos = len(c.tokens)
+	return rest
+}
+
+/* Statements */
+
+func (s *ddlSchema) parseStatement(statement []ddlToken) error {
+
+	if len(statement) == 0 {
+		return nil
+	}
+
+	c := &ddlCursor{tokens: statement}
+
+	switch {
+
+	case c.acceptWord("create"):
+		c.acceptWord("or", "replace")
+
+		isTemporary := false
+		for {
+			if c.acceptWord("temporary") || c.acceptWord("temp") {
+				isTemporary = true
+				continue
+			}
+			if c.acceptWord("global") || c.acceptWord("local") || c.acceptWord("unlogged") || c.acceptWord("recursive") {
+				continue
+			}
+			break
+		}
+		if isTemporary {
+			// temporary objects are not part of the schema
+			return nil
+		}
+
+		switch {
+		case c.acceptWord("table"):
+			return s.parseCreateTable(c)
+		case c.acceptWord("view"):
+			return s.parseCreateView(c, false)
+		case c.acceptWord("materialized", "view"):
+			return s.parseCreateView(c, true)
+		case c.acceptWord("type"):
+			return s.parseCreateType(c)
+		case c.acceptWord("domain"):
+			return s.parseCreateDomain(c)
+		case c.acceptWord("unique", "index"):
+			return s.parseCreateUniqueIndex(c)
+		case c.acceptWord("function"):
+			return s.parseCreateFunction(c)
+		}
+
+	case c.acceptWord("alter", "table"):
+		return s.parseAlterTable(c)
+
+	case c.acceptWord("alter", "view"), c.acceptWord("alter", "materialized", "view"):
+		return s.parseAlterView(c)
+
+	case c.acceptWord("drop"):
+		return s.parseDrop(c)
+
+	case c.acceptWord("comment", "on"):
+		return s.parseComment(c)
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) ownsSchema(schemaName string) bool {
+	return schemaName == "" || schemaName == s.SchemaName
+}
+
+func (s *ddlSchema) table(name string) *ddlTable {
+	for _, tbl := range s.Tables {
+		if tbl.Name == name {
+			return tbl
+		}
+	}
+	return nil
+}
+
+func (s *ddlSchema) view(name string) *ddlView {
+	for _, v := range s.Views {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *ddlSchema) parseCreateTable(c *ddlCursor) error {
+
+	ifNotExists := c.acceptWord("if", "not", "exists")
+
+	schemaName, tableName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	// CREATE TABLE ... AS, PARTITION OF and OF type_name are not supported
+	if !c.peek().isSymbol("(") {
+		s.warnf("line %d: skipping table %s, only CREATE TABLE with a column list is supported.", c.line(), tableName)
+		return nil
+	}
+
+	elements, err := c.parenthesized()
+	if err != nil {
+		return err
+	}
+
+	if !s.ownsSchema(schemaName) || (ifNotExists && s.table(tableName) != nil) {
+		return nil
+	}
+
+	newTable := &ddlTable{Name: tableName}
+
+	// the columns of the INHERITS parents come first, in the parents order
+	if c.acceptWord("inherits") {
+		if err := s.inheritColumns(newTable, c); err != nil {
+			return err
+		}
+	}
+
+	for _, element := range splitDDLTopLevel(elements, ",") {
+		if len(element) == 0 {
+			continue
+		}
+		if err := s.parseTableElement(newTable, &ddlCursor{tokens: element}); err != nil {
+			return err
+		}
+	}
+
+	s.dropTable(tableName)
+	s.Tables = append(s.Tables, newTable)
+
+	return nil
+}
+
+// inheritColumns copies the columns of the parent tables of the INHERITS list, without their comments,
+// the columns of several parents with the same name merged into one. The constraints are not inherited.
+func (s *ddlSchema) inheritColumns(tbl *ddlTable, c *ddlCursor) error {
+
+	parents, err := c.parenthesized()
+	if err != nil {
+		return err
+	}
+
+	for _, parent := range splitDDLTopLevel(parents, ",") {
+		_, parentName, err := (&ddlCursor{tokens: parent}).qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		parentTable := s.table(parentName)
+		if parentTable == nil {
+			s.warnf("line %d: the columns of table %s are missing those of INHERITS %s, which is not defined before it.", c.line(), tbl.Name, parentName)
+			continue
+		}
+
+		for _, parentColumn := range parentTable.Columns {
+			if merged := tbl.column(parentColumn.Name); merged != nil {
+				merged.NotNull = merged.NotNull || parentColumn.NotNull
+				continue
+			}
+			col := *parentColumn
+			col.Comment = ""
+			tbl.Columns = append(tbl.Columns, &col)
+		}
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) dropTable(name string) {
+	for i, tbl := range s.Tables {
+		if tbl.Name == name {
+			s.Tables = append(s.Tables[:i], s.Tables[i+1:]...)
+			return
+		}
+	}
+}
+
+func isDDLTableConstraintStart(tok ddlToken) bool {
+	switch {
+	case tok.is("constraint"), tok.is("primary"), tok.is("unique"), tok.is("foreign"),
+		tok.is("check"), tok.is("exclude"):
+		return true
+	}
+	return false
+}
+
+func (s *ddlSchema) parseTableElement(tbl *ddlTable, c *ddlCursor) error {
+
+	if c.acceptWord("like") {
+		return s.parseLikeTable(tbl, c)
+	}
+
+	if isDDLTableConstraintStart(c.peek()) {
+		return s.parseTableConstraint(tbl, c)
+	}
+
+	col, err := s.parseColumnDefinition(tbl, c)
+	if err != nil {
+		return err
+	}
+
+	// an inherited column declared again keeps its position, taking the NOT NULL and the default
+	if inherited := tbl.column(col.Name); inherited != nil {
+		inherited.NotNull = inherited.NotNull || col.NotNull
+		if col.HasDefault {
+			inherited.HasDefault, inherited.Default = true, col.Default
+		}
+		return nil
+	}
+
+	tbl.Columns = append(tbl.Columns, col)
+	return nil
+}
+
+func (s *ddlSchema) parseColumnDefinition(tbl *ddlTable, c *ddlCursor) (*ddlColumn, error) {
+
+	columnName, err := c.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	columnType, err := s.parseType(c)
+	if err != nil {
+		return nil, err
+	}
+
+	col := &ddlColumn{Name: columnName, Type: columnType}
+
+	// serial types are shorthands for an integer with a sequence default
+	if columnType.IsSerial {
+		col.NotNull = true
+		col.HasDefault = true
+		col.Default = "nextval('" + quoteDDLIdentifier(tbl.Name+"_"+columnName+"_seq") + "'::regclass)"
+	}
+
+	constraintName := ""
+	for !c.atEnd() {
+		switch {
+
+		case c.acceptWord("constraint"):
+			if constraintName, err = c.identifier(); err != nil {
+				return nil, err
+			}
+			continue
+
+		case c.acceptWord("not", "null"):
+			col.NotNull = true
+
+		case c.acceptWord("null"):
+			col.NotNull = false
+
+		case c.acceptWord("not", "deferrable"), c.acceptWord("deferrable"):
+
+		case c.acceptWord("initially"):
+			c.next()
+
+		case c.acceptWord("default"):
+			s.setColumnDefault(col, c.takeUntil(ddlColumnConstraintWords, false))
+
+		case c.acceptWord("primary", "key"):
+			tbl.setPrimaryKey(constraintName, []string{columnName})
+			if err := skipDDLIndexParameters(c); err != nil {
+				return nil, err
+			}
+
+		case c.acceptWord("unique"):
+			c.acceptWord("nulls", "not", "distinct")
+			c.acceptWord("nulls", "distinct")
+			tbl.addUniqueConstraint(constraintName, []string{columnName})
+			if err := skipDDLIndexParameters(c); err != nil {
+				return nil, err
+			}
+
+		case c.acceptWord("references"):
+			if err := skipDDLReferences(c); err != nil {
+				return nil, err
+			}
+
+		case c.acceptWord("check"):
+			if _, err := c.parenthesized(); err != nil {
+				return nil, err
+			}
+			c.acceptWord("no", "inherit")
+
+		case c.acceptWord("collate"):
+			if _, _, err := c.qualifiedName(); err != nil {
+				return nil, err
+			}
+
+		case c.acceptWord("generated"):
+			if !c.acceptWord("always") {
+				c.acceptWord("by", "default")
+			}
+			if err := c.expectWord("as"); err != nil {
+				return nil, err
+			}
+			if c.acceptWord("identity") {
+				// identity columns are implicitly NOT NULL
+				col.NotNull = true
+				if c.peek().isSymbol("(") {
+					if _, err := c.parenthesized(); err != nil {
+						return nil, err
+					}
+				}
+			} else {
+				if _, err := c.parenthesized(); err != nil {
+					return nil, err
+				}
+				c.acceptWord("stored")
+			}
+
+		default:
+			return nil, c.errorf("unexpected %q in the definition of column %s.%s", c.peek().Text, tbl.Name, columnName)
+		}
+
+		constraintName = ""
+	}
+
+	return col, nil
+}
+
+func (s *ddlSchema) setColumnDefault(col *ddlColumn, expression []ddlToken) {
+
+	if len(expression) == 0 || (len(expression) == 1 && expression[0].is("null")) {
+		col.HasDefault = false
+		col.Default = ""
+		return
+	}
+
+	col.HasDefault = true
+	col.Default = renderDDLTokens(expression)
+}
+
+// skipDDLIndexParameters skips the INCLUDE, WITH and USING INDEX TABLESPACE clauses
+// which may follow a PRIMARY KEY or UNIQUE constraint
+func skipDDLIndexParameters(c *ddlCursor) error {
+	for {
+		switch {
+		case c.acceptWord("include"), c.acceptWord("with"):
+			if _, err := c.parenthesized(); err != nil {
+				return err
+			}
+		case c.acceptWord("using", "index", "tablespace"):
+			c.next()
+		default:
+			return nil
+		}
+	}
+}
+
+// skipDDLReferences skips the rest of a REFERENCES clause (the REFERENCES word itself
+// has already been consumed)
+func skipDDLReferences(c *ddlCursor) error {
+
+	if _, _, err := c.qualifiedName(); err != nil {
+		return err
+	}
+	if c.peek().isSymbol("(") {
+		if _, err := c.parenthesized(); err != nil {
+			return err
+		}
+	}
+
+	for {
+		switch {
+		case c.acceptWord("match"):
+			c.next()
+		case c.acceptWord("on", "delete"), c.acceptWord("on", "update"):
+			switch {
+			case c.acceptWord("no", "action"), c.acceptWord("cascade"), c.acceptWord("restrict"):
+			case c.acceptWord("set", "null"), c.acceptWord("set", "default"):
+				if c.peek().isSymbol("(") {
+					if _, err := c.parenthesized(); err != nil {
+						return err
+					}
+				}
+			default:
+				return c.errorf("unexpected referential action %q", c.peek().Text)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (s *ddlSchema) parseTableConstraint(tbl *ddlTable, c *ddlCursor) error {
+
+	var err error
+	constraintName := ""
+	if c.acceptWord("constraint") {
+		if constraintName, err = c.identifier(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+
+	case c.acceptWord("primary", "key"):
+		columnNames, err := c.identifierList()
+		if err != nil {
+			return err
+		}
+		tbl.setPrimaryKey(constraintName, columnNames)
+
+	case c.acceptWord("unique"):
+		c.acceptWord("nulls", "not", "distinct")
+		c.acceptWord("nulls", "distinct")
+		columnNames, err := c.identifierList()
+		if err != nil {
+			return err
+		}
+		tbl.addUniqueConstraint(constraintName, columnNames)
+
+	case c.acceptWord("foreign"), c.acceptWord("check"), c.acceptWord("exclude"):
+		// not relevant for the generated code
+
+	default:
+		return c.errorf("unexpected %q in the constraints of table %s", c.peek().Text, tbl.Name)
+	}
+
+	return nil
+}
+
+// parseLikeTable copies the columns of the LIKE table, with their defaults for INCLUDING DEFAULTS
+// and the primary key and unique constraints for INCLUDING INDEXES (both part of INCLUDING ALL)
+func (s *ddlSchema) parseLikeTable(tbl *ddlTable, c *ddlCursor) error {
+
+	_, likeName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	including := make(map[string]bool)
+	for !c.atEnd() {
+		isIncluding := c.acceptWord("including")
+		if !isIncluding && !c.acceptWord("excluding") {
+			return c.errorf("unexpected %q after LIKE %s in table %s", c.peek().Text, likeName, tbl.Name)
+		}
+		option, err := c.identifier()
+		if err != nil {
+			return err
+		}
+		if option == "all" {
+			including["defaults"], including["indexes"] = isIncluding, isIncluding
+		}
+		including[option] = isIncluding
+	}
+
+	likeTable := s.table(likeName)
+	if likeTable == nil {
+		s.warnf("line %d: the columns of table %s are missing those of LIKE %s, which is not defined before it.", c.line(), tbl.Name, likeName)
+		return nil
+	}
+
+	for _, likeColumn := range likeTable.Columns {
+		col := *likeColumn
+		col.Comment = ""
+		// with INCLUDING DEFAULTS, the copied serial columns share the sequence of the LIKE table
+		if !including["defaults"] {
+			col.HasDefault = false
+			col.Default = ""
+		}
+		tbl.Columns = append(tbl.Columns, &col)
+	}
+
+	if including["indexes"] {
+		if len(likeTable.PrimaryKey) > 0 {
+			tbl.setPrimaryKey("", likeTable.PrimaryKey)
+		}
+		for _, constraint := range likeTable.UniqueConstraints {
+			tbl.addUniqueConstraint("", constraint.Columns)
+		}
+	}
+
+	return nil
+}
+
+func (tbl *ddlTable) column(name string) *ddlColumn {
+	for _, col := range tbl.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func (tbl *ddlTable) setPrimaryKey(constraintName string, columnNames []string) {
+
+	if constraintName == "" {
+		constraintName = tbl.Name + "_pkey"
+	}
+
+	tbl.PrimaryKeyName = constraintName
+	tbl.PrimaryKey = columnNames
+
+	for _, columnName := range columnNames {
+		if col := tbl.column(columnName); col != nil {
+			col.NotNull = true
+		}
+	}
+}
+
+func (tbl *ddlTable) addUniqueConstraint(constraintName string, columnNames []string) {
+
+	// the same naming scheme Postgres uses when no name is provided
+	if constraintName == "" {
+		constraintName = tbl.Name + "_" + strings.Join(columnNames, "_") + "_key"
+	}
+
+	tbl.UniqueConstraints = append(tbl.UniqueConstraints, ddlConstraint{Name: constraintName, Columns: columnNames})
+}
+
+// dropConstraint removes the primary key, unique constraint or unique index with the given name
+func (tbl *ddlTable) dropConstraint(name string) {
+
+	if tbl.PrimaryKeyName == name {
+		tbl.PrimaryKeyName = ""
+		tbl.PrimaryKey = nil
+	}
+	tbl.UniqueConstraints = removeDDLConstraint(tbl.UniqueConstraints, name)
+	tbl.UniqueIndexes = removeDDLConstraint(tbl.UniqueIndexes, name)
+}
+
+func removeDDLConstraint(constraints []ddlConstraint, name string) []ddlConstraint {
+	var remaining []ddlConstraint
+	for _, constraint := range constraints {
+		if constraint.Name != name {
+			remaining = append(remaining, constraint)
+		}
+	}
+	return remaining
+}
+
+func (tbl *ddlTable) renameColumn(oldName, newName string) {
+
+	if col := tbl.column(oldName); col != nil {
+		col.Name = newName
+	}
+
+	renameInList := func(names []string) {
+		for i := range names {
+			if names[i] == oldName {
+				names[i] = newName
+			}
+		}
+	}
+
+	renameInList(tbl.PrimaryKey)
+	for _, constraint := range tbl.UniqueConstraints {
+		renameInList(constraint.Columns)
+	}
+	for _, constraint := range tbl.UniqueIndexes {
+		renameInList(constraint.Columns)
+	}
+}
+
+func (tbl *ddlTable) dropColumn(name string) {
+
+	for i, col := range tbl.Columns {
+		if col.Name == name {
+			tbl.Columns = append(tbl.Columns[:i], tbl.Columns[i+1:]...)
+			break
+		}
+	}
+
+	// like Postgres, drop the constraints depending on the column
+	for _, pkColumn := range tbl.PrimaryKey {
+		if pkColumn == name {
+			tbl.dropConstraint(tbl.PrimaryKeyName)
+			break
+		}
+	}
+	for _, constraints := range [][]ddlConstraint{tbl.UniqueConstraints, tbl.UniqueIndexes} {
+		for _, constraint := range constraints {
+			for _, columnName := range constraint.Columns {
+				if columnName == name {
+					tbl.dropConstraint(constraint.Name)
+				}
+			}
+		}
+	}
+}
+
+func (s *ddlSchema) parseAlterTable(c *ddlCursor) error {
+
+	c.acceptWord("if", "exists")
+	c.acceptWord("only")
+
+	schemaName, tableName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+	c.acceptSymbol("*")
+
+	tbl := s.table(tableName)
+	if !s.ownsSchema(schemaName) || tbl == nil {
+		return nil
+	}
+
+	if c.acceptWord("rename") {
+		switch {
+		case c.acceptWord("to"):
+			newName, err := c.identifier()
+			if err != nil {
+				return err
+			}
+			tbl.Name = newName
+
+		case c.acceptWord("constraint"):
+			oldName, err := c.identifier()
+			if err != nil {
+				return err
+			}
+			if err := c.expectWord("to"); err != nil {
+				return err
+			}
+			newName, err := c.identifier()
+			if err != nil {
+				return err
+			}
+			if tbl.PrimaryKeyName == oldName {
+				tbl.PrimaryKeyName = newName
+			}
+			for i := range tbl.UniqueConstraints {
+				if tbl.UniqueConstraints[i].Name == oldName {
+					tbl.UniqueConstraints[i].Name = newName
+				}
+			}
+
+		default:
+			c.acceptWord("column")
+			oldName, err := c.identifier()
+			if err != nil {
+				return err
+			}
+			if err := c.expectWord("to"); err != nil {
+				return err
+			}
+			newName, err := c.identifier()
+			if err != nil {
+				return err
+			}
+			tbl.renameColumn(oldName, newName)
+		}
+		return nil
+	}
+
+	for _, action := range splitDDLTopLevel(c.rest(), ",") {
+		if err := s.parseAlterTableAction(tbl, &ddlCursor{tokens: action}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) parseAlterTableAction(tbl *ddlTable, c *ddlCursor) error {
+
+	switch {
+
+	case c.acceptWord("add"):
+		if isDDLTableConstraintStart(c.peek()) {
+			return s.parseTableConstraint(tbl, c)
+		}
+		c.acceptWord("column")
+		ifNotExists := c.acceptWord("if", "not", "exists")
+		col, err := s.parseColumnDefinition(tbl, c)
+		if err != nil {
+			return err
+		}
+		if ifNotExists && tbl.column(col.Name) != nil {
+			return nil
+		}
+		tbl.Columns = append(tbl.Columns, col)
+
+	case c.acceptWord("drop"):
+		isConstraint := c.acceptWord("constraint")
+		if !isConstraint {
+			c.acceptWord("column")
+		}
+		c.acceptWord("if", "exists")
+		name, err := c.identifier()
+		if err != nil {
+			return err
+		}
+		if isConstraint {
+			tbl.dropConstraint(name)
+		} else {
+			tbl.dropColumn(name)
+		}
+
+	case c.acceptWord("alter"):
+		c.acceptWord("column")
+		columnName, err := c.identifier()
+		if err != nil {
+			return err
+		}
+		col := tbl.column(columnName)
+		if col == nil {
+			return c.errorf("ALTER TABLE %s: unknown column %s", tbl.Name, columnName)
+		}
+
+		switch {
+		case c.acceptWord("set", "data", "type"), c.acceptWord("type"):
+			newType, err := s.parseType(c)
+			if err != nil {
+				return err
+			}
+			col.Type = newType
+		case c.acceptWord("set", "default"):
+			s.setColumnDefault(col, c.rest())
+		case c.acceptWord("drop", "default"):
+			s.setColumnDefault(col, nil)
+		case c.acceptWord("set", "not", "null"):
+			col.NotNull = true
+		case c.acceptWord("drop", "not", "null"):
+			col.NotNull = false
+		case c.acceptWord("add", "generated"):
+			col.NotNull = true
+		}
+	}
+
+	// anything else (owner, triggers, storage parameters etc.) does not affect the generated code
+	return nil
+}
+
+func (s *ddlSchema) parseCreateUniqueIndex(c *ddlCursor) error {
+
+	c.acceptWord("concurrently")
+	c.acceptWord("if", "not", "exists")
+
+	var err error
+	indexName := ""
+	if !c.peek().is("on") {
+		if _, indexName, err = c.qualifiedName(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.expectWord("on"); err != nil {
+		return err
+	}
+	c.acceptWord("only")
+
+	schemaName, tableName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	if c.acceptWord("using") {
+		c.next()
+	}
+
+	elements, err := c.parenthesized()
+	if err != nil {
+		return err
+	}
+
+	var columnNames []string
+	for _, element := range splitDDLTopLevel(elements, ",") {
+		// expression indexes cannot be turned into getters
+		if len(element) == 0 || !element[0].isIdent() || (len(element) > 1 && element[1].isSymbol("(")) {
+			return nil
+		}
+		columnNames = append(columnNames, element[0].Text)
+	}
+
+	tbl := s.table(tableName)
+	if !s.ownsSchema(schemaName) || tbl == nil {
+		return nil
+	}
+
+	if indexName == "" {
+		indexName = tableName + "_" + strings.Join(columnNames, "_") + "_idx"
+	}
+
+	tbl.UniqueIndexes = append(tbl.UniqueIndexes, ddlConstraint{Name: indexName, Columns: columnNames})
+
+	return nil
+}
+
+func (s *ddlSchema) parseCreateType(c *ddlCursor) error {
+
+	_, typeName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	// composite, range and base types are not mapped to Go types
+	if c.acceptWord("as", "enum") {
+		s.Enums[typeName] = true
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) parseCreateDomain(c *ddlCursor) error {
+
+	_, domainName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+	c.acceptWord("as")
+
+	baseType, err := s.parseType(c)
+	if err != nil {
+		return err
+	}
+
+	s.Domains[domainName] = baseType
+	return nil
+}
+
+func (s *ddlSchema) parseCreateView(c *ddlCursor, isMaterialized bool) error {
+
+	c.acceptWord("if", "not", "exists")
+
+	schemaName, viewName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	newView := &ddlView{Name: viewName, IsMaterialized: isMaterialized}
+
+	if c.peek().isSymbol("(") {
+		if newView.ColumnNames, err = c.identifierList(); err != nil {
+			return err
+		}
+	}
+	if c.acceptWord("using") {
+		c.next()
+	}
+	if c.acceptWord("with") {
+		if _, err := c.parenthesized(); err != nil {
+			return err
+		}
+	}
+	if c.acceptWord("tablespace") {
+		c.next()
+	}
+	if err := c.expectWord("as"); err != nil {
+		return err
+	}
+
+	query := c.rest()
+
+	// strip the trailing WITH [NO] DATA and WITH [CASCADED | LOCAL] CHECK OPTION clauses
+	for _, suffix := range [][]string{{"with", "no", "data"}, {"with", "data"}, {"with", "cascaded", "check", "option"},
+		{"with", "local", "check", "option"}, {"with", "check", "option"}} {
+		if len(query) >= len(suffix) {
+			matches := true
+			for i, word := range suffix {
+				if !query[len(query)-len(suffix)+i].is(word) {
+					matches = false
+				}
+			}
+			if matches {
+				query = query[:len(query)-len(suffix)]
+				break
+			}
+		}
+	}
+	newView.Query = query
+
+	if !s.ownsSchema(schemaName) {
+		return nil
+	}
+
+	if existing := s.view(viewName); existing != nil {
+		*existing = *newView
+		return nil
+	}
+	s.Views = append(s.Views, newView)
+
+	return nil
+}
+
+func (s *ddlSchema) parseAlterView(c *ddlCursor) error {
+
+	c.acceptWord("if", "exists")
+
+	schemaName, viewName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	v := s.view(viewName)
+	if !s.ownsSchema(schemaName) || v == nil {
+		return nil
+	}
+
+	if c.acceptWord("rename", "to") {
+		if v.Name, err = c.identifier(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) parseCreateFunction(c *ddlCursor) error {
+
+	schemaName, functionName, err := c.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	paramTokens, err := c.parenthesized()
+	if err != nil {
+		return err
+	}
+
+	params, err := s.parseFunctionParams(paramTokens)
+	if err != nil {
+		return err
+	}
+
+	newFunction := &ddlFunction{Name: functionName, Params: params}
+
+	if c.acceptWord("returns") {
+		if c.acceptWord("table") {
+			// RETURNS TABLE (...) shows up as a record in the information schema, its columns as OUT parameters
+			columnTokens, err := c.parenthesized()
+			if err != nil {
+				return err
+			}
+			columns, err := s.parseFunctionParams(columnTokens)
+			if err != nil {
+				return err
+			}
+			for _, column := range columns {
+				column.Mode = PARAMETER_MODE_OUT
+				newFunction.Params = append(newFunction.Params, column)
+			}
+			newFunction.ReturnType = ddlBuiltinTypes["record"]
+			newFunction.ReturnsSet = true
+		} else {
+			newFunction.ReturnsSet = c.acceptWord("setof")
+			if newFunction.ReturnType, err = s.parseType(c); err != nil {
+				return err
+			}
+		}
+	} else {
+		// no RETURNS clause means the OUT parameters define a record
+		newFunction.ReturnType = ddlBuiltinTypes["record"]
+	}
+
+	// the rest (language, volatility, body etc.) is not needed
+
+	if !s.ownsSchema(schemaName) {
+		return nil
+	}
+
+	signature := newFunction.signature()
+	for i, existing := range s.Functions {
+		if existing.Name == functionName && existing.signature() == signature {
+			newFunction.Comment = existing.Comment
+			s.Functions[i] = newFunction
+			return nil
+		}
+	}
+	s.Functions = append(s.Functions, newFunction)
+
+	return nil
+}
+
+func (s *ddlSchema) parseFunctionParams(tokens []ddlToken) ([]ddlFunctionParam, error) {
+
+	var params []ddlFunctionParam
+
+	for _, element := range splitDDLTopLevel(tokens, ",") {
+		if len(element) == 0 {
+			continue
+		}
+
+		c := &ddlCursor{tokens: element}
+		param := ddlFunctionParam{Mode: PARAMETER_MODE_IN}
+
+		switch {
+		case c.acceptWord("in"):
+		case c.acceptWord("out"):
+			param.Mode = PARAMETER_MODE_OUT
+		case c.acceptWord("inout"):
+			param.Mode = PARAMETER_MODE_INOUT
+		case c.acceptWord("variadic"):
+			param.Mode = PARAMETER_MODE_VARIADIC
+		}
+
+		declaration := c.takeUntil(map[string]bool{"default": true}, false)
+		for i, tok := range declaration {
+			if tok.isSymbol("=") {
+				c = &ddlCursor{tokens: declaration[i+1:]}
+				declaration = declaration[:i]
+				break
+			}
+		}
+		c.acceptWord("default")
+		if !c.atEnd() {
+			param.Default = renderDDLTokens(c.rest())
+		}
+
+		// the parameter name is optional, so try reading the whole declaration as a type first
+		declarationCursor := &ddlCursor{tokens: declaration}
+		paramType, err := s.parseType(declarationCursor)
+		if err != nil || !declarationCursor.atEnd() {
+			declarationCursor = &ddlCursor{tokens: declaration}
+			if param.Name, err = declarationCursor.identifier(); err != nil {
+				return nil, err
+			}
+			if paramType, err = s.parseType(declarationCursor); err != nil {
+				return nil, err
+			}
+			if !declarationCursor.atEnd() {
+				return nil, declarationCursor.errorf("unexpected %q in the declaration of parameter %s", declarationCursor.peek().Text, param.Name)
+			}
+		}
+		param.Type = paramType
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// signature identifies a function among its overloads, the same way Postgres does (by the
+// types of the input parameters)
+func (f *ddlFunction) signature() string {
+
+	var types []string
+	for _, param := range f.Params {
+		if param.Mode != PARAMETER_MODE_OUT {
+			types = append(types, param.Type.DataType+"/"+param.Type.UdtName)
+		}
+	}
+	return strings.Join(types, ",")
+}
+
+func (s *ddlSchema) parseDrop(c *ddlCursor) error {
+
+	var objectKind string
+	switch {
+	case c.acceptWord("table"):
+		objectKind = "table"
+	case c.acceptWord("view"), c.acceptWord("materialized", "view"):
+		objectKind = "view"
+	case c.acceptWord("function"):
+		objectKind = "function"
+	case c.acceptWord("type"), c.acceptWord("domain"):
+		objectKind = "type"
+	case c.acceptWord("index"):
+		c.acceptWord("concurrently")
+		objectKind = "index"
+	default:
+		return nil
+	}
+	c.acceptWord("if", "exists")
+
+	for _, objectTokens := range splitDDLTopLevel(c.rest(), ",") {
+
+		objectCursor := &ddlCursor{tokens: objectTokens}
+		schemaName, objectName, err := objectCursor.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		switch objectKind {
+
+		case "table":
+			if s.ownsSchema(schemaName) {
+				s.dropTable(objectName)
+			}
+
+		case "view":
+			if s.ownsSchema(schemaName) {
+				for i, v := range s.Views {
+					if v.Name == objectName {
+						s.Views = append(s.Views[:i], s.Views[i+1:]...)
+						break
+					}
+				}
+			}
+
+		case "function":
+			if !s.ownsSchema(schemaName) {
+				continue
+			}
+			// without an argument list, the name is unique
+			signature, hasSignature := "", false
+			if objectCursor.peek().isSymbol("(") {
+				paramTokens, err := objectCursor.parenthesized()
+				if err != nil {
+					return err
+				}
+				params, err := s.parseFunctionParams(paramTokens)
+				if err != nil {
+					return err
+				}
+				signature, hasSignature = (&ddlFunction{Params: params}).signature(), true
+			}
+			var remaining []*ddlFunction
+			for _, f := range s.Functions {
+				if f.Name != objectName || (hasSignature && f.signature() != signature) {
+					remaining = append(remaining, f)
+				}
+			}
+			s.Functions = remaining
+
+		case "type":
+			delete(s.Enums, objectName)
+			delete(s.Domains, objectName)
+
+		case "index":
+			for _, tbl := range s.Tables {
+				tbl.UniqueIndexes = removeDDLConstraint(tbl.UniqueIndexes, objectName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ddlSchema) parseComment(c *ddlCursor) error {
+
+	var objectKind string
+	switch {
+	case c.acceptWord("table"):
+		objectKind = "table"
+	case c.acceptWord("column"):
+		objectKind = "column"
+	case c.acceptWord("view"), c.acceptWord("materialized", "view"):
+		objectKind = "view"
+	case c.acceptWord("function"):
+		objectKind = "function"
+	default:
+		return nil
+	}
+
+	nameParts, err := c.qualifiedNameParts()
+	if err != nil {
+		return err
+	}
+
+	var functionParams []ddlFunctionParam
+	hasSignature := false
+	if objectKind == "function" && c.peek().isSymbol("(") {
+		paramTokens, err := c.parenthesized()
+		if err != nil {
+			return err
+		}
+		if functionParams, err = s.parseFunctionParams(paramTokens); err != nil {
+			return err
+		}
+		hasSignature = true
+	}
+
+	if err := c.expectWord("is"); err != nil {
+		return err
+	}
+
+	comment := ""
+	if tok := c.next(); tok.Kind == ddlTokenString {
+		comment = tok.Text
+	} else if !tok.is("null") {
+		return c.errorf("expected a string literal or NULL as the comment, found %q", tok.Text)
+	}
+
+	// the object name is the last part (or the last two parts, for columns),
+	// optionally preceded by the schema name
+	objectParts := 1
+	if objectKind == "column" {
+		objectParts = 2
+	}
+	if len(nameParts) < objectParts {
+		return c.errorf("COMMENT ON COLUMN needs a table-qualified column name")
+	}
+	schemaName := ""
+	if len(nameParts) > objectParts {
+		schemaName = nameParts[len(nameParts)-objectParts-1]
+	}
+	if !s.ownsSchema(schemaName) {
+		return nil
+	}
+	objectName := nameParts[len(nameParts)-objectParts]
+
+	switch objectKind {
+
+	case "table":
+		if tbl := s.table(objectName); tbl != nil {
+			tbl.Comment = comment
+		}
+
+	case "column":
+		if tbl := s.table(objectName); tbl != nil {
+			if col := tbl.column(nameParts[len(nameParts)-1]); col != nil {
+				col.Comment = comment
+			}
+		} else if v := s.view(objectName); v != nil {
+			if v.ColumnComments == nil {
+				v.ColumnComments = make(map[string]string)
+			}
+			v.ColumnComments[nameParts[len(nameParts)-1]] = comment
+		}
+
+	case "view":
+		if v := s.view(objectName); v != nil {
+			v.Comment = comment
+		}
+
+	case "function":
+		signature := (&ddlFunction{Params: functionParams}).signature()
+		for _, f := range s.Functions {
+			if f.Name == objectName && (!hasSignature || f.signature() == signature) {
+				f.Comment = comment
+			}
+		}
+	}
+
+	return nil
+}
+
+/* Types */
+
+// parseType reads a column type, including the multi-word built-in types, modifiers such
+// as varchar(100) or numeric(10,2) and array suffixes
+func (s *ddlSchema) parseType(c *ddlCursor) (ddlType, error) {
+
+	nameParts, err := c.qualifiedNameParts()
+	if err != nil {
+		return ddlType{}, err
+	}
+	typeName := nameParts[len(nameParts)-1]
+
+	// the quoted "char" is the single byte internal type, not character(1)
+	if c.peekAt(-1).Kind == ddlTokenQuotedIdent && typeName == "char" {
+		typeName = `"char"`
+	}
+
+	typeModifiers := []string{}
+	readModifiers := func() error {
+		if !c.peek().isSymbol("(") {
+			return nil
+		}
+		inner, err := c.parenthesized()
+		if err != nil {
+			return err
+		}
+		for _, modifier := range splitDDLTopLevel(inner, ",") {
+			typeModifiers = append(typeModifiers, renderDDLTokens(modifier))
+		}
+		return nil
+	}
+
+	switch typeName {
+	case "double":
+		if c.acceptWord("precision") {
+			typeName = "double precision"
+		}
+	case "character", "char", "national":
+		if typeName == "national" {
+			c.acceptWord("character")
+			c.acceptWord("char")
+			typeName = "character"
+		}
+		if c.acceptWord("varying") {
+			typeName = "character varying"
+		}
+	case "bit":
+		if c.acceptWord("varying") {
+			typeName = "bit varying"
+		}
+	case "timestamp", "time":
+		if err := readModifiers(); err != nil {
+			return ddlType{}, err
+		}
+		if c.acceptWord("with", "time", "zone") {
+			typeName = typeName + " with time zone"
+		} else {
+			c.acceptWord("without", "time", "zone")
+		}
+	case "interval":
+		for c.acceptWord("year") || c.acceptWord("month") || c.acceptWord("day") || c.acceptWord("hour") ||
+			c.acceptWord("minute") || c.acceptWord("second") || c.acceptWord("to") {
+		}
+	}
+
+	if err := readModifiers(); err != nil {
+		return ddlType{}, err
+	}
+
+	resolvedType := s.resolveTypeName(typeName)
+
+	// the maximum length only applies to the character types (domains keep their own)
+	_, isBuiltin := ddlBuiltinTypes[typeName]
+	switch {
+	case !isBuiltin && resolvedType.DomainName != "":
+	case !isBuiltin:
+		// the enums and the user-defined types have no maximum length
+		resolvedType.MaxLength = -1
+	case resolvedType.DataType == "character varying":
+		resolvedType.MaxLength = -1
+		if len(typeModifiers) > 0 {
+			resolvedType.MaxLength, _ = strconv.Atoi(typeModifiers[0])
+		}
+	case resolvedType.DataType == "character":
+		resolvedType.MaxLength = 1
+		if len(typeModifiers) > 0 {
+			resolvedType.MaxLength, _ = strconv.Atoi(typeModifiers[0])
+		}
+	default:
+		resolvedType.MaxLength = -1
+	}
+
+	// arrays: type[], type[n], type ARRAY, type ARRAY[n]
+	isArray := false
+	for {
+		if c.acceptSymbol("[") || (c.acceptWord("array") && c.acceptSymbol("[")) {
+			for !c.atEnd() && !c.peek().isSymbol("]") {
+				c.next()
+			}
+			if !c.acceptSymbol("]") {
+				return ddlType{}, c.errorf("unterminated array type")
+			}
+			isArray = true
+			continue
+		}
+		if c.acceptWord("array") {
+			isArray = true
+			continue
+		}
+		break
+	}
+
+	if isArray {
+		return ddlType{
+			DataType:        "ARRAY",
+			UdtName:         "_" + resolvedType.UdtName,
+			MaxLength:       -1,
+			ElementDataType: resolvedType.DataType,
+		}, nil
+	}
+
+	return resolvedType, nil
+}
+
+func (s *ddlSchema) resolveTypeName(typeName string) ddlType {
+
+	if builtinType, found := ddlBuiltinTypes[typeName]; found {
+		return builtinType
+	}
+
+	if domainType, found := s.Domains[typeName]; found {
+		domainType.DomainName = typeName
+		return domainType
+	}
+
+	// enums are read and written as text
+	if s.Enums[typeName] {
+		return ddlType{DataType: "text", UdtName: typeName}
+	}
+
+	// tables, views, composite types and extension types
+	return ddlType{DataType: "USER-DEFINED", UdtName: typeName}
+}
+
+/* View column inference */
+
+type ddlViewSource struct {
+	Name    string
+	Alias   string
+	Columns []*ddlColumn
+	IsKnown bool
+}
+
+var ddlSelectClauseEnd = map[string]bool{
+	"from": true, "where": true, "group": true, "having": true, "window": true, "order": true,
+	"limit": true, "offset": true, "union": true, "intersect": true, "except": true, "fetch": true, "for": true,
+}
+
+var ddlFromClauseEnd = map[string]bool{
+	"where": true, "group": true, "having": true, "window": true, "order": true,
+	"limit": true, "offset": true, "union": true, "intersect": true, "except": true, "fetch": true, "for": true,
+}
+
+// inferViewColumns works out the columns of a view from the select list of its query,
+// using the columns of the tables and views it selects from, and explicit casts. The
+// expressions whose type cannot be inferred are skipped with a warning.
+func (s *ddlSchema) inferViewColumns(v *ddlView) []*ddlColumn {
+
+	c := &ddlCursor{tokens: v.Query}
+
+	// the columns of common table expressions are not known
+	unknownNames := make(map[string]bool)
+	if c.acceptWord("with") {
+		c.acceptWord("recursive")
+		for !c.atEnd() {
+			unknownNames[c.next().Text] = true
+			if c.peek().isSymbol("(") {
+				c.parenthesized()
+			}
+			c.acceptWord("as")
+			c.acceptWord("not")
+			c.acceptWord("materialized")
+			if _, err := c.parenthesized(); err != nil {
+				s.warnf("view %s: could not read the WITH clause, skipping the view.", v.Name)
+				return nil
+			}
+			if !c.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+
+	// e.g. (SELECT ...) UNION (SELECT ...): the first select defines the columns
+	for c.peek().isSymbol("(") {
+		inner, err := c.parenthesized()
+		if err != nil {
+			return nil
+		}
+		c = &ddlCursor{tokens: inner}
+	}
+
+	if !c.acceptWord("select") {
+		s.warnf("view %s: the query does not start with SELECT, skipping the view.", v.Name)
+		return nil
+	}
+	if c.acceptWord("distinct") {
+		if c.acceptWord("on") {
+			c.parenthesized()
+		}
+	} else {
+		c.acceptWord("all")
+	}
+
+	var selectList []ddlToken
+	if !c.atEnd() && !(c.peek().Kind == ddlTokenWord && ddlSelectClauseEnd[c.peek().Text]) {
+		selectList = c.takeUntil(ddlSelectClauseEnd, false)
+	}
+
+	var sources []ddlViewSource
+	if c.acceptWord("from") {
+		sources = s.parseViewSources(c.takeUntil(ddlFromClauseEnd, false), unknownNames)
+	}
+
+	var columns []*ddlColumn
+	for _, item := range splitDDLTopLevel(selectList, ",") {
+		itemColumns, ok := s.resolveViewSelectItem(item, sources)
+		if !ok {
+			s.warnf("view %s: could not infer the type of %q, skipping the column. Add an alias and an explicit cast (e.g. expr::text AS name) to include it.",
+				v.Name, renderDDLTokens(item))
+			continue
+		}
+		columns = append(columns, itemColumns...)
+	}
+
+	// the explicit column list renames the columns, in order
+	for i, columnName := range v.ColumnNames {
+		if i < len(columns) {
+			renamed := *columns[i]
+			renamed.Name = columnName
+			columns[i] = &renamed
+		}
+	}
+
+	return columns
+}
+
+func (s *ddlSchema) parseViewSources(tokens []ddlToken, unknownNames map[string]bool) []ddlViewSource {
+
+	var sources []ddlViewSource
+	c := &ddlCursor{tokens: tokens}
+
+	for !c.atEnd() {
+		tok := c.peek()
+
+		switch {
+
+		case tok.isSymbol(","):
+			c.next()
+
+		case tok.is("on"):
+			c.next()
+			c.takeUntil(ddlJoinWords, true)
+
+		case tok.is("using"):
+			c.next()
+			c.parenthesized()
+
+		case tok.Kind == ddlTokenWord && ddlJoinWords[tok.Text]:
+			c.next()
+
+		case tok.isSymbol("("):
+			inner, err := c.parenthesized()
+			if err != nil {
+				return sources
+			}
+			if len(inner) > 0 && (inner[0].is("select") || inner[0].is("with") || inner[0].is("values")) {
+				// a subquery
+				sources = append(sources, ddlViewSource{Alias: readDDLSourceAlias(c)})
+			} else {
+				// a parenthesized join, as pg_dump writes them
+				sources = append(sources, s.parseViewSources(inner, unknownNames)...)
+			}
+
+		case tok.isIdent():
+			schemaName, name, err := c.qualifiedName()
+			if err != nil {
+				return sources
+			}
+			source := ddlViewSource{Name: name}
+			if c.peek().isSymbol("(") {
+				// a set-returning function
+				c.parenthesized()
+			} else if s.ownsSchema(schemaName) && !unknownNames[name] {
+				if tbl := s.table(name); tbl != nil {
+					source.Columns, source.IsKnown = tbl.Columns, true
+				} else if v := s.view(name); v != nil && v.Columns != nil {
+					source.Columns, source.IsKnown = v.Columns, true
+				}
+			}
+			source.Alias = readDDLSourceAlias(c)
+			sources = append(sources, source)
+
+		default:
+			c.next()
+		}
+	}
+
+	return sources
+}
+
+func readDDLSourceAlias(c *ddlCursor) string {
+
+	c.acceptWord("as")
+	tok := c.peek()
+	if !tok.isIdent() || (tok.Kind == ddlTokenWord && ddlJoinWords[tok.Text]) {
+		return ""
+	}
+	c.next()
+
+	// column aliases, e.g. "FROM users AS u (a, b)" are not supported
+	if c.peek().isSymbol("(") {
+		c.parenthesized()
+	}
+
+	return tok.Text
+}
+
+func (s *ddlSchema) resolveViewSelectItem(item []ddlToken, sources []ddlViewSource) ([]*ddlColumn, bool) {
+
+	if len(item) == 0 {
+		return nil, false
+	}
+
+	// * and alias.*
+	if item[len(item)-1].isSymbol("*") && (len(item) == 1 || (len(item) == 3 && item[1].isSymbol("."))) {
+		var columns []*ddlColumn
+		for _, source := range sources {
+			if len(item) == 3 && source.Alias != item[0].Text && (source.Alias != "" || source.Name != item[0].Text) {
+				continue
+			}
+			if !source.IsKnown {
+				return nil, false
+			}
+			columns = append(columns, source.Columns...)
+		}
+		return columns, len(columns) > 0
+	}
+
+	expression, alias := splitDDLSelectAlias(item)
+
+	columnType, defaultName, ok := s.inferExpressionType(expression, sources)
+	if !ok {
+		return nil, false
+	}
+
+	columnName := alias
+	if columnName == "" {
+		columnName = defaultName
+	}
+	if columnName == "" {
+		return nil, false
+	}
+
+	return []*ddlColumn{{Name: columnName, Type: columnType}}, true
+}
+
+// splitDDLSelectAlias separates the expression of a select list item from its alias, if any
+func splitDDLSelectAlias(item []ddlToken) ([]ddlToken, string) {
+
+	depth := 0
+	for i, tok := range item {
+		switch {
+		case tok.isSymbol("("):
+			depth++
+		case tok.isSymbol(")"):
+			depth--
+		case depth == 0 && tok.is("as") && i == len(item)-2 && item[i+1].isIdent():
+			return item[:i], item[i+1].Text
+		}
+	}
+
+	// an alias without AS: an identifier following a complete expression
+	if len(item) < 2 || !item[len(item)-1].isIdent() {
+		return item, ""
+	}
+	previous := item[len(item)-2]
+	if previous.Kind == ddlTokenSymbol && !previous.isSymbol(")") {
+		return item, ""
+	}
+
+	// make sure the trailing words are not part of a multi-word type in a cast,
+	// e.g. x::double precision or x::timestamp with time zone
+	if castAt := lastDDLTopLevelCast(item); castAt >= 0 {
+		typeCursor := &ddlCursor{tokens: item[castAt+1:]}
+		if _, err := (&ddlSchema{}).parseType(typeCursor); err == nil && typeCursor.atEnd() {
+			return item, ""
+		}
+	}
+
+	return item[:len(item)-1], item[len(item)-1].Text
+}
+
+func lastDDLTopLevelCast(expression []ddlToken) int {
+
+	depth := 0
+	castAt := -1
+	for i, tok := range expression {
+		switch {
+		case tok.isSymbol("("):
+			depth++
+		case tok.isSymbol(")"):
+			depth--
+		case depth == 0 && tok.isSymbol("::"):
+			castAt = i
+		}
+	}
+	return castAt
+}
+
+// the result types of the common functions, by function name; an empty data type means
+// the function returns the type of its first argument
+var ddlFunctionResultTypes = map[string]string{
+	"count": "bigint", "row_number": "bigint", "rank": "bigint", "dense_rank": "bigint",
+	"now": "timestamp with time zone", "clock_timestamp": "timestamp with time zone", "statement_timestamp": "timestamp with time zone",
+	"lower": "text", "upper": "text", "btrim": "text", "ltrim": "text", "rtrim": "text", "trim": "text", "concat": "text",
+	"concat_ws": "text", "substring": "text", "substr": "text", "replace": "text", "initcap": "text", "left": "text",
+	"right": "text", "format": "text", "string_agg": "text", "md5": "text", "to_char": "text",
+	"length": "integer", "char_length": "integer", "octet_length": "integer", "strpos": "integer", "position": "integer",
+	"bool_and": "boolean", "bool_or": "boolean", "every": "boolean",
+	"extract": "double precision", "date_part": "double precision",
+	"to_json": "json", "row_to_json": "json", "json_agg": "json", "json_build_object": "json", "json_object_agg": "json",
+	"to_jsonb": "jsonb", "jsonb_agg": "jsonb", "jsonb_build_object": "jsonb", "jsonb_object_agg": "jsonb",
+	"gen_random_uuid": "uuid", "uuid_generate_v4": "uuid",
+	"coalesce": "", "nullif": "", "min": "", "max": "", "greatest": "", "least": "",
+	"first_value": "", "last_value": "", "lag": "", "lead": "",
+}
+
+// inferredDDLType returns the built-in type of an expression, which has no maximum length
+func inferredDDLType(typeName string) ddlType {
+	inferredType := ddlBuiltinTypes[typeName]
+	inferredType.MaxLength = -1
+	return inferredType
+}
+
+// inferExpressionType returns the type of a select list expression, and the name
+// Postgres gives to the column when there is no alias
+func (s *ddlSchema) inferExpressionType(expression []ddlToken, sources []ddlViewSource) (ddlType, string, bool) {
+
+	if len(expression) == 0 {
+		return ddlType{}, "", false
+	}
+
+	// expression::type
+	if castAt := lastDDLTopLevelCast(expression); castAt > 0 {
+		typeCursor := &ddlCursor{tokens: expression[castAt+1:]}
+		castType, err := s.parseType(typeCursor)
+		if err != nil || !typeCursor.atEnd() {
+			return ddlType{}, "", false
+		}
+		_, defaultName, _ := s.inferExpressionType(expression[:castAt], sources)
+		if defaultName == "" {
+			defaultName = expression[len(expression)-1].Text
+		}
+		return castType, defaultName, true
+	}
+
+	first := expression[0]
+
+	// CAST(expression AS type)
+	if first.is("cast") && len(expression) > 1 && expression[1].isSymbol("(") {
+		c := &ddlCursor{tokens: expression[1:]}
+		inner, err := c.parenthesized()
+		if err != nil || !c.atEnd() {
+			return ddlType{}, "", false
+		}
+		for i := len(inner) - 1; i > 0; i-- {
+			if inner[i].is("as") {
+				typeCursor := &ddlCursor{tokens: inner[i+1:]}
+				castType, err := s.parseType(typeCursor)
+				if err != nil || !typeCursor.atEnd() {
+					return ddlType{}, "", false
+				}
+				_, defaultName, _ := s.inferExpressionType(inner[:i], sources)
+				if defaultName == "" {
+					defaultName = typeCursor.tokens[0].Text
+				}
+				return castType, defaultName, true
+			}
+		}
+		return ddlType{}, "", false
+	}
+
+	// (expression)
+	if first.isSymbol("(") {
+		c := &ddlCursor{tokens: expression}
+		inner, err := c.parenthesized()
+		if err == nil && c.atEnd() {
+			return s.inferExpressionType(inner, sources)
+		}
+		return ddlType{}, "", false
+	}
+
+	// literals
+	if len(expression) == 1 {
+		switch {
+		case first.Kind == ddlTokenString:
+			return inferredDDLType("text"), "", true
+		case first.Kind == ddlTokenNumber && strings.ContainsAny(first.Text, ".eE"):
+			return inferredDDLType("numeric"), "", true
+		case first.Kind == ddlTokenNumber:
+			return inferredDDLType("integer"), "", true
+		case first.is("true"), first.is("false"):
+			return inferredDDLType("boolean"), "bool", true
+		case first.is("current_timestamp"):
+			return inferredDDLType("timestamptz"), "current_timestamp", true
+		case first.is("current_date"):
+			return inferredDDLType("date"), "current_date", true
+		case first.is("localtimestamp"):
+			return inferredDDLType("timestamp"), "localtimestamp", true
+		}
+	}
+
+	// CASE WHEN ... THEN result ... END takes the type of the first result
+	if first.is("case") {
+		for i, tok := range expression {
+			if tok.is("then") {
+				c := &ddlCursor{tokens: expression[i+1:]}
+				result := c.takeUntil(map[string]bool{"when": true, "else": true, "end": true}, false)
+				resultType, _, ok := s.inferExpressionType(result, sources)
+				return resultType, "case", ok
+			}
+		}
+		return ddlType{}, "", false
+	}
+
+	// function calls
+	if first.isIdent() && len(expression) > 1 && expression[1].isSymbol("(") {
+		c := &ddlCursor{tokens: expression[1:]}
+		arguments, err := c.parenthesized()
+		if err != nil {
+			return ddlType{}, "", false
+		}
+		// allow FILTER (...) and OVER (...) / OVER window_name after aggregates
+		for c.acceptWord("filter") || c.acceptWord("over") {
+			if c.peek().isSymbol("(") {
+				c.parenthesized()
+			} else {
+				c.next()
+			}
+		}
+		if !c.atEnd() {
+			return ddlType{}, "", false
+		}
+
+		resultType, known := ddlFunctionResultTypes[first.Text]
+		if !known {
+			return ddlType{}, "", false
+		}
+		if resultType != "" {
+			return inferredDDLType(resultType), first.Text, true
+		}
+
+		argumentList := splitDDLTopLevel(arguments, ",")
+		if len(argumentList) == 0 {
+			return ddlType{}, "", false
+		}
+		argumentType, _, ok := s.inferExpressionType(argumentList[0], sources)
+		return argumentType, first.Text, ok
+	}
+
+	// column references: column, source.column or schema.source.column
+	if len(expression)%2 == 1 && len(expression) <= 5 {
+		var parts []string
+		for i, tok := range expression {
+			if (i%2 == 0 && !tok.isIdent()) || (i%2 == 1 && !tok.isSymbol(".")) {
+				return ddlType{}, "", false
+			}
+			if i%2 == 0 {
+				parts = append(parts, tok.Text)
+			}
+		}
+
+		columnName := parts[len(parts)-1]
+		sourceName := ""
+		if len(parts) > 1 {
+			sourceName = parts[len(parts)-2]
+		}
+
+		for _, source := range sources {
+			if sourceName != "" && source.Alias != sourceName && (source.Alias != "" || source.Name != sourceName) {
+				continue
+			}
+			for _, col := range source.Columns {
+				if col.Name == columnName {
+					return col.Type, columnName, true
+				}
+			}
+		}
+	}
+
+	return ddlType{}, "", false
+}
+
+func (s *ddlSchema) warnf(format string, args ...interface{}) {
+	s.Warnings = append(s.Warnings, "DDL: "+fmt.Sprintf(format, args...))
+}
+
+/* Converting to the schema model */
+
+// toDatabase converts the parsed schema into the model, the way Introspect would report it
+func (s *ddlSchema) toDatabase() *Database {
+
+	database := &Database{Schema: s.SchemaName}
+
+	for _, dt := range s.Tables {
+
+		table := Table{Name: dt.Name, Comment: dt.Comment, PrimaryKey: dt.PrimaryKey}
+
+		for i, dc := range dt.Columns {
+			table.Columns = append(table.Columns, dc.toColumn(i+1, !dc.NotNull, dc.Comment))
+		}
+
+		// unique constraints first, then the unique indexes, like Introspect
+		for _, constraint := range append(dt.UniqueConstraints, dt.UniqueIndexes...) {
+			table.UniqueConstraints = append(table.UniqueConstraints, Constraint{Name: constraint.Name, Columns: constraint.Columns})
+		}
+
+		database.Tables = append(database.Tables, table)
+	}
+
+	// the views must be inferred in definition order, since views can select from views
+	for _, dv := range s.Views {
+		dv.Columns = s.inferViewColumns(dv)
+	}
+
+	// regular views first, then the materialized ones, like Introspect
+	for _, materialized := range []bool{false, true} {
+		for _, dv := range s.Views {
+			if dv.IsMaterialized != materialized {
+				continue
+			}
+
+			view := View{Name: dv.Name, Comment: dv.Comment, Materialized: dv.IsMaterialized}
+
+			for i, dc := range dv.Columns {
+				// view columns are always reported as nullable, and without a default
+				column := dc.toColumn(i+1, true, dv.ColumnComments[dc.Name])
+				column.Default = nil
+
+				// the materialized view columns are read from pg_attribute, which names arrays "type[]"
+				if dv.IsMaterialized && column.DataType == "ARRAY" {
+					column.DataType, column.UdtName = dc.Type.ElementDataType+"[]", ""
+				}
+
+				view.Columns = append(view.Columns, column)
+			}
+
+			database.Views = append(database.Views, view)
+		}
+	}
+
+	// Introspect reads the functions ordered by name
+	functions := make([]*ddlFunction, len(s.Functions))
+	copy(functions, s.Functions)
+	sort.SliceStable(functions, func(i, j int) bool { return functions[i].Name < functions[j].Name })
+
+	for _, df := range functions {
+
+		function := Function{
+			Name:           df.Name,
+			SpecificName:   df.Name,
+			Comment:        df.Comment,
+			ReturnDataType: df.ReturnType.DataType,
+			ReturnUdtName:  df.ReturnType.UdtName,
+			ReturnsSet:     df.ReturnsSet,
+		}
+
+		for _, param := range df.Params {
+			function.Parameters = append(function.Parameters, Parameter{
+				Name:     param.Name,
+				Mode:     param.Mode,
+				DataType: param.Type.DataType,
+				Default:  param.Default,
+			})
+		}
+
+		database.Functions = append(database.Functions, function)
+	}
+
+	database.Warnings = s.Warnings
+
+	return database
+}
+
+func (dc *ddlColumn) toColumn(position int, nullable bool, comment string) Column {
+
+	column := Column{
+		Name:       dc.Name,
+		Comment:    comment,
+		Position:   position,
+		DataType:   dc.Type.DataType,
+		UdtName:    dc.Type.UdtName,
+		DomainName: dc.Type.DomainName,
+		MaxLength:  dc.Type.MaxLength,
+		Nullable:   nullable,
+	}
+
+	if dc.HasDefault {
+		defaultValue := dc.Default
+		column.Default = &defaultValue
+	}
+
+	return column
+}
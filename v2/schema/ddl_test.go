@@ -0,0 +1,337 @@
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// ddlTable returns the table of the parsed schema, failing the test if there is none
+func ddlTable(t *testing.T, database *schema.Database, name string) schema.Table {
+
+	for _, tbl := range database.Tables {
+		if tbl.Name == name {
+			return tbl
+		}
+	}
+	t.Fatalf("table %s not found in %+v", name, database.Tables)
+	return schema.Table{}
+}
+
+// ddlColumn returns the column of the table, failing the test if there is none
+func ddlColumn(t *testing.T, tbl schema.Table, name string) schema.Column {
+
+	for _, col := range tbl.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	t.Fatalf("column %s not found in table %s", name, tbl.Name)
+	return schema.Column{}
+}
+
+func TestParseDDLStrings(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		literal string
+		want    string
+	}{
+		{"plain", `'plain'`, "plain"},
+		{"doubled quote", `'it''s'`, "it's"},
+		{"backslash kept in a standard string", `'a\nb'`, `a\nb`},
+		{"escape string doubled quote", `E'it''s'`, "it's"},
+		{"escape string newline", `E'it''s \n'`, "it's \n"},
+		{"escape string tab and backslash", `e'a\tb\\c'`, "a\tb\\c"},
+		{"escape string quote", `E'it\'s'`, "it's"},
+		{"escape string octal", `E'\101\60'`, "A0"},
+		{"escape string hex", `E'\x41\x4a'`, "AJ"},
+		{"escape string unicode", `E'café \U0001F600'`, "café 😀"},
+		{"escape string unknown escape", `E'\q'`, "q"},
+		{"dollar quoted", `$tag$it's \n$tag$`, `it's \n`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			database, err := schema.ParseDDL("CREATE TABLE t (c text);\nCOMMENT ON TABLE t IS "+test.literal+";", "public")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ddlTable(t, database, "t").Comment; got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseDDLTypes(t *testing.T) {
+
+	tests := []struct {
+		columnType string
+		dataType   string
+		udtName    string
+		maxLength  int
+	}{
+		{"integer", "integer", "int4", -1},
+		{"bigserial", "bigint", "int8", -1},
+		{"double precision", "double precision", "float8", -1},
+		{"varchar(40)", "character varying", "varchar", 40},
+		{"character varying", "character varying", "varchar", -1},
+		{"char", "character", "bpchar", 1},
+		{"char(3)", "character", "bpchar", 3},
+		{`"char"`, `"char"`, "char", -1},
+		{"bit", "bit", "bit", -1},
+		{"bit(3)", "bit", "bit", -1},
+		{"bit varying(8)", "bit varying", "varbit", -1},
+		{"varbit", "bit varying", "varbit", -1},
+		{"timestamp(3) with time zone", "timestamp with time zone", "timestamptz", -1},
+		{"time without time zone", "time without time zone", "time", -1},
+		{"numeric(10, 2)", "numeric", "numeric", -1},
+		{"text[]", "ARRAY", "_text", -1},
+		{"int ARRAY[4]", "ARRAY", "_int4", -1},
+		{"hstore", "USER-DEFINED", "hstore", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.columnType, func(t *testing.T) {
+
+			database, err := schema.ParseDDL("CREATE TABLE t (c "+test.columnType+");", "public")
+			if err != nil {
+				t.Fatal(err)
+			}
+			col := ddlColumn(t, ddlTable(t, database, "t"), "c")
+			if col.DataType != test.dataType || col.UdtName != test.udtName || col.MaxLength != test.maxLength {
+				t.Errorf("got %s, %s, %d, want %s, %s, %d", col.DataType, col.UdtName, col.MaxLength,
+					test.dataType, test.udtName, test.maxLength)
+			}
+		})
+	}
+}
+
+func TestParseDDLSerialDefaults(t *testing.T) {
+
+	tests := []struct {
+		ddl    string
+		table  string
+		column string
+		want   string
+	}{
+		{`CREATE TABLE users (id serial);`, "users", "id", "nextval('users_id_seq'::regclass)"},
+		{`CREATE TABLE "Users" ("Id" serial);`, "Users", "Id", `nextval('"Users_Id_seq"'::regclass)`},
+		{`CREATE TABLE "user list" (id bigserial);`, "user list", "id", `nextval('"user list_id_seq"'::regclass)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+
+			database, err := schema.ParseDDL(test.ddl, "public")
+			if err != nil {
+				t.Fatal(err)
+			}
+			col := ddlColumn(t, ddlTable(t, database, test.table), test.column)
+			if col.Default == nil || *col.Default != test.want || col.Nullable {
+				t.Errorf("got default %v, nullable %v, want %s", col.Default, col.Nullable, test.want)
+			}
+		})
+	}
+}
+
+func TestParseDDLLike(t *testing.T) {
+
+	const base = `CREATE TABLE accounts (
+		account_id serial PRIMARY KEY,
+		email text NOT NULL UNIQUE,
+		status text DEFAULT 'open'
+	);
+	`
+
+	tests := []struct {
+		name       string
+		ddl        string
+		columns    []string
+		defaults   bool
+		primaryKey bool
+		warning    string
+	}{
+		{"without options", `CREATE TABLE archive (LIKE accounts);`, []string{"account_id", "email", "status"}, false, false, ""},
+		{"including all", `CREATE TABLE archive (LIKE accounts INCLUDING ALL);`, []string{"account_id", "email", "status"}, true, true, ""},
+		{"including all excluding indexes", `CREATE TABLE archive (LIKE public.accounts INCLUDING ALL EXCLUDING INDEXES);`, []string{"account_id", "email", "status"}, true, false, ""},
+		{"including defaults", `CREATE TABLE archive (LIKE accounts INCLUDING DEFAULTS, archived_at timestamptz);`, []string{"account_id", "email", "status", "archived_at"}, true, false, ""},
+		{"unknown table", `CREATE TABLE archive (LIKE missing INCLUDING ALL, note text);`, []string{"note"}, false, false, "LIKE missing"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			database, err := schema.ParseDDL(base+test.ddl, "public")
+			if err != nil {
+				t.Fatal(err)
+			}
+			archive := ddlTable(t, database, "archive")
+
+			var columns []string
+			for _, col := range archive.Columns {
+				columns = append(columns, col.Name)
+			}
+			if strings.Join(columns, ",") != strings.Join(test.columns, ",") {
+				t.Fatalf("got columns %v, want %v", columns, test.columns)
+			}
+			if test.warning != "" {
+				if len(database.Warnings) != 1 || !strings.Contains(database.Warnings[0], test.warning) {
+					t.Errorf("got warnings %v, want one about %s", database.Warnings, test.warning)
+				}
+				return
+			}
+
+			if email := ddlColumn(t, archive, "email"); email.Nullable {
+				t.Errorf("the NOT NULL of email was not copied")
+			}
+			if status := ddlColumn(t, archive, "status"); (status.Default != nil) != test.defaults {
+				t.Errorf("got the status default %v, want it copied: %v", status.Default, test.defaults)
+			}
+			if id := ddlColumn(t, archive, "account_id"); id.Default != nil && *id.Default != "nextval('accounts_account_id_seq'::regclass)" {
+				t.Errorf("the copied serial column has the default %s, not the sequence of accounts", *id.Default)
+			}
+
+			if (len(archive.PrimaryKey) > 0) != test.primaryKey || (len(archive.UniqueConstraints) > 0) != test.primaryKey {
+				t.Errorf("got the primary key %v and unique constraints %v, want them copied: %v",
+					archive.PrimaryKey, archive.UniqueConstraints, test.primaryKey)
+			}
+			if test.primaryKey && archive.UniqueConstraints[0].Name != "archive_email_key" {
+				t.Errorf("got the unique constraint %s, want archive_email_key", archive.UniqueConstraints[0].Name)
+			}
+		})
+	}
+}
+
+func TestParseDDLInherits(t *testing.T) {
+
+	database, err := schema.ParseDDL(`CREATE TABLE events (
+		event_id serial PRIMARY KEY,
+		created_at timestamptz NOT NULL,
+		payload jsonb
+	);
+	CREATE TABLE audited (audited_by text NOT NULL, created_at timestamptz);
+	CREATE TABLE logins (
+		created_at timestamptz DEFAULT now(),
+		address inet,
+		PRIMARY KEY (event_id)
+	) INHERITS (public.events, audited);
+	CREATE TABLE orphans (note text) INHERITS (missing);
+	`, "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logins := ddlTable(t, database, "logins")
+	var columns []string
+	for _, col := range logins.Columns {
+		columns = append(columns, col.Name)
+	}
+	if want := "event_id,created_at,payload,audited_by,address"; strings.Join(columns, ",") != want {
+		t.Fatalf("got columns %v, want %s", columns, want)
+	}
+
+	if createdAt := ddlColumn(t, logins, "created_at"); createdAt.Nullable || createdAt.Default == nil || *createdAt.Default != "now()" {
+		t.Errorf("the created_at declared again got the nullable %v and default %v, want NOT NULL with now()", createdAt.Nullable, createdAt.Default)
+	}
+	if id := ddlColumn(t, logins, "event_id"); id.Default == nil || *id.Default != "nextval('events_event_id_seq'::regclass)" {
+		t.Errorf("the inherited serial column has the default %v, not the sequence of events", id.Default)
+	}
+	if len(logins.PrimaryKey) != 1 || len(logins.UniqueConstraints) != 0 {
+		t.Errorf("got the primary key %v and unique constraints %v, want only the primary key of logins", logins.PrimaryKey, logins.UniqueConstraints)
+	}
+
+	if len(database.Warnings) != 1 || !strings.Contains(database.Warnings[0], "INHERITS missing") {
+		t.Errorf("got warnings %v, want one about INHERITS missing", database.Warnings)
+	}
+}
+
+func TestParseDDLReturnsTable(t *testing.T) {
+
+	database, err := schema.ParseDDL(`CREATE FUNCTION account_totals(since date, OUT ignored int)
+		RETURNS TABLE (account_id bigint, total numeric(12, 2)) LANGUAGE sql AS $$ SELECT 1, 2 $$;`, "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(database.Functions) != 1 {
+		t.Fatalf("got the functions %+v, want account_totals", database.Functions)
+	}
+	function := database.Functions[0]
+
+	var parameters []string
+	for _, parameter := range function.Parameters {
+		parameters = append(parameters, parameter.Mode+" "+parameter.Name+" "+parameter.DataType)
+	}
+	want := "IN since date,OUT ignored integer,OUT account_id bigint,OUT total numeric"
+	if strings.Join(parameters, ",") != want {
+		t.Errorf("got the parameters %v, want %s", parameters, want)
+	}
+	if !function.ReturnsSet || function.ReturnDataType != "record" {
+		t.Errorf("got the return type %s, set: %v, want a set of records", function.ReturnDataType, function.ReturnsSet)
+	}
+}
+
+func TestParseDDLExpressions(t *testing.T) {
+
+	database, err := schema.ParseDDL(`CREATE TABLE documents (
+		document_id int PRIMARY KEY,
+		body jsonb NOT NULL,
+		title text DEFAULT '{"title":"untitled"}'::jsonb->>'title',
+		slug text DEFAULT 'doc-'||'new'
+	);
+	CREATE VIEW document_stats AS SELECT count(*) AS documents, max(document_id) AS last_id, (body->>'kind')::text AS kind FROM documents;
+	`, "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	documents := ddlTable(t, database, "documents")
+	for name, want := range map[string]string{
+		"title": `'{"title":"untitled"}'::jsonb ->> 'title'`,
+		"slug":  `'doc-' || 'new'`,
+	} {
+		if col := ddlColumn(t, documents, name); col.Default == nil || *col.Default != want {
+			t.Errorf("got the %s default %v, want %s", name, col.Default, want)
+		}
+	}
+
+	if len(database.Views) != 1 {
+		t.Fatalf("got the views %+v, want document_stats", database.Views)
+	}
+	if len(database.Views[0].Columns) != 3 {
+		t.Fatalf("got the view columns %+v and warnings %v, want 3 columns", database.Views[0].Columns, database.Warnings)
+	}
+	for _, col := range database.Views[0].Columns {
+		if col.MaxLength != -1 {
+			t.Errorf("got the maximum length %d for the inferred column %s, want -1", col.MaxLength, col.Name)
+		}
+	}
+}
+
+func TestParseDDLErrors(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ddl  string
+		want string
+	}{
+		{"unterminated string", `COMMENT ON TABLE t IS 'abc`, "line 1: unterminated quoted sequence"},
+		{"unterminated comment", "CREATE TABLE t (c text);\n/* never closed", "line 2: unterminated block comment"},
+		{"unexpected LIKE option", `CREATE TABLE a (c text); CREATE TABLE b (LIKE a INCLUDING);`, "expected an identifier"},
+		{"unexpected column constraint", `CREATE TABLE t (c text FOO);`, `unexpected "foo" in the definition of column t.c`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			_, err := schema.ParseDDL(test.ddl, "public")
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want %q", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,591 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Queryer runs the introspection queries. *sql.DB, *sql.Conn and *sql.Tx all implement it.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Options tells Introspect where to look and what to collect
+type Options struct {
+	Schema  string // e.g. "public"
+	Catalog string // the database name
+
+	// when false, the functions are not collected
+	Functions bool
+
+	// when not nil, every query is written here before it runs, to help identify the one failing
+	Log io.Writer
+
+	// the number of queries run at the same time for the details of the tables, views and
+	// functions, 0 or 1 for one at a time. Above 1, db must be safe for concurrent use, as *sql.DB is.
+	Jobs int
+}
+
+// Introspect reads the tables, views, materialized views and (optionally) the functions
+// of a schema from a live database, in the order given by Database.Sort
+func Introspect(ctx context.Context, db Queryer, opts Options) (*Database, error) {
+
+	in := &introspector{ctx: ctx, db: db, opts: opts}
+
+	database, err := in.database()
+	if err != nil {
+		return nil, err
+	}
+	database.Sort()
+
+	return database, nil
+}
+
+func (in *introspector) database() (*Database, error) {
+
+	opts := in.opts
+	database := &Database{Schema: opts.Schema}
+
+	majorVersion, minorVersion, err := in.serverVersion()
+	if err != nil {
+		return nil, err
+	}
+	database.MajorVersion = majorVersion
+	database.MinorVersion = minorVersion
+
+	if database.Tables, err = in.tables(); err != nil {
+		return nil, err
+	}
+
+	if database.Views, err = in.views(); err != nil {
+		return nil, err
+	}
+
+	materializedViews, err := in.materializedViews()
+	if err != nil {
+		return nil, err
+	}
+	database.Views = append(database.Views, materializedViews...)
+
+	if !opts.Functions {
+		return database, nil
+	}
+
+	if majorVersion <= 9 && minorVersion < 4 {
+		database.Warnings = append(database.Warnings, "the functions were not collected: Postgres versions before 9.4 do not support "+
+			"parameter_default inside the information schema parameters view, see https://www.postgresql.org/docs/9.5/static/infoschema-parameters.html")
+		return database, nil
+	}
+
+	if database.Functions, err = in.functions(); err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
+
+type introspector struct {
+	ctx  context.Context
+	db   Queryer
+	opts Options
+
+	// serializes the writes to opts.Log
+	logMutex sync.Mutex
+}
+
+func (in *introspector) query(query string, args ...interface{}) (*sql.Rows, error) {
+
+	if in.opts.Log != nil {
+		in.logMutex.Lock()
+		fmt.Fprintf(in.opts.Log, "\n-- DEBUG [begin] --\nQuery:\n%s\n-------\nArguments: %v\n-- DEBUG [end] --\n", query, args)
+		in.logMutex.Unlock()
+	}
+
+	return in.db.QueryContext(in.ctx, query, args...)
+}
+
+func (in *introspector) tables() ([]Table, error) {
+
+	tablesQuery := "SELECT table_name, obj_description((quote_ident(table_schema) || '.' || quote_ident(table_name))::regclass, 'pg_class') " +
+		"FROM information_schema.tables WHERE table_schema=$1 AND table_type='BASE TABLE' ORDER BY table_name;"
+
+	rows, err := in.query(tablesQuery, in.opts.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("CollectTables(): error running the tables query: %s", err)
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var tableName string
+		var tableComment sql.NullString
+		if err := rows.Scan(&tableName, &tableComment); err != nil {
+			return nil, fmt.Errorf("CollectTables(): error reading the tables query results: %s", err)
+		}
+		tables = append(tables, Table{Name: tableName, Comment: tableComment.String})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("CollectTables(): error reading the tables query results: %s", err)
+	}
+	rows.Close()
+
+	err = forEach(in.opts.Jobs, len(tables), func(i int) error {
+		return in.table(&tables[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// table collects the columns, the primary key and the unique constraints of a table
+func (in *introspector) table(tbl *Table) (err error) {
+
+	if tbl.Columns, err = in.columns(tbl.Name); err != nil {
+		return err
+	}
+
+	if tbl.PrimaryKey, err = in.primaryKey(tbl.Name); err != nil {
+		return err
+	}
+
+	constraintsQuery := `SELECT
+        tc.constraint_name,
+        kcu.column_name
+    FROM
+        information_schema.table_constraints AS tc
+        JOIN information_schema.key_column_usage AS kcu ON (tc.constraint_name = kcu.constraint_name and tc.table_name = kcu.table_name)
+    WHERE tc.constraint_schema = $1 AND tc.table_name = $2 AND tc.constraint_type = 'UNIQUE'
+    ORDER BY tc.constraint_name, kcu.ordinal_position
+	`
+	uniqueConstraints, err := in.uniqueConstraints("CollectUniqueConstraints", tbl.Name, constraintsQuery, in.opts.Schema, tbl.Name)
+	if err != nil {
+		return err
+	}
+
+	// the unique indexes minus the ones already collected as unique constraints,
+	// with the columns in the order of the index key
+	uniqueIndexesQuery := `select i.relname as constraint_name, a.attname as column_name
+	from pg_class t, information_schema.tables ist, pg_class i,  pg_index ix, pg_attribute a
+	where t.relname = $1 and t.oid = ix.indrelid and ix.indisunique = true and i.oid = ix.indexrelid
+    and a.attrelid = t.oid and a.attnum = ANY(ix.indkey) and t.relkind = 'r'
+    and t.relname = ist.table_name and ist.table_catalog = $2 and ist.table_schema = $3
+    and i.relname NOT IN (SELECT tc.constraint_name FROM information_schema.table_constraints AS tc
+    JOIN information_schema.key_column_usage AS kcu ON (tc.constraint_name = kcu.constraint_name and tc.table_name = kcu.table_name))
+	group by t.relname, i.relname, ix.indisunique, a.attname, ist.table_schema, array_position(ix.indkey::int2[], a.attnum)
+	order by t.relname, i.relname, array_position(ix.indkey::int2[], a.attnum);
+	`
+	uniqueIndexes, err := in.uniqueConstraints("CollectUniqueIndexes", tbl.Name, uniqueIndexesQuery, tbl.Name, in.opts.Catalog, in.opts.Schema)
+	if err != nil {
+		return err
+	}
+
+	tbl.UniqueConstraints = append(uniqueConstraints, uniqueIndexes...)
+
+	return nil
+}
+
+// columns collects the columns of a table or a regular view
+func (in *introspector) columns(tableName string) ([]Column, error) {
+
+	// the enums are read and written as text, they are reported the way ParseDDL does it
+	columnQuery := "SELECT column_name, column_default, is_nullable, " +
+		" CASE WHEN data_type = 'USER-DEFINED' AND EXISTS (SELECT 1 FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace " +
+		"   WHERE t.typtype = 'e' AND t.typname = udt_name AND n.nspname = udt_schema) THEN 'text' ELSE data_type END AS data_type, " +
+		" udt_name, character_maximum_length, ordinal_position, domain_name, " +
+		" col_description((quote_ident(table_schema) || '.' || quote_ident(table_name))::regclass, ordinal_position) AS column_comment FROM information_schema.columns " +
+		" WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position;"
+
+	rows, err := in.query(columnQuery, in.opts.Schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("CollectColumns(): error running the query for %s: %s\nQuery:\n%s", tableName, err, columnQuery)
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+
+		// For fixed length arrays (e.g. character[]) we cannot infer the data type just
+		// from the data_type column. That will contain "ARRAY" and udt_name will contain
+		// the specific type (e.g. "_bpchar" for character[])
+		var column Column
+		var isNullable string
+		var columnDefault, domainName, columnComment sql.NullString
+		var charMaxLength sql.NullInt64
+
+		err := rows.Scan(&column.Name, &columnDefault, &isNullable, &column.DataType, &column.UdtName, &charMaxLength, &column.Position, &domainName, &columnComment)
+		if err != nil {
+			return nil, fmt.Errorf("CollectColumns(): error reading the query results for %s: %s", tableName, err)
+		}
+
+		column.Nullable = decodeNullable(isNullable)
+		column.MaxLength = decodeMaxLength(charMaxLength)
+		column.DomainName = domainName.String
+		column.Comment = columnComment.String
+		if columnDefault.Valid {
+			column.Default = &columnDefault.String
+		}
+
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("CollectColumns(): error reading the query results for %s: %s", tableName, err)
+	}
+
+	return columns, nil
+}
+
+func (in *introspector) primaryKey(tableName string) ([]string, error) {
+
+	pkQuery := `SELECT kcu.column_name
+			FROM    INFORMATION_SCHEMA.TABLES t
+			         LEFT JOIN INFORMATION_SCHEMA.TABLE_CONSTRAINTS tc
+			                 ON tc.table_catalog = t.table_catalog
+			                 AND tc.table_schema = t.table_schema
+			                 AND tc.table_name = t.table_name
+			                 AND tc.constraint_type = 'PRIMARY KEY'
+			         LEFT JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE kcu
+			                 ON kcu.table_catalog = tc.table_catalog
+			                 AND kcu.table_schema = tc.table_schema
+			                 AND kcu.table_name = tc.table_name
+			                 AND kcu.constraint_name = tc.constraint_name
+			WHERE   t.table_schema = $1 AND t.table_catalog = $2 AND t.table_name = $3
+			ORDER BY t.table_catalog,
+			         t.table_schema,
+			         t.table_name,
+			         kcu.constraint_name,
+			         kcu.ordinal_position;`
+
+	rows, err := in.query(pkQuery, in.opts.Schema, in.opts.Catalog, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("CollectPrimaryKeys(): error running the query for table %s: %s\nQuery:\n%s", tableName, err, pkQuery)
+	}
+	defer rows.Close()
+
+	var pkColumnNames []string
+	for rows.Next() {
+		// the tables without a primary key come back as a single row of nulls
+		var columnName sql.NullString
+		if err := rows.Scan(&columnName); err != nil {
+			return nil, fmt.Errorf("CollectPrimaryKeys(): error reading the query results for table %s: %s", tableName, err)
+		}
+		if columnName.Valid {
+			pkColumnNames = append(pkColumnNames, columnName.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("CollectPrimaryKeys(): error reading the query results for table %s: %s", tableName, err)
+	}
+
+	return pkColumnNames, nil
+}
+
+// uniqueConstraints runs a query returning (constraint name, column name) rows ordered by
+// constraint, and groups the columns by constraint, in the order of the rows
+func (in *introspector) uniqueConstraints(caller, tableName, query string, args ...interface{}) ([]Constraint, error) {
+
+	rows, err := in.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): error running the query for table %s: %s\nQuery:\n%s", caller, tableName, err, query)
+	}
+	defer rows.Close()
+
+	var constraints []Constraint
+	for rows.Next() {
+		var constraintName, columnName string
+		if err := rows.Scan(&constraintName, &columnName); err != nil {
+			return nil, fmt.Errorf("%s(): error reading the query results for table %s: %s", caller, tableName, err)
+		}
+
+		if len(constraints) == 0 || constraints[len(constraints)-1].Name != constraintName {
+			constraints = append(constraints, Constraint{Name: constraintName})
+		}
+		last := &constraints[len(constraints)-1]
+		last.Columns = append(last.Columns, columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s(): error reading the query results for table %s: %s", caller, tableName, err)
+	}
+
+	return constraints, nil
+}
+
+func (in *introspector) views() ([]View, error) {
+
+	viewsQuery := "SELECT table_name, obj_description((quote_ident(table_schema) || '.' || quote_ident(table_name))::regclass, 'pg_class') " +
+		"FROM information_schema.views WHERE table_schema=$1 AND table_catalog=$2 ORDER BY table_name"
+
+	views, err := in.viewNames("CollectViews", viewsQuery, in.opts.Schema, in.opts.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEach(in.opts.Jobs, len(views), func(i int) (err error) {
+		views[i].Columns, err = in.columns(views[i].Name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+func (in *introspector) materializedViews() ([]View, error) {
+
+	// materialized views cannot (as of March 2015) be extracted easily from information schema
+	materializedViewsQuery := `SELECT c.relname, d.description
+FROM pg_catalog.pg_class c
+    JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+    LEFT JOIN pg_catalog.pg_description d ON (c.oid = d.objoid AND d.objsubid = 0)
+WHERE c.relkind = 'm' AND n.nspname = $1
+ORDER BY c.relname`
+
+	views, err := in.viewNames("CollectMaterializedViews", materializedViewsQuery, in.opts.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// the columns come from pg_attribute, where the types are named the SQL way, e.g. "integer[]"
+	columnsQuery := `SELECT attname AS column_name,
+	CAST(NOT(attnotnull) AS varchar(10)) as is_nullable,
+	format_type(atttypid, NULL) AS data_type,
+	atttypmod AS character_maximum_length,
+	attnum AS ordinal_position,
+	col_description(attrelid, attnum) AS column_comment
+FROM   pg_attribute
+WHERE  attrelid = (quote_ident($1) || '.' || quote_ident($2))::regclass
+AND    attnum > 0
+AND    NOT attisdropped
+ORDER BY attnum;
+`
+
+	err = forEach(in.opts.Jobs, len(views), func(i int) error {
+		views[i].Materialized = true
+		return in.materializedViewColumns(&views[i], columnsQuery)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+func (in *introspector) materializedViewColumns(view *View, columnsQuery string) error {
+
+	rows, err := in.query(columnsQuery, in.opts.Schema, view.Name)
+	if err != nil {
+		return fmt.Errorf("CollectMaterializedViewColumns(): error running the query for view %s: %s\nQuery:\n%s", view.Name, err, columnsQuery)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var column Column
+		var isNullable string
+		var charMaxLength sql.NullInt64
+		var columnComment sql.NullString
+
+		if err := rows.Scan(&column.Name, &isNullable, &column.DataType, &charMaxLength, &column.Position, &columnComment); err != nil {
+			return fmt.Errorf("CollectMaterializedViewColumns(): error reading the query results for view %s: %s", view.Name, err)
+		}
+
+		column.Nullable = decodeNullable(isNullable)
+		column.MaxLength = decodeMaxLength(charMaxLength)
+		column.Comment = columnComment.String
+
+		view.Columns = append(view.Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("CollectMaterializedViewColumns(): error reading the query results for view %s: %s", view.Name, err)
+	}
+
+	return nil
+}
+
+// viewNames runs a query returning (view name, comment) rows
+func (in *introspector) viewNames(caller, query string, args ...interface{}) ([]View, error) {
+
+	rows, err := in.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): error running the views query: %s", caller, err)
+	}
+	defer rows.Close()
+
+	var views []View
+	for rows.Next() {
+		var viewName string
+		var viewComment sql.NullString
+		if err := rows.Scan(&viewName, &viewComment); err != nil {
+			return nil, fmt.Errorf("%s(): error reading the views query results: %s", caller, err)
+		}
+		views = append(views, View{Name: viewName, Comment: viewComment.String})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s(): error reading the views query results: %s", caller, err)
+	}
+
+	return views, nil
+}
+
+func (in *introspector) functions() ([]Function, error) {
+
+	// The routine_name column is the "friendly" name (not guaranteed to be unique).
+	// The specific_name is the unique name.
+	// e.g. a hello_world function with multiple signatures, would have the
+	// "hello_world" value in the routing_name column for all records, but unique,
+	// number-prefixed names (such as "hello_world_18534") in the specific_name field.
+	functionsQuery := `SELECT r.routine_name, r.specific_name, obj_description(p.oid, 'pg_proc'),
+			r.data_type, r.type_udt_name, p.proretset
+			FROM information_schema.routines r JOIN pg_catalog.pg_proc p ON r.specific_name = p.proname || '_' || p.oid
+			WHERE r.routine_schema=$1 AND routine_catalog=$2 AND r.routine_type = 'FUNCTION'
+			AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_aggregate a WHERE a.aggfnoid = p.oid)
+			ORDER BY r.routine_name, r.specific_name;`
+
+	rows, err := in.query(functionsQuery, in.opts.Schema, in.opts.Catalog)
+	if err != nil {
+		return nil, fmt.Errorf("CollectFunctions(): error running the functions query: %s\nQuery:\n%s", err, functionsQuery)
+	}
+	defer rows.Close()
+
+	var functions []Function
+	for rows.Next() {
+		var function Function
+		var functionComment, returnDataType, returnUdtName sql.NullString
+		if err := rows.Scan(&function.Name, &function.SpecificName, &functionComment, &returnDataType, &returnUdtName, &function.ReturnsSet); err != nil {
+			return nil, fmt.Errorf("CollectFunctions(): error reading the functions query results: %s", err)
+		}
+
+		function.Comment = functionComment.String
+		function.ReturnDataType = returnDataType.String
+		function.ReturnUdtName = returnUdtName.String
+
+		functions = append(functions, function)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("CollectFunctions(): error reading the functions query results: %s", err)
+	}
+	rows.Close()
+
+	err = forEach(in.opts.Jobs, len(functions), func(i int) (err error) {
+		functions[i].Parameters, err = in.parameters(functions[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+func (in *introspector) parameters(function Function) ([]Parameter, error) {
+
+	paramsQuery := `
+		SELECT p.parameter_name, p.data_type, p.parameter_mode, p.parameter_default
+		FROM information_schema.routines r
+		    JOIN information_schema.parameters p ON r.specific_name=p.specific_name
+		WHERE r.routine_schema=$1 AND r.routine_catalog=$2 AND r.specific_name=$3
+		AND r.routine_type = 'FUNCTION'
+		ORDER BY r.routine_name, p.ordinal_position;
+	`
+
+	rows, err := in.query(paramsQuery, in.opts.Schema, in.opts.Catalog, function.SpecificName)
+	if err != nil {
+		return nil, fmt.Errorf("CollectParameters(): error running the query for function %s: %s\nQuery:\n%s", function.Name, err, paramsQuery)
+	}
+	defer rows.Close()
+
+	var parameters []Parameter
+	for rows.Next() {
+		var parameter Parameter
+		var parameterName, parameterMode, parameterDefault sql.NullString
+		if err := rows.Scan(&parameterName, &parameter.DataType, &parameterMode, &parameterDefault); err != nil {
+			return nil, fmt.Errorf("CollectParameters(): error reading the query results for function %s: %s", function.Name, err)
+		}
+
+		parameter.Name = parameterName.String
+		parameter.Mode = parameterMode.String
+		parameter.Default = parameterDefault.String
+
+		parameters = append(parameters, parameter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("CollectParameters(): error reading the query results for function %s: %s", function.Name, err)
+	}
+
+	return parameters, nil
+}
+
+// serverVersion retrieves the PostgreSQL version.
+// "SHOW server_version" returns something like "9.3.6", or after version 11 even "11.2 (Ubuntu 11.2-1.pgdg18.04+1)"
+func (in *introspector) serverVersion() (majorVersion int, minorVersion int, err error) {
+
+	rows, err := in.query("SHOW server_version;")
+	if err != nil {
+		return -1, -1, fmt.Errorf("GetPostgresVersion(): error reading the version: %s", err)
+	}
+	defer rows.Close()
+
+	var pgVersion string
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return -1, -1, fmt.Errorf("GetPostgresVersion(): error reading the version: %s", err)
+		}
+		return -1, -1, fmt.Errorf("GetPostgresVersion(): the row supposed to contain the version number is missing")
+	}
+	if err := rows.Scan(&pgVersion); err != nil {
+		return -1, -1, fmt.Errorf("GetPostgresVersion(): error reading the version: %s", err)
+	}
+
+	return parseServerVersion(pgVersion)
+}
+
+func parseServerVersion(pgVersion string) (majorVersion int, minorVersion int, err error) {
+
+	versions := strings.Split(pgVersion, ".")
+
+	majorVersion, err = strconv.Atoi(versions[0])
+	if err != nil {
+		return -1, -1, fmt.Errorf("GetPostgresVersion(): error parsing the major version of %q: %s", pgVersion, err)
+	}
+
+	if len(versions) < 2 {
+		return majorVersion, 0, nil
+	}
+
+	// eliminate any suffix from the minor version, e.g. "2 (Ubuntu 11" for "11.2 (Ubuntu 11.2-1.pgdg18.04+1)"
+	minorDigits := strings.IndexFunc(versions[1], func(c rune) bool { return !unicode.IsDigit(c) })
+	if minorDigits == -1 {
+		minorDigits = len(versions[1])
+	}
+
+	minorVersion, err = strconv.Atoi(versions[1][:minorDigits])
+	if err != nil {
+		return -1, -1, fmt.Errorf("GetPostgresVersion(): error parsing the minor version of %q: %s", pgVersion, err)
+	}
+
+	return majorVersion, minorVersion, nil
+}
+
+func decodeNullable(isNullable string) bool {
+
+	switch isNullable {
+	case "YES", "Yes", "yes", "y", "Y", "t", "T", "true", "TRUE", "True":
+		return true
+	}
+	return false
+}
+
+func decodeMaxLength(maxLength sql.NullInt64) int {
+
+	if !maxLength.Valid {
+		return -1
+	}
+	return int(maxLength.Int64)
+}
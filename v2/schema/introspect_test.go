@@ -0,0 +1,333 @@
+package schema_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/internal/pgx/pgmock"
+	"github.com/silviucm/pgtogogen/v2/internal/pgx/pgproto3"
+	_ "github.com/silviucm/pgtogogen/v2/internal/pgx/stdlib"
+	"github.com/silviucm/pgtogogen/v2/schema"
+)
+
+// textOID is the type of all the parameters and result columns of the fake server,
+// database/sql converts the values to the types scanned into
+const textOID = 25
+
+// fakeQuery is an introspection query the fake server answers, recognized by a part of its text
+type fakeQuery struct {
+	fragment string
+	columns  int
+
+	// the rows returned for each list of arguments, joined with ",", a nil value being NULL.
+	// The arguments not listed get no rows.
+	rows map[string][][]interface{}
+}
+
+// fakeServer answers the introspection queries over the PostgreSQL protocol, the way pgx sends
+// them through database/sql: each one is prepared, then executed with its arguments.
+// An unknown query gets an error.
+type fakeServer struct {
+	queries []fakeQuery
+}
+
+func (s *fakeServer) Serve(backend *pgproto3.Backend) error {
+
+	connect := pgmock.Script{Steps: append(pgmock.AcceptUnauthenticatedConnRequestSteps(), pgmock.PgxInitSteps()...)}
+	if err := connect.Run(backend); err != nil {
+		return err
+	}
+
+	var query *fakeQuery
+	var parameterCount int
+	var failed bool
+	var args string
+
+	for {
+		msg, err := backend.Receive()
+		if err != nil {
+			return err
+		}
+
+		var replies []pgproto3.BackendMessage
+
+		switch msg := msg.(type) {
+
+		case *pgproto3.Parse:
+			query, parameterCount = s.find(msg.Query), countParameters(msg.Query)
+			if query == nil {
+				failed = true
+				replies = append(replies, &pgproto3.ErrorResponse{Severity: "ERROR", Code: "42P01", Message: "unexpected query: " + msg.Query})
+				break
+			}
+			replies = append(replies, &pgproto3.ParseComplete{})
+
+		case *pgproto3.Describe:
+			if failed {
+				break
+			}
+			parameters := &pgproto3.ParameterDescription{ParameterOIDs: make([]uint32, parameterCount)}
+			for i := range parameters.ParameterOIDs {
+				parameters.ParameterOIDs[i] = textOID
+			}
+			fields := &pgproto3.RowDescription{Fields: make([]pgproto3.FieldDescription, query.columns)}
+			for i := range fields.Fields {
+				fields.Fields[i] = pgproto3.FieldDescription{Name: "column" + strconv.Itoa(i+1), DataTypeOID: textOID, DataTypeSize: -1}
+			}
+			replies = append(replies, parameters, fields)
+
+		case *pgproto3.Bind:
+			values := make([]string, len(msg.Parameters))
+			for i, parameter := range msg.Parameters {
+				values[i] = string(parameter)
+			}
+			args = strings.Join(values, ",")
+			replies = append(replies, &pgproto3.BindComplete{})
+
+		case *pgproto3.Execute:
+			rows := query.rows[args]
+			for _, row := range rows {
+				dataRow := &pgproto3.DataRow{Values: make([][]byte, len(row))}
+				for i, value := range row {
+					if value != nil {
+						dataRow.Values[i] = []byte(value.(string))
+					}
+				}
+				replies = append(replies, dataRow)
+			}
+			replies = append(replies, &pgproto3.CommandComplete{CommandTag: "SELECT " + strconv.Itoa(len(rows))})
+
+		case *pgproto3.Sync:
+			failed = false
+			replies = append(replies, &pgproto3.ReadyForQuery{TxStatus: 'I'})
+
+		case *pgproto3.Terminate:
+			return nil
+
+		default:
+			return fmt.Errorf("unexpected message %T", msg)
+		}
+
+		for _, reply := range replies {
+			if err := backend.Send(reply); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *fakeServer) find(query string) *fakeQuery {
+	for i := range s.queries {
+		if strings.Contains(query, s.queries[i].fragment) {
+			return &s.queries[i]
+		}
+	}
+	return nil
+}
+
+var parameterPattern = regexp.MustCompile(`\$(\d+)`)
+
+// countParameters returns the highest $n parameter number of the query
+func countParameters(query string) int {
+
+	count := 0
+	for _, match := range parameterPattern.FindAllStringSubmatch(query, -1) {
+		if n, _ := strconv.Atoi(match[1]); n > count {
+			count = n
+		}
+	}
+	return count
+}
+
+// bankQueries describe the "bank" database: two tables, a view, a materialized view and two functions
+func bankQueries() []fakeQuery {
+	return []fakeQuery{
+		{"SHOW server_version", 1, map[string][][]interface{}{
+			"": {{"12.4 (Debian 12.4-1.pgdg100+1)"}},
+		}},
+		{"FROM information_schema.tables", 2, map[string][][]interface{}{
+			"public": {{"accounts", "The customer accounts"}, {"transfers", nil}},
+		}},
+		{"FROM information_schema.columns", 9, map[string][][]interface{}{
+			"public,accounts": {
+				{"account_id", "nextval('accounts_account_id_seq'::regclass)", "NO", "bigint", "int8", nil, "1", nil, nil},
+				{"email", nil, "NO", "character varying", "varchar", "255", "2", "email", "Login e-mail"},
+				{"status", nil, "YES", "text", "account_status", nil, "3", nil, nil},
+				{"flags", nil, "YES", "ARRAY", "_bpchar", nil, "4", nil, nil},
+			},
+			"public,transfers": {
+				{"transfer_id", nil, "NO", "integer", "int4", nil, "1", nil, nil},
+				{"memo", nil, "YES", "text", "text", nil, "2", nil, nil},
+			},
+			"public,account_balances": {
+				{"account_id", nil, "YES", "bigint", "int8", nil, "1", nil, nil},
+				{"balance", nil, "YES", "numeric", "numeric", nil, "2", nil, nil},
+			},
+		}},
+		{"tc.constraint_type = 'PRIMARY KEY'", 1, map[string][][]interface{}{
+			"public,bank,accounts": {{"account_id"}},
+			// the tables without a primary key get a single row of nulls
+			"public,bank,transfers": {{nil}},
+		}},
+		{"tc.constraint_type = 'UNIQUE'", 2, map[string][][]interface{}{
+			"public,accounts": {{"accounts_email_key", "email"}},
+		}},
+		// the columns come in the order of the index key, not by name
+		{"order by t.relname, i.relname, array_position(ix.indkey::int2[], a.attnum)", 2, map[string][][]interface{}{
+			"accounts,bank,public": {{"accounts_status_flags_idx", "status"}, {"accounts_status_flags_idx", "flags"}},
+		}},
+		{"FROM information_schema.views", 2, map[string][][]interface{}{
+			"public,bank": {{"account_balances", "The open accounts"}},
+		}},
+		{"c.relkind = 'm'", 2, map[string][][]interface{}{
+			"public": {{"daily_totals", nil}},
+		}},
+		{"format_type(atttypid, NULL)", 6, map[string][][]interface{}{
+			"public,daily_totals": {{"day", "true", "date", "-1", "1", nil}, {"total", "true", "double precision", "-1", "2", nil}},
+		}},
+		{"FROM information_schema.routines r JOIN pg_catalog.pg_proc p", 6, map[string][][]interface{}{
+			"public,bank": {
+				{"close_account", "close_account_16422", "Closes the account", "void", "void", "f"},
+				{"open_accounts", "open_accounts_16424", nil, "USER-DEFINED", "accounts", "t"},
+			},
+		}},
+		{"JOIN information_schema.parameters p", 4, map[string][][]interface{}{
+			"public,bank,close_account_16422": {{"p_account_id", "bigint", "IN", nil}},
+		}},
+	}
+}
+
+// bankSchema is the schema Introspect reads from the bankQueries
+func bankSchema() *schema.Database {
+
+	accountIdDefault := "nextval('accounts_account_id_seq'::regclass)"
+
+	return &schema.Database{
+		Schema:       "public",
+		MajorVersion: 12,
+		MinorVersion: 4,
+		Tables: []schema.Table{
+			{
+				Name:    "accounts",
+				Comment: "The customer accounts",
+				Columns: []schema.Column{
+					{Name: "account_id", Position: 1, DataType: "bigint", UdtName: "int8", MaxLength: -1, Default: &accountIdDefault},
+					{Name: "email", Comment: "Login e-mail", Position: 2, DataType: "character varying", UdtName: "varchar", DomainName: "email", MaxLength: 255},
+					{Name: "status", Position: 3, DataType: "text", UdtName: "account_status", MaxLength: -1, Nullable: true},
+					{Name: "flags", Position: 4, DataType: "ARRAY", UdtName: "_bpchar", MaxLength: -1, Nullable: true},
+				},
+				PrimaryKey: []string{"account_id"},
+				UniqueConstraints: []schema.Constraint{
+					{Name: "accounts_email_key", Columns: []string{"email"}},
+					{Name: "accounts_status_flags_idx", Columns: []string{"status", "flags"}},
+				},
+			},
+			{
+				Name: "transfers",
+				Columns: []schema.Column{
+					{Name: "transfer_id", Position: 1, DataType: "integer", UdtName: "int4", MaxLength: -1},
+					{Name: "memo", Position: 2, DataType: "text", UdtName: "text", MaxLength: -1, Nullable: true},
+				},
+			},
+		},
+		Views: []schema.View{
+			{
+				Name:    "account_balances",
+				Comment: "The open accounts",
+				Columns: []schema.Column{
+					{Name: "account_id", Position: 1, DataType: "bigint", UdtName: "int8", MaxLength: -1, Nullable: true},
+					{Name: "balance", Position: 2, DataType: "numeric", UdtName: "numeric", MaxLength: -1, Nullable: true},
+				},
+			},
+			{
+				Name:         "daily_totals",
+				Materialized: true,
+				Columns: []schema.Column{
+					{Name: "day", Position: 1, DataType: "date", MaxLength: -1, Nullable: true},
+					{Name: "total", Position: 2, DataType: "double precision", MaxLength: -1, Nullable: true},
+				},
+			},
+		},
+		Functions: []schema.Function{
+			{
+				Name: "close_account", SpecificName: "close_account_16422", Comment: "Closes the account",
+				ReturnDataType: "void", ReturnUdtName: "void",
+				Parameters: []schema.Parameter{{Name: "p_account_id", Mode: schema.PARAMETER_MODE_IN, DataType: "bigint"}},
+			},
+			{
+				Name: "open_accounts", SpecificName: "open_accounts_16424",
+				ReturnDataType: "USER-DEFINED", ReturnUdtName: "accounts", ReturnsSet: true,
+			},
+		},
+	}
+}
+
+// introspectFake runs Introspect against a fake server answering the queries
+func introspectFake(t *testing.T, queries []fakeQuery, opts schema.Options) (*schema.Database, error) {
+
+	server, err := pgmock.NewServer(&fakeServer{queries: queries})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ServeOne()
+	}()
+
+	db, err := sql.Open("pgx", fmt.Sprintf("postgres://pgtogogen@%s/bank?sslmode=disable", server.Addr()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fake server serves a single connection
+	db.SetMaxOpenConns(1)
+
+	database, introspectErr := schema.LiveSource{DB: db, Options: opts}.ReadSchema(context.Background())
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Fatalf("fake server error: %s", err)
+	}
+
+	return database, introspectErr
+}
+
+func TestIntrospect(t *testing.T) {
+
+	for _, jobs := range []int{1, 4} {
+		t.Run("jobs="+strconv.Itoa(jobs), func(t *testing.T) {
+
+			database, err := introspectFake(t, bankQueries(), schema.Options{Schema: "public", Catalog: "bank", Functions: true, Jobs: jobs})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, _ := json.MarshalIndent(database, "", "  ")
+			want, _ := json.MarshalIndent(bankSchema(), "", "  ")
+			if string(got) != string(want) {
+				t.Errorf("unexpected schema:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestIntrospectError(t *testing.T) {
+
+	// a server without the functions query
+	queries := bankQueries()
+	queries = queries[:len(queries)-2]
+
+	_, err := introspectFake(t, queries, schema.Options{Schema: "public", Catalog: "bank", Functions: true})
+	if err == nil || !strings.Contains(err.Error(), "CollectFunctions(): error running the functions query") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Package schema reads the structure of a PostgreSQL schema, either from a live database
+// (Introspect), from a DDL file (ParseDDL) or from a JSON fixture (LoadFixture), into a plain
+// model that the generator renders. Source hides which one is used.
+// The model holds what the database reports, without any Go-specific information.
+package schema
+
+import (
+	"sort"
+	"strings"
+)
+
+// Database is a schema, with the tables, views and functions found in it
+type Database struct {
+	Schema string `json:"schema"` // e.g. "public"
+
+	// the server version, zero when the schema comes from a DDL file
+	MajorVersion int `json:"majorVersion"`
+	MinorVersion int `json:"minorVersion"`
+
+	Tables    []Table    `json:"tables"`    // ordered by name
+	Views     []View     `json:"views"`     // the regular and the materialized ones, ordered by name
+	Functions []Function `json:"functions"` // ordered by name, then by parameter types
+
+	// the objects and columns that could not be read and were left out, e.g. the view
+	// columns whose type cannot be inferred from a DDL file
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Table is a base table
+type Table struct {
+	Name    string `json:"name"`
+	Comment string `json:"comment,omitempty"`
+
+	Columns []Column `json:"columns"` // in their ordinal position order
+
+	// the names of the primary key columns, in the key order, empty if there is no primary key
+	PrimaryKey []string `json:"primaryKey,omitempty"`
+
+	// the unique constraints and the unique indexes that are not constraints, ordered by name
+	UniqueConstraints []Constraint `json:"uniqueConstraints,omitempty"`
+}
+
+// View is a view or a materialized view
+type View struct {
+	Name         string `json:"name"`
+	Comment      string `json:"comment,omitempty"`
+	Materialized bool   `json:"materialized,omitempty"`
+
+	Columns []Column `json:"columns"`
+}
+
+// Column is a table or view column, described the way information_schema.columns does it
+type Column struct {
+	Name     string `json:"name"`
+	Comment  string `json:"comment,omitempty"`
+	Position int    `json:"position"` // the 1-based ordinal position
+
+	DataType   string `json:"dataType"`             // e.g. "character varying", "ARRAY", "USER-DEFINED"
+	UdtName    string `json:"udtName"`              // e.g. "varchar", "_int4"
+	DomainName string `json:"domainName,omitempty"` // the domain the column type comes from, if any
+	MaxLength  int    `json:"maxLength"`            // character_maximum_length, -1 if not applicable
+
+	Nullable bool `json:"nullable"`
+
+	// the default value expression, nil if there is no default
+	Default *string `json:"default,omitempty"`
+}
+
+// Constraint is a unique constraint or a unique index
+type Constraint struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+}
+
+// Function is a function, with its return type and parameters
+type Function struct {
+	Name         string `json:"name"`
+	SpecificName string `json:"specificName"` // unique among the overloads of the same function
+	Comment      string `json:"comment,omitempty"`
+
+	ReturnDataType string `json:"returnDataType"`          // e.g. "integer", "void", "USER-DEFINED"
+	ReturnUdtName  string `json:"returnUdtName,omitempty"` // for USER-DEFINED, the table or view returned
+	ReturnsSet     bool   `json:"returnsSet,omitempty"`
+
+	Parameters []Parameter `json:"parameters,omitempty"`
+}
+
+// the function parameter modes, as information_schema.parameters reports them
+const (
+	PARAMETER_MODE_IN       = "IN"
+	PARAMETER_MODE_OUT      = "OUT"
+	PARAMETER_MODE_INOUT    = "INOUT"
+	PARAMETER_MODE_VARIADIC = "VARIADIC"
+)
+
+// Parameter is a function parameter
+type Parameter struct {
+	Name     string `json:"name"` // empty for the unnamed parameters
+	Mode     string `json:"mode"` // one of the PARAMETER_MODE_ values
+	DataType string `json:"dataType"`
+	Default  string `json:"default,omitempty"` // the default value expression, empty if there is none
+}
+
+// Table returns the table with the given name, or nil
+func (db *Database) Table(name string) *Table {
+	for i := range db.Tables {
+		if db.Tables[i].Name == name {
+			return &db.Tables[i]
+		}
+	}
+	return nil
+}
+
+// View returns the view with the given name, or nil
+func (db *Database) View(name string) *View {
+	for i := range db.Views {
+		if db.Views[i].Name == name {
+			return &db.Views[i]
+		}
+	}
+	return nil
+}
+
+// Sort puts the tables, the views, the functions and the unique constraints of each table in name
+// order, so that the generated code does not depend on the order the server or the DDL file lists
+// them in. The columns and the parameters keep their ordinal order. The overloads of a function
+// are ordered by their parameter types. Introspect and ParseDDL return sorted schemas.
+func (db *Database) Sort() {
+
+	sort.SliceStable(db.Tables, func(i, j int) bool { return db.Tables[i].Name < db.Tables[j].Name })
+	for i := range db.Tables {
+		constraints := db.Tables[i].UniqueConstraints
+		sort.SliceStable(constraints, func(i, j int) bool { return constraints[i].Name < constraints[j].Name })
+	}
+
+	sort.SliceStable(db.Views, func(i, j int) bool { return db.Views[i].Name < db.Views[j].Name })
+
+	sort.SliceStable(db.Functions, func(i, j int) bool {
+		if db.Functions[i].Name != db.Functions[j].Name {
+			return db.Functions[i].Name < db.Functions[j].Name
+		}
+		return db.Functions[i].signature() < db.Functions[j].signature()
+	})
+}
+
+// signature returns the parameter types of the function, e.g. "integer,text"
+func (f *Function) signature() string {
+
+	dataTypes := make([]string, len(f.Parameters))
+	for i, parameter := range f.Parameters {
+		dataTypes[i] = parameter.Mode + " " + parameter.DataType
+	}
+
+	return strings.Join(dataTypes, ",")
+}
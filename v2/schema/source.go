@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Source is where a schema is read from. LiveSource introspects a database, DDLSource parses
+// a DDL script and MemorySource hands out a schema held in memory, e.g. a fixture loaded with
+// LoadFixture, which lets the generator run and be tested without a database.
+type Source interface {
+	ReadSchema(ctx context.Context) (*Database, error)
+}
+
+// LiveSource reads the schema from a live database with Introspect
+type LiveSource struct {
+	DB      Queryer
+	Options Options
+}
+
+// ReadSchema introspects the database
+func (s LiveSource) ReadSchema(ctx context.Context) (*Database, error) {
+	return Introspect(ctx, s.DB, s.Options)
+}
+
+// DDLSource reads the schema from a DDL script with ParseDDL
+type DDLSource struct {
+	DDL    string // e.g. the output of pg_dump --schema-only
+	Schema string // e.g. "public"
+}
+
+// ReadSchema parses the DDL script
+func (s DDLSource) ReadSchema(ctx context.Context) (*Database, error) {
+	return ParseDDL(s.DDL, s.Schema)
+}
+
+// MemorySource hands out a copy of a schema held in memory, sorted, so that the callers
+// may change what they get without affecting the next ones
+type MemorySource struct {
+	Database *Database
+}
+
+// ReadSchema returns a sorted copy of the schema
+func (s MemorySource) ReadSchema(ctx context.Context) (*Database, error) {
+
+	if s.Database == nil {
+		return nil, fmt.Errorf("MemorySource.ReadSchema(): no schema")
+	}
+
+	encoded, err := json.Marshal(s.Database)
+	if err != nil {
+		return nil, fmt.Errorf("MemorySource.ReadSchema(): error copying the schema: %s", err)
+	}
+
+	db := &Database{}
+	if err := json.Unmarshal(encoded, db); err != nil {
+		return nil, fmt.Errorf("MemorySource.ReadSchema(): error copying the schema: %s", err)
+	}
+	db.Sort()
+
+	return db, nil
+}
+
+// LoadFixture reads a schema saved as JSON, the encoding of Database, e.g. by WriteFixture.
+// The columns and the functions are described the way Introspect reports them, e.g. an integer
+// array column has the "ARRAY" data type and the "_int4" udt name.
+func LoadFixture(path string) (*Database, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LoadFixture(): error reading %s: %s", path, err)
+	}
+
+	db := &Database{}
+	if err := json.Unmarshal(content, db); err != nil {
+		return nil, fmt.Errorf("LoadFixture(): error decoding %s: %s", path, err)
+	}
+	db.Sort()
+
+	return db, nil
+}
+
+// WriteFixture saves the schema as JSON, for LoadFixture to read it back
+func WriteFixture(db *Database, path string) error {
+
+	content, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return fmt.Errorf("WriteFixture(): error encoding the schema: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, append(content, '\n'), 0644); err != nil {
+		return fmt.Errorf("WriteFixture(): error writing %s: %s", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package schema
+
+import "sync"
+
+// forEach calls fn for each index from 0 to n-1, on up to jobs goroutines at a time, and returns
+// the error of the lowest index, so that the outcome does not depend on the scheduling.
+// With jobs <= 1, the calls are made in order and the first error stops them.
+func forEach(jobs, n int, fn func(i int) error) error {
+
+	if jobs <= 1 || n <= 1 {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if jobs > n {
+		jobs = n
+	}
+
+	errs := make([]error, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
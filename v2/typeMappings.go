@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+// TypeMappingFlags collects the repeatable -type flag values, each one in the form
+// key=[import/path.]Type, where the key is a database type, a domain or table.column.
+// For example: -type=numeric=github.com/shopspring/decimal.Decimal
+type TypeMappingFlags []string
+
+func (f *TypeMappingFlags) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *TypeMappingFlags) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("expected key=[import/path.]Type, got %q", value)
+	}
+	*f = append(*f, value)
+	return nil
+}
+
+// CollectTypeMappings builds the type registry from the configuration file and
+// the -type flags. Later sources win for the same key: the column "goType" settings,
+// then the "types" section, then the flags.
+func (t *ToolOptions) CollectTypeMappings(typeFlags TypeMappingFlags) error {
+
+	t.TypeMappings = gen.TypeMappings{}
+
+	if t.Config != nil {
+
+		// sorted, so that any error is reported deterministically
+		for _, tableName := range sortedKeys(t.Config.Tables) {
+			for _, columnName := range sortedKeys(t.Config.Tables[tableName].Columns) {
+				columnConfig := t.Config.Tables[tableName].Columns[columnName]
+				if columnConfig.GoType == "" {
+					continue
+				}
+				mapping := &gen.TypeMapping{GoType: columnConfig.GoType, Import: columnConfig.GoImport}
+				if err := t.TypeMappings.Add(tableName+"."+columnName, mapping); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, key := range sortedKeys(t.Config.Types) {
+			mapping := t.Config.Types[key]
+			if mapping == nil {
+				return fmt.Errorf("type %s has no settings", key)
+			}
+			if err := t.TypeMappings.Add(key, mapping); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, typeFlag := range typeFlags {
+		separatorPos := strings.Index(typeFlag, "=")
+		goType, goImport := gen.ParseGoTypeSpec(typeFlag[separatorPos+1:])
+		if err := t.TypeMappings.Add(typeFlag[:separatorPos], &gen.TypeMapping{GoType: goType, Import: goImport}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
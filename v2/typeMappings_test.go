@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/silviucm/pgtogogen/v2/gen"
+)
+
+func TestCollectTypeMappings(t *testing.T) {
+
+	options := &ToolOptions{Config: &ProjectConfig{
+		Tables: map[string]*gen.TableConfig{
+			"orders": {Columns: map[string]*gen.ColumnConfig{
+				"total":    {GoType: "decimal.Decimal", GoImport: "github.com/shopspring/decimal"},
+				"currency": {GoType: "Currency"},
+				"note":     {JSONTag: "comment"},
+			}},
+		},
+		Types: map[string]*gen.TypeMapping{
+			"orders.currency": {GoType: "string"},
+			"uuid":            {GoType: "uuid.UUID", Import: "github.com/google/uuid"},
+		},
+	}}
+
+	typeFlags := TypeMappingFlags{"uuid=github.com/gofrs/uuid.UUID", "money=int64"}
+	if err := options.CollectTypeMappings(typeFlags); err != nil {
+		t.Fatal(err)
+	}
+
+	// the column settings, then the types section, then the flags
+	want := map[string]gen.TypeMapping{
+		"orders.total":    {GoType: "decimal.Decimal", Import: "github.com/shopspring/decimal"},
+		"orders.currency": {GoType: "string"},
+		"uuid":            {GoType: "uuid.UUID", Import: "github.com/gofrs/uuid"},
+		"money":           {GoType: "int64"},
+	}
+	if len(options.TypeMappings) != len(want) {
+		t.Errorf("got %d type mappings, want %d", len(options.TypeMappings), len(want))
+	}
+	for key, wantMapping := range want {
+		mapping := options.TypeMappings[key]
+		if mapping == nil {
+			t.Errorf("no type mapping for %s", key)
+			continue
+		}
+		if mapping.GoType != wantMapping.GoType || mapping.Import != wantMapping.Import {
+			t.Errorf("%s: got %s from %q, want %s from %q", key, mapping.GoType, mapping.Import, wantMapping.GoType, wantMapping.Import)
+		}
+	}
+	if options.TypeMappings["orders.total"].NullableType != "decimal.NullDecimal" {
+		t.Errorf("the decimal preset was not applied to the column setting")
+	}
+}
+
+func TestCollectTypeMappingsErrors(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		config    *ProjectConfig
+		typeFlags TypeMappingFlags
+		want      string
+	}{
+		{"type without settings", &ProjectConfig{Types: map[string]*gen.TypeMapping{"numeric": nil}}, nil, "type numeric has no settings"},
+		{"type without a Go type", &ProjectConfig{Types: map[string]*gen.TypeMapping{"numeric": {Import: "github.com/shopspring/decimal"}}}, nil, "the Go type is required"},
+		{"incomplete nullable type", &ProjectConfig{Types: map[string]*gen.TypeMapping{"numeric": {GoType: "Amount", NullableType: "NullAmount"}}}, nil, "also needs scanValue"},
+		{"flag without a Go type", nil, TypeMappingFlags{"numeric="}, "type mapping numeric: the Go type is required"},
+		{"flag without a key", nil, TypeMappingFlags{"=int64"}, "the key is required"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			options := &ToolOptions{Config: test.config}
+			err := options.CollectTypeMappings(test.typeFlags)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got error %v, want %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestTypeMappingFlags(t *testing.T) {
+
+	var typeFlags TypeMappingFlags
+	if err := typeFlags.Set("numeric=github.com/shopspring/decimal.Decimal"); err != nil {
+		t.Fatal(err)
+	}
+	if err := typeFlags.Set("numeric"); err == nil {
+		t.Errorf("a value without = should be rejected")
+	}
+	if len(typeFlags) != 1 || typeFlags.String() != "numeric=github.com/shopspring/decimal.Decimal" {
+		t.Errorf("got %v", typeFlags)
+	}
+}